@@ -0,0 +1,186 @@
+// Command campaigns imports and exports campaigns of advertiser through advertising service API.
+//
+//	campaigns import -advertiser <id> [-url <server url>] [-format csv|ndjson] [-atomic] [file]
+//	campaigns export -advertiser <id> [-url <server url>] [-format csv|ndjson] [-o file]
+//
+// Import reads stdin if file is not given and prints report of every row,
+// command exits with code 1 if some rows are not imported
+package main
+
+import (
+	api "advertising/pkg/ogen/advertising-service"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+)
+
+const defaultServerUrl = "http://localhost:8080"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	ctx := context.Background()
+
+	var err error
+	switch os.Args[1] {
+	case "import":
+		err = importCampaigns(ctx, os.Args[2:])
+	case "export":
+		err = exportCampaigns(ctx, os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: campaigns import|export -advertiser <id> [flags]")
+	os.Exit(2)
+}
+
+func importCampaigns(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	serverUrl := flags.String("url", defaultServerUrl, "advertising service url")
+	advertiser := flags.String("advertiser", "", "advertiser id")
+	format := flags.String("format", "", "file format: csv or ndjson, detected by file extension if not set")
+	atomic := flags.Bool("atomic", false, "import all campaigns or none of them")
+	flags.Parse(args)
+
+	advertiserId, err := uuid.Parse(*advertiser)
+	if err != nil {
+		return fmt.Errorf("invalid advertiser id: %w", err)
+	}
+
+	var file io.Reader = os.Stdin
+	if flags.Arg(0) != "" {
+		f, err := os.Open(flags.Arg(0))
+		if err != nil {
+			return fmt.Errorf("open file: %w", err)
+		}
+		defer f.Close()
+		file = f
+
+		if *format == "" {
+			switch filepath.Ext(flags.Arg(0)) {
+			case ".ndjson", ".jsonl":
+				*format = "ndjson"
+			}
+		}
+	}
+
+	var req api.ImportCampaignsReq
+	switch *format {
+	case "", "csv":
+		req = &api.ImportCampaignsReqTextCsv{Data: file}
+	case "ndjson":
+		req = &api.ImportCampaignsReqApplicationXNdjson{Data: file}
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	client, err := api.NewClient(*serverUrl)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	res, err := client.ImportCampaigns(ctx, req, api.ImportCampaignsParams{
+		AdvertiserId: advertiserId,
+		Atomic:       api.NewOptBool(*atomic),
+	})
+	if err != nil {
+		return fmt.Errorf("import campaigns: %w", err)
+	}
+
+	switch res := res.(type) {
+	case *api.CampaignsImportReport:
+		for _, row := range res.Rows {
+			switch {
+			case row.CampaignID.IsSet():
+				fmt.Printf("row %d: created %s\n", row.Row, row.CampaignID.Value)
+			case row.Error.IsSet():
+				fmt.Printf("row %d: %s\n", row.Row, row.Error.Value)
+			default:
+				fmt.Printf("row %d: not created\n", row.Row)
+			}
+		}
+		fmt.Printf("created: %d, failed: %d\n", res.Created, res.Failed)
+		if res.Failed > 0 {
+			os.Exit(1)
+		}
+		return nil
+	case *api.Response400:
+		return fmt.Errorf("invalid file: %s", res.Message.Or("bad request"))
+	case *api.Response404:
+		return errors.New("advertiser not found")
+	}
+
+	return fmt.Errorf("unexpected response %T", res)
+}
+
+func exportCampaigns(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	serverUrl := flags.String("url", defaultServerUrl, "advertising service url")
+	advertiser := flags.String("advertiser", "", "advertiser id")
+	format := flags.String("format", "csv", "file format: csv or ndjson")
+	output := flags.String("o", "", "output file, stdout if not set")
+	flags.Parse(args)
+
+	advertiserId, err := uuid.Parse(*advertiser)
+	if err != nil {
+		return fmt.Errorf("invalid advertiser id: %w", err)
+	}
+
+	client, err := api.NewClient(*serverUrl)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	res, err := client.ExportCampaigns(ctx, api.ExportCampaignsParams{
+		AdvertiserId: advertiserId,
+		Format:       api.NewOptExportCampaignsFormat(api.ExportCampaignsFormat(*format)),
+	})
+	if err != nil {
+		return fmt.Errorf("export campaigns: %w", err)
+	}
+
+	var data io.Reader
+	switch res := res.(type) {
+	case *api.ExportCampaignsOKTextCsv:
+		data = res.Data
+	case *api.ExportCampaignsOKApplicationXNdjson:
+		data = res.Data
+	case *api.Response400:
+		return fmt.Errorf("invalid request: %s", res.Message.Or("bad request"))
+	case *api.Response404:
+		return errors.New("advertiser not found")
+	default:
+		return fmt.Errorf("unexpected response %T", res)
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("create file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if _, err := io.Copy(out, data); err != nil {
+		return fmt.Errorf("write campaigns: %w", err)
+	}
+
+	return nil
+}
@@ -60,25 +60,58 @@ func main() {
 
 	timeRepo := redis.NewTimeRepo(rdb)
 	clientsRepo := postgres.NewClientRepo(db)
+	locationsRepo := postgres.NewLocationsRepo(db)
 	advertisersRepo := postgres.NewAdvertiserRepo(db)
 	mlScoreRepo := postgres.NewMlScoresRepo(db)
 	campaignsRepo := postgres.NewCampaignsRepo(db)
+	campaignHistoryRepo := postgres.NewCampaignHistoryRepo(db)
+	campaignTemplatesRepo := postgres.NewCampaignTemplatesRepo(db)
 	adsRepo := postgres.NewAdsRepo(db)
 	clientActionsRepo := postgres.NewClientActionsRepo(db)
 	statsRepo := postgres.NewStatsRepo(db)
 	staticRepo := minio.NewStaticRepo(minioCli, cfg.StaticBucket)
 
-	timeService := service.NewTimeService(timeRepo)
-	advertisersService := service.NewAdvertisersService(advertisersRepo, mlScoreRepo)
-	campaignsService := service.NewCampaignsService(campaignsRepo, advertisersRepo, timeRepo, staticRepo, cfg.StaticBaseUrl)
-	adsService := service.NewAdsService(adsRepo, clientsRepo, campaignsRepo, clientActionsRepo, timeRepo)
-	statsService := service.NewStatsService(statsRepo, campaignsRepo, advertisersRepo)
+	ranker, err := service.NewRanker(cfg.RankingConfig.Strategy, service.RankingWeights{
+		Profit: cfg.RankingConfig.ProfitWeight,
+		Score:  cfg.RankingConfig.ScoreWeight,
+		Limits: cfg.RankingConfig.LimitsWeight,
+		Pacing: cfg.RankingConfig.PacingWeight,
+	})
+	if err != nil {
+		l.Fatal("get ranker", zap.Error(err))
+	}
+
+	explorer, err := service.NewExplorer(cfg.ExplorationConfig.Strategy, service.ExplorationParams{
+		Traffic:        cfg.ExplorationConfig.Traffic,
+		MaxImpressions: cfg.ExplorationConfig.MaxImpressions,
+	}, cfg.ExplorationConfig.Seed)
+	if err != nil {
+		l.Fatal("get explorer", zap.Error(err))
+	}
+
+	pricer, err := service.NewPricer(cfg.PricingMode)
+	if err != nil {
+		l.Fatal("get pricer", zap.Error(err))
+	}
+
 	aiService := service.NewAIService(chat)
 
+	moderator, err := service.NewModerator(cfg.ModerationConfig.Mode, aiService, cfg.ModerationConfig.Timeout)
+	if err != nil {
+		l.Fatal("get moderator", zap.Error(err))
+	}
+
+	timeService := service.NewTimeService(timeRepo, campaignsRepo)
+	advertisersService := service.NewAdvertisersService(advertisersRepo, mlScoreRepo, timeRepo)
+	campaignsService := service.NewCampaignsService(campaignsRepo, advertisersRepo, timeRepo, staticRepo, campaignHistoryRepo, campaignTemplatesRepo, moderator, cfg.StaticBaseUrl)
+	adsService := service.NewAdsService(adsRepo, clientsRepo, campaignsRepo, clientActionsRepo, timeRepo, ranker, explorer, pricer)
+	statsService := service.NewStatsService(statsRepo, campaignsRepo, advertisersRepo)
+
 	adsHandler := handlers.NewAdsHandler(adsService)
 	advertisersHandler := handlers.NewAdvertisersHandler(advertisersService)
 	campaignsHandler := handlers.NewCampaignsHandler(campaignsService)
 	clietnsHandler := handlers.NewClientsHandler(clientsRepo)
+	locationsHandler := handlers.NewLocationsHandler(locationsRepo)
 	statisticsHandler := handlers.NewStatsHandler(statsService)
 	timeHandler := handlers.NewTimeHandler(timeService)
 	staticHandler := handlers.NewStaticHandler(staticRepo)
@@ -86,8 +119,8 @@ func main() {
 
 	handler := rest.NewHandler(
 		adsHandler, advertisersHandler, campaignsHandler,
-		clietnsHandler, statisticsHandler, timeHandler,
-		aiHandler,
+		clietnsHandler, locationsHandler, statisticsHandler,
+		timeHandler, aiHandler,
 	)
 
 	server, err := rest.NewServer(handler, staticHandler, l)
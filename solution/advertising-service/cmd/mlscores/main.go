@@ -0,0 +1,87 @@
+// Command mlscores loads ml scores from file through advertising service API.
+//
+//	mlscores [-url <server url>] [-format csv|ndjson] [file]
+//
+// File may be compressed by gzip, stdin is read if file is not given.
+// Command prints rows which are not loaded and exits with code 1 if there are any
+package main
+
+import (
+	api "advertising/pkg/ogen/advertising-service"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const defaultServerUrl = "http://localhost:8080"
+
+func main() {
+	serverUrl := flag.String("url", defaultServerUrl, "advertising service url")
+	format := flag.String("format", "", "file format: csv or ndjson, detected by file extension if not set")
+	flag.Parse()
+
+	err := loadMLScores(context.Background(), *serverUrl, *format, flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadMLScores(ctx context.Context, serverUrl, format, path string) error {
+	var file io.Reader = os.Stdin
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("open file: %w", err)
+		}
+		defer f.Close()
+		file = f
+
+		if format == "" {
+			switch filepath.Ext(strings.TrimSuffix(path, ".gz")) {
+			case ".ndjson", ".jsonl":
+				format = "ndjson"
+			}
+		}
+	}
+
+	var req api.LoadMLScoresReq
+	switch format {
+	case "", "csv":
+		req = &api.LoadMLScoresReqTextCsv{Data: file}
+	case "ndjson":
+		req = &api.LoadMLScoresReqApplicationXNdjson{Data: file}
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	client, err := api.NewClient(serverUrl)
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+
+	res, err := client.LoadMLScores(ctx, req)
+	if err != nil {
+		return fmt.Errorf("load ml scores: %w", err)
+	}
+
+	switch res := res.(type) {
+	case *api.MLScoresLoadReport:
+		for _, loadErr := range res.Errors {
+			fmt.Printf("row %d: %s\n", loadErr.Row, loadErr.Error)
+		}
+		fmt.Printf("loaded: %d, failed: %d\n", res.Loaded, res.Failed)
+		if res.Failed > 0 {
+			os.Exit(1)
+		}
+		return nil
+	case *api.Response400:
+		return fmt.Errorf("invalid file: %s", res.Message.Or("bad request"))
+	}
+
+	return fmt.Errorf("unexpected response %T", res)
+}
@@ -99,7 +99,7 @@ func seedAdvertisers(ctx context.Context, db *sqlx.DB, n int) ([]models.Advertis
 		advertisers = append(advertisers, generateAdvertiser())
 	}
 
-	_, err := ar.UpsertAdvertisers(ctx, advertisers)
+	_, err := ar.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
 	if err != nil {
 		return nil, err
 	}
@@ -156,7 +156,7 @@ func seedCampaigns(ctx context.Context, db *sqlx.DB, n int, advertisers []models
 	for range n {
 		advertiserId := advertisers[gofakeit.IntRange(0, len(advertisers)-1)].Id
 		campaign := generateCampaign(advertiserId)
-		campaignId, err := cr.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+		campaignId, err := cr.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 		if err != nil {
 			return nil, err
 		}
@@ -200,10 +200,12 @@ func seedImpressions(ctx context.Context, db *sqlx.DB, clients []models.Client,
 			date := gofakeit.IntRange(campaign.StartDate, campaign.EndDate)
 
 			impression := models.Impression{
-				ClientId:   client.Id,
-				CampaignId: campaign.Id,
-				Date:       date,
-				Profit:     campaign.CostPerImpression,
+				ClientId:    client.Id,
+				CampaignId:  campaign.Id,
+				Date:        date,
+				Profit:      campaign.CostPerImpression,
+				ClickPrice:  campaign.CostPerClick,
+				PricingMode: models.PricingModeFirstPrice,
 			}
 
 			err := clientActionsRepo.RecordImpression(ctx, impression)
@@ -244,14 +246,20 @@ func seedClicks(ctx context.Context, db *sqlx.DB, impressions []models.Impressio
 
 		date := gofakeit.IntRange(impression.Date, campaign.EndDate)
 
+		recorded, _, err := clientActionsRepo.CheckImpressed(ctx, impression.ClientId, impression.CampaignId)
+		if err != nil {
+			return nil, err
+		}
+
 		click := models.Click{
-			ClientId:   impression.ClientId,
-			CampaignId: impression.CampaignId,
-			Date:       date,
-			Profit:     campaign.CostPerClick,
+			ImpressionId: recorded.Id,
+			ClientId:     impression.ClientId,
+			CampaignId:   impression.CampaignId,
+			Date:         date,
+			Profit:       recorded.ClickPrice,
 		}
 
-		err := clientActionsRepo.RecordClick(ctx, click)
+		err = clientActionsRepo.RecordClick(ctx, click)
 		if err != nil {
 			return nil, err
 		}
@@ -5,19 +5,44 @@ import (
 	"advertising/pkg/openai"
 	"advertising/pkg/postgres"
 	"advertising/pkg/redis"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	ServerPort     int    `env:"SERVER_PORT" env-default:"8080"`
-	LogLevel       string `env:"LOG_LEVEL" env-default:"info"`
-	StaticBucket   string `env:"MINIO_STATIC_BUCKET" env-default:"static"`
-	StaticBaseUrl  string `env:"STATIC_BASE_URL" env-default:"http://localhost:8080/static"`
-	PostgresConfig postgres.Config
-	RedisConfig    redis.Config
-	MinioConfig    minio.Config
-	OpenAIConfig   openai.Config
+	ServerPort        int    `env:"SERVER_PORT" env-default:"8080"`
+	LogLevel          string `env:"LOG_LEVEL" env-default:"info"`
+	StaticBucket      string `env:"MINIO_STATIC_BUCKET" env-default:"static"`
+	StaticBaseUrl     string `env:"STATIC_BASE_URL" env-default:"http://localhost:8080/static"`
+	PostgresConfig    postgres.Config
+	RedisConfig       redis.Config
+	MinioConfig       minio.Config
+	OpenAIConfig      openai.Config
+	RankingConfig     RankingConfig
+	ExplorationConfig ExplorationConfig
+	PricingMode       string `env:"PRICING_MODE" env-default:"first_price"`
+	ModerationConfig  ModerationConfig
+}
+
+type ModerationConfig struct {
+	Mode    string        `env:"MODERATION_MODE" env-default:"ai"`
+	Timeout time.Duration `env:"MODERATION_TIMEOUT" env-default:"10s"`
+}
+
+type RankingConfig struct {
+	Strategy     string  `env:"RANKING_STRATEGY" env-default:"weighted"`
+	ProfitWeight float64 `env:"RANKING_PROFIT_WEIGHT" env-default:"1"`
+	ScoreWeight  float64 `env:"RANKING_SCORE_WEIGHT" env-default:"0.25"`
+	LimitsWeight float64 `env:"RANKING_LIMITS_WEIGHT" env-default:"0.1"`
+	PacingWeight float64 `env:"RANKING_PACING_WEIGHT" env-default:"0"`
+}
+
+type ExplorationConfig struct {
+	Strategy       string  `env:"EXPLORATION_STRATEGY" env-default:"none"`
+	Traffic        float64 `env:"EXPLORATION_TRAFFIC" env-default:"0.1"`
+	MaxImpressions int     `env:"EXPLORATION_MAX_IMPRESSIONS" env-default:"100"`
+	Seed           uint64  `env:"EXPLORATION_SEED" env-default:"0"`
 }
 
 func Get() (Config, error) {
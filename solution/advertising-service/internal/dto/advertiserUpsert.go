@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"advertising/advertising-service/internal/models"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// AdvertiserUpsert is advertiser created or updated by bulk upsert.
+// Budgets which are not set keep values of existing advertiser, nil budget which is set removes the cap
+type AdvertiserUpsert struct {
+	Advertiser     models.Advertiser
+	DailyBudgetSet bool
+	TotalBudgetSet bool
+}
+
+// NewAdvertisersUpsert returns upsert of advertisers with all fields set
+func NewAdvertisersUpsert(advertisers []models.Advertiser) []AdvertiserUpsert {
+	res := make([]AdvertiserUpsert, 0, len(advertisers))
+	for _, advertiser := range advertisers {
+		res = append(res, AdvertiserUpsert{
+			Advertiser:     advertiser,
+			DailyBudgetSet: true,
+			TotalBudgetSet: true,
+		})
+	}
+
+	return res
+}
+
+// DeletedAdvertisersError is returned when upsert contains deleted advertisers, nothing is upserted then
+type DeletedAdvertisersError struct {
+	Ids []uuid.UUID
+}
+
+func (e DeletedAdvertisersError) Error() string {
+	ids := make([]string, 0, len(e.Ids))
+	for _, id := range e.Ids {
+		ids = append(ids, id.String())
+	}
+
+	return fmt.Sprintf("%s: %s", models.ErrAdvertiserDeleted, strings.Join(ids, ", "))
+}
+
+func (e DeletedAdvertisersError) Unwrap() error {
+	return models.ErrAdvertiserDeleted
+}
@@ -0,0 +1,15 @@
+package dto
+
+import "advertising/advertising-service/internal/models"
+
+type AdvertisersListParams struct {
+	PaginationParams
+	// Name limits listing to advertisers which names contain it case-insensitively
+	Name *string
+}
+
+type AdvertisersPage struct {
+	Advertisers []models.Advertiser
+	// Total is a count of listed advertisers without pagination
+	Total int
+}
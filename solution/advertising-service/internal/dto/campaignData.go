@@ -1,52 +1,80 @@
 package dto
 
-import "advertising/advertising-service/internal/models"
+import (
+	"advertising/advertising-service/internal/models"
+
+	"github.com/lib/pq"
+)
 
 type CampaignData struct {
-	ImpressionsLimit  int            `db:"impressions_limit"`
-	ClicksLimit       int            `db:"clicks_limit"`
-	CostPerImpression float64        `db:"cost_per_impression"`
-	CostPerClick      float64        `db:"cost_per_click"`
-	AdTitle           string         `db:"ad_title"`
-	AdText            string         `db:"ad_text"`
-	StartDate         int            `db:"start_date"`
-	EndDate           int            `db:"end_date"`
-	Gender            *models.Gender `db:"gender"`
-	AgeFrom           *int           `db:"age_from"`
-	AgeTo             *int           `db:"age_to"`
-	Location          *string        `db:"location"`
+	ImpressionsLimit        int                     `db:"impressions_limit"`
+	ClicksLimit             int                     `db:"clicks_limit"`
+	CostPerImpression       float64                 `db:"cost_per_impression"`
+	CostPerClick            float64                 `db:"cost_per_click"`
+	AdTitle                 string                  `db:"ad_title"`
+	AdText                  string                  `db:"ad_text"`
+	StartDate               int                     `db:"start_date"`
+	EndDate                 int                     `db:"end_date"`
+	Gender                  *models.Gender          `db:"gender"`
+	AgeFrom                 *int                    `db:"age_from"`
+	AgeTo                   *int                    `db:"age_to"`
+	Location                *string                 `db:"location"`
+	Locations               pq.StringArray          `db:"locations"`
+	TargetingRules          *models.TargetingRule   `db:"targeting_rules"`
+	FrequencyCapImpressions *int                    `db:"frequency_cap_impressions"`
+	FrequencyCapDays        *int                    `db:"frequency_cap_days"`
+	Pacing                  models.Pacing           `db:"pacing"`
+	Status                  models.CampaignStatus   `db:"status"`
+	ModerationStatus        models.ModerationStatus `db:"moderation_status"`
+	FlaggedPhrases          pq.StringArray          `db:"flagged_phrases"`
 }
 
 func CampaignDataFromCampaign(campaign models.Campaign) CampaignData {
 	return CampaignData{
-		ImpressionsLimit:  campaign.ImpressionsLimit,
-		ClicksLimit:       campaign.ClicksLimit,
-		CostPerImpression: campaign.CostPerImpression,
-		CostPerClick:      campaign.CostPerClick,
-		AdTitle:           campaign.AdTitle,
-		AdText:            campaign.AdText,
-		StartDate:         campaign.StartDate,
-		EndDate:           campaign.EndDate,
-		Gender:            campaign.Gender,
-		AgeFrom:           campaign.AgeFrom,
-		AgeTo:             campaign.AgeTo,
-		Location:          campaign.Location,
+		ImpressionsLimit:        campaign.ImpressionsLimit,
+		ClicksLimit:             campaign.ClicksLimit,
+		CostPerImpression:       campaign.CostPerImpression,
+		CostPerClick:            campaign.CostPerClick,
+		AdTitle:                 campaign.AdTitle,
+		AdText:                  campaign.AdText,
+		StartDate:               campaign.StartDate,
+		EndDate:                 campaign.EndDate,
+		Gender:                  campaign.Gender,
+		AgeFrom:                 campaign.AgeFrom,
+		AgeTo:                   campaign.AgeTo,
+		Location:                campaign.Location,
+		Locations:               campaign.Locations,
+		TargetingRules:          campaign.TargetingRules,
+		FrequencyCapImpressions: campaign.FrequencyCapImpressions,
+		FrequencyCapDays:        campaign.FrequencyCapDays,
+		Pacing:                  campaign.Pacing,
+		Status:                  campaign.Status,
+		ModerationStatus:        campaign.ModerationStatus,
+		FlaggedPhrases:          campaign.FlaggedPhrases,
 	}
 }
 
 func (cd CampaignData) ToCampaign() models.Campaign {
 	return models.Campaign{
-		ImpressionsLimit:  cd.ImpressionsLimit,
-		ClicksLimit:       cd.ClicksLimit,
-		CostPerImpression: cd.CostPerImpression,
-		CostPerClick:      cd.CostPerClick,
-		AdTitle:           cd.AdTitle,
-		AdText:            cd.AdText,
-		StartDate:         cd.StartDate,
-		EndDate:           cd.EndDate,
-		Gender:            cd.Gender,
-		AgeFrom:           cd.AgeFrom,
-		AgeTo:             cd.AgeTo,
-		Location:          cd.Location,
+		ImpressionsLimit:        cd.ImpressionsLimit,
+		ClicksLimit:             cd.ClicksLimit,
+		CostPerImpression:       cd.CostPerImpression,
+		CostPerClick:            cd.CostPerClick,
+		AdTitle:                 cd.AdTitle,
+		AdText:                  cd.AdText,
+		StartDate:               cd.StartDate,
+		EndDate:                 cd.EndDate,
+		Gender:                  cd.Gender,
+		AgeFrom:                 cd.AgeFrom,
+		AgeTo:                   cd.AgeTo,
+		Location:                cd.Location,
+		Locations:               cd.Locations,
+		TargetingRules:          cd.TargetingRules,
+		FrequencyCapImpressions: cd.FrequencyCapImpressions,
+		FrequencyCapDays:        cd.FrequencyCapDays,
+		Pacing:                  cd.Pacing,
+		Status:                  cd.Status,
+		ModerationStatus:        cd.ModerationStatus,
+		FlaggedPhrases:          cd.FlaggedPhrases,
 	}
 }
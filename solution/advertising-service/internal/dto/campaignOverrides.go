@@ -0,0 +1,37 @@
+package dto
+
+import "advertising/advertising-service/internal/models"
+
+// CampaignOverrides are parameters replaced in cloned campaign or campaign created from template,
+// nil fields are kept as they are
+type CampaignOverrides struct {
+	StartDate        *int
+	EndDate          *int
+	ImpressionsLimit *int
+	ClicksLimit      *int
+	// Draft creates campaign in draft status instead of active
+	Draft bool
+}
+
+// Apply returns campaign data with overridden parameters
+func (co CampaignOverrides) Apply(data CampaignData) CampaignData {
+	if co.StartDate != nil {
+		data.StartDate = *co.StartDate
+	}
+	if co.EndDate != nil {
+		data.EndDate = *co.EndDate
+	}
+	if co.ImpressionsLimit != nil {
+		data.ImpressionsLimit = *co.ImpressionsLimit
+	}
+	if co.ClicksLimit != nil {
+		data.ClicksLimit = *co.ClicksLimit
+	}
+
+	data.Status = models.CampaignStatusActive
+	if co.Draft {
+		data.Status = models.CampaignStatusDraft
+	}
+
+	return data
+}
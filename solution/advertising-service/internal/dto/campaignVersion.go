@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"advertising/advertising-service/internal/models"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// NewCampaignVersion returns campaign state after action to save to campaign history
+// numbered with campaign version. If previous state is given, changed fields are saved too
+func NewCampaignVersion(
+	day int,
+	action models.CampaignAction,
+	campaignWas *models.Campaign,
+	campaign models.Campaign,
+) (models.CampaignVersion, error) {
+	version := models.CampaignVersion{
+		CampaignId: campaign.Id,
+		Version:    campaign.Version,
+		Action:     action,
+		Day:        day,
+		Campaign:   models.CampaignSnapshot(campaign),
+		Changes:    models.CampaignChanges{},
+	}
+
+	if campaignWas != nil {
+		changes, err := diffCampaigns(*campaignWas, campaign)
+		if err != nil {
+			return models.CampaignVersion{}, fmt.Errorf("diff campaigns: %w", err)
+		}
+		version.Changes = changes
+	}
+
+	return version, nil
+}
+
+// diffCampaigns returns changed fields of campaign sorted by field name
+func diffCampaigns(campaignWas, campaign models.Campaign) (models.CampaignChanges, error) {
+	fieldsWas, err := campaignFields(campaignWas)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := campaignFields(campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := models.CampaignChanges{}
+	for _, field := range slices.Sorted(maps.Keys(fields)) {
+		if bytes.Equal(fieldsWas[field], fields[field]) {
+			continue
+		}
+		changes = append(changes, models.CampaignChange{
+			Field: field,
+			Old:   fieldsWas[field],
+			New:   fields[field],
+		})
+	}
+
+	return changes, nil
+}
+
+func campaignFields(campaign models.Campaign) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"advertising/advertising-service/internal/models"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffCampaigns(t *testing.T) {
+	campaignWas := models.Campaign{
+		Id:           uuid.New(),
+		CostPerClick: 10,
+		AdTitle:      "title",
+		Locations:    []string{"Moscow"},
+		Status:       models.CampaignStatusActive,
+	}
+
+	campaign := campaignWas
+	campaign.CostPerClick = 12.5
+	campaign.Locations = []string{"Moscow", "Kazan"}
+	gender := models.GenderFemale
+	campaign.Gender = &gender
+
+	changes, err := diffCampaigns(campaignWas, campaign)
+	require.NoError(t, err)
+	require.Equal(t, models.CampaignChanges{
+		{Field: "cost_per_click", Old: json.RawMessage(`10`), New: json.RawMessage(`12.5`)},
+		{Field: "gender", Old: json.RawMessage(`null`), New: json.RawMessage(`"FEMALE"`)},
+		{Field: "locations", Old: json.RawMessage(`["Moscow"]`), New: json.RawMessage(`["Moscow","Kazan"]`)},
+	}, changes)
+
+	changes, err = diffCampaigns(campaign, campaign)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/google/uuid"
+
+// CampaignImportRow is a campaign read from import file.
+// Err is set if the row can`t be read, then Data is empty
+type CampaignImportRow struct {
+	// Row is a number of row in file starting from 1, header is not counted
+	Row  int
+	Data CampaignData
+	Err  error
+}
+
+// CampaignImportResult is a result of import of one row, campaign id is set if campaign is created
+type CampaignImportResult struct {
+	Row        int
+	CampaignId *uuid.UUID
+	Err        error
+}
+
+type CampaignsImportReport struct {
+	Created int
+	Failed  int
+	Results []CampaignImportResult
+}
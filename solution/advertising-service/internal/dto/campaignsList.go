@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"advertising/advertising-service/internal/models"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CampaignsSort is a campaign column campaigns list is sorted by
+type CampaignsSort string
+
+const (
+	CampaignsSortCreatedAt         CampaignsSort = "created_at"
+	CampaignsSortStartDate         CampaignsSort = "start_date"
+	CampaignsSortCostPerImpression CampaignsSort = "cost_per_impression"
+	CampaignsSortCostPerClick      CampaignsSort = "cost_per_click"
+)
+
+// CampaignsFilter contains optional conditions of campaigns list, nil fields are not applied
+type CampaignsFilter struct {
+	Status           *models.CampaignStatus
+	ModerationStatus *models.ModerationStatus
+	// ActiveOn is a day which must be between start_date and end_date of campaign
+	ActiveOn *int
+	Location *string
+	Gender   *models.Gender
+	// Title is a case insensitive substring of campaign title
+	Title *string
+}
+
+type CampaignsListParams struct {
+	PaginationParams
+	Filter CampaignsFilter
+	Sort   CampaignsSort
+	Desc   bool
+	// Cursor is used instead of page if set
+	Cursor *CampaignsCursor
+}
+
+// CampaignsCursor points to the last campaign of a page in the sort it was got with
+type CampaignsCursor struct {
+	Sort      CampaignsSort `json:"sort"`
+	Desc      bool          `json:"desc"`
+	SortValue string        `json:"value"`
+	Id        uuid.UUID     `json:"id"`
+}
+
+// Encode returns opaque cursor representation for clients
+func (c CampaignsCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCampaignsCursor(cursor string) (CampaignsCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return CampaignsCursor{}, fmt.Errorf("%w: %w", models.ErrInvalidCursor, err)
+	}
+
+	var res CampaignsCursor
+	if err := json.Unmarshal(data, &res); err != nil {
+		return CampaignsCursor{}, fmt.Errorf("%w: %w", models.ErrInvalidCursor, err)
+	}
+
+	return res, nil
+}
+
+type CampaignsPage struct {
+	Campaigns []models.Campaign
+	// Total is a count of campaigns matching filter
+	Total int
+	// Next is nil on the last page
+	Next *CampaignsCursor
+}
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"advertising/advertising-service/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type CampaignsSearchParams struct {
+	PaginationParams
+	// Query is a websearch_to_tsquery query
+	Query string
+	// AdvertiserId limits search to campaigns of advertiser, search is global if nil
+	AdvertiserId *uuid.UUID
+}
+
+type CampaignsSearchPage struct {
+	Results []models.CampaignSearchResult
+	// Total is a count of found campaigns
+	Total int
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "advertising/advertising-service/internal/models"
+
+// ImpressionReservation is an impression recorded only if the campaign
+// has got less impressions on the impression day than its daily target
+type ImpressionReservation struct {
+	Impression models.Impression
+	// DailyImpressionsTarget is impressions the campaign should get on the day according to its pacing,
+	// nil if campaign has no pacing
+	DailyImpressionsTarget *int
+}
+
+// ImpressionPricing returns prices of the impression of reservation winner.
+// RunnerUp is the next reservation of another advertiser that could be recorded instead of the winner,
+// -1 if there is none. Both are indexes in reservations passed to the repo
+type ImpressionPricing func(winner, runnerUp int) models.AdPrice
@@ -0,0 +1,25 @@
+package dto
+
+import "advertising/advertising-service/internal/models"
+
+// MLScoreLoadRow is an ml score read from load file.
+// Err is set if the row can`t be read, then Score is empty
+type MLScoreLoadRow struct {
+	// Row is a number of row in file starting from 1, header is not counted
+	Row   int
+	Score models.MLScore
+	Err   error
+}
+
+// MLScoreLoadError is a reason why row of load file is not loaded
+type MLScoreLoadError struct {
+	Row int
+	Err error
+}
+
+type MLScoresLoadReport struct {
+	Loaded int
+	Failed int
+	// Errors are failed rows in file order, their count may be limited
+	Errors []MLScoreLoadError
+}
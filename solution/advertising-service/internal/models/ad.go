@@ -9,3 +9,38 @@ type Ad struct {
 	AdText       string    `db:"ad_text"`
 	AdImageUrl   *string   `db:"ad_image_url"`
 }
+
+// AdCandidate is an ad that can be shown to client
+// together with raw features used for ranking
+type AdCandidate struct {
+	Ad
+	CostPerImpression float64 `db:"cost_per_impression"`
+	CostPerClick      float64 `db:"cost_per_click"`
+	ImpressionsLimit  int     `db:"impressions_limit"`
+	ImpressionsCount  int     `db:"impressions_count"`
+	ImpressionsToday  int     `db:"impressions_today"`
+	EndDate           int     `db:"end_date"`
+	Pacing            Pacing  `db:"pacing"`
+	ClicksLimit       int     `db:"clicks_limit"`
+	ClicksCount       int     `db:"clicks_count"`
+	Score             int     `db:"score"`
+	// nil if campaign has no targeting rules over client attributes
+	TargetingRules *TargetingRule `db:"targeting_rules"`
+	// max ml score among all client-advertiser pairs
+	MaxScore int `db:"max_score"`
+	// impressions campaign should get today according to its pacing
+	DailyImpressionsTarget *int `db:"-"`
+}
+
+type RankedAdCandidate struct {
+	AdCandidate
+	Rank float64
+	// candidate was moved ahead of its rank to explore it
+	Explored bool
+}
+
+// AdPrice is what advertiser is charged for impression and click
+type AdPrice struct {
+	Impression float64
+	Click      float64
+}
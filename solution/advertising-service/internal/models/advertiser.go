@@ -5,4 +5,7 @@ import "github.com/google/uuid"
 type Advertiser struct {
 	Id   uuid.UUID `db:"id"`
 	Name string    `db:"name"`
+	// spend caps across all campaigns, nil means no cap
+	DailyBudget *float64 `db:"daily_budget"`
+	TotalBudget *float64 `db:"total_budget"`
 }
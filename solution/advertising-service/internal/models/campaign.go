@@ -1,21 +1,35 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
 
 type Campaign struct {
-	Id                uuid.UUID `db:"id"`
-	AdvertiserId      uuid.UUID `db:"advertiser_id"`
-	ImpressionsLimit  int       `db:"impressions_limit"`
-	ClicksLimit       int       `db:"clicks_limit"`
-	CostPerImpression float64   `db:"cost_per_impression"`
-	CostPerClick      float64   `db:"cost_per_click"`
-	AdTitle           string    `db:"ad_title"`
-	AdText            string    `db:"ad_text"`
-	AdImageUrl        *string   `db:"ad_image_url"`
-	StartDate         int       `db:"start_date"`
-	EndDate           int       `db:"end_date"`
-	Gender            *Gender   `db:"gender"`
-	AgeFrom           *int      `db:"age_from"`
-	AgeTo             *int      `db:"age_to"`
-	Location          *string   `db:"location"`
+	Id                      uuid.UUID        `db:"id" json:"id"`
+	AdvertiserId            uuid.UUID        `db:"advertiser_id" json:"advertiser_id"`
+	ImpressionsLimit        int              `db:"impressions_limit" json:"impressions_limit"`
+	ClicksLimit             int              `db:"clicks_limit" json:"clicks_limit"`
+	CostPerImpression       float64          `db:"cost_per_impression" json:"cost_per_impression"`
+	CostPerClick            float64          `db:"cost_per_click" json:"cost_per_click"`
+	AdTitle                 string           `db:"ad_title" json:"ad_title"`
+	AdText                  string           `db:"ad_text" json:"ad_text"`
+	AdImageUrl              *string          `db:"ad_image_url" json:"ad_image_url"`
+	StartDate               int              `db:"start_date" json:"start_date"`
+	EndDate                 int              `db:"end_date" json:"end_date"`
+	Gender                  *Gender          `db:"gender" json:"gender"`
+	AgeFrom                 *int             `db:"age_from" json:"age_from"`
+	AgeTo                   *int             `db:"age_to" json:"age_to"`
+	Location                *string          `db:"location" json:"location"`
+	Locations               pq.StringArray   `db:"locations" json:"locations"`
+	TargetingRules          *TargetingRule   `db:"targeting_rules" json:"targeting_rules"`
+	FrequencyCapImpressions *int             `db:"frequency_cap_impressions" json:"frequency_cap_impressions"`
+	FrequencyCapDays        *int             `db:"frequency_cap_days" json:"frequency_cap_days"`
+	Pacing                  Pacing           `db:"pacing" json:"pacing"`
+	Status                  CampaignStatus   `db:"status" json:"status"`
+	ModerationStatus        ModerationStatus `db:"moderation_status" json:"moderation_status"`
+	// FlaggedPhrases are obscene or illegal phrases found by moderation
+	FlaggedPhrases pq.StringArray `db:"flagged_phrases" json:"flagged_phrases"`
+	// Version is incremented on every change of campaign, it is not part of campaign snapshot
+	Version int `db:"version" json:"-"`
 }
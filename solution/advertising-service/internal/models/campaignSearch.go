@@ -0,0 +1,10 @@
+package models
+
+// CampaignSearchResult is a campaign found by full-text search
+type CampaignSearchResult struct {
+	Campaign
+	Rank float64 `db:"rank"`
+	// TitleHighlight and TextHighlight contain found words wrapped in <b> tag
+	TitleHighlight string `db:"title_highlight"`
+	TextHighlight  string `db:"text_highlight"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type CampaignStatus string
+
+var (
+	CampaignStatusDraft     CampaignStatus = "DRAFT"
+	CampaignStatusActive    CampaignStatus = "ACTIVE"
+	CampaignStatusPaused    CampaignStatus = "PAUSED"
+	CampaignStatusCompleted CampaignStatus = "COMPLETED"
+	CampaignStatusArchived  CampaignStatus = "ARCHIVED"
+)
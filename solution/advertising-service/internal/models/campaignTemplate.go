@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CampaignTemplate is named campaign parameters advertiser creates campaigns from
+type CampaignTemplate struct {
+	Id           uuid.UUID        `db:"id"`
+	AdvertiserId uuid.UUID        `db:"advertiser_id"`
+	Name         string           `db:"name"`
+	Campaign     CampaignSnapshot `db:"campaign"`
+	CreatedAt    time.Time        `db:"created_at"`
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CampaignAction string
+
+var (
+	CampaignActionCreate  CampaignAction = "CREATE"
+	CampaignActionUpdate  CampaignAction = "UPDATE"
+	CampaignActionImage   CampaignAction = "IMAGE"
+	CampaignActionStatus  CampaignAction = "STATUS"
+	CampaignActionDelete  CampaignAction = "DELETE"
+	CampaignActionRestore CampaignAction = "RESTORE"
+	// CampaignActionModeration is admin override of moderation verdict
+	CampaignActionModeration CampaignAction = "MODERATION"
+)
+
+// CampaignVersion is campaign state saved after an action with campaign
+type CampaignVersion struct {
+	CampaignId uuid.UUID        `db:"campaign_id"`
+	Version    int              `db:"version"`
+	Action     CampaignAction   `db:"action"`
+	Day        int              `db:"day"`
+	Campaign   CampaignSnapshot `db:"snapshot"`
+	Changes    CampaignChanges  `db:"changes"`
+	CreatedAt  time.Time        `db:"created_at"`
+}
+
+// CampaignSnapshot is campaign stored as json
+type CampaignSnapshot Campaign
+
+func (cs CampaignSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(Campaign(cs))
+}
+
+func (cs *CampaignSnapshot) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("can`t scan %T into CampaignSnapshot", src)
+	}
+	return json.Unmarshal(data, (*Campaign)(cs))
+}
+
+// CampaignChange is change of campaign field, values are json encoded
+type CampaignChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+type CampaignChanges []CampaignChange
+
+func (cc CampaignChanges) Value() (driver.Value, error) {
+	if cc == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(cc)
+}
+
+func (cc *CampaignChanges) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("can`t scan %T into CampaignChanges", src)
+	}
+	return json.Unmarshal(data, cc)
+}
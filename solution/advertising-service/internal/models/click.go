@@ -3,8 +3,9 @@ package models
 import "github.com/google/uuid"
 
 type Click struct {
-	ClientId   uuid.UUID
-	CampaignId uuid.UUID
-	Date       int
-	Profit     float64
+	ImpressionId uuid.UUID `db:"impression_id"`
+	ClientId     uuid.UUID `db:"client_id"`
+	CampaignId   uuid.UUID `db:"campaign_id"`
+	Date         int       `db:"date"`
+	Profit       float64   `db:"profit"`
 }
@@ -8,4 +8,6 @@ type Client struct {
 	Age      int       `db:"age"`
 	Location string    `db:"location"`
 	Gender   Gender    `db:"gender"`
+	// custom attributes matched by campaigns targeting rules
+	Attributes ClientAttributes `db:"attributes"`
 }
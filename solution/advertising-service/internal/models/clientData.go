@@ -0,0 +1,9 @@
+package models
+
+// ClientData is everything stored about client, it is exported on client request
+type ClientData struct {
+	Client      Client
+	MLScores    []MLScore
+	Impressions []Impression
+	Clicks      []Click
+}
@@ -5,12 +5,33 @@ import "errors"
 var (
 	ErrClientNotFound     = errors.New("client not found")
 	ErrAdvertiserNotFound = errors.New("advertiser not found")
+	ErrAdvertiserDeleted  = errors.New("advertiser deleted")
 	ErrInvalidStartDate   = errors.New("invalid start date")
 	ErrCampaignNotFound   = errors.New("campaign not found")
 	ErrCantUpdateCampaign = errors.New("can`t update campaign")
+	ErrCampaignNotCreated = errors.New("campaign not created")
+	ErrVersionNotFound    = errors.New("campaign version not found")
+	ErrVersionMismatch    = errors.New("campaign version mismatch")
+	ErrCampaignNotActive  = errors.New("campaign not active")
+	ErrCampaignEnded      = errors.New("campaign end date has passed")
+	ErrNotModerated       = errors.New("campaign not approved by moderation")
+	ErrInvalidTransition  = errors.New("invalid campaign status transition")
 	ErrNoAdsForClient     = errors.New("no ads for client")
 	ErrAlreadyImpressed   = errors.New("already impressed")
+	ErrImpressionsLimit   = errors.New("impressions limit reached")
+	ErrClicksLimit        = errors.New("clicks limit reached")
+	ErrDailyTarget        = errors.New("daily impressions target reached")
+	ErrBudgetReached      = errors.New("advertiser budget reached")
 	ErrAlreadyClicked     = errors.New("already clicked")
 	ErrNotImpressed       = errors.New("not impressed")
 	ErrStaticNotFound     = errors.New("static not found")
+	ErrLocationNotFound   = errors.New("location not found")
+	ErrInvalidTargeting   = errors.New("invalid targeting")
+	ErrInvalidCursor      = errors.New("invalid cursor")
+	ErrInvalidCampaign    = errors.New("invalid campaign")
+	ErrTemplateNotFound   = errors.New("campaign template not found")
+	ErrTemplateExists     = errors.New("campaign template already exists")
+	ErrInvalidModeration  = errors.New("invalid moderation status")
+
+	ErrAdvertiserHasActiveCampaigns = errors.New("advertiser has active campaigns")
 )
@@ -0,0 +1,55 @@
+package models
+
+import "github.com/google/uuid"
+
+type ExclusionReason string
+
+const (
+	ExclusionReasonCampaignNotActive       ExclusionReason = "CAMPAIGN_NOT_ACTIVE"
+	ExclusionReasonNotModerated            ExclusionReason = "NOT_MODERATED"
+	ExclusionReasonDateWindow              ExclusionReason = "DATE_WINDOW"
+	ExclusionReasonTargetingGender         ExclusionReason = "TARGETING_GENDER"
+	ExclusionReasonTargetingLocation       ExclusionReason = "TARGETING_LOCATION"
+	ExclusionReasonTargetingAge            ExclusionReason = "TARGETING_AGE"
+	ExclusionReasonTargetingRules          ExclusionReason = "TARGETING_RULES"
+	ExclusionReasonAlreadyImpressed        ExclusionReason = "ALREADY_IMPRESSED"
+	ExclusionReasonImpressionsLimitReached ExclusionReason = "IMPRESSIONS_LIMIT_REACHED"
+	ExclusionReasonDailyTargetReached      ExclusionReason = "DAILY_TARGET_REACHED"
+	ExclusionReasonClicksLimitReached      ExclusionReason = "CLICKS_LIMIT_REACHED"
+	ExclusionReasonAdvertiserBudgetReached ExclusionReason = "ADVERTISER_BUDGET_REACHED"
+)
+
+// AdCandidateChecks is a campaign with features for ranking
+// and results of all ad selection checks for client
+type AdCandidateChecks struct {
+	AdCandidate
+	// impressions limit with 5% tolerance
+	MaxImpressions          int  `db:"max_impressions"`
+	StatusMatched           bool `db:"status_matched"`
+	ModerationMatched       bool `db:"moderation_matched"`
+	DateMatched             bool `db:"date_matched"`
+	GenderMatched           bool `db:"gender_matched"`
+	LocationMatched         bool `db:"location_matched"`
+	AgeMatched              bool `db:"age_matched"`
+	ClientImpressionsCount  int  `db:"client_impressions_count"`
+	FrequencyCapImpressions int  `db:"frequency_cap_impressions"`
+	// advertiser daily and total spend is under its budgets
+	BudgetMatched bool `db:"budget_matched"`
+	// client attributes match targeting rules, evaluated in service
+	RulesMatched bool `db:"-"`
+}
+
+type CampaignExplanation struct {
+	AdCandidate
+	ExclusionReasons []ExclusionReason
+	ExpectedProfit   float64
+	Rank             float64
+	// position among eligible campaigns starting from 1, 0 if campaign is excluded
+	Position int
+}
+
+type AdExplanation struct {
+	ClientId  uuid.UUID
+	Date      int
+	Campaigns []CampaignExplanation
+}
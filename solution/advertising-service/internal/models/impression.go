@@ -3,8 +3,15 @@ package models
 import "github.com/google/uuid"
 
 type Impression struct {
-	ClientId   uuid.UUID
-	CampaignId uuid.UUID
-	Date       int
-	Profit     float64
+	Id         uuid.UUID `db:"id"`
+	ClientId   uuid.UUID `db:"client_id"`
+	CampaignId uuid.UUID `db:"campaign_id"`
+	Date       int       `db:"date"`
+	Profit     float64   `db:"profit"`
+	// price of a click attributed to the impression
+	ClickPrice float64 `db:"click_price"`
+	// impression was an exploration decision rather than exploitation
+	Explored bool `db:"explored"`
+	// auction type the impression and click prices were set by
+	PricingMode PricingMode `db:"pricing_mode"`
 }
@@ -0,0 +1,17 @@
+package models
+
+type LocationType string
+
+var (
+	LocationTypeCity    LocationType = "CITY"
+	LocationTypeRegion  LocationType = "REGION"
+	LocationTypeCountry LocationType = "COUNTRY"
+)
+
+// Location is a node of locations hierarchy city -> region -> country.
+// Campaigns targeted to a location are shown to clients of all its descendants
+type Location struct {
+	Name   string       `db:"name"`
+	Type   LocationType `db:"type"`
+	Parent *string      `db:"parent"`
+}
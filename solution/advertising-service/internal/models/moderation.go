@@ -0,0 +1,15 @@
+package models
+
+// ModerationStatus is a verdict of ad title and text moderation, only approved campaigns are shown
+type ModerationStatus string
+
+var (
+	ModerationStatusApproved ModerationStatus = "APPROVED"
+	// ModerationStatusPendingReview is set when moderation doubts the ad without naming
+	// any phrase and campaign waits for admin review
+	ModerationStatusPendingReview ModerationStatus = "PENDING_REVIEW"
+	ModerationStatusRejected      ModerationStatus = "REJECTED"
+	// ModerationStatusFailed is set when moderator has failed or timed out,
+	// campaign is not shown until admin review
+	ModerationStatusFailed ModerationStatus = "MODERATION_FAILED"
+)
@@ -0,0 +1,10 @@
+package models
+
+type Pacing string
+
+var (
+	// PacingNone sets no daily impressions target, campaign is shown until its impressions limit
+	PacingNone        Pacing = "NONE"
+	PacingEven        Pacing = "EVEN"
+	PacingFrontLoaded Pacing = "FRONT_LOADED"
+)
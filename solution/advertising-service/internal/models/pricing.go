@@ -0,0 +1,9 @@
+package models
+
+// PricingMode is the auction type advertisers are charged by
+type PricingMode string
+
+const (
+	PricingModeFirstPrice  PricingMode = "first_price"
+	PricingModeSecondPrice PricingMode = "second_price"
+)
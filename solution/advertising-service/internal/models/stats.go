@@ -7,9 +7,23 @@ type Stats struct {
 	SpentImpressions float64 `db:"spent_impressions"`
 	SpentClicks      float64 `db:"spent_clicks"`
 	SpentTotal       float64 `db:"spent_total"`
+	// spent on impressions and clicks priced by first and second price auctions
+	SpentFirstPrice  float64 `db:"spent_first_price"`
+	SpentSecondPrice float64 `db:"spent_second_price"`
+}
+
+type CampaignStats struct {
+	Stats
+	ClicksLimitReached bool
 }
 
 type StatsDaily struct {
 	Stats
 	Date int `db:"date"`
 }
+
+type CampaignStatsDaily struct {
+	StatsDaily
+	// nil if the day is out of campaign dates
+	ImpressionsTarget *int
+}
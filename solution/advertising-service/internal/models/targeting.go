@@ -0,0 +1,73 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// ClientAttributes are arbitrary client attributes used by targeting rules.
+// Values are strings, float64 numbers, bools or slices of them
+type ClientAttributes map[string]any
+
+func (ca ClientAttributes) Value() (driver.Value, error) {
+	if ca == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(ca)
+}
+
+func (ca *ClientAttributes) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("can`t scan %T into ClientAttributes", src)
+	}
+
+	attributes := ClientAttributes{}
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return err
+	}
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+
+	*ca = attributes
+	return nil
+}
+
+type TargetingRuleOp string
+
+var (
+	TargetingRuleOpEq     TargetingRuleOp = "eq"
+	TargetingRuleOpNe     TargetingRuleOp = "ne"
+	TargetingRuleOpGt     TargetingRuleOp = "gt"
+	TargetingRuleOpGte    TargetingRuleOp = "gte"
+	TargetingRuleOpLt     TargetingRuleOp = "lt"
+	TargetingRuleOpLte    TargetingRuleOp = "lte"
+	TargetingRuleOpIn     TargetingRuleOp = "in"
+	TargetingRuleOpExists TargetingRuleOp = "exists"
+)
+
+// TargetingRule is an expression over client attributes.
+// Exactly one of And, Or or Attribute with Op is set, Negate inverts the result
+type TargetingRule struct {
+	And       []TargetingRule `json:"and,omitempty"`
+	Or        []TargetingRule `json:"or,omitempty"`
+	Negate    bool            `json:"negate,omitempty"`
+	Attribute string          `json:"attribute,omitempty"`
+	Op        TargetingRuleOp `json:"op,omitempty"`
+	Operand   any             `json:"value,omitempty"`
+	Operands  []any           `json:"values,omitempty"`
+}
+
+func (tr TargetingRule) Value() (driver.Value, error) {
+	return json.Marshal(tr)
+}
+
+func (tr *TargetingRule) Scan(src any) error {
+	data, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("can`t scan %T into TargetingRule", src)
+	}
+	return json.Unmarshal(data, tr)
+}
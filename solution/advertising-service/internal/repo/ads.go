@@ -7,5 +7,6 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name AdsRepo
 type AdsRepo interface {
-	GetAdForClient(ctx context.Context, client models.Client, currentDay int) (models.Ad, error)
+	GetAdCandidatesForClient(ctx context.Context, client models.Client, currentDay int) ([]models.AdCandidate, error)
+	GetAdCandidatesChecksForClient(ctx context.Context, client models.Client, currentDay int) ([]models.AdCandidateChecks, error)
 }
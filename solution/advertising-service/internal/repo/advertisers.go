@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"context"
 
@@ -10,5 +11,8 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name AdvertisersRepo
 type AdvertisersRepo interface {
 	GetAdvertiserById(ctx context.Context, id uuid.UUID) (models.Advertiser, error)
-	UpsertAdvertisers(ctx context.Context, advertisers []models.Advertiser) ([]models.Advertiser, error)
+	ListAdvertisers(ctx context.Context, params dto.AdvertisersListParams) ([]models.Advertiser, error)
+	CountAdvertisers(ctx context.Context, params dto.AdvertisersListParams) (int, error)
+	UpsertAdvertisers(ctx context.Context, advertisers []dto.AdvertiserUpsert) ([]models.Advertiser, error)
+	DeleteAdvertiser(ctx context.Context, id uuid.UUID, day int) error
 }
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name CampaignHistoryRepo
+type CampaignHistoryRepo interface {
+	ListCampaignVersions(ctx context.Context, campaignId uuid.UUID, params dto.PaginationParams) ([]models.CampaignVersion, error)
+	GetCampaignVersion(ctx context.Context, campaignId uuid.UUID, version int) (models.CampaignVersion, error)
+}
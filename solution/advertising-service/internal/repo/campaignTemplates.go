@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"advertising/advertising-service/internal/models"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name CampaignTemplatesRepo
+type CampaignTemplatesRepo interface {
+	CreateTemplate(ctx context.Context, template models.CampaignTemplate) (models.CampaignTemplate, error)
+	ListTemplates(ctx context.Context, advertiserId uuid.UUID) ([]models.CampaignTemplate, error)
+	GetTemplate(ctx context.Context, templateId uuid.UUID) (models.CampaignTemplate, error)
+	DeleteTemplate(ctx context.Context, templateId uuid.UUID) error
+}
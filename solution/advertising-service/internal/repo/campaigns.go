@@ -10,10 +10,17 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name CampaignsRepo
 type CampaignsRepo interface {
-	CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData) (uuid.UUID, error)
-	ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.PaginationParams) ([]models.Campaign, error)
+	CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData, day int) (uuid.UUID, error)
+	CreateCampaigns(ctx context.Context, advertiserId uuid.UUID, data []dto.CampaignData, day int) ([]uuid.UUID, error)
+	ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.CampaignsListParams) ([]models.Campaign, *dto.CampaignsCursor, error)
+	CountCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, filter dto.CampaignsFilter) (int, error)
+	SearchCampaigns(ctx context.Context, params dto.CampaignsSearchParams) ([]models.CampaignSearchResult, error)
+	CountSearchedCampaigns(ctx context.Context, params dto.CampaignsSearchParams) (int, error)
 	GetCampaignById(ctx context.Context, campaignId uuid.UUID) (models.Campaign, error)
-	UpdateCampaign(ctx context.Context, campaignId uuid.UUID, data dto.CampaignData) error
-	SetCampaignAdImageUrl(ctx context.Context, campaignId uuid.UUID, adImageUrl *string) error
-	DeleteCampaign(ctx context.Context, campaignId uuid.UUID) error
+	UpdateCampaign(ctx context.Context, campaignId uuid.UUID, data dto.CampaignData, version int, day int, action models.CampaignAction) error
+	SetCampaignAdImageUrl(ctx context.Context, campaignId uuid.UUID, adImageUrl *string, day int) error
+	DeleteCampaign(ctx context.Context, campaignId uuid.UUID, day int) error
+	PurgeCampaign(ctx context.Context, campaignId uuid.UUID) error
+	UpdateCampaignStatus(ctx context.Context, campaignId uuid.UUID, from, to models.CampaignStatus, day int) error
+	CompleteCampaigns(ctx context.Context, currentDay int) (int, error)
 }
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"context"
 
@@ -10,6 +11,7 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name ClientActionsRepo
 type ClientActionsRepo interface {
 	RecordImpression(ctx context.Context, impression models.Impression) error
+	RecordImpressions(ctx context.Context, reservations []dto.ImpressionReservation, slots int, pricing dto.ImpressionPricing) ([]models.Impression, error)
 	RecordClick(ctx context.Context, click models.Click) error
-	CheckImpressed(ctx context.Context, clientId, campaignId uuid.UUID) (bool, error)
+	CheckImpressed(ctx context.Context, clientId, campaignId uuid.UUID) (models.Impression, bool, error)
 }
@@ -11,4 +11,6 @@ import (
 type ClientsRepo interface {
 	GetClientById(ctx context.Context, id uuid.UUID) (models.Client, error)
 	UpsertClients(ctx context.Context, clients []models.Client) ([]models.Client, error)
+	ExportClientData(ctx context.Context, id uuid.UUID) (models.ClientData, error)
+	EraseClient(ctx context.Context, id uuid.UUID) error
 }
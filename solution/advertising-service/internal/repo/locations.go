@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"advertising/advertising-service/internal/models"
+	"context"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name LocationsRepo
+type LocationsRepo interface {
+	ListLocations(ctx context.Context) ([]models.Location, error)
+	UpsertLocations(ctx context.Context, locations []models.Location) ([]models.Location, error)
+}
@@ -57,6 +57,24 @@ func (sr *StaticRepo) LoadStatic(ctx context.Context, name string) (models.Stati
 	}, nil
 }
 
+// CopyStatic copies static on storage side without loading it
+func (sr *StaticRepo) CopyStatic(ctx context.Context, from, to string) error {
+	op := "StaticRepo.CopyStatic"
+
+	_, err := sr.cli.CopyObject(ctx,
+		minio.CopyDestOptions{Bucket: sr.bucketName, Object: to},
+		minio.CopySrcOptions{Bucket: sr.bucketName, Object: from},
+	)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return models.ErrStaticNotFound
+		}
+		return fmt.Errorf("%s: cli.CopyObject: %w", op, err)
+	}
+
+	return nil
+}
+
 func (sr StaticRepo) DeleteStatic(ctx context.Context, name string) error {
 	op := "StaticRepo.DeleteStatic"
 
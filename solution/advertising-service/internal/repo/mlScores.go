@@ -1,11 +1,14 @@
 package repo
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"context"
+	"iter"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@v2.52.2 --name MlScoresRepo
 type MlScoresRepo interface {
 	UpsertMLScore(ctx context.Context, mlScore models.MLScore) error
+	LoadMLScores(ctx context.Context, rows iter.Seq2[dto.MLScoreLoadRow, error], maxErrors int) (dto.MLScoresLoadReport, error)
 }
@@ -14,23 +14,55 @@ type AdsRepo struct {
 	mock.Mock
 }
 
-// GetAdForClient provides a mock function with given fields: ctx, client, currentDay
-func (_m *AdsRepo) GetAdForClient(ctx context.Context, client models.Client, currentDay int) (models.Ad, error) {
+// GetAdCandidatesChecksForClient provides a mock function with given fields: ctx, client, currentDay
+func (_m *AdsRepo) GetAdCandidatesChecksForClient(ctx context.Context, client models.Client, currentDay int) ([]models.AdCandidateChecks, error) {
 	ret := _m.Called(ctx, client, currentDay)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAdForClient")
+		panic("no return value specified for GetAdCandidatesChecksForClient")
 	}
 
-	var r0 models.Ad
+	var r0 []models.AdCandidateChecks
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Client, int) (models.Ad, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, models.Client, int) ([]models.AdCandidateChecks, error)); ok {
 		return rf(ctx, client, currentDay)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Client, int) models.Ad); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, models.Client, int) []models.AdCandidateChecks); ok {
 		r0 = rf(ctx, client, currentDay)
 	} else {
-		r0 = ret.Get(0).(models.Ad)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AdCandidateChecks)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Client, int) error); ok {
+		r1 = rf(ctx, client, currentDay)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdCandidatesForClient provides a mock function with given fields: ctx, client, currentDay
+func (_m *AdsRepo) GetAdCandidatesForClient(ctx context.Context, client models.Client, currentDay int) ([]models.AdCandidate, error) {
+	ret := _m.Called(ctx, client, currentDay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdCandidatesForClient")
+	}
+
+	var r0 []models.AdCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Client, int) ([]models.AdCandidate, error)); ok {
+		return rf(ctx, client, currentDay)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Client, int) []models.AdCandidate); ok {
+		r0 = rf(ctx, client, currentDay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AdCandidate)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, models.Client, int) error); ok {
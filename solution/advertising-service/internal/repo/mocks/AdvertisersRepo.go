@@ -3,11 +3,13 @@
 package mocks
 
 import (
-	models "advertising/advertising-service/internal/models"
+	dto "advertising/advertising-service/internal/dto"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
 
+	models "advertising/advertising-service/internal/models"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -16,6 +18,52 @@ type AdvertisersRepo struct {
 	mock.Mock
 }
 
+// CountAdvertisers provides a mock function with given fields: ctx, params
+func (_m *AdvertisersRepo) CountAdvertisers(ctx context.Context, params dto.AdvertisersListParams) (int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAdvertisers")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AdvertisersListParams) (int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AdvertisersListParams) int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.AdvertisersListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAdvertiser provides a mock function with given fields: ctx, id, day
+func (_m *AdvertisersRepo) DeleteAdvertiser(ctx context.Context, id uuid.UUID, day int) error {
+	ret := _m.Called(ctx, id, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAdvertiser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, id, day)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAdvertiserById provides a mock function with given fields: ctx, id
 func (_m *AdvertisersRepo) GetAdvertiserById(ctx context.Context, id uuid.UUID) (models.Advertiser, error) {
 	ret := _m.Called(ctx, id)
@@ -44,8 +92,38 @@ func (_m *AdvertisersRepo) GetAdvertiserById(ctx context.Context, id uuid.UUID)
 	return r0, r1
 }
 
+// ListAdvertisers provides a mock function with given fields: ctx, params
+func (_m *AdvertisersRepo) ListAdvertisers(ctx context.Context, params dto.AdvertisersListParams) ([]models.Advertiser, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAdvertisers")
+	}
+
+	var r0 []models.Advertiser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AdvertisersListParams) ([]models.Advertiser, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AdvertisersListParams) []models.Advertiser); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Advertiser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.AdvertisersListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertAdvertisers provides a mock function with given fields: ctx, advertisers
-func (_m *AdvertisersRepo) UpsertAdvertisers(ctx context.Context, advertisers []models.Advertiser) ([]models.Advertiser, error) {
+func (_m *AdvertisersRepo) UpsertAdvertisers(ctx context.Context, advertisers []dto.AdvertiserUpsert) ([]models.Advertiser, error) {
 	ret := _m.Called(ctx, advertisers)
 
 	if len(ret) == 0 {
@@ -54,10 +132,10 @@ func (_m *AdvertisersRepo) UpsertAdvertisers(ctx context.Context, advertisers []
 
 	var r0 []models.Advertiser
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []models.Advertiser) ([]models.Advertiser, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.AdvertiserUpsert) ([]models.Advertiser, error)); ok {
 		return rf(ctx, advertisers)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []models.Advertiser) []models.Advertiser); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.AdvertiserUpsert) []models.Advertiser); ok {
 		r0 = rf(ctx, advertisers)
 	} else {
 		if ret.Get(0) != nil {
@@ -65,7 +143,7 @@ func (_m *AdvertisersRepo) UpsertAdvertisers(ctx context.Context, advertisers []
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []models.Advertiser) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, []dto.AdvertiserUpsert) error); ok {
 		r1 = rf(ctx, advertisers)
 	} else {
 		r1 = ret.Error(1)
@@ -0,0 +1,91 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "advertising/advertising-service/internal/dto"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "advertising/advertising-service/internal/models"
+
+	uuid "github.com/google/uuid"
+)
+
+// CampaignHistoryRepo is an autogenerated mock type for the CampaignHistoryRepo type
+type CampaignHistoryRepo struct {
+	mock.Mock
+}
+
+// GetCampaignVersion provides a mock function with given fields: ctx, campaignId, version
+func (_m *CampaignHistoryRepo) GetCampaignVersion(ctx context.Context, campaignId uuid.UUID, version int) (models.CampaignVersion, error) {
+	ret := _m.Called(ctx, campaignId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignVersion")
+	}
+
+	var r0 models.CampaignVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (models.CampaignVersion, error)); ok {
+		return rf(ctx, campaignId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) models.CampaignVersion); ok {
+		r0 = rf(ctx, campaignId, version)
+	} else {
+		r0 = ret.Get(0).(models.CampaignVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, campaignId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCampaignVersions provides a mock function with given fields: ctx, campaignId, params
+func (_m *CampaignHistoryRepo) ListCampaignVersions(ctx context.Context, campaignId uuid.UUID, params dto.PaginationParams) ([]models.CampaignVersion, error) {
+	ret := _m.Called(ctx, campaignId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCampaignVersions")
+	}
+
+	var r0 []models.CampaignVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.PaginationParams) ([]models.CampaignVersion, error)); ok {
+		return rf(ctx, campaignId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.PaginationParams) []models.CampaignVersion); ok {
+		r0 = rf(ctx, campaignId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.PaginationParams) error); ok {
+		r1 = rf(ctx, campaignId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCampaignHistoryRepo creates a new instance of CampaignHistoryRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCampaignHistoryRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CampaignHistoryRepo {
+	mock := &CampaignHistoryRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "advertising/advertising-service/internal/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// CampaignTemplatesRepo is an autogenerated mock type for the CampaignTemplatesRepo type
+type CampaignTemplatesRepo struct {
+	mock.Mock
+}
+
+// CreateTemplate provides a mock function with given fields: ctx, template
+func (_m *CampaignTemplatesRepo) CreateTemplate(ctx context.Context, template models.CampaignTemplate) (models.CampaignTemplate, error) {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 models.CampaignTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CampaignTemplate) (models.CampaignTemplate, error)); ok {
+		return rf(ctx, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CampaignTemplate) models.CampaignTemplate); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(models.CampaignTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CampaignTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, templateId
+func (_m *CampaignTemplatesRepo) DeleteTemplate(ctx context.Context, templateId uuid.UUID) error {
+	ret := _m.Called(ctx, templateId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, templateId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetTemplate provides a mock function with given fields: ctx, templateId
+func (_m *CampaignTemplatesRepo) GetTemplate(ctx context.Context, templateId uuid.UUID) (models.CampaignTemplate, error) {
+	ret := _m.Called(ctx, templateId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 models.CampaignTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.CampaignTemplate, error)); ok {
+		return rf(ctx, templateId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.CampaignTemplate); ok {
+		r0 = rf(ctx, templateId)
+	} else {
+		r0 = ret.Get(0).(models.CampaignTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, templateId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTemplates provides a mock function with given fields: ctx, advertiserId
+func (_m *CampaignTemplatesRepo) ListTemplates(ctx context.Context, advertiserId uuid.UUID) ([]models.CampaignTemplate, error) {
+	ret := _m.Called(ctx, advertiserId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTemplates")
+	}
+
+	var r0 []models.CampaignTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.CampaignTemplate, error)); ok {
+		return rf(ctx, advertiserId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.CampaignTemplate); ok {
+		r0 = rf(ctx, advertiserId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, advertiserId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCampaignTemplatesRepo creates a new instance of CampaignTemplatesRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCampaignTemplatesRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CampaignTemplatesRepo {
+	mock := &CampaignTemplatesRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -18,9 +18,93 @@ type CampaignsRepo struct {
 	mock.Mock
 }
 
-// CreateCampaign provides a mock function with given fields: ctx, advertiserId, data
-func (_m *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData) (uuid.UUID, error) {
-	ret := _m.Called(ctx, advertiserId, data)
+// CompleteCampaigns provides a mock function with given fields: ctx, currentDay
+func (_m *CampaignsRepo) CompleteCampaigns(ctx context.Context, currentDay int) (int, error) {
+	ret := _m.Called(ctx, currentDay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteCampaigns")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, currentDay)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, currentDay)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, currentDay)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCampaignsForAdvertiser provides a mock function with given fields: ctx, advertiserId, filter
+func (_m *CampaignsRepo) CountCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, filter dto.CampaignsFilter) (int, error) {
+	ret := _m.Called(ctx, advertiserId, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCampaignsForAdvertiser")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignsFilter) (int, error)); ok {
+		return rf(ctx, advertiserId, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignsFilter) int); ok {
+		r0 = rf(ctx, advertiserId, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.CampaignsFilter) error); ok {
+		r1 = rf(ctx, advertiserId, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountSearchedCampaigns provides a mock function with given fields: ctx, params
+func (_m *CampaignsRepo) CountSearchedCampaigns(ctx context.Context, params dto.CampaignsSearchParams) (int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSearchedCampaigns")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CampaignsSearchParams) (int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CampaignsSearchParams) int); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CampaignsSearchParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCampaign provides a mock function with given fields: ctx, advertiserId, data, day
+func (_m *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData, day int) (uuid.UUID, error) {
+	ret := _m.Called(ctx, advertiserId, data, day)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateCampaign")
@@ -28,19 +112,19 @@ func (_m *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.U
 
 	var r0 uuid.UUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignData) (uuid.UUID, error)); ok {
-		return rf(ctx, advertiserId, data)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignData, int) (uuid.UUID, error)); ok {
+		return rf(ctx, advertiserId, data, day)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignData) uuid.UUID); ok {
-		r0 = rf(ctx, advertiserId, data)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignData, int) uuid.UUID); ok {
+		r0 = rf(ctx, advertiserId, data, day)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(uuid.UUID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.CampaignData) error); ok {
-		r1 = rf(ctx, advertiserId, data)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.CampaignData, int) error); ok {
+		r1 = rf(ctx, advertiserId, data, day)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -48,17 +132,47 @@ func (_m *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.U
 	return r0, r1
 }
 
-// DeleteCampaign provides a mock function with given fields: ctx, campaignId
-func (_m *CampaignsRepo) DeleteCampaign(ctx context.Context, campaignId uuid.UUID) error {
-	ret := _m.Called(ctx, campaignId)
+// CreateCampaigns provides a mock function with given fields: ctx, advertiserId, data, day
+func (_m *CampaignsRepo) CreateCampaigns(ctx context.Context, advertiserId uuid.UUID, data []dto.CampaignData, day int) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, advertiserId, data, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampaigns")
+	}
+
+	var r0 []uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.CampaignData, int) ([]uuid.UUID, error)); ok {
+		return rf(ctx, advertiserId, data, day)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []dto.CampaignData, int) []uuid.UUID); ok {
+		r0 = rf(ctx, advertiserId, data, day)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []dto.CampaignData, int) error); ok {
+		r1 = rf(ctx, advertiserId, data, day)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCampaign provides a mock function with given fields: ctx, campaignId, day
+func (_m *CampaignsRepo) DeleteCampaign(ctx context.Context, campaignId uuid.UUID, day int) error {
+	ret := _m.Called(ctx, campaignId, day)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCampaign")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, campaignId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = rf(ctx, campaignId, day)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -95,7 +209,7 @@ func (_m *CampaignsRepo) GetCampaignById(ctx context.Context, campaignId uuid.UU
 }
 
 // ListCampaignsForAdvertiser provides a mock function with given fields: ctx, advertiserId, params
-func (_m *CampaignsRepo) ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.PaginationParams) ([]models.Campaign, error) {
+func (_m *CampaignsRepo) ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.CampaignsListParams) ([]models.Campaign, *dto.CampaignsCursor, error) {
 	ret := _m.Called(ctx, advertiserId, params)
 
 	if len(ret) == 0 {
@@ -103,11 +217,12 @@ func (_m *CampaignsRepo) ListCampaignsForAdvertiser(ctx context.Context, adverti
 	}
 
 	var r0 []models.Campaign
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.PaginationParams) ([]models.Campaign, error)); ok {
+	var r1 *dto.CampaignsCursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignsListParams) ([]models.Campaign, *dto.CampaignsCursor, error)); ok {
 		return rf(ctx, advertiserId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.PaginationParams) []models.Campaign); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignsListParams) []models.Campaign); ok {
 		r0 = rf(ctx, advertiserId, params)
 	} else {
 		if ret.Get(0) != nil {
@@ -115,8 +230,64 @@ func (_m *CampaignsRepo) ListCampaignsForAdvertiser(ctx context.Context, adverti
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.PaginationParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dto.CampaignsListParams) *dto.CampaignsCursor); ok {
 		r1 = rf(ctx, advertiserId, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*dto.CampaignsCursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, dto.CampaignsListParams) error); ok {
+		r2 = rf(ctx, advertiserId, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PurgeCampaign provides a mock function with given fields: ctx, campaignId
+func (_m *CampaignsRepo) PurgeCampaign(ctx context.Context, campaignId uuid.UUID) error {
+	ret := _m.Called(ctx, campaignId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeCampaign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, campaignId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchCampaigns provides a mock function with given fields: ctx, params
+func (_m *CampaignsRepo) SearchCampaigns(ctx context.Context, params dto.CampaignsSearchParams) ([]models.CampaignSearchResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCampaigns")
+	}
+
+	var r0 []models.CampaignSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CampaignsSearchParams) ([]models.CampaignSearchResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CampaignsSearchParams) []models.CampaignSearchResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CampaignSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CampaignsSearchParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,17 +295,17 @@ func (_m *CampaignsRepo) ListCampaignsForAdvertiser(ctx context.Context, adverti
 	return r0, r1
 }
 
-// SetCampaignAdImageUrl provides a mock function with given fields: ctx, campaignId, adImageUrl
-func (_m *CampaignsRepo) SetCampaignAdImageUrl(ctx context.Context, campaignId uuid.UUID, adImageUrl *string) error {
-	ret := _m.Called(ctx, campaignId, adImageUrl)
+// SetCampaignAdImageUrl provides a mock function with given fields: ctx, campaignId, adImageUrl, day
+func (_m *CampaignsRepo) SetCampaignAdImageUrl(ctx context.Context, campaignId uuid.UUID, adImageUrl *string, day int) error {
+	ret := _m.Called(ctx, campaignId, adImageUrl, day)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetCampaignAdImageUrl")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string) error); ok {
-		r0 = rf(ctx, campaignId, adImageUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, int) error); ok {
+		r0 = rf(ctx, campaignId, adImageUrl, day)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -142,17 +313,35 @@ func (_m *CampaignsRepo) SetCampaignAdImageUrl(ctx context.Context, campaignId u
 	return r0
 }
 
-// UpdateCampaign provides a mock function with given fields: ctx, campaignId, data
-func (_m *CampaignsRepo) UpdateCampaign(ctx context.Context, campaignId uuid.UUID, data dto.CampaignData) error {
-	ret := _m.Called(ctx, campaignId, data)
+// UpdateCampaign provides a mock function with given fields: ctx, campaignId, data, version, day, action
+func (_m *CampaignsRepo) UpdateCampaign(ctx context.Context, campaignId uuid.UUID, data dto.CampaignData, version int, day int, action models.CampaignAction) error {
+	ret := _m.Called(ctx, campaignId, data, version, day, action)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateCampaign")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignData) error); ok {
-		r0 = rf(ctx, campaignId, data)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dto.CampaignData, int, int, models.CampaignAction) error); ok {
+		r0 = rf(ctx, campaignId, data, version, day, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCampaignStatus provides a mock function with given fields: ctx, campaignId, from, to, day
+func (_m *CampaignsRepo) UpdateCampaignStatus(ctx context.Context, campaignId uuid.UUID, from models.CampaignStatus, to models.CampaignStatus, day int) error {
+	ret := _m.Called(ctx, campaignId, from, to, day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCampaignStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CampaignStatus, models.CampaignStatus, int) error); ok {
+		r0 = rf(ctx, campaignId, from, to, day)
 	} else {
 		r0 = ret.Error(0)
 	}
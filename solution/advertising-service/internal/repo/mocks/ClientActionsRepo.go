@@ -3,11 +3,13 @@
 package mocks
 
 import (
-	models "advertising/advertising-service/internal/models"
+	dto "advertising/advertising-service/internal/dto"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
 
+	models "advertising/advertising-service/internal/models"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -17,31 +19,38 @@ type ClientActionsRepo struct {
 }
 
 // CheckImpressed provides a mock function with given fields: ctx, clientId, campaignId
-func (_m *ClientActionsRepo) CheckImpressed(ctx context.Context, clientId uuid.UUID, campaignId uuid.UUID) (bool, error) {
+func (_m *ClientActionsRepo) CheckImpressed(ctx context.Context, clientId uuid.UUID, campaignId uuid.UUID) (models.Impression, bool, error) {
 	ret := _m.Called(ctx, clientId, campaignId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CheckImpressed")
 	}
 
-	var r0 bool
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+	var r0 models.Impression
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.Impression, bool, error)); ok {
 		return rf(ctx, clientId, campaignId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.Impression); ok {
 		r0 = rf(ctx, clientId, campaignId)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(models.Impression)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
 		r1 = rf(ctx, clientId, campaignId)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r2 = rf(ctx, clientId, campaignId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // RecordClick provides a mock function with given fields: ctx, click
@@ -80,6 +89,36 @@ func (_m *ClientActionsRepo) RecordImpression(ctx context.Context, impression mo
 	return r0
 }
 
+// RecordImpressions provides a mock function with given fields: ctx, reservations, slots, pricing
+func (_m *ClientActionsRepo) RecordImpressions(ctx context.Context, reservations []dto.ImpressionReservation, slots int, pricing dto.ImpressionPricing) ([]models.Impression, error) {
+	ret := _m.Called(ctx, reservations, slots, pricing)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordImpressions")
+	}
+
+	var r0 []models.Impression
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.ImpressionReservation, int, dto.ImpressionPricing) ([]models.Impression, error)); ok {
+		return rf(ctx, reservations, slots, pricing)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.ImpressionReservation, int, dto.ImpressionPricing) []models.Impression); ok {
+		r0 = rf(ctx, reservations, slots, pricing)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Impression)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []dto.ImpressionReservation, int, dto.ImpressionPricing) error); ok {
+		r1 = rf(ctx, reservations, slots, pricing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewClientActionsRepo creates a new instance of ClientActionsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientActionsRepo(t interface {
@@ -16,6 +16,52 @@ type ClientsRepo struct {
 	mock.Mock
 }
 
+// EraseClient provides a mock function with given fields: ctx, id
+func (_m *ClientsRepo) EraseClient(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseClient")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportClientData provides a mock function with given fields: ctx, id
+func (_m *ClientsRepo) ExportClientData(ctx context.Context, id uuid.UUID) (models.ClientData, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportClientData")
+	}
+
+	var r0 models.ClientData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.ClientData, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.ClientData); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ClientData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetClientById provides a mock function with given fields: ctx, id
 func (_m *ClientsRepo) GetClientById(ctx context.Context, id uuid.UUID) (models.Client, error) {
 	ret := _m.Called(ctx, id)
@@ -0,0 +1,89 @@
+// Code generated by mockery v2.52.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "advertising/advertising-service/internal/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LocationsRepo is an autogenerated mock type for the LocationsRepo type
+type LocationsRepo struct {
+	mock.Mock
+}
+
+// ListLocations provides a mock function with given fields: ctx
+func (_m *LocationsRepo) ListLocations(ctx context.Context) ([]models.Location, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocations")
+	}
+
+	var r0 []models.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Location, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Location); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertLocations provides a mock function with given fields: ctx, locations
+func (_m *LocationsRepo) UpsertLocations(ctx context.Context, locations []models.Location) ([]models.Location, error) {
+	ret := _m.Called(ctx, locations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertLocations")
+	}
+
+	var r0 []models.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Location) ([]models.Location, error)); ok {
+		return rf(ctx, locations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Location) []models.Location); ok {
+		r0 = rf(ctx, locations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.Location) error); ok {
+		r1 = rf(ctx, locations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLocationsRepo creates a new instance of LocationsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLocationsRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LocationsRepo {
+	mock := &LocationsRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
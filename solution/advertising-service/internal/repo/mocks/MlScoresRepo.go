@@ -3,10 +3,13 @@
 package mocks
 
 import (
-	models "advertising/advertising-service/internal/models"
+	dto "advertising/advertising-service/internal/dto"
 	context "context"
+	iter "iter"
 
 	mock "github.com/stretchr/testify/mock"
+
+	models "advertising/advertising-service/internal/models"
 )
 
 // MlScoresRepo is an autogenerated mock type for the MlScoresRepo type
@@ -14,6 +17,34 @@ type MlScoresRepo struct {
 	mock.Mock
 }
 
+// LoadMLScores provides a mock function with given fields: ctx, rows, maxErrors
+func (_m *MlScoresRepo) LoadMLScores(ctx context.Context, rows iter.Seq2[dto.MLScoreLoadRow, error], maxErrors int) (dto.MLScoresLoadReport, error) {
+	ret := _m.Called(ctx, rows, maxErrors)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadMLScores")
+	}
+
+	var r0 dto.MLScoresLoadReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, iter.Seq2[dto.MLScoreLoadRow, error], int) (dto.MLScoresLoadReport, error)); ok {
+		return rf(ctx, rows, maxErrors)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, iter.Seq2[dto.MLScoreLoadRow, error], int) dto.MLScoresLoadReport); ok {
+		r0 = rf(ctx, rows, maxErrors)
+	} else {
+		r0 = ret.Get(0).(dto.MLScoresLoadReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, iter.Seq2[dto.MLScoreLoadRow, error], int) error); ok {
+		r1 = rf(ctx, rows, maxErrors)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertMLScore provides a mock function with given fields: ctx, mlScore
 func (_m *MlScoresRepo) UpsertMLScore(ctx context.Context, mlScore models.MLScore) error {
 	ret := _m.Called(ctx, mlScore)
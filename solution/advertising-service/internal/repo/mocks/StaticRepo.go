@@ -14,6 +14,24 @@ type StaticRepo struct {
 	mock.Mock
 }
 
+// CopyStatic provides a mock function with given fields: ctx, from, to
+func (_m *StaticRepo) CopyStatic(ctx context.Context, from string, to string) error {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyStatic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteStatic provides a mock function with given fields: ctx, name
 func (_m *StaticRepo) DeleteStatic(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -3,8 +3,6 @@ package postgres
 import (
 	"advertising/advertising-service/internal/models"
 	"context"
-	"database/sql"
-	"errors"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -20,21 +18,25 @@ func NewAdsRepo(db *sqlx.DB) *AdsRepo {
 	}
 }
 
-func (ar *AdsRepo) GetAdForClient(ctx context.Context, client models.Client, currentDay int) (models.Ad, error) {
-	op := "AdsRepo.GetAdForClient"
-
-	// $1 - client id
-	// $2 - current day
-	// $3 - client gender
-	// $4 - client location
-	// $5 - client age
-
-	args := []any{
-		client.Id, currentDay, client.Gender, client.Location, client.Age,
-	}
-
-	query := `
-	WITH
+// adCandidatesChecksQuery selects not deleted campaigns with features for ranking
+// and results of each ad selection check for client.
+//
+// $1 - client id
+// $2 - current day
+// $3 - client gender
+// $4 - client location, matched with campaign locations and their descendants
+// $5 - client age
+const adCandidatesChecksQuery = `
+	WITH RECURSIVE
+		client_locations AS
+		(
+			SELECT $4::text AS name
+			UNION
+			SELECT locations.parent
+			FROM locations
+			JOIN client_locations ON client_locations.name = locations.name
+			WHERE locations.parent IS NOT NULL
+		),
 		ml_scores_max_score AS
 		(
 			SELECT
@@ -47,90 +49,133 @@ func (ar *AdsRepo) GetAdForClient(ctx context.Context, client models.Client, cur
 		),
 		impressions_counted AS
 		(
-			SELECT campaign_id, count(*) AS impressions_count
+			SELECT
+				campaign_id,
+				count(*) AS impressions_count,
+				count(*) FILTER (WHERE date = $2) AS impressions_today
 			FROM impressions
 			GROUP BY campaign_id
 		),
-		impressions_by_client AS
+		clicks_counted AS
 		(
-			SELECT campaign_id, true AS impressed_by_client
-			FROM impressions
-			WHERE client_id = $1
+			SELECT campaign_id, count(*) AS clicks_count
+			FROM clicks
+			GROUP BY campaign_id
 		),
-    	campaigns_filtered AS
+		advertisers_spent AS
 		(
-			SELECT *
-			FROM campaigns
-			WHERE
-				$2 BETWEEN campaigns.start_date AND campaigns.end_date AND
-				(campaigns.gender IS NULL OR campaigns.gender = 'ALL' OR campaigns.gender = $3) AND
-				(campaigns.location IS NULL OR campaigns.location = $4) AND
-				$5 BETWEEN COALESCE(campaigns.age_from, -1) AND COALESCE(campaigns.age_to, 999)
+			SELECT
+				advertiser_id,
+				sum(spent) AS spent_total,
+				COALESCE(sum(spent) FILTER (WHERE date = $2), 0) AS spent_today
+			FROM advertisers_daily_spend
+			GROUP BY advertiser_id
 		),
-		candidates AS 
+		impressions_by_client AS
 		(
-			SELECT
-				campaigns.id AS campaign_id,
-				campaigns.advertiser_id AS advertiser_id,
-				campaigns.ad_title AS ad_title,
-				campaigns.ad_text AS ad_text,
-				campaigns.ad_image_url AS ad_image_url,
-          		cost_per_impression + (COALESCE(ml_scores.score, 0)::double precision / max_score::double precision) * 0.5 * cost_per_click AS profit,
-				COALESCE(ml_scores.score, 0) AS score,
-				ABS(campaigns.impressions_limit - COALESCE(impressions_counted.impressions_count, 0)) AS limits_diff
-			FROM campaigns_filtered campaigns
-			LEFT JOIN ml_scores ON
-				ml_scores.client_id = $1 AND
-				ml_scores.advertiser_id = campaigns.advertiser_id
-			LEFT JOIN impressions_counted ON impressions_counted.campaign_id = campaigns.id
-			LEFT JOIN impressions_by_client on impressions_by_client.campaign_id = campaigns.id
-			JOIN ml_scores_max_score ON true
-            WHERE
-				COALESCE(impressions_by_client.impressed_by_client, false) = false AND
-				COALESCE(impressions_counted.impressions_count, 0) < ROUND(campaigns.impressions_limit::double precision * 1.05)
- 		),
-		candidates_max_values AS
-        (
-        	SELECT
-				CASE
-					WHEN max(profit) != 0
-						THEN max(profit)
-					ELSE 0.1
-				END AS max_profit,
-				CASE
-					WHEN max(limits_diff) != 0
-						THEN max(limits_diff)
-					ELSE 1
-				END AS max_limits_diff,
-				CASE
-					WHEN max(score) != 0
-						THEN max(score)
-					ELSE 1
-				END AS max_score
-          	FROM candidates
-        ),
-        candidates_normalized_profit AS
-        (
-        	SELECT
-          		*,
-          		profit / max_profit AS profit_normalized,
-				limits_diff::double precision / max_limits_diff::double precision AS limits_compilance,
-				score::double precision / max_score::double precision AS score_normalized
-          	FROM candidates
-          	JOIN candidates_max_values ON true
-        )
-	SELECT campaign_id, advertiser_id, ad_title, ad_text, ad_image_url
-	FROM candidates_normalized_profit
-	ORDER BY profit_normalized + score_normalized * 0.25 + limits_compilance * 0.1 DESC
-	LIMIT 1
-	`
-	var ad models.Ad
-	if err := ar.db.GetContext(ctx, &ad, query, args...); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return models.Ad{}, models.ErrNoAdsForClient
-		}
-		return models.Ad{}, fmt.Errorf("%s: execute query: %w", op, err)
+			SELECT impressions.campaign_id, count(*) AS impressions_count
+			FROM impressions
+			JOIN campaigns ON campaigns.id = impressions.campaign_id
+			WHERE
+				impressions.client_id = $1 AND
+				(campaigns.frequency_cap_days IS NULL OR impressions.date > $2 - campaigns.frequency_cap_days)
+			GROUP BY impressions.campaign_id
+		)
+	SELECT
+		campaigns.id AS campaign_id,
+		campaigns.advertiser_id AS advertiser_id,
+		campaigns.ad_title AS ad_title,
+		campaigns.ad_text AS ad_text,
+		campaigns.ad_image_url AS ad_image_url,
+		campaigns.cost_per_impression AS cost_per_impression,
+		campaigns.cost_per_click AS cost_per_click,
+		campaigns.impressions_limit AS impressions_limit,
+		COALESCE(impressions_counted.impressions_count, 0) AS impressions_count,
+		COALESCE(impressions_counted.impressions_today, 0) AS impressions_today,
+		campaigns.end_date AS end_date,
+		campaigns.pacing AS pacing,
+		campaigns.clicks_limit AS clicks_limit,
+		COALESCE(clicks_counted.clicks_count, 0) AS clicks_count,
+		COALESCE(ml_scores.score, 0) AS score,
+		campaigns.targeting_rules AS targeting_rules,
+		ml_scores_max_score.max_score AS max_score,
+		ROUND(campaigns.impressions_limit::double precision * 1.05)::integer AS max_impressions,
+		campaigns.status = 'ACTIVE' AS status_matched,
+		campaigns.moderation_status = 'APPROVED' AS moderation_matched,
+		$2 BETWEEN campaigns.start_date AND campaigns.end_date AS date_matched,
+		(campaigns.gender IS NULL OR campaigns.gender = 'ALL' OR campaigns.gender = $3) AS gender_matched,
+		(
+			(campaigns.location IS NULL AND campaigns.locations IS NULL) OR
+			campaigns.location IN (SELECT name FROM client_locations) OR
+			campaigns.locations && ARRAY(SELECT name FROM client_locations)
+		) AS location_matched,
+		$5 BETWEEN COALESCE(campaigns.age_from, -1) AND COALESCE(campaigns.age_to, 999) AS age_matched,
+		COALESCE(impressions_by_client.impressions_count, 0) AS client_impressions_count,
+		COALESCE(campaigns.frequency_cap_impressions, 1) AS frequency_cap_impressions,
+		(advertisers.daily_budget IS NULL OR COALESCE(advertisers_spent.spent_today, 0) < advertisers.daily_budget) AND
+		(advertisers.total_budget IS NULL OR COALESCE(advertisers_spent.spent_total, 0) < advertisers.total_budget) AS budget_matched
+	FROM campaigns
+	LEFT JOIN ml_scores ON
+		ml_scores.client_id = $1 AND
+		ml_scores.advertiser_id = campaigns.advertiser_id
+	LEFT JOIN impressions_counted ON impressions_counted.campaign_id = campaigns.id
+	LEFT JOIN clicks_counted ON clicks_counted.campaign_id = campaigns.id
+	LEFT JOIN impressions_by_client on impressions_by_client.campaign_id = campaigns.id
+	JOIN advertisers ON advertisers.id = campaigns.advertiser_id
+	LEFT JOIN advertisers_spent ON advertisers_spent.advertiser_id = campaigns.advertiser_id
+	JOIN ml_scores_max_score ON true
+	WHERE campaigns.deleted_at IS NULL
+`
+
+// adCandidatesCond passes campaigns that pass all ad selection checks except targeting rules evaluated in service
+const adCandidatesCond = `
+	status_matched AND
+	moderation_matched AND
+	date_matched AND
+	gender_matched AND
+	location_matched AND
+	age_matched AND
+	client_impressions_count < frequency_cap_impressions AND
+	impressions_count < max_impressions AND
+	clicks_count < clicks_limit AND
+	budget_matched
+`
+
+func adCandidatesChecksArgs(client models.Client, currentDay int) []any {
+	return []any{
+		client.Id, currentDay, client.Gender, client.Location, client.Age,
+	}
+}
+
+func (ar *AdsRepo) GetAdCandidatesForClient(ctx context.Context, client models.Client, currentDay int) ([]models.AdCandidate, error) {
+	op := "AdsRepo.GetAdCandidatesForClient"
+
+	query := `SELECT * FROM (` + adCandidatesChecksQuery + `) AS checks WHERE ` + adCandidatesCond
+
+	checks := []models.AdCandidateChecks{}
+	if err := ar.db.SelectContext(ctx, &checks, query, adCandidatesChecksArgs(client, currentDay)...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	candidates := make([]models.AdCandidate, 0, len(checks))
+	for _, check := range checks {
+		candidates = append(candidates, check.AdCandidate)
+	}
+
+	return candidates, nil
+}
+
+// GetAdCandidatesChecksForClient returns all campaigns with results
+// of each ad selection check for client
+func (ar *AdsRepo) GetAdCandidatesChecksForClient(ctx context.Context, client models.Client, currentDay int) ([]models.AdCandidateChecks, error) {
+	op := "AdsRepo.GetAdCandidatesChecksForClient"
+
+	query := adCandidatesChecksQuery + `ORDER BY campaigns.created_at DESC`
+
+	checks := []models.AdCandidateChecks{}
+	if err := ar.db.SelectContext(ctx, &checks, query, adCandidatesChecksArgs(client, currentDay)...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
 	}
 
-	return ad, nil
+	return checks, nil
 }
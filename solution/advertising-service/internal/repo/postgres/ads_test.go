@@ -24,7 +24,7 @@ func TestGetAdForClient(t *testing.T) {
 	advertisersRepo := NewAdvertiserRepo(db)
 	advertiser := generateAdvertiser()
 	advertiserId := advertiser.Id
-	_, err = advertisersRepo.UpsertAdvertisers(ctx, []models.Advertiser{advertiser})
+	_, err = advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
 	require.NoError(t, err)
 
 	// check if works without ml_score
@@ -36,19 +36,21 @@ func TestGetAdForClient(t *testing.T) {
 	campaign.Location = nil
 	campaign.AgeFrom = nil
 	campaign.AgeTo = nil
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
 	adsRepo := NewAdsRepo(db)
-	ad, err := adsRepo.GetAdForClient(ctx, client, 0)
+	candidates, err := adsRepo.GetAdCandidatesForClient(ctx, client, 0)
 	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	ad := candidates[0]
 
 	require.Equal(t, campaign.Id, ad.CampaignId)
 	require.Equal(t, campaign.AdvertiserId, ad.AdvertiserId)
 	require.Equal(t, campaign.AdTitle, ad.AdTitle)
 	require.Equal(t, campaign.AdText, ad.AdText)
 
-	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
 	require.NoError(t, err)
 
 	// check if works with ml_score
@@ -68,18 +70,26 @@ func TestGetAdForClient(t *testing.T) {
 	campaign.Location = nil
 	campaign.AgeFrom = nil
 	campaign.AgeTo = nil
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
-	ad, err = adsRepo.GetAdForClient(ctx, client, 0)
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 0)
 	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	ad = candidates[0]
 
 	require.Equal(t, campaign.Id, ad.CampaignId)
 	require.Equal(t, campaign.AdvertiserId, ad.AdvertiserId)
 	require.Equal(t, campaign.AdTitle, ad.AdTitle)
 	require.Equal(t, campaign.AdText, ad.AdText)
-
-	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id)
+	require.Equal(t, mlScore.Score, ad.Score)
+	require.Equal(t, mlScore.Score, ad.MaxScore)
+	require.Equal(t, campaign.CostPerImpression, ad.CostPerImpression)
+	require.Equal(t, campaign.CostPerClick, ad.CostPerClick)
+	require.Equal(t, campaign.ImpressionsLimit, ad.ImpressionsLimit)
+	require.Equal(t, 0, ad.ImpressionsCount)
+
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
 	require.NoError(t, err)
 
 	// check if returns campaign with gender='ALL'
@@ -90,23 +100,26 @@ func TestGetAdForClient(t *testing.T) {
 	campaign.Location = nil
 	campaign.AgeFrom = nil
 	campaign.AgeTo = nil
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
-	ad, err = adsRepo.GetAdForClient(ctx, client, 0)
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 0)
 	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	ad = candidates[0]
 
 	require.Equal(t, campaign.Id, ad.CampaignId)
 	require.Equal(t, campaign.AdvertiserId, ad.AdvertiserId)
 	require.Equal(t, campaign.AdTitle, ad.AdTitle)
 	require.Equal(t, campaign.AdText, ad.AdText)
 
-	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
 	require.NoError(t, err)
 
-	// check if returns error models.ErrNoAdsForClient
-	_, err = adsRepo.GetAdForClient(ctx, client, 0)
-	require.ErrorIs(t, err, models.ErrNoAdsForClient)
+	// check if returns no candidates
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 0)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
 
 	// check if don`t return not active campaign
 
@@ -118,7 +131,7 @@ func TestGetAdForClient(t *testing.T) {
 	campaign1.Location = nil
 	campaign1.AgeFrom = nil
 	campaign1.AgeTo = nil
-	campaign1.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign1))
+	campaign1.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign1), 0)
 	require.NoError(t, err)
 
 	// campaign has already finished
@@ -130,15 +143,16 @@ func TestGetAdForClient(t *testing.T) {
 	campaign2.Location = nil
 	campaign2.AgeFrom = nil
 	campaign2.AgeTo = nil
-	campaign2.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign2))
+	campaign2.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign2), 0)
 	require.NoError(t, err)
 
-	_, err = adsRepo.GetAdForClient(ctx, client, 6)
-	require.ErrorIs(t, err, models.ErrNoAdsForClient)
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 6)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
 
-	err = campaignsRepo.DeleteCampaign(ctx, campaign1.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign1.Id, 0)
 	require.NoError(t, err)
-	err = campaignsRepo.DeleteCampaign(ctx, campaign2.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign2.Id, 0)
 	require.NoError(t, err)
 
 	// check if don`t return campaign that doesn`t match client
@@ -160,7 +174,7 @@ func TestGetAdForClient(t *testing.T) {
 	campaign1.Location = nil
 	campaign1.AgeFrom = nil
 	campaign1.AgeTo = nil
-	campaign1.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign1))
+	campaign1.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign1), 0)
 	require.NoError(t, err)
 
 	// campaign with another location targeting
@@ -171,7 +185,7 @@ func TestGetAdForClient(t *testing.T) {
 	campaign2.Location = pointer("Rostov")
 	campaign2.AgeFrom = nil
 	campaign2.AgeTo = nil
-	campaign2.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign2))
+	campaign2.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign2), 0)
 	require.NoError(t, err)
 
 	// campaigns with another age targeting
@@ -182,7 +196,7 @@ func TestGetAdForClient(t *testing.T) {
 	campaign3.Location = nil
 	campaign3.AgeFrom = pointer(43)
 	campaign3.AgeTo = nil
-	campaign3.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign3))
+	campaign3.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign3), 0)
 	require.NoError(t, err)
 
 	campaign4 := generateCampaign()
@@ -192,19 +206,20 @@ func TestGetAdForClient(t *testing.T) {
 	campaign4.Location = nil
 	campaign4.AgeFrom = nil
 	campaign4.AgeTo = pointer(41)
-	campaign4.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign4))
+	campaign4.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign4), 0)
 	require.NoError(t, err)
 
-	_, err = adsRepo.GetAdForClient(ctx, client, 0)
-	require.ErrorIs(t, err, models.ErrNoAdsForClient)
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 0)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
 
-	err = campaignsRepo.DeleteCampaign(ctx, campaign1.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign1.Id, 0)
 	require.NoError(t, err)
-	err = campaignsRepo.DeleteCampaign(ctx, campaign2.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign2.Id, 0)
 	require.NoError(t, err)
-	err = campaignsRepo.DeleteCampaign(ctx, campaign3.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign3.Id, 0)
 	require.NoError(t, err)
-	err = campaignsRepo.DeleteCampaign(ctx, campaign4.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign4.Id, 0)
 	require.NoError(t, err)
 
 	// check if don`t return already impressed campaign
@@ -216,7 +231,7 @@ func TestGetAdForClient(t *testing.T) {
 	campaign.Location = nil
 	campaign.AgeFrom = nil
 	campaign.AgeTo = nil
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
 	client = generateClient()
@@ -232,9 +247,348 @@ func TestGetAdForClient(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	_, err = adsRepo.GetAdForClient(ctx, client, 0)
-	require.ErrorIs(t, err, models.ErrNoAdsForClient)
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 0)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
+	require.NoError(t, err)
+
+	// check if return campaign until frequency cap is reached: 2 impressions within 2 days
+	campaign = generateCampaign()
+	campaign.AdvertiserId = advertiser.Id
+	campaign.StartDate = 0
+	campaign.FrequencyCapImpressions = pointer(2)
+	campaign.FrequencyCapDays = pointer(2)
+	campaign.Gender = nil
+	campaign.Location = nil
+	campaign.AgeFrom = nil
+	campaign.AgeTo = nil
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	for range 2 {
+		candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 0)
+		require.NoError(t, err)
+		require.Len(t, candidates, 1)
+		require.Equal(t, campaign.Id, candidates[0].CampaignId)
+
+		err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+			ClientId:   client.Id,
+			CampaignId: campaign.Id,
+			Date:       0,
+			Profit:     100,
+		})
+		require.NoError(t, err)
+	}
+
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 1)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+
+	// impressions of day 0 are out of the window on day 2
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, client, 2)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	require.Equal(t, campaign.Id, candidates[0].CampaignId)
+
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
+	require.NoError(t, err)
+
+	// check if don`t return campaign that reached clicks limit
+	campaign = generateCampaign()
+	campaign.AdvertiserId = advertiser.Id
+	campaign.StartDate = 0
+	campaign.ClicksLimit = 1
+	campaign.Gender = nil
+	campaign.Location = nil
+	campaign.AgeFrom = nil
+	campaign.AgeTo = nil
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   client.Id,
+		CampaignId: campaign.Id,
+		Date:       0,
+		Profit:     100,
+	})
+	require.NoError(t, err)
+
+	impression, _, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign.Id)
+	require.NoError(t, err)
+
+	err = clientActionsRepo.RecordClick(ctx, models.Click{
+		ImpressionId: impression.Id,
+		ClientId:     client.Id,
+		CampaignId:   campaign.Id,
+		Date:         0,
+		Profit:       100,
+	})
+	require.NoError(t, err)
+
+	anotherClient := generateClient()
+	_, err = clientsRepo.UpsertClients(ctx, []models.Client{anotherClient})
+	require.NoError(t, err)
+
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, anotherClient, 0)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
+	require.NoError(t, err)
+
+	// check if don`t return campaigns of advertiser that reached its budgets
+	advertiser.DailyBudget = pointer(150.0)
+	advertiser.TotalBudget = pointer(250.0)
+	_, err = advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	campaignIds := make([]uuid.UUID, 0, 2)
+	for range 2 {
+		campaign = generateCampaign()
+		campaign.AdvertiserId = advertiser.Id
+		campaign.StartDate = 0
+		campaign.Gender = nil
+		campaign.Location = nil
+		campaign.AgeFrom = nil
+		campaign.AgeTo = nil
+		campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+		campaignIds = append(campaignIds, campaign.Id)
+	}
+
+	clients := make([]models.Client, 0, 3)
+	for range 3 {
+		clients = append(clients, generateClient())
+	}
+	_, err = clientsRepo.UpsertClients(ctx, clients)
+	require.NoError(t, err)
+
+	// day 0: spend 100 on impression and 50 on click of the first campaign
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   clients[0].Id,
+		CampaignId: campaignIds[0],
+		Date:       0,
+		Profit:     100,
+	})
+	require.NoError(t, err)
+
+	impression, _, err = clientActionsRepo.CheckImpressed(ctx, clients[0].Id, campaignIds[0])
+	require.NoError(t, err)
+
+	err = clientActionsRepo.RecordClick(ctx, models.Click{
+		ImpressionId: impression.Id,
+		ClientId:     clients[0].Id,
+		CampaignId:   campaignIds[0],
+		Date:         0,
+		Profit:       50,
+	})
+	require.NoError(t, err)
+
+	// daily budget is reached for all campaigns of advertiser
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, clients[1], 0)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+
+	// next day daily budget is available
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, clients[1], 1)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+
+	// day 1: spend 100 on impression of the second campaign, total budget is reached
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   clients[1].Id,
+		CampaignId: campaignIds[1],
+		Date:       1,
+		Profit:     100,
+	})
+	require.NoError(t, err)
+
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, clients[2], 2)
+	require.NoError(t, err)
+	require.Empty(t, candidates)
+
+	checks, err := adsRepo.GetAdCandidatesChecksForClient(ctx, clients[2], 2)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	for _, check := range checks {
+		require.False(t, check.BudgetMatched)
+	}
+
+	// budgets are removed
+	advertiser.DailyBudget = nil
+	advertiser.TotalBudget = nil
+	_, err = advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	candidates, err = adsRepo.GetAdCandidatesForClient(ctx, clients[2], 2)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+}
+
+func TestGetAdCandidatesChecksForClient(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+
+	clientsRepo := NewClientRepo(db)
+	client := generateClient()
+	client.Age = 30
+	client.Gender = models.Gender("MALE")
+	_, err := clientsRepo.UpsertClients(ctx, []models.Client{client})
+	require.NoError(t, err)
+
+	advertisersRepo := NewAdvertiserRepo(db)
+	advertiser := generateAdvertiser()
+	advertiserId := advertiser.Id
+	_, err = advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	// campaign that matches client
+	campaignsRepo := NewCampaignsRepo(db)
+	matched := generateCampaign()
+	matched.AdvertiserId = advertiser.Id
+	matched.StartDate = 0
+	matched.ImpressionsLimit = 100
+	matched.Gender = nil
+	matched.Location = pointer(client.Location)
+	matched.AgeFrom = nil
+	matched.AgeTo = nil
+	matched.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(matched), 0)
+	require.NoError(t, err)
+
+	// campaign that doesn`t match client
+	mismatched := generateCampaign()
+	mismatched.AdvertiserId = advertiser.Id
+	mismatched.StartDate = 5
+	mismatched.Gender = pointer(models.Gender("FEMALE"))
+	mismatched.Location = pointer(client.Location + " another")
+	mismatched.AgeFrom = pointer(40)
+	mismatched.AgeTo = nil
+	mismatched.ModerationStatus = models.ModerationStatusRejected
+	mismatched.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(mismatched), 0)
+	require.NoError(t, err)
+
+	clientActionsRepo := NewClientActionsRepo(db)
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   client.Id,
+		CampaignId: matched.Id,
+		Date:       0,
+		Profit:     100,
+	})
+	require.NoError(t, err)
+
+	adsRepo := NewAdsRepo(db)
+	checks, err := adsRepo.GetAdCandidatesChecksForClient(ctx, client, 0)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+
+	// campaigns are ordered from the newest
+	mismatchedChecks := checks[0]
+	require.Equal(t, mismatched.Id, mismatchedChecks.CampaignId)
+	require.False(t, mismatchedChecks.ModerationMatched)
+	require.False(t, mismatchedChecks.DateMatched)
+	require.False(t, mismatchedChecks.GenderMatched)
+	require.False(t, mismatchedChecks.LocationMatched)
+	require.False(t, mismatchedChecks.AgeMatched)
+	require.Equal(t, 0, mismatchedChecks.ClientImpressionsCount)
+
+	matchedChecks := checks[1]
+	require.Equal(t, matched.Id, matchedChecks.CampaignId)
+	require.True(t, matchedChecks.ModerationMatched)
+	require.True(t, matchedChecks.DateMatched)
+	require.True(t, matchedChecks.GenderMatched)
+	require.True(t, matchedChecks.LocationMatched)
+	require.True(t, matchedChecks.AgeMatched)
+	require.True(t, matchedChecks.BudgetMatched)
+	require.Equal(t, 1, matchedChecks.ImpressionsCount)
+	require.Equal(t, 1, matchedChecks.ImpressionsToday)
+	require.Equal(t, matched.EndDate, matchedChecks.EndDate)
+	require.Equal(t, matched.Pacing, matchedChecks.Pacing)
+	require.Equal(t, matched.TargetingRules, matchedChecks.TargetingRules)
+	require.Equal(t, 105, matchedChecks.MaxImpressions)
+	require.Equal(t, 1, matchedChecks.ClientImpressionsCount)
+	require.Equal(t, 1, matchedChecks.FrequencyCapImpressions)
+}
+
+func TestGetAdForClientByLocations(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
 
-	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id)
+	// Moscow -> Moscow Oblast -> Russia, Kazan -> Tatarstan -> Russia
+	locationsRepo := NewLocationsRepo(db)
+	_, err := locationsRepo.UpsertLocations(ctx, []models.Location{
+		{Name: "Russia", Type: models.LocationTypeCountry},
+		{Name: "Moscow Oblast", Type: models.LocationTypeRegion, Parent: pointer("Russia")},
+		{Name: "Moscow", Type: models.LocationTypeCity, Parent: pointer("Moscow Oblast")},
+		{Name: "Tatarstan", Type: models.LocationTypeRegion, Parent: pointer("Russia")},
+		{Name: "Kazan", Type: models.LocationTypeCity, Parent: pointer("Tatarstan")},
+	})
 	require.NoError(t, err)
+
+	clientsRepo := NewClientRepo(db)
+	moscowClient := generateClient()
+	moscowClient.Location = "Moscow"
+	kazanClient := generateClient()
+	kazanClient.Location = "Kazan"
+	unknownClient := generateClient()
+	unknownClient.Location = "Paris"
+	_, err = clientsRepo.UpsertClients(ctx, []models.Client{moscowClient, kazanClient, unknownClient})
+	require.NoError(t, err)
+
+	advertisersRepo := NewAdvertiserRepo(db)
+	advertiser := generateAdvertiser()
+	_, err = advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	campaignsRepo := NewCampaignsRepo(db)
+	createCampaign := func(location *string, locations []string) uuid.UUID {
+		campaign := generateCampaign()
+		campaign.AdvertiserId = advertiser.Id
+		campaign.StartDate = 0
+		campaign.Gender = nil
+		campaign.Location = location
+		campaign.Locations = locations
+		campaign.AgeFrom = nil
+		campaign.AgeTo = nil
+		id, err := campaignsRepo.CreateCampaign(ctx, advertiser.Id, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+
+		campaignGot, err := campaignsRepo.GetCampaignById(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, campaign.Location, campaignGot.Location)
+		require.ElementsMatch(t, campaign.Locations, campaignGot.Locations)
+
+		return id
+	}
+
+	exactCity := createCampaign(pointer("Moscow"), nil)
+	country := createCampaign(pointer("Russia"), nil)
+	region := createCampaign(nil, []string{"Tatarstan"})
+	severalCities := createCampaign(nil, []string{"Moscow", "Saint Petersburg"})
+	unknownCity := createCampaign(pointer("Paris"), nil)
+
+	candidateIds := func(client models.Client) []uuid.UUID {
+		candidates, err := NewAdsRepo(db).GetAdCandidatesForClient(ctx, client, 0)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(candidates))
+		for _, candidate := range candidates {
+			ids = append(ids, candidate.CampaignId)
+		}
+		return ids
+	}
+
+	require.ElementsMatch(t, []uuid.UUID{exactCity, country, severalCities}, candidateIds(moscowClient))
+	require.ElementsMatch(t, []uuid.UUID{country, region}, candidateIds(kazanClient))
+	// locations out of the hierarchy are matched exactly
+	require.ElementsMatch(t, []uuid.UUID{unknownCity}, candidateIds(unknownClient))
+
+	checks, err := NewAdsRepo(db).GetAdCandidatesChecksForClient(ctx, kazanClient, 0)
+	require.NoError(t, err)
+	require.Len(t, checks, 5)
+	for _, check := range checks {
+		matched := check.CampaignId == country || check.CampaignId == region
+		require.Equal(t, matched, check.LocationMatched)
+	}
 }
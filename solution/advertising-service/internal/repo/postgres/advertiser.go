@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"context"
 	"database/sql"
@@ -10,6 +11,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type AdvertiserRepo struct {
@@ -28,9 +30,9 @@ func (ar *AdvertiserRepo) GetAdvertiserById(ctx context.Context, id uuid.UUID) (
 	op := "AdvertiserRepo.GetAdvertiserById"
 
 	query, args, err := ar.sq.
-		Select("id", "name").
+		Select("id", "name", "daily_budget", "total_budget").
 		From("advertisers").
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return models.Advertiser{}, fmt.Errorf("%s: build query: %w", op, err)
@@ -48,37 +50,254 @@ func (ar *AdvertiserRepo) GetAdvertiserById(ctx context.Context, id uuid.UUID) (
 	return advertiser, nil
 }
 
-func (ar *AdvertiserRepo) UpsertAdvertisers(ctx context.Context, advertisers []models.Advertiser) ([]models.Advertiser, error) {
+// ListAdvertisers returns not deleted advertisers ordered by name
+func (ar *AdvertiserRepo) ListAdvertisers(ctx context.Context, params dto.AdvertisersListParams) ([]models.Advertiser, error) {
+	op := "AdvertiserRepo.ListAdvertisers"
+
+	query, args, err := ar.sq.
+		Select("id", "name", "daily_budget", "total_budget").
+		From("advertisers").
+		Where(advertisersListCond(params)).
+		OrderBy("name", "id").
+		Limit(uint64(params.Size)).
+		Offset(uint64(params.Page-1) * uint64(params.Size)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	advertisers := []models.Advertiser{}
+	if err := ar.db.SelectContext(ctx, &advertisers, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return advertisers, nil
+}
+
+func (ar *AdvertiserRepo) CountAdvertisers(ctx context.Context, params dto.AdvertisersListParams) (int, error) {
+	op := "AdvertiserRepo.CountAdvertisers"
+
+	query, args, err := ar.sq.
+		Select("COUNT(*)").
+		From("advertisers").
+		Where(advertisersListCond(params)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var count int
+	if err := ar.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return count, nil
+}
+
+func advertisersListCond(params dto.AdvertisersListParams) sq.And {
+	cond := sq.And{sq.Eq{"deleted_at": nil}}
+	if params.Name != nil {
+		cond = append(cond, sq.ILike{"name": "%" + escapeLike(*params.Name) + "%"})
+	}
+
+	return cond
+}
+
+// UpsertAdvertisers creates or updates advertisers in one transaction.
+// Budgets which are not set keep their values. Deleted advertisers are not restored:
+// if any of them is upserted, nothing is saved and DeletedAdvertisersError is returned
+func (ar *AdvertiserRepo) UpsertAdvertisers(ctx context.Context, advertisers []dto.AdvertiserUpsert) ([]models.Advertiser, error) {
 	op := "AdvertiserRepo.UpsertAdvertisers"
 
-	toInsert := map[uuid.UUID]models.Advertiser{}
+	toInsert := map[uuid.UUID]dto.AdvertiserUpsert{}
 	for _, advertiser := range advertisers {
-		toInsert[advertiser.Id] = advertiser
+		toInsert[advertiser.Advertiser.Id] = advertiser
 	}
 
-	qb := ar.sq.Insert("advertisers").Columns("id", "name")
-	for _, advertiser := range toInsert {
-		qb = qb.Values(advertiser.Id, advertiser.Name)
+	ids := make([]uuid.UUID, 0, len(toInsert))
+	for id := range toInsert {
+		ids = append(ids, id)
+	}
+
+	tx, err := ar.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	// existing advertisers are locked so concurrent deletion can't happen between check and update
+	query, args, err := ar.sq.
+		Select("id", "deleted_at IS NOT NULL AS deleted").
+		From("advertisers").
+		Where("id = ANY(?)", pq.Array(ids)).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	query, args, err := qb.Suffix(
+	var existing []struct {
+		Id      uuid.UUID `db:"id"`
+		Deleted bool      `db:"deleted"`
+	}
+	if err := tx.SelectContext(ctx, &existing, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: lock advertisers: %w", op, err)
+	}
+
+	var deleted []uuid.UUID
+	for _, advertiser := range existing {
+		if advertiser.Deleted {
+			deleted = append(deleted, advertiser.Id)
+		}
+	}
+	if len(deleted) > 0 {
+		return nil, dto.DeletedAdvertisersError{Ids: deleted}
+	}
+
+	qb := ar.sq.Insert("advertisers").Columns("id", "name", "daily_budget", "total_budget")
+	for _, upsert := range toInsert {
+		advertiser := upsert.Advertiser
+
+		// budget which is not set is taken from existing row, new advertiser gets no cap
+		var dailyBudget, totalBudget any = advertiser.DailyBudget, advertiser.TotalBudget
+		if !upsert.DailyBudgetSet {
+			dailyBudget = sq.Expr("(SELECT daily_budget FROM advertisers WHERE id = ?)", advertiser.Id)
+		}
+		if !upsert.TotalBudgetSet {
+			totalBudget = sq.Expr("(SELECT total_budget FROM advertisers WHERE id = ?)", advertiser.Id)
+		}
+
+		qb = qb.Values(advertiser.Id, advertiser.Name, dailyBudget, totalBudget)
+	}
+
+	query, args, err = qb.Suffix(
 		`ON CONFLICT(id)
 			DO UPDATE SET
-			name = EXCLUDED.name
-		`,
+			name = EXCLUDED.name,
+			daily_budget = EXCLUDED.daily_budget,
+			total_budget = EXCLUDED.total_budget
+		RETURNING id, name, daily_budget, total_budget`,
 	).ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	if _, err := ar.db.ExecContext(ctx, query, args...); err != nil {
-		return nil, fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	var upserted []models.Advertiser
+	if err := tx.SelectContext(ctx, &upserted, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return upserted, nil
+}
+
+// DeleteAdvertiser marks advertiser as deleted and archives all its campaigns in one transaction.
+// Advertiser with active campaigns is not deleted, its campaigns impressions and clicks are kept
+func (ar *AdvertiserRepo) DeleteAdvertiser(ctx context.Context, id uuid.UUID, day int) error {
+	op := "AdvertiserRepo.DeleteAdvertiser"
+
+	tx, err := ar.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	// advertiser lock blocks creation of new campaigns until deletion is committed
+	query, args, err := ar.sq.
+		Select("id").
+		From("advertisers").
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var lockedId uuid.UUID
+	if err := tx.GetContext(ctx, &lockedId, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrAdvertiserNotFound
+		}
+
+		return fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	// campaigns locks prevent concurrent status transitions between check and archiving
+	query, args, err = ar.sq.
+		Select(campaignColumns...).
+		From("campaigns").
+		Where(sq.Eq{"advertiser_id": id, "deleted_at": nil}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	campaigns := []models.Campaign{}
+	if err := tx.SelectContext(ctx, &campaigns, query, args...); err != nil {
+		return fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	for _, campaign := range campaigns {
+		if campaign.Status == models.CampaignStatusActive {
+			return models.ErrAdvertiserHasActiveCampaigns
+		}
+	}
+
+	query, args, err = ar.sq.
+		Update("campaigns").
+		Set("status", models.CampaignStatusArchived).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"advertiser_id": id, "deleted_at": nil}).
+		Where(sq.NotEq{"status": models.CampaignStatusArchived}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	for _, campaignWas := range campaigns {
+		if campaignWas.Status == models.CampaignStatusArchived {
+			continue
+		}
+
+		campaign := campaignWas
+		campaign.Status = models.CampaignStatusArchived
+		campaign.Version++
+
+		version, err := dto.NewCampaignVersion(day, models.CampaignActionStatus, &campaignWas, campaign)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertCampaignVersion(ctx, tx, ar.sq, version); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	query, args, err = ar.sq.
+		Update("advertisers").
+		Set("deleted_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%s: tx.ExecContext: %w", op, err)
 	}
 
-	inserted := make([]models.Advertiser, 0, len(toInsert))
-	for _, advertiser := range toInsert {
-		inserted = append(inserted, advertiser)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
 	}
 
-	return inserted, nil
+	return nil
 }
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/tests/helpers"
 	"context"
@@ -22,7 +23,7 @@ func TestAdvertiserRepo(t *testing.T) {
 		advertisers = append(advertisers, generateAdvertiser())
 	}
 
-	advertisersGot, err := advertiserRepo.UpsertAdvertisers(ctx, advertisers)
+	advertisersGot, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
 	require.NoError(t, err)
 	require.ElementsMatch(t, advertisers, advertisersGot)
 
@@ -48,7 +49,7 @@ func TestAdvertiserRepo(t *testing.T) {
 		advertisers = append(advertisers, generateAdvertiser())
 	}
 
-	advertisersGot, err = advertiserRepo.UpsertAdvertisers(ctx, advertisers)
+	advertisersGot, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
 	require.NoError(t, err)
 	require.ElementsMatch(t, advertisers, advertisersGot)
 
@@ -60,10 +61,198 @@ func TestAdvertiserRepo(t *testing.T) {
 		advertisers[10+i] = newAdvertiser
 	}
 
-	advertisersGot, err = advertiserRepo.UpsertAdvertisers(ctx, advertisers)
+	advertisersGot, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
 	require.NoError(t, err)
 	require.ElementsMatch(t, advertisers[:10], advertisersGot)
 
+	// check upsert advertiser with budgets
+	advertiser := generateAdvertiser()
+	advertiser.DailyBudget = pointer(gofakeit.Float64Range(0, 999))
+	advertiser.TotalBudget = pointer(gofakeit.Float64Range(1000, 9999))
+
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	advertiserGot, err := advertiserRepo.GetAdvertiserById(ctx, advertiser.Id)
+	require.NoError(t, err)
+	require.Equal(t, advertiser, advertiserGot)
+
+	// check budgets which are not set are kept
+	renamed := advertiser
+	renamed.Name = gofakeit.Company()
+	renamed.DailyBudget = nil
+	renamed.TotalBudget = nil
+
+	advertisersGot, err = advertiserRepo.UpsertAdvertisers(ctx, []dto.AdvertiserUpsert{{Advertiser: renamed}})
+	require.NoError(t, err)
+	advertiser.Name = renamed.Name
+	require.Equal(t, []models.Advertiser{advertiser}, advertisersGot)
+
+	advertiserGot, err = advertiserRepo.GetAdvertiserById(ctx, advertiser.Id)
+	require.NoError(t, err)
+	require.Equal(t, advertiser, advertiserGot)
+
+	// check budgets are removed
+	advertiser.DailyBudget = nil
+	advertiser.TotalBudget = nil
+
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	advertiserGot, err = advertiserRepo.GetAdvertiserById(ctx, advertiser.Id)
+	require.NoError(t, err)
+	require.Equal(t, advertiser, advertiserGot)
+}
+
+func TestListAdvertisers(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertiserRepo := NewAdvertiserRepo(db)
+
+	advertisers := []models.Advertiser{
+		{Id: uuid.New(), Name: "Delta Foods"},
+		{Id: uuid.New(), Name: "alpha motors"},
+		{Id: uuid.New(), Name: "Beta_Foods"},
+		{Id: uuid.New(), Name: "Gamma Motors"},
+	}
+	_, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
+	require.NoError(t, err)
+
+	// check list is ordered by name and paginated
+	params := dto.AdvertisersListParams{PaginationParams: dto.PaginationParams{Size: 3, Page: 1}}
+	advertisersGot, err := advertiserRepo.ListAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, []models.Advertiser{advertisers[2], advertisers[0], advertisers[3]}, advertisersGot)
+
+	params.Page = 2
+	advertisersGot, err = advertiserRepo.ListAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, []models.Advertiser{advertisers[1]}, advertisersGot)
+
+	total, err := advertiserRepo.CountAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 4, total)
+
+	// check search by name is case-insensitive
+	params = dto.AdvertisersListParams{
+		PaginationParams: dto.PaginationParams{Size: 10, Page: 1},
+		Name:             pointer("MOTORS"),
+	}
+	advertisersGot, err = advertiserRepo.ListAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, []models.Advertiser{advertisers[3], advertisers[1]}, advertisersGot)
+
+	total, err = advertiserRepo.CountAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+
+	// check wildcards are searched literally
+	params.Name = pointer("_")
+	advertisersGot, err = advertiserRepo.ListAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, []models.Advertiser{advertisers[2]}, advertisersGot)
+
+	// check deleted advertisers are not listed
+	err = advertiserRepo.DeleteAdvertiser(ctx, advertisers[2].Id, 0)
+	require.NoError(t, err)
+
+	params.Name = nil
+	advertisersGot, err = advertiserRepo.ListAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, []models.Advertiser{advertisers[0], advertisers[3], advertisers[1]}, advertisersGot)
+
+	total, err = advertiserRepo.CountAdvertisers(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+}
+
+func TestDeleteAdvertiser(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertiserRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiser := generateAdvertiser()
+	_, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	campaignIds := map[models.CampaignStatus]uuid.UUID{}
+	for _, status := range []models.CampaignStatus{
+		models.CampaignStatusDraft, models.CampaignStatusActive, models.CampaignStatusPaused,
+		models.CampaignStatusCompleted, models.CampaignStatusArchived,
+	} {
+		campaign := generateCampaign()
+		campaign.Status = status
+		campaignIds[status], err = campaignsRepo.CreateCampaign(ctx, advertiser.Id, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+	}
+
+	// check advertiser with active campaign is not deleted
+	err = advertiserRepo.DeleteAdvertiser(ctx, advertiser.Id, 0)
+	require.ErrorIs(t, err, models.ErrAdvertiserHasActiveCampaigns)
+
+	_, err = advertiserRepo.GetAdvertiserById(ctx, advertiser.Id)
+	require.NoError(t, err)
+
+	campaign, err := campaignsRepo.GetCampaignById(ctx, campaignIds[models.CampaignStatusDraft])
+	require.NoError(t, err)
+	require.Equal(t, models.CampaignStatusDraft, campaign.Status)
+
+	// check campaigns are archived on deletion
+	err = campaignsRepo.UpdateCampaignStatus(ctx, campaignIds[models.CampaignStatusActive], models.CampaignStatusActive, models.CampaignStatusPaused, 0)
+	require.NoError(t, err)
+
+	err = advertiserRepo.DeleteAdvertiser(ctx, advertiser.Id, 7)
+	require.NoError(t, err)
+
+	_, err = advertiserRepo.GetAdvertiserById(ctx, advertiser.Id)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+
+	historyRepo := NewCampaignHistoryRepo(db)
+	for status, campaignId := range campaignIds {
+		campaign, err := campaignsRepo.GetCampaignById(ctx, campaignId)
+		require.NoError(t, err)
+		require.Equal(t, models.CampaignStatusArchived, campaign.Status)
+
+		// check archiving is saved to history of campaigns which were not archived
+		versions, err := historyRepo.ListCampaignVersions(ctx, campaignId, dto.PaginationParams{Page: 1, Size: 10})
+		require.NoError(t, err)
+		if status == models.CampaignStatusArchived {
+			require.Len(t, versions, 1)
+			require.Equal(t, models.CampaignActionCreate, versions[0].Action)
+			continue
+		}
+		require.Equal(t, campaign.Version, versions[0].Version)
+		require.Equal(t, models.CampaignActionStatus, versions[0].Action)
+		require.Equal(t, 7, versions[0].Day)
+		require.Equal(t, models.CampaignStatusArchived, versions[0].Campaign.Status)
+		require.Len(t, versions[0].Changes, 1)
+		require.Equal(t, "status", versions[0].Changes[0].Field)
+	}
+
+	// check deleted advertiser can't be deleted again and has no new campaigns
+	err = advertiserRepo.DeleteAdvertiser(ctx, advertiser.Id, 0)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+
+	_, err = campaignsRepo.CreateCampaign(ctx, advertiser.Id, dto.CampaignDataFromCampaign(generateCampaign()), 0)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+
+	// check upsert does not restore deleted advertiser and saves nothing
+	otherAdvertiser := generateAdvertiser()
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser, otherAdvertiser}))
+	var deletedErr dto.DeletedAdvertisersError
+	require.ErrorAs(t, err, &deletedErr)
+	require.ErrorIs(t, err, models.ErrAdvertiserDeleted)
+	require.Equal(t, []uuid.UUID{advertiser.Id}, deletedErr.Ids)
+
+	_, err = advertiserRepo.GetAdvertiserById(ctx, advertiser.Id)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+	_, err = advertiserRepo.GetAdvertiserById(ctx, otherAdvertiser.Id)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+
+	// check delete non-existent advertiser
+	err = advertiserRepo.DeleteAdvertiser(ctx, uuid.New(), 0)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
 }
 
 func generateAdvertiser() models.Advertiser {
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+type CampaignHistoryRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewCampaignHistoryRepo(db *sqlx.DB) *CampaignHistoryRepo {
+	return &CampaignHistoryRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// insertCampaignVersion saves campaign version to campaign history in the transaction.
+// Version is numbered with campaign version, so history and campaign ETag use the same number
+func insertCampaignVersion(ctx context.Context, tx *sqlx.Tx, builder sq.StatementBuilderType, version models.CampaignVersion) error {
+	query, args, err := builder.
+		Insert("campaign_versions").
+		Columns("campaign_id", "version", "action", "day", "snapshot", "changes").
+		Values(version.CampaignId, version.Version, version.Action, version.Day, version.Campaign, version.Changes).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build version insert query: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("insert version: %w", err)
+	}
+
+	return nil
+}
+
+func (hr *CampaignHistoryRepo) ListCampaignVersions(ctx context.Context, campaignId uuid.UUID, params dto.PaginationParams) ([]models.CampaignVersion, error) {
+	op := "CampaignHistoryRepo.ListCampaignVersions"
+
+	query, args, err := hr.sq.
+		Select("campaign_id", "version", "action", "day", "snapshot", "changes", "created_at").
+		From("campaign_versions").
+		Where(sq.Eq{"campaign_id": campaignId}).
+		OrderBy("version DESC").
+		Limit(uint64(params.Size)).
+		Offset(uint64(params.Page-1) * uint64(params.Size)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	versions := []models.CampaignVersion{}
+	if err := hr.db.SelectContext(ctx, &versions, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return versions, nil
+}
+
+func (hr *CampaignHistoryRepo) GetCampaignVersion(ctx context.Context, campaignId uuid.UUID, version int) (models.CampaignVersion, error) {
+	op := "CampaignHistoryRepo.GetCampaignVersion"
+
+	query, args, err := hr.sq.
+		Select("campaign_id", "version", "action", "day", "snapshot", "changes", "created_at").
+		From("campaign_versions").
+		Where(sq.Eq{"campaign_id": campaignId, "version": version}).
+		ToSql()
+	if err != nil {
+		return models.CampaignVersion{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var res models.CampaignVersion
+	if err := hr.db.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.CampaignVersion{}, models.ErrVersionNotFound
+		}
+		return models.CampaignVersion{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/tests/helpers"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignHistory(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+	historyRepo := NewCampaignHistoryRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	campaign := generateCampaign()
+	campaign.AdvertiserId = advertiserId
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 1)
+	require.NoError(t, err)
+
+	// check campaign writes save versions numbered with campaign version
+	campaignWas, err := campaignsRepo.GetCampaignById(ctx, campaign.Id)
+	require.NoError(t, err)
+
+	campaign = campaignWas
+	campaign.AdTitle = gofakeit.Sentence(8)
+	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaign), campaignWas.Version, 2, models.CampaignActionUpdate)
+	require.NoError(t, err)
+
+	campaign, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
+	require.NoError(t, err)
+	require.Equal(t, 2, campaign.Version)
+
+	// check get version
+	actual, err := historyRepo.GetCampaignVersion(ctx, campaign.Id, campaign.Version)
+	require.NoError(t, err)
+	require.Equal(t, campaign.Version, actual.Version)
+	require.Equal(t, models.CampaignActionUpdate, actual.Action)
+	require.Equal(t, 2, actual.Day)
+	require.Equal(t, models.CampaignSnapshot(campaign), actual.Campaign)
+	require.Equal(t, models.CampaignChanges{
+		{
+			Field: "ad_title",
+			Old:   mustMarshal(t, campaignWas.AdTitle),
+			New:   mustMarshal(t, campaign.AdTitle),
+		},
+	}, actual.Changes)
+
+	_, err = historyRepo.GetCampaignVersion(ctx, campaign.Id, 3)
+	require.ErrorIs(t, err, models.ErrVersionNotFound)
+
+	// check list versions from the last
+	versions, err := historyRepo.ListCampaignVersions(ctx, campaign.Id, dto.PaginationParams{Size: 10, Page: 1})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, 2, versions[0].Version)
+	require.Equal(t, 1, versions[1].Version)
+	require.Equal(t, models.CampaignActionCreate, versions[1].Action)
+	require.Equal(t, 1, versions[1].Day)
+	require.Empty(t, versions[1].Changes)
+
+	versions, err = historyRepo.ListCampaignVersions(ctx, campaign.Id, dto.PaginationParams{Size: 1, Page: 2})
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, 1, versions[0].Version)
+
+	// check status change and image write versions in the same way
+	err = campaignsRepo.UpdateCampaignStatus(ctx, campaign.Id, campaign.Status, models.CampaignStatusArchived, 3)
+	require.NoError(t, err)
+
+	imageUrl := gofakeit.URL()
+	err = campaignsRepo.SetCampaignAdImageUrl(ctx, campaign.Id, &imageUrl, 3)
+	require.NoError(t, err)
+
+	campaign, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
+	require.NoError(t, err)
+	require.Equal(t, 4, campaign.Version)
+
+	versions, err = historyRepo.ListCampaignVersions(ctx, campaign.Id, dto.PaginationParams{Size: 2, Page: 1})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, 4, versions[0].Version)
+	require.Equal(t, models.CampaignActionImage, versions[0].Action)
+	require.Equal(t, 3, versions[1].Version)
+	require.Equal(t, models.CampaignActionStatus, versions[1].Action)
+
+	// check version mismatch saves no version
+	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaign), 1, 3, models.CampaignActionUpdate)
+	require.ErrorIs(t, err, models.ErrVersionMismatch)
+
+	_, err = historyRepo.GetCampaignVersion(ctx, campaign.Id, 5)
+	require.ErrorIs(t, err, models.ErrVersionNotFound)
+}
+
+func mustMarshal(t *testing.T, value any) json.RawMessage {
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	return data
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"advertising/advertising-service/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type CampaignTemplatesRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewCampaignTemplatesRepo(db *sqlx.DB) *CampaignTemplatesRepo {
+	return &CampaignTemplatesRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// CreateTemplate saves template and returns it with generated id and creation time
+func (tr *CampaignTemplatesRepo) CreateTemplate(ctx context.Context, template models.CampaignTemplate) (models.CampaignTemplate, error) {
+	op := "CampaignTemplatesRepo.CreateTemplate"
+
+	query, args, err := tr.sq.
+		Insert("campaign_templates").
+		Columns("advertiser_id", "name", "campaign").
+		Values(template.AdvertiserId, template.Name, template.Campaign).
+		Suffix("RETURNING id, created_at").
+		ToSql()
+	if err != nil {
+		return models.CampaignTemplate{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	if err := tr.db.QueryRowContext(ctx, query, args...).Scan(&template.Id, &template.CreatedAt); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return models.CampaignTemplate{}, models.ErrTemplateExists
+			case "23503":
+				return models.CampaignTemplate{}, models.ErrAdvertiserNotFound
+			}
+		}
+		return models.CampaignTemplate{}, fmt.Errorf("%s: db.QueryRowContext: %w", op, err)
+	}
+
+	return template, nil
+}
+
+func (tr *CampaignTemplatesRepo) ListTemplates(ctx context.Context, advertiserId uuid.UUID) ([]models.CampaignTemplate, error) {
+	op := "CampaignTemplatesRepo.ListTemplates"
+
+	query, args, err := tr.sq.
+		Select("id", "advertiser_id", "name", "campaign", "created_at").
+		From("campaign_templates").
+		Where(sq.Eq{"advertiser_id": advertiserId}).
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	templates := []models.CampaignTemplate{}
+	if err := tr.db.SelectContext(ctx, &templates, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return templates, nil
+}
+
+func (tr *CampaignTemplatesRepo) GetTemplate(ctx context.Context, templateId uuid.UUID) (models.CampaignTemplate, error) {
+	op := "CampaignTemplatesRepo.GetTemplate"
+
+	query, args, err := tr.sq.
+		Select("id", "advertiser_id", "name", "campaign", "created_at").
+		From("campaign_templates").
+		Where(sq.Eq{"id": templateId}).
+		ToSql()
+	if err != nil {
+		return models.CampaignTemplate{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var template models.CampaignTemplate
+	if err := tr.db.GetContext(ctx, &template, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.CampaignTemplate{}, models.ErrTemplateNotFound
+		}
+		return models.CampaignTemplate{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return template, nil
+}
+
+func (tr *CampaignTemplatesRepo) DeleteTemplate(ctx context.Context, templateId uuid.UUID) error {
+	op := "CampaignTemplatesRepo.DeleteTemplate"
+
+	query, args, err := tr.sq.
+		Delete("campaign_templates").
+		Where(sq.Eq{"id": templateId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	res, err := tr.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrTemplateNotFound
+	}
+
+	return nil
+}
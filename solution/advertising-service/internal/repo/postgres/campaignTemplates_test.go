@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/tests/helpers"
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignTemplates(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	templatesRepo := NewCampaignTemplatesRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	campaign := generateCampaign()
+	campaign.AdvertiserId = advertiserId
+
+	// check create
+	template, err := templatesRepo.CreateTemplate(ctx, models.CampaignTemplate{
+		AdvertiserId: advertiserId,
+		Name:         "b template",
+		Campaign:     models.CampaignSnapshot(campaign),
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.UUID{}, template.Id)
+
+	other, err := templatesRepo.CreateTemplate(ctx, models.CampaignTemplate{
+		AdvertiserId: advertiserId,
+		Name:         "a template",
+		Campaign:     models.CampaignSnapshot(campaign),
+	})
+	require.NoError(t, err)
+
+	// check name is unique for advertiser
+	_, err = templatesRepo.CreateTemplate(ctx, models.CampaignTemplate{
+		AdvertiserId: advertiserId,
+		Name:         "b template",
+		Campaign:     models.CampaignSnapshot(campaign),
+	})
+	require.ErrorIs(t, err, models.ErrTemplateExists)
+
+	_, err = templatesRepo.CreateTemplate(ctx, models.CampaignTemplate{
+		AdvertiserId: uuid.New(),
+		Name:         "b template",
+		Campaign:     models.CampaignSnapshot(campaign),
+	})
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+
+	// check get
+	actual, err := templatesRepo.GetTemplate(ctx, template.Id)
+	require.NoError(t, err)
+	require.Equal(t, template.Name, actual.Name)
+	require.Equal(t, template.Campaign, actual.Campaign)
+
+	// check list is sorted by name
+	templates, err := templatesRepo.ListTemplates(ctx, advertiserId)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	require.Equal(t, other.Id, templates[0].Id)
+	require.Equal(t, template.Id, templates[1].Id)
+
+	// check delete
+	err = templatesRepo.DeleteTemplate(ctx, template.Id)
+	require.NoError(t, err)
+
+	_, err = templatesRepo.GetTemplate(ctx, template.Id)
+	require.ErrorIs(t, err, models.ErrTemplateNotFound)
+
+	err = templatesRepo.DeleteTemplate(ctx, template.Id)
+	require.ErrorIs(t, err, models.ErrTemplateNotFound)
+}
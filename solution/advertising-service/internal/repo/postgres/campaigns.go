@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -26,16 +27,78 @@ func NewCampaignsRepo(db *sqlx.DB) *CampaignsRepo {
 	}
 }
 
-func (cr *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData) (uuid.UUID, error) {
+// CreateCampaign creates campaign and saves its first version to campaign history in one transaction
+func (cr *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData, day int) (uuid.UUID, error) {
 	op := "CampaignsRepo.CreateCampaign"
 
+	ids, err := cr.CreateCampaigns(ctx, advertiserId, []dto.CampaignData{data}, day)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids[0], nil
+}
+
+// CreateCampaigns creates all campaigns and saves their versions to campaign history in one transaction.
+// Returns ids of campaigns in the same order
+func (cr *CampaignsRepo) CreateCampaigns(ctx context.Context, advertiserId uuid.UUID, data []dto.CampaignData, day int) ([]uuid.UUID, error) {
+	op := "CampaignsRepo.CreateCampaigns"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	ids := make([]uuid.UUID, 0, len(data))
+	for _, campaignData := range data {
+		query, args, err := cr.campaignInsert(advertiserId, campaignData).ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("%s: build query: %w", op, err)
+		}
+
+		var id uuid.UUID
+		if err := tx.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+			if pqErr, ok := err.(*pq.Error); ok {
+				switch pqErr.Code {
+				case "23502", "23503":
+					return nil, models.ErrAdvertiserNotFound
+				}
+			}
+			return nil, fmt.Errorf("%s: tx.QueryRowContext: %w", op, err)
+		}
+
+		campaign := campaignData.ToCampaign()
+		campaign.Id = id
+		campaign.AdvertiserId = advertiserId
+		campaign.Version = 1
+
+		version, err := dto.NewCampaignVersion(day, models.CampaignActionCreate, nil, campaign)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertCampaignVersion(ctx, tx, cr.sq, version); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+func (cr *CampaignsRepo) campaignInsert(advertiserId uuid.UUID, data dto.CampaignData) sq.InsertBuilder {
 	columns := []string{
 		"advertiser_id", "impressions_limit", "clicks_limit",
 		"cost_per_impression", "cost_per_click",
 		"ad_title", "ad_text", "start_date", "end_date",
 	}
 	values := []any{
-		advertiserId, data.ImpressionsLimit, data.ClicksLimit,
+		notDeletedAdvertiserId(advertiserId), data.ImpressionsLimit, data.ClicksLimit,
 		data.CostPerImpression, data.CostPerClick,
 		data.AdTitle, data.AdText, data.StartDate, data.EndDate,
 	}
@@ -56,43 +119,74 @@ func (cr *CampaignsRepo) CreateCampaign(ctx context.Context, advertiserId uuid.U
 		columns = append(columns, "location")
 		values = append(values, *data.Location)
 	}
+	if data.Locations != nil {
+		columns = append(columns, "locations")
+		values = append(values, data.Locations)
+	}
+	if data.TargetingRules != nil {
+		columns = append(columns, "targeting_rules")
+		values = append(values, data.TargetingRules)
+	}
+	if data.FrequencyCapImpressions != nil {
+		columns = append(columns, "frequency_cap_impressions")
+		values = append(values, *data.FrequencyCapImpressions)
+	}
+	if data.FrequencyCapDays != nil {
+		columns = append(columns, "frequency_cap_days")
+		values = append(values, *data.FrequencyCapDays)
+	}
+	if data.Pacing != "" {
+		columns = append(columns, "pacing")
+		values = append(values, data.Pacing)
+	}
+	if data.Status != "" {
+		columns = append(columns, "status")
+		values = append(values, data.Status)
+	}
+	if data.ModerationStatus != "" {
+		columns = append(columns, "moderation_status", "flagged_phrases")
+		values = append(values, data.ModerationStatus, moderationFlaggedPhrases(data.FlaggedPhrases))
+	}
 
-	query, args, err := cr.sq.
+	return cr.sq.
 		Insert("campaigns").
 		Columns(columns...).
 		Values(values...).
-		Suffix("RETURNING id").
-		ToSql()
-	if err != nil {
-		return uuid.UUID{}, fmt.Errorf("%s: build query: %w", op, err)
-	}
+		Suffix("RETURNING id")
+}
 
-	var id uuid.UUID
-	if err := cr.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code {
-			case "23503":
-				return uuid.UUID{}, models.ErrAdvertiserNotFound
-			}
-		}
-		return uuid.UUID{}, fmt.Errorf("%s: db.QueryRowContext: %w", op, err)
+// notDeletedAdvertiserId is NULL for deleted advertiser, so campaign insert violates not null constraint.
+// The row lock waits for concurrent advertiser deletion to commit
+func notDeletedAdvertiserId(advertiserId uuid.UUID) sq.Sqlizer {
+	return sq.Expr("(SELECT id FROM advertisers WHERE id = ? AND deleted_at IS NULL FOR KEY SHARE)", advertiserId)
+}
+
+// moderationFlaggedPhrases replaces nil phrases with empty array, flagged_phrases column is not nullable
+func moderationFlaggedPhrases(phrases pq.StringArray) pq.StringArray {
+	if phrases == nil {
+		return pq.StringArray{}
 	}
+	return phrases
+}
 
-	return id, nil
+// campaignColumns are columns of models.Campaign
+var campaignColumns = []string{
+	"id", "advertiser_id", "impressions_limit", "clicks_limit",
+	"cost_per_impression", "cost_per_click",
+	"ad_title", "ad_text", "ad_image_url",
+	"start_date", "end_date",
+	"gender", "age_from", "age_to", "location", "locations", "targeting_rules",
+	"frequency_cap_impressions", "frequency_cap_days",
+	"pacing", "status", "moderation_status", "flagged_phrases", "version",
 }
 
 func (cr *CampaignsRepo) GetCampaignById(ctx context.Context, campaignId uuid.UUID) (models.Campaign, error) {
 	op := "CampaignsRepo.GetCampaignById"
 
 	query, args, err := cr.sq.
-		Select(
-			"id", "advertiser_id", "impressions_limit", "clicks_limit",
-			"cost_per_impression", "cost_per_click",
-			"ad_title", "ad_text", "ad_image_url",
-			"start_date", "end_date",
-			"gender", "age_from", "age_to", "location",
-		).From("campaigns").
-		Where(sq.Eq{"id": campaignId}).
+		Select(campaignColumns...).
+		From("campaigns").
+		Where(sq.Eq{"id": campaignId, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return models.Campaign{}, fmt.Errorf("%s: build query: %w", op, err)
@@ -109,39 +203,240 @@ func (cr *CampaignsRepo) GetCampaignById(ctx context.Context, campaignId uuid.UU
 	return campaign, nil
 }
 
-func (cr *CampaignsRepo) ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.PaginationParams) ([]models.Campaign, error) {
+// campaignsSortTypes contains sql types of columns campaigns list can be sorted by,
+// cursor keeps sort value as text and casts it back to compare with column
+var campaignsSortTypes = map[dto.CampaignsSort]string{
+	dto.CampaignsSortCreatedAt:         "timestamp",
+	dto.CampaignsSortStartDate:         "integer",
+	dto.CampaignsSortCostPerImpression: "double precision",
+	dto.CampaignsSortCostPerClick:      "double precision",
+}
+
+// ListCampaignsForAdvertiser returns page of campaigns matching filter and cursor of the next page.
+// Campaigns are ordered by sort column and id, so cursor pagination is stable
+func (cr *CampaignsRepo) ListCampaignsForAdvertiser(
+	ctx context.Context,
+	advertiserId uuid.UUID,
+	params dto.CampaignsListParams,
+) ([]models.Campaign, *dto.CampaignsCursor, error) {
 	op := "CampaignsRepo.ListCampaignsForAdvertiser"
 
+	sortType, ok := campaignsSortTypes[params.Sort]
+	if !ok {
+		return nil, nil, fmt.Errorf("%s: unknown sort %q", op, params.Sort)
+	}
+	sortColumn := string(params.Sort)
+
+	direction, compare := "ASC", ">"
+	if params.Desc {
+		direction, compare = "DESC", "<"
+	}
+
 	qb := cr.sq.
-		Select(
-			"id", "advertiser_id", "impressions_limit", "clicks_limit",
-			"cost_per_impression", "cost_per_click",
-			"ad_title", "ad_text", "ad_image_url",
-			"start_date", "end_date",
-			"gender", "age_from", "age_to", "location",
-		).From("campaigns").
-		Where(sq.Eq{"advertiser_id": advertiserId}).
-		Limit(uint64(params.Size)).
-		Offset(uint64(params.Page-1) * uint64(params.Size)).
-		OrderBy("created_at DESC")
+		Select(campaignColumns...).
+		Column(sortColumn + "::text AS sort_value").
+		From("campaigns").
+		Where(campaignsFilterCond(advertiserId, params.Filter)).
+		OrderBy(fmt.Sprintf("%s %s, id %s", sortColumn, direction, direction)).
+		// one more campaign shows if there is the next page
+		Limit(uint64(params.Size) + 1)
+
+	if params.Cursor != nil {
+		qb = qb.Where(
+			fmt.Sprintf("(%s, id) %s (?::%s, ?::uuid)", sortColumn, compare, sortType),
+			params.Cursor.SortValue, params.Cursor.Id,
+		)
+	} else {
+		qb = qb.Offset(uint64(params.Page-1) * uint64(params.Size))
+	}
 
 	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	rows := []struct {
+		models.Campaign
+		SortValue string `db:"sort_value"`
+	}{}
+	if err := cr.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	var next *dto.CampaignsCursor
+	if len(rows) > params.Size {
+		rows = rows[:params.Size]
+		if len(rows) > 0 {
+			last := rows[len(rows)-1]
+			next = &dto.CampaignsCursor{
+				Sort:      params.Sort,
+				Desc:      params.Desc,
+				SortValue: last.SortValue,
+				Id:        last.Id,
+			}
+		}
+	}
+
+	campaigns := make([]models.Campaign, 0, len(rows))
+	for _, row := range rows {
+		campaigns = append(campaigns, row.Campaign)
+	}
+
+	return campaigns, next, nil
+}
+
+func (cr *CampaignsRepo) CountCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, filter dto.CampaignsFilter) (int, error) {
+	op := "CampaignsRepo.CountCampaignsForAdvertiser"
+
+	query, args, err := cr.sq.
+		Select("COUNT(*)").
+		From("campaigns").
+		Where(campaignsFilterCond(advertiserId, filter)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var count int
+	if err := cr.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// campaignsSearchQuery joins search query parsed with russian and english configurations as q
+const campaignsSearchQuery = "CROSS JOIN (SELECT websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?)) AS search(q)"
+
+// SearchCampaigns returns campaigns matching full-text query ordered by rank.
+// Found words are highlighted in title and text fragments
+func (cr *CampaignsRepo) SearchCampaigns(ctx context.Context, params dto.CampaignsSearchParams) ([]models.CampaignSearchResult, error) {
+	op := "CampaignsRepo.SearchCampaigns"
+
+	query, args, err := cr.sq.
+		Select(campaignColumns...).
+		Columns(
+			"ts_rank_cd(search_vector, q) AS rank",
+			"ts_headline('russian', ad_title, q, 'HighlightAll=true') AS title_highlight",
+			"ts_headline('russian', ad_text, q, 'MaxFragments=3, MinWords=5, MaxWords=20') AS text_highlight",
+		).
+		From("campaigns").
+		JoinClause(campaignsSearchQuery, params.Query, params.Query).
+		Where(campaignsSearchCond(params)).
+		OrderBy("rank DESC", "id").
+		Limit(uint64(params.Size)).
+		Offset(uint64(params.Page-1) * uint64(params.Size)).
+		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	campaigns := []models.Campaign{}
-	if err := cr.db.SelectContext(ctx, &campaigns, query, args...); err != nil {
+	results := []models.CampaignSearchResult{}
+	if err := cr.db.SelectContext(ctx, &results, query, args...); err != nil {
 		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
 	}
 
-	return campaigns, nil
+	return results, nil
 }
 
-func (cr *CampaignsRepo) UpdateCampaign(ctx context.Context, campaignId uuid.UUID, data dto.CampaignData) error {
-	op := "CampaignsRepo.UpdateCampaign"
+func (cr *CampaignsRepo) CountSearchedCampaigns(ctx context.Context, params dto.CampaignsSearchParams) (int, error) {
+	op := "CampaignsRepo.CountSearchedCampaigns"
 
 	query, args, err := cr.sq.
+		Select("COUNT(*)").
+		From("campaigns").
+		JoinClause(campaignsSearchQuery, params.Query, params.Query).
+		Where(campaignsSearchCond(params)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var count int
+	if err := cr.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("%s: db.GetContext: %w", op, err)
+	}
+
+	return count, nil
+}
+
+func campaignsSearchCond(params dto.CampaignsSearchParams) sq.And {
+	cond := sq.And{
+		sq.Expr("search_vector @@ q"),
+		sq.Eq{"deleted_at": nil},
+	}
+	if params.AdvertiserId != nil {
+		cond = append(cond, sq.Eq{"advertiser_id": *params.AdvertiserId})
+	}
+
+	return cond
+}
+
+func campaignsFilterCond(advertiserId uuid.UUID, filter dto.CampaignsFilter) sq.And {
+	cond := sq.And{sq.Eq{"advertiser_id": advertiserId, "deleted_at": nil}}
+
+	if filter.Status != nil {
+		cond = append(cond, sq.Eq{"status": *filter.Status})
+	}
+	if filter.ModerationStatus != nil {
+		cond = append(cond, sq.Eq{"moderation_status": *filter.ModerationStatus})
+	}
+	if filter.ActiveOn != nil {
+		cond = append(cond,
+			sq.LtOrEq{"start_date": *filter.ActiveOn},
+			sq.GtOrEq{"end_date": *filter.ActiveOn},
+		)
+	}
+	if filter.Location != nil {
+		cond = append(cond, sq.Or{
+			sq.Eq{"location": *filter.Location},
+			sq.Expr("? = ANY(locations)", *filter.Location),
+		})
+	}
+	if filter.Gender != nil {
+		cond = append(cond, sq.Eq{"gender": *filter.Gender})
+	}
+	if filter.Title != nil {
+		cond = append(cond, sq.ILike{"ad_title": "%" + escapeLike(*filter.Title) + "%"})
+	}
+
+	return cond
+}
+
+// escapeLike escapes wildcards of LIKE pattern
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// UpdateCampaign updates campaign if its current version equals to given version, increments the version
+// and saves new version with given action to campaign history in the same transaction.
+// Returns models.ErrVersionMismatch if campaign has been changed
+func (cr *CampaignsRepo) UpdateCampaign(
+	ctx context.Context,
+	campaignId uuid.UUID,
+	data dto.CampaignData,
+	version int,
+	day int,
+	action models.CampaignAction,
+) error {
+	op := "CampaignsRepo.UpdateCampaign"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	campaignWas, err := cr.lockCampaignForUpdate(ctx, tx, campaignId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if campaignWas.Version != version {
+		return models.ErrVersionMismatch
+	}
+
+	update := cr.sq.
 		Update("campaigns").
 		Set("impressions_limit", data.ImpressionsLimit).
 		Set("clicks_limit", data.ClicksLimit).
@@ -155,60 +450,158 @@ func (cr *CampaignsRepo) UpdateCampaign(ctx context.Context, campaignId uuid.UUI
 		Set("age_from", data.AgeFrom).
 		Set("age_to", data.AgeTo).
 		Set("location", data.Location).
-		Where(sq.Eq{"id": campaignId}).
-		ToSql()
-	if err != nil {
-		return fmt.Errorf("%s: build query: %w", op, err)
+		Set("locations", data.Locations).
+		Set("targeting_rules", data.TargetingRules).
+		Set("frequency_cap_impressions", data.FrequencyCapImpressions).
+		Set("frequency_cap_days", data.FrequencyCapDays).
+		Set("pacing", data.Pacing).
+		Set("moderation_status", data.ModerationStatus).
+		Set("flagged_phrases", moderationFlaggedPhrases(data.FlaggedPhrases))
+
+	if err := cr.updateCampaignVersion(ctx, tx, campaignWas, update, day, action); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	res, err := cr.db.ExecContext(ctx, query, args...)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetCampaignAdImageUrl sets campaign image and saves new version to campaign history in the same transaction
+func (cr *CampaignsRepo) SetCampaignAdImageUrl(ctx context.Context, campaignId uuid.UUID, adImageUrl *string, day int) error {
+	op := "CampaignsRepo.SetCampaignAdImageUrl"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("%s: db.GetContext: %w", op, err)
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := res.RowsAffected()
+	campaignWas, err := cr.lockCampaignForUpdate(ctx, tx, campaignId)
 	if err != nil {
-		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if rowsAffected == 0 {
-		return models.ErrCampaignNotFound
+	update := cr.sq.
+		Update("campaigns").
+		Set("ad_image_url", adImageUrl)
+
+	if err := cr.updateCampaignVersion(ctx, tx, campaignWas, update, day, models.CampaignActionImage); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
 	}
 
 	return nil
 }
 
-func (cr *CampaignsRepo) SetCampaignAdImageUrl(ctx context.Context, campaignId uuid.UUID, adImageUrl *string) error {
-	op := "CampaignsRepo.SetCampaignAdImageUrl"
+// lockCampaignForUpdate locks not deleted campaign row until the end of transaction and returns the campaign
+func (cr *CampaignsRepo) lockCampaignForUpdate(ctx context.Context, tx *sqlx.Tx, campaignId uuid.UUID) (models.Campaign, error) {
+	query, args, err := cr.sq.
+		Select(campaignColumns...).
+		From("campaigns").
+		Where(sq.Eq{"id": campaignId, "deleted_at": nil}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("build lock query: %w", err)
+	}
+
+	var campaign models.Campaign
+	if err := tx.GetContext(ctx, &campaign, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Campaign{}, models.ErrCampaignNotFound
+		}
+		return models.Campaign{}, fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	return campaign, nil
+}
+
+// updateCampaignVersion applies update to the locked campaign, increments its version
+// and saves the updated campaign with action to campaign history under the new version number
+func (cr *CampaignsRepo) updateCampaignVersion(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	campaignWas models.Campaign,
+	update sq.UpdateBuilder,
+	day int,
+	action models.CampaignAction,
+) error {
+	query, args, err := update.
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": campaignWas.Id}).
+		Suffix("RETURNING " + strings.Join(campaignColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update query: %w", err)
+	}
+
+	var campaign models.Campaign
+	if err := tx.GetContext(ctx, &campaign, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	version, err := dto.NewCampaignVersion(day, action, &campaignWas, campaign)
+	if err != nil {
+		return err
+	}
+
+	return insertCampaignVersion(ctx, tx, cr.sq, version)
+}
+
+// DeleteCampaign marks campaign as deleted and saves its last state to campaign history in the same transaction.
+// Deleted campaign is not shown and not returned, but its impressions and clicks are kept for advertiser stats
+func (cr *CampaignsRepo) DeleteCampaign(ctx context.Context, campaignId uuid.UUID, day int) error {
+	op := "CampaignsRepo.DeleteCampaign"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
 
 	query, args, err := cr.sq.
 		Update("campaigns").
-		Set("ad_image_url", adImageUrl).
-		Where(sq.Eq{"id": campaignId}).
+		Set("deleted_at", sq.Expr("now()")).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": campaignId, "deleted_at": nil}).
+		Suffix("RETURNING " + strings.Join(campaignColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	res, err := cr.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	var campaign models.Campaign
+	if err := tx.GetContext(ctx, &campaign, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrCampaignNotFound
+		}
+		return fmt.Errorf("%s: tx.GetContext: %w", op, err)
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	version, err := dto.NewCampaignVersion(day, models.CampaignActionDelete, nil, campaign)
 	if err != nil {
-		return fmt.Errorf("%s: res.RowsAffected: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := insertCampaignVersion(ctx, tx, cr.sq, version); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if rowsAffected == 0 {
-		return models.ErrCampaignNotFound
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
 	}
 
 	return nil
 }
 
-func (cr *CampaignsRepo) DeleteCampaign(ctx context.Context, campaignId uuid.UUID) error {
-	op := "CampaignsRepo.DeleteCampaigns"
+// PurgeCampaign removes campaign, including deleted one, with its impressions and clicks
+func (cr *CampaignsRepo) PurgeCampaign(ctx context.Context, campaignId uuid.UUID) error {
+	op := "CampaignsRepo.PurgeCampaign"
 
 	query, args, err := cr.sq.
 		Delete("campaigns").
@@ -234,3 +627,164 @@ func (cr *CampaignsRepo) DeleteCampaign(ctx context.Context, campaignId uuid.UUI
 
 	return nil
 }
+
+// UpdateCampaignStatus moves campaign from one status to another and saves new version
+// to campaign history in the same transaction. If campaign status has been changed concurrently,
+// nothing is updated. Campaign can't become active after reaching impressions (with 5% tolerance) or clicks limit
+func (cr *CampaignsRepo) UpdateCampaignStatus(ctx context.Context, campaignId uuid.UUID, from, to models.CampaignStatus, day int) error {
+	op := "CampaignsRepo.UpdateCampaignStatus"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	campaignWas, err := cr.lockCampaignForUpdate(ctx, tx, campaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return models.ErrInvalidTransition
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if campaignWas.Status != from {
+		return models.ErrInvalidTransition
+	}
+
+	if to == models.CampaignStatusActive {
+		if err := cr.checkCampaignLimitsLeft(ctx, tx, campaignId); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	update := cr.sq.
+		Update("campaigns").
+		Set("status", to)
+
+	if err := cr.updateCampaignVersion(ctx, tx, campaignWas, update, day, models.CampaignActionStatus); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkCampaignLimitsLeft checks campaign has not reached impressions (with 5% tolerance)
+// or clicks limit, the same way as CompleteCampaigns. Campaign must be locked by the transaction
+func (cr *CampaignsRepo) checkCampaignLimitsLeft(ctx context.Context, tx *sqlx.Tx, campaignId uuid.UUID) error {
+	query, args, err := cr.sq.
+		Select(
+			`(SELECT count(*) FROM impressions WHERE impressions.campaign_id = campaigns.id) >=
+				ROUND(campaigns.impressions_limit::double precision * 1.05) AS impressions_reached`,
+			`(SELECT count(*) FROM clicks WHERE clicks.campaign_id = campaigns.id) >= campaigns.clicks_limit AS clicks_reached`,
+		).
+		From("campaigns").
+		Where(sq.Eq{"id": campaignId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build limits query: %w", err)
+	}
+
+	var reached struct {
+		Impressions bool `db:"impressions_reached"`
+		Clicks      bool `db:"clicks_reached"`
+	}
+	if err := tx.GetContext(ctx, &reached, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	if reached.Impressions {
+		return models.ErrImpressionsLimit
+	}
+	if reached.Clicks {
+		return models.ErrClicksLimit
+	}
+
+	return nil
+}
+
+// CompleteCampaigns moves active and paused campaigns, which have ended
+// before current day or reached impressions (with 5% tolerance) or clicks limit,
+// to completed status and saves their versions to campaign history in the same transaction.
+// Returns the number of completed campaigns
+func (cr *CampaignsRepo) CompleteCampaigns(ctx context.Context, currentDay int) (int, error) {
+	op := "CampaignsRepo.CompleteCampaigns"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := cr.sq.
+		Select(campaignColumns...).
+		From("campaigns").
+		Where(sq.Eq{
+			"status":     []models.CampaignStatus{models.CampaignStatusActive, models.CampaignStatusPaused},
+			"deleted_at": nil,
+		}).
+		Where(sq.Or{
+			sq.Lt{"end_date": currentDay},
+			sq.Expr(`(SELECT count(*) FROM impressions WHERE impressions.campaign_id = campaigns.id) >=
+				ROUND(campaigns.impressions_limit::double precision * 1.05)`),
+			sq.Expr(`(SELECT count(*) FROM clicks WHERE clicks.campaign_id = campaigns.id) >= campaigns.clicks_limit`),
+		}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: build select query: %w", op, err)
+	}
+
+	campaigns := []models.Campaign{}
+	if err := tx.SelectContext(ctx, &campaigns, query, args...); err != nil {
+		return 0, fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	if len(campaigns) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		ids = append(ids, campaign.Id)
+	}
+
+	query, args, err = cr.sq.
+		Update("campaigns").
+		Set("status", models.CampaignStatusCompleted).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%s: build update query: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	for _, campaignWas := range campaigns {
+		campaign := campaignWas
+		campaign.Status = models.CampaignStatusCompleted
+		campaign.Version++
+
+		version, err := dto.NewCampaignVersion(currentDay, models.CampaignActionStatus, &campaignWas, campaign)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := insertCampaignVersion(ctx, tx, cr.sq, version); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return len(campaigns), nil
+}
@@ -5,11 +5,14 @@ import (
 	"advertising/advertising-service/internal/models"
 	"advertising/tests/helpers"
 	"context"
+	"fmt"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,20 +24,21 @@ func TestCampaignCreateAndGetById(t *testing.T) {
 
 	advertiserId := uuid.New()
 
-	_, err := advertisersRepo.UpsertAdvertisers(ctx, []models.Advertiser{
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
 		{
 			Id:   advertiserId,
 			Name: gofakeit.Company(),
 		},
-	})
+	}))
 	require.NoError(t, err)
 
 	// check create campaign with all fields
 	campaign := generateCampaign()
 	campaign.AdvertiserId = advertiserId
 
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
+	campaign.Version = 1
 
 	campaignGot, err := campaignsRepo.GetCampaignById(ctx, campaign.Id)
 	require.NoError(t, err)
@@ -46,8 +50,9 @@ func TestCampaignCreateAndGetById(t *testing.T) {
 	campaign.AgeTo = nil
 	campaign.Gender = nil
 
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
+	campaign.Version = 1
 
 	campaignGot, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
 	require.NoError(t, err)
@@ -60,11 +65,64 @@ func TestCampaignCreateAndGetById(t *testing.T) {
 	// check create campaign with non-existent advertiser
 	campaign = generateCampaign()
 
-	_, err = campaignsRepo.CreateCampaign(ctx, uuid.New(), dto.CampaignDataFromCampaign(campaign))
+	_, err = campaignsRepo.CreateCampaign(ctx, uuid.New(), dto.CampaignDataFromCampaign(campaign), 0)
 	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
 
 }
 
+func TestCreateCampaigns(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	// check all campaigns are created
+	campaigns := []models.Campaign{generateCampaign(), generateCampaign()}
+	data := make([]dto.CampaignData, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		data = append(data, dto.CampaignDataFromCampaign(campaign))
+	}
+
+	ids, err := campaignsRepo.CreateCampaigns(ctx, advertiserId, data, 3)
+	require.NoError(t, err)
+	require.Len(t, ids, len(campaigns))
+
+	historyRepo := NewCampaignHistoryRepo(db)
+	for i, campaign := range campaigns {
+		campaign.Id = ids[i]
+		campaign.AdvertiserId = advertiserId
+		campaign.Version = 1
+
+		campaignGot, err := campaignsRepo.GetCampaignById(ctx, campaign.Id)
+		require.NoError(t, err)
+		require.Equal(t, campaign, campaignGot)
+
+		// check creation is saved to history
+		versions, err := historyRepo.ListCampaignVersions(ctx, campaign.Id, dto.PaginationParams{Page: 1, Size: 10})
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		require.Equal(t, 1, versions[0].Version)
+		require.Equal(t, models.CampaignActionCreate, versions[0].Action)
+		require.Equal(t, 3, versions[0].Day)
+		require.Equal(t, campaign.Id, versions[0].Campaign.Id)
+		require.Equal(t, campaign.AdTitle, versions[0].Campaign.AdTitle)
+	}
+
+	// check nothing is created with non-existent advertiser
+	_, err = campaignsRepo.CreateCampaigns(ctx, uuid.New(), data, 3)
+	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+}
+
 func TestListCampaignsByAdvertiserId(t *testing.T) {
 	ctx := context.Background()
 	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
@@ -73,12 +131,12 @@ func TestListCampaignsByAdvertiserId(t *testing.T) {
 
 	advertiserId := uuid.New()
 
-	_, err := advertisersRepo.UpsertAdvertisers(ctx, []models.Advertiser{
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
 		{
 			Id:   advertiserId,
 			Name: gofakeit.Company(),
 		},
-	})
+	}))
 	require.NoError(t, err)
 
 	campaigns := make([]models.Campaign, 0, 20)
@@ -98,8 +156,9 @@ func TestListCampaignsByAdvertiserId(t *testing.T) {
 			campaign.Location = nil
 		}
 
-		campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+		campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 		require.NoError(t, err)
+		campaign.Version = 1
 
 		campaigns = append(campaigns, campaign)
 	}
@@ -107,37 +166,34 @@ func TestListCampaignsByAdvertiserId(t *testing.T) {
 	slices.Reverse(campaigns)
 
 	// check list with big size and page 1
-	campaignsGot, err := campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, dto.PaginationParams{
-		Size: 9999,
-		Page: 1,
-	})
+	campaignsGot, next, err := campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, newestCampaignsParams(9999, 1))
 	require.NoError(t, err)
 	require.Equal(t, campaigns, campaignsGot)
+	require.Nil(t, next)
 
 	// check list with size 10 and page 1
-	campaignsGot, err = campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, dto.PaginationParams{
-		Size: 10,
-		Page: 1,
-	})
+	campaignsGot, next, err = campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, newestCampaignsParams(10, 1))
 	require.NoError(t, err)
 	require.Equal(t, campaigns[:10], campaignsGot)
+	require.NotNil(t, next)
 
 	// check list with size 5 and page 2
-	campaignsGot, err = campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, dto.PaginationParams{
-		Size: 5,
-		Page: 2,
-	})
+	campaignsGot, _, err = campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, newestCampaignsParams(5, 2))
 	require.NoError(t, err)
 	require.ElementsMatch(t, campaigns[5:10], campaignsGot)
 
 	// check list with empty result
-	campaignsGot, err = campaignsRepo.ListCampaignsForAdvertiser(ctx, uuid.New(), dto.PaginationParams{})
+	campaignsGot, next, err = campaignsRepo.ListCampaignsForAdvertiser(ctx, uuid.New(), newestCampaignsParams(10, 1))
 	require.NoError(t, err)
 	require.Empty(t, campaignsGot)
+	require.Nil(t, next)
 
+	count, err := campaignsRepo.CountCampaignsForAdvertiser(ctx, advertiserId, dto.CampaignsFilter{})
+	require.NoError(t, err)
+	require.Equal(t, len(campaigns), count)
 }
 
-func TestUpdateCampaign(t *testing.T) {
+func TestListCampaignsWithFiltersAndCursor(t *testing.T) {
 	ctx := context.Background()
 	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
 	advertisersRepo := NewAdvertiserRepo(db)
@@ -145,26 +201,123 @@ func TestUpdateCampaign(t *testing.T) {
 
 	advertiserId := uuid.New()
 
-	_, err := advertisersRepo.UpsertAdvertisers(ctx, []models.Advertiser{
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
 		{
 			Id:   advertiserId,
 			Name: gofakeit.Company(),
 		},
+	}))
+	require.NoError(t, err)
+
+	campaigns := make([]models.Campaign, 0, 10)
+	for i := range 10 {
+		campaign := generateCampaign()
+		campaign.AdvertiserId = advertiserId
+		campaign.Version = 1
+		// equal start dates check ordering by id
+		campaign.StartDate = i / 2
+		campaign.EndDate = 10
+		campaign.AdTitle = fmt.Sprintf("campaign %d", i)
+		campaign.Location = pointer("Moscow")
+		campaign.Gender = pointer(models.GenderMale)
+		if i%2 == 0 {
+			campaign.AdTitle = fmt.Sprintf("Summer_sale %d", i)
+			campaign.Location = nil
+			campaign.Locations = pq.StringArray{"Moscow"}
+			campaign.Gender = pointer(models.GenderFemale)
+			campaign.Status = models.CampaignStatusPaused
+		}
+
+		campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+
+		campaigns = append(campaigns, campaign)
+	}
+
+	// check cursor pagination by start date
+	slices.SortFunc(campaigns, func(a, b models.Campaign) int {
+		if a.StartDate != b.StartDate {
+			return a.StartDate - b.StartDate
+		}
+		return strings.Compare(a.Id.String(), b.Id.String())
 	})
+
+	params := dto.CampaignsListParams{
+		PaginationParams: dto.PaginationParams{Size: 3, Page: 1},
+		Sort:             dto.CampaignsSortStartDate,
+	}
+	campaignsGot := []models.Campaign{}
+	for {
+		page, next, err := campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+		require.NoError(t, err)
+		campaignsGot = append(campaignsGot, page...)
+
+		if next == nil {
+			break
+		}
+		params.Cursor = next
+	}
+	require.Equal(t, campaigns, campaignsGot)
+
+	// check filters
+	tests := []struct {
+		name     string
+		filter   dto.CampaignsFilter
+		expected int
+	}{
+		{"status", dto.CampaignsFilter{Status: pointer(models.CampaignStatusPaused)}, 5},
+		{"active on", dto.CampaignsFilter{ActiveOn: pointer(1)}, 4},
+		{"location", dto.CampaignsFilter{Location: pointer("Moscow")}, 10},
+		{"gender", dto.CampaignsFilter{Gender: pointer(models.GenderMale)}, 5},
+		{"title", dto.CampaignsFilter{Title: pointer("summer_")}, 5},
+		{"title wildcard", dto.CampaignsFilter{Title: pointer("%")}, 0},
+		{"all", dto.CampaignsFilter{Status: pointer(models.CampaignStatusPaused), Gender: pointer(models.GenderMale)}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := newestCampaignsParams(100, 1)
+			params.Filter = tt.filter
+
+			campaignsGot, _, err := campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+			require.NoError(t, err)
+			require.Len(t, campaignsGot, tt.expected)
+
+			count, err := campaignsRepo.CountCampaignsForAdvertiser(ctx, advertiserId, tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, count)
+		})
+	}
+}
+
+func TestUpdateCampaign(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
 	require.NoError(t, err)
 
 	// check update all fields
 	campaign := generateCampaign()
 	campaign.AdvertiserId = advertiserId
 
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
 	campaignNew := generateCampaign()
 	campaignNew.Id = campaign.Id
 	campaignNew.AdvertiserId = advertiserId
+	campaignNew.Version = 2
 
-	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaignNew))
+	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaignNew), 1, 0, models.CampaignActionUpdate)
 	require.NoError(t, err)
 
 	campaignGot, err := campaignsRepo.GetCampaignById(ctx, campaign.Id)
@@ -175,23 +328,32 @@ func TestUpdateCampaign(t *testing.T) {
 	campaign = generateCampaign()
 	campaign.AdvertiserId = advertiserId
 
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
 	campaignNew = campaign
 	campaignNew.ClicksLimit = gofakeit.IntRange(0, 9999)
 	campaignNew.CostPerImpression = gofakeit.Float64Range(0, 999)
 	campaignNew.AdText = gofakeit.Sentence(30)
+	campaignNew.Version = 2
 
-	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaignNew))
+	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaignNew), 1, 0, models.CampaignActionUpdate)
 	require.NoError(t, err)
 
 	campaignGot, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
 	require.NoError(t, err)
 	require.Equal(t, campaignNew, campaignGot)
 
+	// check update with stale version
+	err = campaignsRepo.UpdateCampaign(ctx, campaign.Id, dto.CampaignDataFromCampaign(campaign), 1, 0, models.CampaignActionUpdate)
+	require.ErrorIs(t, err, models.ErrVersionMismatch)
+
+	campaignGot, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
+	require.NoError(t, err)
+	require.Equal(t, campaignNew, campaignGot)
+
 	// check update non-existent campaign
-	err = campaignsRepo.UpdateCampaign(ctx, uuid.New(), dto.CampaignData{})
+	err = campaignsRepo.UpdateCampaign(ctx, uuid.New(), dto.CampaignData{}, 1, 0, models.CampaignActionUpdate)
 	require.ErrorIs(t, err, models.ErrCampaignNotFound)
 
 }
@@ -204,37 +366,346 @@ func TestDeleteCampaign(t *testing.T) {
 
 	advertiserId := uuid.New()
 
-	_, err := advertisersRepo.UpsertAdvertisers(ctx, []models.Advertiser{
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
 		{
 			Id:   advertiserId,
 			Name: gofakeit.Company(),
 		},
-	})
+	}))
 	require.NoError(t, err)
 
 	// check delete existing campaign
 	campaign := generateCampaign()
 	campaign.AdvertiserId = advertiserId
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
-	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id)
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 4)
 	require.NoError(t, err)
 
 	_, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
 	require.ErrorIs(t, err, models.ErrCampaignNotFound)
 
+	// check deletion is saved to history once
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 5)
+	require.ErrorIs(t, err, models.ErrCampaignNotFound)
+
+	versions, err := NewCampaignHistoryRepo(db).ListCampaignVersions(ctx, campaign.Id, dto.PaginationParams{Page: 1, Size: 10})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, 2, versions[0].Version)
+	require.Equal(t, models.CampaignActionDelete, versions[0].Action)
+	require.Equal(t, 4, versions[0].Day)
+	require.Equal(t, campaign.Id, versions[0].Campaign.Id)
+	require.Equal(t, campaign.AdTitle, versions[0].Campaign.AdTitle)
+	require.Empty(t, versions[0].Changes)
+
 	// check delete non-existent campaign
 	campaign = generateCampaign()
 	campaign.AdvertiserId = advertiserId
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
-	err = campaignsRepo.DeleteCampaign(ctx, uuid.New())
+	err = campaignsRepo.DeleteCampaign(ctx, uuid.New(), 0)
 	require.ErrorIs(t, err, models.ErrCampaignNotFound)
 
 }
 
+func TestPurgeCampaign(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	campaign := generateCampaign()
+	campaign.AdvertiserId = advertiserId
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	// check deleted campaign is not returned and can't be deleted again
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
+	require.NoError(t, err)
+
+	campaigns, _, err := campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, newestCampaignsParams(10, 1))
+	require.NoError(t, err)
+	require.Empty(t, campaigns)
+
+	err = campaignsRepo.DeleteCampaign(ctx, campaign.Id, 0)
+	require.ErrorIs(t, err, models.ErrCampaignNotFound)
+
+	// check purge deleted campaign
+	err = campaignsRepo.PurgeCampaign(ctx, campaign.Id)
+	require.NoError(t, err)
+
+	err = campaignsRepo.PurgeCampaign(ctx, campaign.Id)
+	require.ErrorIs(t, err, models.ErrCampaignNotFound)
+}
+
+func TestUpdateCampaignStatus(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	campaign := generateCampaign()
+	campaign.AdvertiserId = advertiserId
+	campaign.Status = models.CampaignStatusDraft
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	// check transition from actual status
+	err = campaignsRepo.UpdateCampaignStatus(ctx, campaign.Id, models.CampaignStatusDraft, models.CampaignStatusActive, 0)
+	require.NoError(t, err)
+
+	actual, err := campaignsRepo.GetCampaignById(ctx, campaign.Id)
+	require.NoError(t, err)
+	require.Equal(t, models.CampaignStatusActive, actual.Status)
+	require.Equal(t, 2, actual.Version)
+
+	// check transition from stale status
+	err = campaignsRepo.UpdateCampaignStatus(ctx, campaign.Id, models.CampaignStatusDraft, models.CampaignStatusArchived, 0)
+	require.ErrorIs(t, err, models.ErrInvalidTransition)
+
+	actual, err = campaignsRepo.GetCampaignById(ctx, campaign.Id)
+	require.NoError(t, err)
+	require.Equal(t, models.CampaignStatusActive, actual.Status)
+}
+
+func TestCampaignModeration(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	// check campaign is created with moderation verdict
+	rejected := generateCampaign()
+	rejected.AdvertiserId = advertiserId
+	rejected.ModerationStatus = models.ModerationStatusRejected
+	rejected.FlaggedPhrases = pq.StringArray{"first phrase", "second phrase"}
+	rejected.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(rejected), 0)
+	require.NoError(t, err)
+	rejected.Version = 1
+
+	actual, err := campaignsRepo.GetCampaignById(ctx, rejected.Id)
+	require.NoError(t, err)
+	require.Equal(t, rejected, actual)
+
+	approved := generateCampaign()
+	approved.AdvertiserId = advertiserId
+	approved.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(approved), 0)
+	require.NoError(t, err)
+
+	// check filter by moderation status
+	params := newestCampaignsParams(10, 1)
+	params.Filter.ModerationStatus = pointer(models.ModerationStatusRejected)
+	campaigns, _, err := campaignsRepo.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+	require.NoError(t, err)
+	require.Len(t, campaigns, 1)
+	require.Equal(t, rejected.Id, campaigns[0].Id)
+
+	// check verdict is updated with campaign
+	data := dto.CampaignDataFromCampaign(rejected)
+	data.ModerationStatus = models.ModerationStatusApproved
+	err = campaignsRepo.UpdateCampaign(ctx, rejected.Id, data, rejected.Version, 0, models.CampaignActionUpdate)
+	require.NoError(t, err)
+
+	actual, err = campaignsRepo.GetCampaignById(ctx, rejected.Id)
+	require.NoError(t, err)
+	require.Equal(t, models.ModerationStatusApproved, actual.ModerationStatus)
+	require.Equal(t, rejected.FlaggedPhrases, actual.FlaggedPhrases)
+}
+
+func TestCompleteCampaigns(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId := uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	createCampaign := func(status models.CampaignStatus, startDate, endDate int) uuid.UUID {
+		campaign := generateCampaign()
+		campaign.Status = status
+		campaign.StartDate = startDate
+		campaign.EndDate = endDate
+
+		id, err := campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+		return id
+	}
+
+	ended := createCampaign(models.CampaignStatusActive, 0, 5)
+	endedPaused := createCampaign(models.CampaignStatusPaused, 0, 5)
+	endedDraft := createCampaign(models.CampaignStatusDraft, 0, 5)
+	running := createCampaign(models.CampaignStatusActive, 0, 10)
+
+	completed, err := campaignsRepo.CompleteCampaigns(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, 2, completed)
+
+	expected := map[uuid.UUID]models.CampaignStatus{
+		ended:       models.CampaignStatusCompleted,
+		endedPaused: models.CampaignStatusCompleted,
+		endedDraft:  models.CampaignStatusDraft,
+		running:     models.CampaignStatusActive,
+	}
+	for id, status := range expected {
+		actual, err := campaignsRepo.GetCampaignById(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, status, actual.Status)
+	}
+
+	// check completion is saved to history of completed campaigns only
+	historyRepo := NewCampaignHistoryRepo(db)
+	versions, err := historyRepo.ListCampaignVersions(ctx, endedPaused, dto.PaginationParams{Page: 1, Size: 10})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, 2, versions[0].Version)
+	require.Equal(t, models.CampaignActionStatus, versions[0].Action)
+	require.Equal(t, 6, versions[0].Day)
+	require.Equal(t, models.CampaignStatusCompleted, versions[0].Campaign.Status)
+	require.Equal(t, models.CampaignChanges{
+		{Field: "status", Old: mustMarshal(t, models.CampaignStatusPaused), New: mustMarshal(t, models.CampaignStatusCompleted)},
+	}, versions[0].Changes)
+
+	versions, err = historyRepo.ListCampaignVersions(ctx, running, dto.PaginationParams{Page: 1, Size: 10})
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, models.CampaignActionCreate, versions[0].Action)
+}
+
+func TestSearchCampaigns(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	advertisersRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+
+	advertiserId, otherAdvertiserId := uuid.New(), uuid.New()
+
+	_, err := advertisersRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{
+		{
+			Id:   advertiserId,
+			Name: gofakeit.Company(),
+		},
+		{
+			Id:   otherAdvertiserId,
+			Name: gofakeit.Company(),
+		},
+	}))
+	require.NoError(t, err)
+
+	createCampaign := func(advertiserId uuid.UUID, title, text string) uuid.UUID {
+		campaign := generateCampaign()
+		campaign.AdTitle = title
+		campaign.AdText = text
+
+		id, err := campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+		return id
+	}
+
+	titleMatch := createCampaign(advertiserId, "Летняя распродажа обуви", "Скидки на кроссовки до 50%")
+	textMatch := createCampaign(advertiserId, "Новая коллекция", "Успейте на летние распродажи")
+	englishMatch := createCampaign(advertiserId, "Summer sales", "Running shoes discounts")
+	createCampaign(advertiserId, "Зимние шины", "Шиномонтаж в подарок")
+	otherAdvertiserMatch := createCampaign(otherAdvertiserId, "Распродажа техники", "Телевизоры и ноутбуки")
+
+	// check russian morphology and title rank
+	params := dto.CampaignsSearchParams{
+		PaginationParams: dto.PaginationParams{Size: 10, Page: 1},
+		Query:            "распродажа",
+		AdvertiserId:     &advertiserId,
+	}
+	results, err := campaignsRepo.SearchCampaigns(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, titleMatch, results[0].Id)
+	require.Equal(t, textMatch, results[1].Id)
+	require.Greater(t, results[0].Rank, results[1].Rank)
+	require.Contains(t, results[0].TitleHighlight, "<b>распродажа</b>")
+	require.Contains(t, results[1].TextHighlight, "<b>распродажи</b>")
+
+	count, err := campaignsRepo.CountSearchedCampaigns(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// check english morphology
+	params.Query = "sale shoe"
+	results, err = campaignsRepo.SearchCampaigns(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, englishMatch, results[0].Id)
+
+	// check global search
+	params.Query = "распродажа"
+	params.AdvertiserId = nil
+	count, err = campaignsRepo.CountSearchedCampaigns(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	// check pagination
+	params.Size = 1
+	params.Page = 3
+	results, err = campaignsRepo.SearchCampaigns(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	// check deleted campaigns are not found
+	err = campaignsRepo.DeleteCampaign(ctx, otherAdvertiserMatch, 0)
+	require.NoError(t, err)
+
+	count, err = campaignsRepo.CountSearchedCampaigns(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+// newestCampaignsParams returns params of campaigns list page sorted from the newest campaign
+func newestCampaignsParams(size, page int) dto.CampaignsListParams {
+	return dto.CampaignsListParams{
+		PaginationParams: dto.PaginationParams{Size: size, Page: page},
+		Sort:             dto.CampaignsSortCreatedAt,
+		Desc:             true,
+	}
+}
+
 func pointer[T any](value T) *T {
 	return &value
 }
@@ -243,6 +714,10 @@ func generateGender() models.Gender {
 	return models.Gender(gofakeit.RandomString([]string{"MALE", "FEMALE", "ALL"}))
 }
 
+func generatePacing() models.Pacing {
+	return models.Pacing(gofakeit.RandomString([]string{"NONE", "EVEN", "FRONT_LOADED"}))
+}
+
 func generateCampaign() models.Campaign {
 	return models.Campaign{
 		ImpressionsLimit:  gofakeit.IntRange(100, 9999),
@@ -257,5 +732,15 @@ func generateCampaign() models.Campaign {
 		AgeFrom:           pointer(gofakeit.IntRange(0, 10)),
 		AgeTo:             pointer(gofakeit.IntRange(15, 90)),
 		Location:          pointer(gofakeit.City()),
+		Pacing:            generatePacing(),
+		Status:            models.CampaignStatusActive,
+		ModerationStatus:  models.ModerationStatusApproved,
+		FlaggedPhrases:    pq.StringArray{},
+		TargetingRules: &models.TargetingRule{
+			Or: []models.TargetingRule{
+				{Attribute: "tier", Op: models.TargetingRuleOpGte, Operand: float64(gofakeit.IntRange(1, 5))},
+				{Attribute: "interests", Op: models.TargetingRuleOpIn, Operands: []any{gofakeit.Hobby()}},
+			},
+		},
 	}
 }
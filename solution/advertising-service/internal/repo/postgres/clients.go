@@ -28,7 +28,7 @@ func (cr *ClientsRepo) GetClientById(ctx context.Context, id uuid.UUID) (models.
 	op := "ClientsRepo.GetClientById"
 
 	query, args, err := cr.sq.
-		Select("id", "login", "age", "location", "gender").
+		Select("id", "login", "age", "location", "gender", "attributes").
 		From("clients").
 		Where(sq.Eq{"id": id}).
 		ToSql()
@@ -53,7 +53,7 @@ func (cr *ClientsRepo) UpsertClients(ctx context.Context, clients []models.Clien
 
 	qb := cr.sq.
 		Insert("clients").
-		Columns("id", "login", "age", "location", "gender")
+		Columns("id", "login", "age", "location", "gender", "attributes")
 
 	toInsert := map[uuid.UUID]models.Client{}
 	for _, client := range clients {
@@ -61,7 +61,7 @@ func (cr *ClientsRepo) UpsertClients(ctx context.Context, clients []models.Clien
 	}
 
 	for _, client := range toInsert {
-		qb = qb.Values(client.Id, client.Login, client.Age, client.Location, client.Gender)
+		qb = qb.Values(client.Id, client.Login, client.Age, client.Location, client.Gender, client.Attributes)
 	}
 
 	query, args, err := qb.
@@ -69,7 +69,8 @@ func (cr *ClientsRepo) UpsertClients(ctx context.Context, clients []models.Clien
 			login = EXCLUDED.login,
 			age = EXCLUDED.age,
 			location = EXCLUDED.location,
-			gender = EXCLUDED.gender`,
+			gender = EXCLUDED.gender,
+			attributes = EXCLUDED.attributes`,
 		).ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%s: build query: %w", op, err)
@@ -86,3 +87,135 @@ func (cr *ClientsRepo) UpsertClients(ctx context.Context, clients []models.Clien
 
 	return inserted, nil
 }
+
+// ExportClientData returns client with its ml scores, impressions and clicks
+// read from one snapshot of database
+func (cr *ClientsRepo) ExportClientData(ctx context.Context, id uuid.UUID) (models.ClientData, error) {
+	op := "ClientsRepo.ExportClientData"
+
+	tx, err := cr.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := cr.sq.
+		Select("id", "login", "age", "location", "gender", "attributes").
+		From("clients").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var data models.ClientData
+	if err := tx.GetContext(ctx, &data.Client, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ClientData{}, models.ErrClientNotFound
+		}
+
+		return models.ClientData{}, fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	query, args, err = cr.sq.
+		Select("client_id", "advertiser_id", "score").
+		From("ml_scores").
+		Where(sq.Eq{"client_id": id}).
+		OrderBy("advertiser_id").
+		ToSql()
+	if err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	data.MLScores = []models.MLScore{}
+	if err := tx.SelectContext(ctx, &data.MLScores, query, args...); err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	query, args, err = cr.sq.
+		Select("id", "client_id", "campaign_id", "date", "profit", "click_price", "explored", "pricing_mode").
+		From("impressions").
+		Where(sq.Eq{"client_id": id}).
+		OrderBy("date", "created_at", "id").
+		ToSql()
+	if err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	data.Impressions = []models.Impression{}
+	if err := tx.SelectContext(ctx, &data.Impressions, query, args...); err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	query, args, err = cr.sq.
+		Select("impression_id", "client_id", "campaign_id", "date", "profit").
+		From("clicks").
+		Where(sq.Eq{"client_id": id}).
+		OrderBy("date", "impression_id").
+		ToSql()
+	if err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	data.Clicks = []models.Click{}
+	if err := tx.SelectContext(ctx, &data.Clicks, query, args...); err != nil {
+		return models.ClientData{}, fmt.Errorf("%s: tx.SelectContext: %w", op, err)
+	}
+
+	return data, nil
+}
+
+// EraseClient removes client with its ml scores in one transaction. Client impressions and clicks
+// are kept without client, so advertisers stats and spendings don't change
+func (cr *ClientsRepo) EraseClient(ctx context.Context, id uuid.UUID) error {
+	op := "ClientsRepo.EraseClient"
+
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	// client lock makes concurrent impressions and clicks wait for erasure and fail after it
+	query, args, err := cr.sq.
+		Select("id").
+		From("clients").
+		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	var lockedId uuid.UUID
+	if err := tx.GetContext(ctx, &lockedId, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrClientNotFound
+		}
+
+		return fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	queries := []sq.Sqlizer{
+		cr.sq.Delete("ml_scores").Where(sq.Eq{"client_id": id}),
+		cr.sq.Update("clicks").Set("client_id", nil).Where(sq.Eq{"client_id": id}),
+		cr.sq.Update("impressions").Set("client_id", nil).Where(sq.Eq{"client_id": id}),
+		cr.sq.Delete("clients").Where(sq.Eq{"id": id}),
+	}
+	for _, qb := range queries {
+		query, args, err := qb.ToSql()
+		if err != nil {
+			return fmt.Errorf("%s: build query: %w", op, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"context"
 	"database/sql"
@@ -25,23 +26,430 @@ func NewClientActionsRepo(db *sqlx.DB) *ClientActionsRepo {
 	}
 }
 
+type campaignLimits struct {
+	Id                      uuid.UUID               `db:"id"`
+	AdvertiserId            uuid.UUID               `db:"advertiser_id"`
+	Status                  models.CampaignStatus   `db:"status"`
+	ModerationStatus        models.ModerationStatus `db:"moderation_status"`
+	ImpressionsLimit        int                     `db:"impressions_limit"`
+	ClicksLimit             int                     `db:"clicks_limit"`
+	FrequencyCapImpressions int                     `db:"frequency_cap_impressions"`
+	FrequencyCapDays        *int                    `db:"frequency_cap_days"`
+}
+
+type advertiserBudget struct {
+	Id          uuid.UUID `db:"id"`
+	DailyBudget *float64  `db:"daily_budget"`
+	TotalBudget *float64  `db:"total_budget"`
+}
+
+// addAdvertiserSpendQuery adds spend to the counter of the campaign advertiser for the day.
+//
+// $1 - campaign id
+// $2 - day
+// $3 - spent amount
+const addAdvertiserSpendQuery = `
+	INSERT INTO advertisers_daily_spend (advertiser_id, date, spent)
+	SELECT advertiser_id, $2, $3
+	FROM campaigns
+	WHERE id = $1
+	ON CONFLICT (advertiser_id, date) DO UPDATE
+	SET spent = advertisers_daily_spend.spent + EXCLUDED.spent
+`
+
+// RecordImpression atomically reserves an impression slot for the campaign:
+// the campaign and its advertiser rows are locked until the impression is inserted, so concurrent
+// requests can`t overshoot impressions limit (with 5% tolerance), clicks limit, advertiser budgets
+// and campaign frequency cap for the client, or show campaign that is not active or approved anymore
 func (car *ClientActionsRepo) RecordImpression(ctx context.Context, impression models.Impression) error {
 	op := "ClientActionsRepo.RecordImpression"
 
+	tx, err := car.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	budgets, err := car.lockAdvertisers(ctx, tx, []uuid.UUID{impression.CampaignId})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	limits, err := car.lockCampaign(ctx, tx, impression.CampaignId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := car.checkLimits(ctx, tx, impression, limits); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := car.checkBudget(ctx, tx, budgets[limits.AdvertiserId], impression.Date); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := car.insertImpression(ctx, tx, impression); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// RecordImpressions atomically records up to slots impressions in the given order,
+// skipping campaigns deleted, deactivated or rejected since they were chosen, campaigns that reached
+// their limits or daily impressions targets and campaigns of advertisers that already got an impression
+// or reached their budgets. Impressions are priced by pricing against the next reservation left after skips,
+// which may be beyond the recorded ones. Advertisers and then campaigns of all candidates are locked at once
+// in id order, so concurrent requests can`t deadlock. Returns recorded impressions
+func (car *ClientActionsRepo) RecordImpressions(ctx context.Context, reservations []dto.ImpressionReservation, slots int, pricing dto.ImpressionPricing) ([]models.Impression, error) {
+	op := "ClientActionsRepo.RecordImpressions"
+
+	tx, err := car.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	campaignIds := make([]uuid.UUID, 0, len(reservations))
+	for _, reservation := range reservations {
+		campaignIds = append(campaignIds, reservation.Impression.CampaignId)
+	}
+
+	budgets, err := car.lockAdvertisers(ctx, tx, campaignIds)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	campaignsLimits, err := car.lockCampaigns(ctx, tx, campaignIds)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// one more reservation than slots is kept to price the last recorded impression against it.
+	// Each advertiser gets one impression at most, so checks of a reservation don`t depend
+	// on impressions recorded before it in this request
+	kept := make([]int, 0, slots+1)
+	advertisers := make(map[uuid.UUID]struct{}, slots+1)
+	for i, reservation := range reservations {
+		if len(kept) == slots+1 {
+			break
+		}
+		impression := reservation.Impression
+
+		limits, ok := campaignsLimits[impression.CampaignId]
+		if !ok {
+			continue
+		}
+
+		if _, ok := advertisers[limits.AdvertiserId]; ok {
+			continue
+		}
+
+		if err := car.checkLimits(ctx, tx, impression, limits); err != nil {
+			if errors.Is(err, models.ErrImpressionsLimit) ||
+				errors.Is(err, models.ErrClicksLimit) ||
+				errors.Is(err, models.ErrAlreadyImpressed) ||
+				errors.Is(err, models.ErrCampaignNotActive) ||
+				errors.Is(err, models.ErrNotModerated) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := car.checkDailyTarget(ctx, tx, reservation); err != nil {
+			if errors.Is(err, models.ErrDailyTarget) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := car.checkBudget(ctx, tx, budgets[limits.AdvertiserId], impression.Date); err != nil {
+			if errors.Is(err, models.ErrBudgetReached) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		kept = append(kept, i)
+		advertisers[limits.AdvertiserId] = struct{}{}
+	}
+
+	recorded := make([]models.Impression, 0, slots)
+	for k, i := range kept[:min(slots, len(kept))] {
+		runnerUp := -1
+		if k+1 < len(kept) {
+			runnerUp = kept[k+1]
+		}
+
+		impression := reservations[i].Impression
+		price := pricing(i, runnerUp)
+		impression.Profit = price.Impression
+		impression.ClickPrice = price.Click
+
+		if err := car.insertImpression(ctx, tx, impression); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		recorded = append(recorded, impression)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	return recorded, nil
+}
+
+// lockAdvertisers locks rows of advertisers of the campaigns in id order until the end of transaction
+// and returns their budgets by advertiser id. Advertisers are locked before their campaigns,
+// the same way as on advertiser deletion
+func (car *ClientActionsRepo) lockAdvertisers(ctx context.Context, tx *sqlx.Tx, campaignIds []uuid.UUID) (map[uuid.UUID]advertiserBudget, error) {
+	query, args, err := car.sq.
+		Select("id", "daily_budget", "total_budget").
+		From("advertisers").
+		Where(sq.Expr("id IN (SELECT advertiser_id FROM campaigns WHERE id = ANY(?))", pq.Array(campaignIds))).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build advertisers lock query: %w", err)
+	}
+
+	locked := []advertiserBudget{}
+	if err := tx.SelectContext(ctx, &locked, query, args...); err != nil {
+		return nil, fmt.Errorf("tx.SelectContext: %w", err)
+	}
+
+	budgets := make(map[uuid.UUID]advertiserBudget, len(locked))
+	for _, budget := range locked {
+		budgets[budget.Id] = budget
+	}
+
+	return budgets, nil
+}
+
+// lockCampaign locks the campaign row until the end of transaction and returns its limits
+func (car *ClientActionsRepo) lockCampaign(ctx context.Context, tx *sqlx.Tx, campaignId uuid.UUID) (campaignLimits, error) {
+	campaignsLimits, err := car.lockCampaigns(ctx, tx, []uuid.UUID{campaignId})
+	if err != nil {
+		return campaignLimits{}, err
+	}
+
+	limits, ok := campaignsLimits[campaignId]
+	if !ok {
+		return campaignLimits{}, models.ErrCampaignNotFound
+	}
+
+	return limits, nil
+}
+
+// lockCampaigns locks rows of not deleted campaigns in id order until the end of transaction
+// and returns their limits by campaign id
+func (car *ClientActionsRepo) lockCampaigns(ctx context.Context, tx *sqlx.Tx, campaignIds []uuid.UUID) (map[uuid.UUID]campaignLimits, error) {
+	query, args, err := car.sq.
+		Select(
+			"id",
+			"advertiser_id",
+			"status",
+			"moderation_status",
+			"ROUND(impressions_limit::double precision * 1.05)::integer AS impressions_limit",
+			"clicks_limit",
+			"COALESCE(frequency_cap_impressions, 1) AS frequency_cap_impressions",
+			"frequency_cap_days",
+		).
+		From("campaigns").
+		Where(sq.Eq{"id": campaignIds, "deleted_at": nil}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build lock query: %w", err)
+	}
+
+	locked := []campaignLimits{}
+	if err := tx.SelectContext(ctx, &locked, query, args...); err != nil {
+		return nil, fmt.Errorf("tx.SelectContext: %w", err)
+	}
+
+	campaignsLimits := make(map[uuid.UUID]campaignLimits, len(locked))
+	for _, limits := range locked {
+		campaignsLimits[limits.Id] = limits
+	}
+
+	return campaignsLimits, nil
+}
+
+// checkLimits checks campaign status, moderation, impressions and clicks limits and frequency cap for the client.
+// Campaign must be locked by the transaction
+func (car *ClientActionsRepo) checkLimits(ctx context.Context, tx *sqlx.Tx, impression models.Impression, limits campaignLimits) error {
+	if limits.Status != models.CampaignStatusActive {
+		return models.ErrCampaignNotActive
+	}
+
+	if limits.ModerationStatus != models.ModerationStatusApproved {
+		return models.ErrNotModerated
+	}
+
+	query, args, err := car.sq.
+		Select("count(*)").
+		From("impressions").
+		Where(sq.Eq{"campaign_id": impression.CampaignId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build count query: %w", err)
+	}
+
+	var impressionsCount int
+	if err := tx.GetContext(ctx, &impressionsCount, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	if impressionsCount >= limits.ImpressionsLimit {
+		return models.ErrImpressionsLimit
+	}
+
+	query, args, err = car.sq.
+		Select("count(*)").
+		From("clicks").
+		Where(sq.Eq{"campaign_id": impression.CampaignId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build clicks count query: %w", err)
+	}
+
+	var clicksCount int
+	if err := tx.GetContext(ctx, &clicksCount, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	if clicksCount >= limits.ClicksLimit {
+		return models.ErrClicksLimit
+	}
+
+	qb := car.sq.
+		Select("count(*)").
+		From("impressions").
+		Where(sq.Eq{
+			"campaign_id": impression.CampaignId,
+			"client_id":   impression.ClientId,
+		})
+	if limits.FrequencyCapDays != nil {
+		qb = qb.Where(sq.Gt{"date": impression.Date - *limits.FrequencyCapDays})
+	}
+
+	query, args, err = qb.ToSql()
+	if err != nil {
+		return fmt.Errorf("build client count query: %w", err)
+	}
+
+	var clientImpressionsCount int
+	if err := tx.GetContext(ctx, &clientImpressionsCount, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	if clientImpressionsCount >= limits.FrequencyCapImpressions {
+		return models.ErrAlreadyImpressed
+	}
+
+	return nil
+}
+
+// checkDailyTarget checks the campaign has got less impressions on the impression day than its daily target.
+// Campaign must be locked by the transaction
+func (car *ClientActionsRepo) checkDailyTarget(ctx context.Context, tx *sqlx.Tx, reservation dto.ImpressionReservation) error {
+	if reservation.DailyImpressionsTarget == nil {
+		return nil
+	}
+
+	query, args, err := car.sq.
+		Select("count(*)").
+		From("impressions").
+		Where(sq.Eq{
+			"campaign_id": reservation.Impression.CampaignId,
+			"date":        reservation.Impression.Date,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build daily count query: %w", err)
+	}
+
+	var impressionsToday int
+	if err := tx.GetContext(ctx, &impressionsToday, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	if impressionsToday >= *reservation.DailyImpressionsTarget {
+		return models.ErrDailyTarget
+	}
+
+	return nil
+}
+
+// checkBudget checks the advertiser has spent less than its daily budget on the day and its total budget.
+// Advertiser must be locked by the transaction
+func (car *ClientActionsRepo) checkBudget(ctx context.Context, tx *sqlx.Tx, budget advertiserBudget, day int) error {
+	if budget.DailyBudget == nil && budget.TotalBudget == nil {
+		return nil
+	}
+
+	query, args, err := car.sq.
+		Select("COALESCE(sum(spent), 0) AS spent_total").
+		Column(sq.Expr("COALESCE(sum(spent) FILTER (WHERE date = ?), 0) AS spent_today", day)).
+		From("advertisers_daily_spend").
+		Where(sq.Eq{"advertiser_id": budget.Id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build spend query: %w", err)
+	}
+
+	var spent struct {
+		Total float64 `db:"spent_total"`
+		Today float64 `db:"spent_today"`
+	}
+	if err := tx.GetContext(ctx, &spent, query, args...); err != nil {
+		return fmt.Errorf("tx.GetContext: %w", err)
+	}
+
+	if budget.DailyBudget != nil && spent.Today >= *budget.DailyBudget {
+		return models.ErrBudgetReached
+	}
+	if budget.TotalBudget != nil && spent.Total >= *budget.TotalBudget {
+		return models.ErrBudgetReached
+	}
+
+	return nil
+}
+
+// addAdvertiserSpend adds amount spent on the campaign on the day to spend counters of its advertiser
+func (car *ClientActionsRepo) addAdvertiserSpend(ctx context.Context, tx *sqlx.Tx, campaignId uuid.UUID, day int, amount float64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, addAdvertiserSpendQuery, campaignId, day, amount); err != nil {
+		return fmt.Errorf("tx.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+func (car *ClientActionsRepo) insertImpression(ctx context.Context, tx *sqlx.Tx, impression models.Impression) error {
 	query, args, err := car.sq.
 		Insert("impressions").
-		Columns("client_id", "campaign_id", "date", "profit").
-		Values(impression.ClientId, impression.CampaignId, impression.Date, impression.Profit).
+		Columns("client_id", "campaign_id", "date", "profit", "click_price", "explored", "pricing_mode").
+		Values(impression.ClientId, impression.CampaignId, impression.Date, impression.Profit, impression.ClickPrice, impression.Explored, impression.PricingMode).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("%s: build query: %w", op, err)
+		return fmt.Errorf("build query: %w", err)
 	}
 
-	if _, err := car.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
-			case "23505":
-				return models.ErrAlreadyImpressed
 			case "23503":
 				switch pqErr.Constraint {
 				case "impressions_client_id_fkey":
@@ -51,25 +459,61 @@ func (car *ClientActionsRepo) RecordImpression(ctx context.Context, impression m
 				}
 			}
 		}
-		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+		return fmt.Errorf("tx.ExecContext: %w", err)
 	}
 
-	return nil
+	return car.addAdvertiserSpend(ctx, tx, impression.CampaignId, impression.Date, impression.Profit)
 }
 
+// RecordClick records click of the campaign and adds its profit to the advertiser spend.
+// The advertiser and campaign rows are locked until the click is inserted, so concurrent clicks
+// can`t be billed over clicks limit: clicks over the limit are recorded with zero profit
 func (car *ClientActionsRepo) RecordClick(ctx context.Context, click models.Click) error {
 	op := "ClientActionsRepo.RecordClick"
 
+	tx, err := car.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := car.lockAdvertisers(ctx, tx, []uuid.UUID{click.CampaignId}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	limits, err := car.lockCampaign(ctx, tx, click.CampaignId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	query, args, err := car.sq.
+		Select("count(*)").
+		From("clicks").
+		Where(sq.Eq{"campaign_id": click.CampaignId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%s: build count query: %w", op, err)
+	}
+
+	var clicksCount int
+	if err := tx.GetContext(ctx, &clicksCount, query, args...); err != nil {
+		return fmt.Errorf("%s: tx.GetContext: %w", op, err)
+	}
+
+	if clicksCount >= limits.ClicksLimit {
+		click.Profit = 0
+	}
+
+	query, args, err = car.sq.
 		Insert("clicks").
-		Columns("client_id", "campaign_id", "date", "profit").
-		Values(click.ClientId, click.CampaignId, click.Date, click.Profit).
+		Columns("impression_id", "client_id", "campaign_id", "date", "profit").
+		Values(click.ImpressionId, click.ClientId, click.CampaignId, click.Date, click.Profit).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	if _, err := car.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
 			case "23505":
@@ -80,38 +524,53 @@ func (car *ClientActionsRepo) RecordClick(ctx context.Context, click models.Clic
 					return models.ErrClientNotFound
 				case "clicks_campaign_id_fkey":
 					return models.ErrCampaignNotFound
+				case "clicks_impression_id_fkey":
+					return models.ErrNotImpressed
 				}
 			}
 		}
-		return fmt.Errorf("%s: db.ExecContext: %w", op, err)
+		return fmt.Errorf("%s: tx.ExecContext: %w", op, err)
+	}
+
+	if err := car.addAdvertiserSpend(ctx, tx, click.CampaignId, click.Date, click.Profit); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: tx.Commit: %w", op, err)
 	}
 
 	return nil
 }
 
-func (car *ClientActionsRepo) CheckImpressed(ctx context.Context, clientId, campaignId uuid.UUID) (bool, error) {
+// CheckImpressed returns the latest impression of the campaign for the client
+func (car *ClientActionsRepo) CheckImpressed(ctx context.Context, clientId, campaignId uuid.UUID) (models.Impression, bool, error) {
 	op := "ClientActionsRepo.CheckImpressed"
 
 	query, args, err := car.sq.
-		Select("true").
+		Select("id", "client_id", "campaign_id", "date", "profit", "click_price", "explored", "pricing_mode").
 		From("impressions").
 		Where(sq.Eq{
 			"client_id":   clientId,
 			"campaign_id": campaignId,
 		}).
+		OrderBy("date DESC", "created_at DESC").
+		Limit(1).
 		ToSql()
 	if err != nil {
-		return false, fmt.Errorf("%s: build query: %w", op, err)
+		return models.Impression{}, false, fmt.Errorf("%s: build query: %w", op, err)
 	}
 
-	var impressed bool
-	if err := car.db.QueryRowContext(ctx, query, args...).Scan(&impressed); err != nil {
+	var impression models.Impression
+	if err := car.db.QueryRowContext(ctx, query, args...).Scan(
+		&impression.Id, &impression.ClientId, &impression.CampaignId, &impression.Date, &impression.Profit, &impression.ClickPrice, &impression.Explored,
+	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return false, nil
+			return models.Impression{}, false, nil
 		}
 
-		return false, fmt.Errorf("%s: db.QueryRowContext: %w", op, err)
+		return models.Impression{}, false, fmt.Errorf("%s: db.QueryRowContext: %w", op, err)
 	}
 
-	return true, nil
+	return impression, true, nil
 }
@@ -5,10 +5,13 @@ import (
 	"advertising/advertising-service/internal/models"
 	"advertising/tests/helpers"
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,12 +30,12 @@ func TestRecordImpression(t *testing.T) {
 
 	advertiser := generateAdvertiser()
 	advertiserId := advertiser.Id
-	_, err = advertiserRepo.UpsertAdvertisers(ctx, []models.Advertiser{advertiser})
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
 	require.NoError(t, err)
 
 	campaign := generateCampaign()
 	campaign.AdvertiserId = advertiser.Id
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
 	// record impression success
@@ -70,6 +73,303 @@ func TestRecordImpression(t *testing.T) {
 		Profit:     gofakeit.Float64Range(0, 999),
 	})
 	require.ErrorIs(t, err, models.ErrCampaignNotFound)
+
+	// check returns models.ErrImpressionsLimit
+	campaign = generateCampaign()
+	campaign.AdvertiserId = advertiser.Id
+	campaign.ImpressionsLimit = 1
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   client.Id,
+		CampaignId: campaign.Id,
+		Date:       gofakeit.IntRange(0, 999),
+		Profit:     gofakeit.Float64Range(0, 999),
+	})
+	require.NoError(t, err)
+
+	anotherClient := generateClient()
+	_, err = clientsRepo.UpsertClients(ctx, []models.Client{anotherClient})
+	require.NoError(t, err)
+
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   anotherClient.Id,
+		CampaignId: campaign.Id,
+		Date:       gofakeit.IntRange(0, 999),
+		Profit:     gofakeit.Float64Range(0, 999),
+	})
+	require.ErrorIs(t, err, models.ErrImpressionsLimit)
+
+	// check frequency cap: 2 impressions within 3 days
+	campaign = generateCampaign()
+	campaign.AdvertiserId = advertiser.Id
+	campaign.FrequencyCapImpressions = pointer(2)
+	campaign.FrequencyCapDays = pointer(3)
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	for _, day := range []int{10, 11} {
+		err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+			ClientId:   client.Id,
+			CampaignId: campaign.Id,
+			Date:       day,
+			Profit:     gofakeit.Float64Range(0, 999),
+		})
+		require.NoError(t, err)
+	}
+
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   client.Id,
+		CampaignId: campaign.Id,
+		Date:       12,
+		Profit:     gofakeit.Float64Range(0, 999),
+	})
+	require.ErrorIs(t, err, models.ErrAlreadyImpressed)
+
+	// impression of day 10 is out of the window on day 13
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   client.Id,
+		CampaignId: campaign.Id,
+		Date:       13,
+		Profit:     gofakeit.Float64Range(0, 999),
+	})
+	require.NoError(t, err)
+}
+
+func TestRecordImpressions(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+
+	clientsRepo := NewClientRepo(db)
+	advertiserRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+	clientActionsRepo := NewClientActionsRepo(db)
+
+	client := generateClient()
+	anotherClient := generateClient()
+	_, err := clientsRepo.UpsertClients(ctx, []models.Client{client, anotherClient})
+	require.NoError(t, err)
+
+	advertisers := []models.Advertiser{generateAdvertiser(), generateAdvertiser(), generateAdvertiser()}
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
+	require.NoError(t, err)
+
+	createCampaign := func(advertiserId uuid.UUID, impressionsLimit int) uuid.UUID {
+		campaign := generateCampaign()
+		campaign.AdvertiserId = advertiserId
+		campaign.ImpressionsLimit = impressionsLimit
+		campaignId, err := campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+		require.NoError(t, err)
+		return campaignId
+	}
+
+	reserve := func(impressions []models.Impression, dailyTarget int) []dto.ImpressionReservation {
+		reservations := make([]dto.ImpressionReservation, 0, len(impressions))
+		for _, impression := range impressions {
+			reservations = append(reservations, dto.ImpressionReservation{Impression: impression, DailyImpressionsTarget: &dailyTarget})
+		}
+		return reservations
+	}
+
+	// impressions are charged the prices they are reserved with, runner-ups are collected by winner
+	var runnerUps map[int]int
+	pricing := func(impressions []models.Impression) dto.ImpressionPricing {
+		runnerUps = map[int]int{}
+		return func(winner, runnerUp int) models.AdPrice {
+			runnerUps[winner] = runnerUp
+			return models.AdPrice{Impression: impressions[winner].Profit, Click: impressions[winner].ClickPrice}
+		}
+	}
+
+	// first campaign already reached impressions limit
+	campaign1Id := createCampaign(advertisers[0].Id, 1)
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   anotherClient.Id,
+		CampaignId: campaign1Id,
+		Date:       0,
+		Profit:     1,
+	})
+	require.NoError(t, err)
+
+	campaign2Id := createCampaign(advertisers[0].Id, 100)
+	campaign3Id := createCampaign(advertisers[0].Id, 100)
+	campaign4Id := createCampaign(advertisers[1].Id, 100)
+	campaign5Id := createCampaign(advertisers[2].Id, 100)
+
+	impressions := make([]models.Impression, 0, 5)
+	for _, campaignId := range []uuid.UUID{campaign1Id, campaign2Id, campaign3Id, campaign4Id, campaign5Id} {
+		impressions = append(impressions, models.Impression{
+			ClientId:    client.Id,
+			CampaignId:  campaignId,
+			Date:        0,
+			Profit:      gofakeit.Float64Range(0, 999),
+			PricingMode: models.PricingModeSecondPrice,
+		})
+	}
+
+	// skips campaign over the limit and campaign of already chosen advertiser,
+	// recorded impressions are priced against the next campaigns left
+	recorded, err := clientActionsRepo.RecordImpressions(ctx, reserve(impressions, 100), 2, pricing(impressions))
+	require.NoError(t, err)
+	require.Equal(t, []models.Impression{impressions[1], impressions[3]}, recorded)
+	require.Equal(t, map[int]int{1: 3, 3: 4}, runnerUps)
+
+	impression, _, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign2Id)
+	require.NoError(t, err)
+	require.Equal(t, models.PricingModeSecondPrice, impression.PricingMode)
+
+	_, impressed, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign3Id)
+	require.NoError(t, err)
+	require.False(t, impressed)
+
+	_, impressed, err = clientActionsRepo.CheckImpressed(ctx, client.Id, campaign5Id)
+	require.NoError(t, err)
+	require.False(t, impressed)
+
+	// check returns models.ErrClientNotFound and records nothing
+	impressions[2].ClientId = uuid.New()
+	_, err = clientActionsRepo.RecordImpressions(ctx, reserve(impressions[2:], 100), 3, pricing(impressions[2:]))
+	require.ErrorIs(t, err, models.ErrClientNotFound)
+
+	_, impressed, err = clientActionsRepo.CheckImpressed(ctx, client.Id, campaign5Id)
+	require.NoError(t, err)
+	require.False(t, impressed)
+
+	// skips campaigns deleted, rejected or reached clicks limit after they were chosen
+	deletedCampaignId := createCampaign(advertisers[1].Id, 100)
+	err = campaignsRepo.DeleteCampaign(ctx, deletedCampaignId, 0)
+	require.NoError(t, err)
+
+	rejectedCampaign := generateCampaign()
+	rejectedCampaign.ModerationStatus = models.ModerationStatusRejected
+	rejectedCampaignId, err := campaignsRepo.CreateCampaign(ctx, advertisers[1].Id, dto.CampaignDataFromCampaign(rejectedCampaign), 0)
+	require.NoError(t, err)
+
+	clickedCampaign := generateCampaign()
+	clickedCampaign.ClicksLimit = 0
+	clickedCampaignId, err := campaignsRepo.CreateCampaign(ctx, advertisers[1].Id, dto.CampaignDataFromCampaign(clickedCampaign), 0)
+	require.NoError(t, err)
+
+	impressions = make([]models.Impression, 0, 4)
+	for _, campaignId := range []uuid.UUID{deletedCampaignId, rejectedCampaignId, clickedCampaignId, campaign5Id} {
+		impressions = append(impressions, models.Impression{
+			ClientId:   anotherClient.Id,
+			CampaignId: campaignId,
+			Date:       0,
+			Profit:     gofakeit.Float64Range(0, 999),
+		})
+	}
+
+	recorded, err = clientActionsRepo.RecordImpressions(ctx, reserve(impressions, 100), 1, pricing(impressions))
+	require.NoError(t, err)
+	require.Equal(t, []models.Impression{impressions[3]}, recorded)
+
+	// skips campaign that already got its daily target today
+	impressions = make([]models.Impression, 0, 2)
+	for _, campaignId := range []uuid.UUID{campaign2Id, campaign3Id} {
+		impressions = append(impressions, models.Impression{
+			ClientId:   anotherClient.Id,
+			CampaignId: campaignId,
+			Date:       0,
+			Profit:     gofakeit.Float64Range(0, 999),
+		})
+	}
+
+	recorded, err = clientActionsRepo.RecordImpressions(ctx, reserve(impressions, 1), 1, pricing(impressions))
+	require.NoError(t, err)
+	require.Equal(t, []models.Impression{impressions[1]}, recorded)
+
+	// skips campaign of advertiser that reached daily budget after it was chosen
+	dailyBudget := 10.0
+	budgetAdvertiser := generateAdvertiser()
+	budgetAdvertiser.DailyBudget = &dailyBudget
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{budgetAdvertiser}))
+	require.NoError(t, err)
+
+	budgetCampaign1Id := createCampaign(budgetAdvertiser.Id, 100)
+	budgetCampaign2Id := createCampaign(budgetAdvertiser.Id, 100)
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   anotherClient.Id,
+		CampaignId: budgetCampaign1Id,
+		Date:       0,
+		Profit:     dailyBudget,
+	})
+	require.NoError(t, err)
+
+	impressions = []models.Impression{
+		{
+			ClientId:   client.Id,
+			CampaignId: budgetCampaign2Id,
+			Date:       0,
+			Profit:     1,
+		},
+		{
+			ClientId:   client.Id,
+			CampaignId: budgetCampaign2Id,
+			Date:       1,
+			Profit:     1,
+		},
+	}
+
+	recorded, err = clientActionsRepo.RecordImpressions(ctx, reserve(impressions, 100), 1, pricing(impressions))
+	require.NoError(t, err)
+	require.Equal(t, []models.Impression{impressions[1]}, recorded)
+	require.Equal(t, map[int]int{1: -1}, runnerUps)
+}
+
+func TestRecordImpressionConcurrent(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+
+	clientsRepo := NewClientRepo(db)
+	advertiserRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+	clientActionsRepo := NewClientActionsRepo(db)
+
+	advertiser := generateAdvertiser()
+	advertiserId := advertiser.Id
+	_, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	// limit 20 allows 21 impressions with 5% tolerance
+	campaign := generateCampaign()
+	campaign.AdvertiserId = advertiser.Id
+	campaign.ImpressionsLimit = 20
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	clientsNumber := 50
+	clients := make([]models.Client, 0, clientsNumber)
+	for range clientsNumber {
+		clients = append(clients, generateClient())
+	}
+	_, err = clientsRepo.UpsertClients(ctx, clients)
+	require.NoError(t, err)
+
+	var recorded atomic.Int64
+	var wg sync.WaitGroup
+	for _, client := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := clientActionsRepo.RecordImpression(ctx, models.Impression{
+				ClientId:   client.Id,
+				CampaignId: campaign.Id,
+				Date:       0,
+				Profit:     campaign.CostPerImpression,
+			})
+			if err == nil {
+				recorded.Add(1)
+				return
+			}
+			assert.ErrorIs(t, err, models.ErrImpressionsLimit)
+		}()
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 21, recorded.Load())
 }
 
 func TestRecordClick(t *testing.T) {
@@ -87,16 +387,15 @@ func TestRecordClick(t *testing.T) {
 
 	advertiser := generateAdvertiser()
 	advertiserId := advertiser.Id
-	_, err = advertiserRepo.UpsertAdvertisers(ctx, []models.Advertiser{advertiser})
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
 	require.NoError(t, err)
 
 	campaign := generateCampaign()
 	campaign.AdvertiserId = advertiser.Id
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
-	// record click success
-	err = clientActionsRepo.RecordClick(ctx, models.Click{
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
 		ClientId:   client.Id,
 		CampaignId: campaign.Id,
 		Date:       gofakeit.IntRange(0, 999),
@@ -104,32 +403,58 @@ func TestRecordClick(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	impression, _, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign.Id)
+	require.NoError(t, err)
+
+	// record click success
+	err = clientActionsRepo.RecordClick(ctx, models.Click{
+		ImpressionId: impression.Id,
+		ClientId:     client.Id,
+		CampaignId:   campaign.Id,
+		Date:         gofakeit.IntRange(0, 999),
+		Profit:       gofakeit.Float64Range(0, 999),
+	})
+	require.NoError(t, err)
+
 	// check returns models.ErrAlreadyClicked
 	err = clientActionsRepo.RecordClick(ctx, models.Click{
-		ClientId:   client.Id,
-		CampaignId: campaign.Id,
-		Date:       gofakeit.IntRange(0, 999),
-		Profit:     gofakeit.Float64Range(0, 999),
+		ImpressionId: impression.Id,
+		ClientId:     client.Id,
+		CampaignId:   campaign.Id,
+		Date:         gofakeit.IntRange(0, 999),
+		Profit:       gofakeit.Float64Range(0, 999),
 	})
 	require.ErrorIs(t, err, models.ErrAlreadyClicked)
 
 	// check returns models.ErrClientNotFound
 	err = clientActionsRepo.RecordClick(ctx, models.Click{
-		ClientId:   uuid.New(),
-		CampaignId: campaign.Id,
-		Date:       gofakeit.IntRange(0, 999),
-		Profit:     gofakeit.Float64Range(0, 999),
+		ImpressionId: impression.Id,
+		ClientId:     uuid.New(),
+		CampaignId:   campaign.Id,
+		Date:         gofakeit.IntRange(0, 999),
+		Profit:       gofakeit.Float64Range(0, 999),
 	})
 	require.ErrorIs(t, err, models.ErrClientNotFound)
 
 	// check returns models.ErrCampaignNotFound
 	err = clientActionsRepo.RecordClick(ctx, models.Click{
-		ClientId:   client.Id,
-		CampaignId: uuid.New(),
-		Date:       gofakeit.IntRange(0, 999),
-		Profit:     gofakeit.Float64Range(0, 999),
+		ImpressionId: impression.Id,
+		ClientId:     client.Id,
+		CampaignId:   uuid.New(),
+		Date:         gofakeit.IntRange(0, 999),
+		Profit:       gofakeit.Float64Range(0, 999),
 	})
 	require.ErrorIs(t, err, models.ErrCampaignNotFound)
+
+	// check returns models.ErrNotImpressed
+	err = clientActionsRepo.RecordClick(ctx, models.Click{
+		ImpressionId: uuid.New(),
+		ClientId:     client.Id,
+		CampaignId:   campaign.Id,
+		Date:         gofakeit.IntRange(0, 999),
+		Profit:       gofakeit.Float64Range(0, 999),
+	})
+	require.ErrorIs(t, err, models.ErrNotImpressed)
 }
 
 func TestCheckImpressed(t *testing.T) {
@@ -147,33 +472,112 @@ func TestCheckImpressed(t *testing.T) {
 
 	advertiser := generateAdvertiser()
 	advertiserId := advertiser.Id
-	_, err = advertiserRepo.UpsertAdvertisers(ctx, []models.Advertiser{advertiser})
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
 	require.NoError(t, err)
 
 	campaign := generateCampaign()
 	campaign.AdvertiserId = advertiser.Id
-	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign))
+	campaign.FrequencyCapImpressions = pointer(2)
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
 	require.NoError(t, err)
 
-	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
-		ClientId:   client.Id,
-		CampaignId: campaign.Id,
-		Date:       gofakeit.IntRange(0, 999),
-		Profit:     gofakeit.Float64Range(0, 999),
-	})
-	require.NoError(t, err)
+	for _, day := range []int{1, 5} {
+		err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+			ClientId:   client.Id,
+			CampaignId: campaign.Id,
+			Date:       day,
+			Profit:     gofakeit.Float64Range(0, 999),
+			ClickPrice: float64(day),
+			Explored:   day == 5,
+		})
+		require.NoError(t, err)
+	}
 
-	// check impressed true
-	impressed, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign.Id)
+	// check impressed true and returns the latest impression
+	impression, impressed, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign.Id)
 	require.NoError(t, err)
 	require.True(t, impressed)
+	require.NotEqual(t, uuid.UUID{}, impression.Id)
+	require.Equal(t, client.Id, impression.ClientId)
+	require.Equal(t, campaign.Id, impression.CampaignId)
+	require.Equal(t, 5, impression.Date)
+	require.Equal(t, 5.0, impression.ClickPrice)
+	require.True(t, impression.Explored)
 
 	// check impressed false
-	impressed, err = clientActionsRepo.CheckImpressed(ctx, uuid.New(), campaign.Id)
+	_, impressed, err = clientActionsRepo.CheckImpressed(ctx, uuid.New(), campaign.Id)
 	require.NoError(t, err)
 	require.False(t, impressed)
 
-	impressed, err = clientActionsRepo.CheckImpressed(ctx, client.Id, uuid.New())
+	_, impressed, err = clientActionsRepo.CheckImpressed(ctx, client.Id, uuid.New())
 	require.NoError(t, err)
 	require.False(t, impressed)
 }
+
+func TestRecordClickConcurrent(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+
+	clientsRepo := NewClientRepo(db)
+	advertiserRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+	clientActionsRepo := NewClientActionsRepo(db)
+	statsRepo := NewStatsRepo(db)
+
+	advertiser := generateAdvertiser()
+	advertiserId := advertiser.Id
+	_, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	campaign := generateCampaign()
+	campaign.AdvertiserId = advertiser.Id
+	campaign.ClicksLimit = 3
+	campaign.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign), 0)
+	require.NoError(t, err)
+
+	clientsNumber := 10
+	clients := make([]models.Client, 0, clientsNumber)
+	for range clientsNumber {
+		clients = append(clients, generateClient())
+	}
+	_, err = clientsRepo.UpsertClients(ctx, clients)
+	require.NoError(t, err)
+
+	impressions := make([]models.Impression, 0, clientsNumber)
+	for _, client := range clients {
+		err := clientActionsRepo.RecordImpression(ctx, models.Impression{
+			ClientId:   client.Id,
+			CampaignId: campaign.Id,
+			Date:       campaign.StartDate,
+		})
+		require.NoError(t, err)
+
+		impression, _, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign.Id)
+		require.NoError(t, err)
+		impressions = append(impressions, impression)
+	}
+
+	// all clicks are recorded, but only clicks within the limit are billed
+	var wg sync.WaitGroup
+	for _, impression := range impressions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := clientActionsRepo.RecordClick(ctx, models.Click{
+				ImpressionId: impression.Id,
+				ClientId:     impression.ClientId,
+				CampaignId:   campaign.Id,
+				Date:         campaign.StartDate,
+				Profit:       10,
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	stats, err := statsRepo.GetStatsForCampaign(ctx, campaign.Id)
+	require.NoError(t, err)
+	require.Equal(t, clientsNumber, stats.ClicksCount)
+	require.Equal(t, 30.0, stats.SpentClicks)
+}
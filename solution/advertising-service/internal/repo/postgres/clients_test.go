@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/tests/helpers"
 	"context"
@@ -59,6 +60,116 @@ func TestClientsRepo(t *testing.T) {
 	require.ElementsMatch(t, newClients, newClientsGot)
 }
 
+func TestClientDataExportAndErasure(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	clientsRepo := NewClientRepo(db)
+	advertiserRepo := NewAdvertiserRepo(db)
+	campaignsRepo := NewCampaignsRepo(db)
+	clientActionsRepo := NewClientActionsRepo(db)
+	mlScoresRepo := NewMlScoresRepo(db)
+	statsRepo := NewStatsRepo(db)
+
+	advertiser := generateAdvertiser()
+	_, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
+	require.NoError(t, err)
+
+	campaigns := []models.Campaign{generateCampaign(), generateCampaign()}
+	for i := range campaigns {
+		campaigns[i].AdvertiserId = advertiser.Id
+		campaigns[i].Id, err = campaignsRepo.CreateCampaign(ctx, advertiser.Id, dto.CampaignDataFromCampaign(campaigns[i]), 0)
+		require.NoError(t, err)
+	}
+
+	client := generateClient()
+	otherClient := generateClient()
+	_, err = clientsRepo.UpsertClients(ctx, []models.Client{client, otherClient})
+	require.NoError(t, err)
+
+	mlScore := models.MLScore{ClientId: client.Id, AdvertiserId: advertiser.Id, Score: 10}
+	err = mlScoresRepo.UpsertMLScore(ctx, mlScore)
+	require.NoError(t, err)
+
+	for i, campaign := range campaigns {
+		err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+			ClientId:   client.Id,
+			CampaignId: campaign.Id,
+			Date:       i + 1,
+			Profit:     campaign.CostPerImpression,
+			ClickPrice: campaign.CostPerClick,
+		})
+		require.NoError(t, err)
+	}
+	err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+		ClientId:   otherClient.Id,
+		CampaignId: campaigns[0].Id,
+		Date:       1,
+		Profit:     campaigns[0].CostPerImpression,
+		ClickPrice: campaigns[0].CostPerClick,
+	})
+	require.NoError(t, err)
+
+	impression, _, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaigns[0].Id)
+	require.NoError(t, err)
+
+	click := models.Click{
+		ImpressionId: impression.Id,
+		ClientId:     client.Id,
+		CampaignId:   campaigns[0].Id,
+		Date:         1,
+		Profit:       campaigns[0].CostPerClick,
+	}
+	err = clientActionsRepo.RecordClick(ctx, click)
+	require.NoError(t, err)
+
+	// check export
+	data, err := clientsRepo.ExportClientData(ctx, client.Id)
+	require.NoError(t, err)
+	require.Equal(t, client, data.Client)
+	require.Equal(t, []models.MLScore{mlScore}, data.MLScores)
+	require.Equal(t, []models.Click{click}, data.Clicks)
+	require.Len(t, data.Impressions, 2)
+	for i, impression := range data.Impressions {
+		require.Equal(t, client.Id, impression.ClientId)
+		require.Equal(t, campaigns[i].Id, impression.CampaignId)
+		require.Equal(t, i+1, impression.Date)
+	}
+
+	// check erasure keeps stats
+	statsWas, err := statsRepo.GetStatsForAdvertiser(ctx, advertiser.Id)
+	require.NoError(t, err)
+
+	err = clientsRepo.EraseClient(ctx, client.Id)
+	require.NoError(t, err)
+
+	statsGot, err := statsRepo.GetStatsForAdvertiser(ctx, advertiser.Id)
+	require.NoError(t, err)
+	require.Equal(t, statsWas, statsGot)
+
+	_, err = clientsRepo.GetClientById(ctx, client.Id)
+	require.ErrorIs(t, err, models.ErrClientNotFound)
+
+	_, err = clientsRepo.ExportClientData(ctx, client.Id)
+	require.ErrorIs(t, err, models.ErrClientNotFound)
+
+	err = mlScoresRepo.UpsertMLScore(ctx, mlScore)
+	require.ErrorIs(t, err, models.ErrClientNotFound)
+
+	// check other client data is kept
+	data, err = clientsRepo.ExportClientData(ctx, otherClient.Id)
+	require.NoError(t, err)
+	require.Len(t, data.Impressions, 1)
+	require.Empty(t, data.MLScores)
+	require.Empty(t, data.Clicks)
+
+	// check erase erased and non-existent client
+	err = clientsRepo.EraseClient(ctx, client.Id)
+	require.ErrorIs(t, err, models.ErrClientNotFound)
+
+	err = clientsRepo.EraseClient(ctx, uuid.New())
+	require.ErrorIs(t, err, models.ErrClientNotFound)
+}
+
 func generateClient() models.Client {
 	return models.Client{
 		Id:       uuid.New(),
@@ -66,5 +177,10 @@ func generateClient() models.Client {
 		Age:      gofakeit.IntRange(4, 99),
 		Location: gofakeit.City(),
 		Gender:   models.Gender(strings.ToUpper(gofakeit.Gender())),
+		Attributes: models.ClientAttributes{
+			"device":    gofakeit.RandomString([]string{"ios", "android"}),
+			"tier":      float64(gofakeit.IntRange(1, 5)),
+			"interests": []any{gofakeit.Hobby(), gofakeit.Hobby()},
+		},
 	}
 }
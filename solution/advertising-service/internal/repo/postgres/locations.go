@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"advertising/advertising-service/internal/models"
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type LocationsRepo struct {
+	db *sqlx.DB
+	sq sq.StatementBuilderType
+}
+
+func NewLocationsRepo(db *sqlx.DB) *LocationsRepo {
+	return &LocationsRepo{
+		db: db,
+		sq: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (lr *LocationsRepo) ListLocations(ctx context.Context) ([]models.Location, error) {
+	op := "LocationsRepo.ListLocations"
+
+	query, args, err := lr.sq.
+		Select("name", "type", "parent").
+		From("locations").
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	locations := []models.Location{}
+	if err := lr.db.SelectContext(ctx, &locations, query, args...); err != nil {
+		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
+	}
+
+	return locations, nil
+}
+
+// UpsertLocations inserts locations in one statement,
+// so parents may be passed along with their children
+func (lr *LocationsRepo) UpsertLocations(ctx context.Context, locations []models.Location) ([]models.Location, error) {
+	op := "LocationsRepo.UpsertLocations"
+
+	if len(locations) == 0 {
+		return []models.Location{}, nil
+	}
+
+	qb := lr.sq.
+		Insert("locations").
+		Columns("name", "type", "parent")
+
+	toInsert := map[string]models.Location{}
+	for _, location := range locations {
+		toInsert[location.Name] = location
+	}
+
+	for _, location := range toInsert {
+		qb = qb.Values(location.Name, location.Type, location.Parent)
+	}
+
+	query, args, err := qb.
+		Suffix(`ON CONFLICT (name) DO UPDATE SET
+			type = EXCLUDED.type,
+			parent = EXCLUDED.parent`,
+		).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%s: build query: %w", op, err)
+	}
+
+	if _, err := lr.db.ExecContext(ctx, query, args...); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503":
+				return nil, models.ErrLocationNotFound
+			}
+		}
+		return nil, fmt.Errorf("%s: db.ExecContext: %w", op, err)
+	}
+
+	inserted := make([]models.Location, 0, len(toInsert))
+	for _, location := range toInsert {
+		inserted = append(inserted, location)
+	}
+
+	return inserted, nil
+}
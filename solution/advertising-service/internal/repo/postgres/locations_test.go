@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"advertising/advertising-service/internal/models"
+	"advertising/tests/helpers"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationsRepo(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	locationsRepo := NewLocationsRepo(db)
+
+	// check UpsertLocations with parents in the same batch
+	locations := []models.Location{
+		{Name: "Moscow", Type: models.LocationTypeCity, Parent: pointer("Moscow Oblast")},
+		{Name: "Moscow Oblast", Type: models.LocationTypeRegion, Parent: pointer("Russia")},
+		{Name: "Russia", Type: models.LocationTypeCountry},
+	}
+
+	locationsGot, err := locationsRepo.UpsertLocations(ctx, locations)
+	require.NoError(t, err)
+	require.ElementsMatch(t, locations, locationsGot)
+
+	// check ListLocations
+	locationsGot, err = locationsRepo.ListLocations(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, locations, locationsGot)
+
+	// check UpsertLocations with update
+	updated := []models.Location{
+		{Name: "Moscow", Type: models.LocationTypeCity, Parent: pointer("Russia")},
+		{Name: "Saint Petersburg", Type: models.LocationTypeCity, Parent: pointer("Russia")},
+	}
+	_, err = locationsRepo.UpsertLocations(ctx, updated)
+	require.NoError(t, err)
+
+	locationsGot, err = locationsRepo.ListLocations(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []models.Location{updated[0], locations[1], locations[2], updated[1]}, locationsGot)
+
+	// check UpsertLocations with unknown parent
+	_, err = locationsRepo.UpsertLocations(ctx, []models.Location{
+		{Name: "Kazan", Type: models.LocationTypeCity, Parent: pointer("Tatarstan")},
+	})
+	require.ErrorIs(t, err, models.ErrLocationNotFound)
+}
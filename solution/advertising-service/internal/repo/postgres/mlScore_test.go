@@ -1,9 +1,12 @@
 package postgres
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/tests/helpers"
 	"context"
+	"errors"
+	"iter"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -40,7 +43,7 @@ func TestMLScoreRepo(t *testing.T) {
 
 	_, err := clientsRepo.UpsertClients(ctx, clients)
 	require.NoError(t, err)
-	_, err = advertiserRepo.UpsertAdvertisers(ctx, advertisers)
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
 	require.NoError(t, err)
 
 	for _, mlScore := range mlScores {
@@ -75,3 +78,108 @@ func TestMLScoreRepo(t *testing.T) {
 	})
 	require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
 }
+
+func TestLoadMLScores(t *testing.T) {
+	ctx := context.Background()
+	db := helpers.SetUpPostgres(ctx, t, "../../../migrations")
+	clientsRepo := NewClientRepo(db)
+	advertiserRepo := NewAdvertiserRepo(db)
+	mlScoreRepo := NewMlScoresRepo(db)
+
+	clients := []models.Client{generateClient(), generateClient()}
+	advertisers := []models.Advertiser{generateAdvertiser(), generateAdvertiser()}
+
+	_, err := clientsRepo.UpsertClients(ctx, clients)
+	require.NoError(t, err)
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert(advertisers))
+	require.NoError(t, err)
+
+	getScore := func(clientId, advertiserId uuid.UUID) int {
+		var score int
+		err := db.GetContext(ctx, &score,
+			"SELECT score FROM ml_scores WHERE client_id = $1 AND advertiser_id = $2",
+			clientId, advertiserId,
+		)
+		require.NoError(t, err)
+		return score
+	}
+
+	rows := []dto.MLScoreLoadRow{
+		{Row: 1, Score: models.MLScore{ClientId: clients[0].Id, AdvertiserId: advertisers[0].Id, Score: 10}},
+		{Row: 2, Score: models.MLScore{ClientId: clients[1].Id, AdvertiserId: advertisers[1].Id, Score: 20}},
+		{Row: 3, Score: models.MLScore{ClientId: uuid.New(), AdvertiserId: advertisers[0].Id, Score: 30}},
+		{Row: 4, Err: errors.New("score must be integer")},
+		{Row: 5, Score: models.MLScore{ClientId: clients[0].Id, AdvertiserId: uuid.New(), Score: 40}},
+		// last score of the same pair wins
+		{Row: 6, Score: models.MLScore{ClientId: clients[0].Id, AdvertiserId: advertisers[0].Id, Score: 50}},
+	}
+
+	// check load with unknown client and advertiser
+	report, err := mlScoreRepo.LoadMLScores(ctx, loadRows(rows), 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Loaded)
+	require.Equal(t, 2, report.Failed)
+	require.Len(t, report.Errors, 2)
+	require.Equal(t, 3, report.Errors[0].Row)
+	require.ErrorIs(t, report.Errors[0].Err, models.ErrClientNotFound)
+	require.Equal(t, 5, report.Errors[1].Row)
+	require.ErrorIs(t, report.Errors[1].Err, models.ErrAdvertiserNotFound)
+
+	require.Equal(t, 50, getScore(clients[0].Id, advertisers[0].Id))
+	require.Equal(t, 20, getScore(clients[1].Id, advertisers[1].Id))
+
+	// check errors are limited
+	report, err = mlScoreRepo.LoadMLScores(ctx, loadRows(rows), 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Failed)
+	require.Len(t, report.Errors, 1)
+	require.Equal(t, 3, report.Errors[0].Row)
+
+	// check scores of deleted advertiser are not loaded
+	deletedAdvertiser := generateAdvertiser()
+	_, err = advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{deletedAdvertiser}))
+	require.NoError(t, err)
+	err = advertiserRepo.DeleteAdvertiser(ctx, deletedAdvertiser.Id, 0)
+	require.NoError(t, err)
+
+	report, err = mlScoreRepo.LoadMLScores(ctx, loadRows([]dto.MLScoreLoadRow{
+		{Row: 1, Score: models.MLScore{ClientId: clients[0].Id, AdvertiserId: deletedAdvertiser.Id, Score: 60}},
+		{Row: 2, Score: models.MLScore{ClientId: clients[1].Id, AdvertiserId: advertisers[1].Id, Score: 25}},
+	}), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Loaded)
+	require.Equal(t, 1, report.Failed)
+	require.Len(t, report.Errors, 1)
+	require.Equal(t, 1, report.Errors[0].Row)
+	require.ErrorIs(t, report.Errors[0].Err, models.ErrAdvertiserNotFound)
+
+	var deletedScores int
+	err = db.GetContext(ctx, &deletedScores, "SELECT count(*) FROM ml_scores WHERE advertiser_id = $1", deletedAdvertiser.Id)
+	require.NoError(t, err)
+	require.Zero(t, deletedScores)
+	require.Equal(t, 25, getScore(clients[1].Id, advertisers[1].Id))
+
+	// check nothing is loaded if rows can not be read
+	readErr := errors.New("unexpected EOF")
+	_, err = mlScoreRepo.LoadMLScores(ctx, func(yield func(dto.MLScoreLoadRow, error) bool) {
+		if !yield(dto.MLScoreLoadRow{Row: 1, Score: models.MLScore{
+			ClientId: clients[1].Id, AdvertiserId: advertisers[1].Id, Score: 70,
+		}}, nil) {
+			return
+		}
+		yield(dto.MLScoreLoadRow{}, readErr)
+	}, 10)
+	require.ErrorIs(t, err, readErr)
+
+	require.Equal(t, 25, getScore(clients[1].Id, advertisers[1].Id))
+}
+
+func loadRows(rows []dto.MLScoreLoadRow) iter.Seq2[dto.MLScoreLoadRow, error] {
+	return func(yield func(dto.MLScoreLoadRow, error) bool) {
+		for _, row := range rows {
+			if !yield(row, nil) {
+				return
+			}
+		}
+	}
+}
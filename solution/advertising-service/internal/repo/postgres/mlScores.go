@@ -1,9 +1,11 @@
 package postgres
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"context"
 	"fmt"
+	"iter"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -56,3 +58,144 @@ func (msr *MLScoresRepo) UpsertMLScore(ctx context.Context, mlscore models.MLSco
 
 	return nil
 }
+
+// mlScoresStagingTable receives loaded ml scores by COPY before they are merged into ml_scores
+const mlScoresStagingTable = "ml_scores_staging"
+
+// LoadMLScores copies ml scores to staging table and upserts them in one transaction.
+// If pair of client and advertiser is repeated, the score from the last row is kept.
+// Rows with unknown client or advertiser are reported as failed, at most maxErrors of them are returned.
+// Rows with read error are skipped, caller reports them. If rows sequence fails, nothing is loaded
+func (msr *MLScoresRepo) LoadMLScores(
+	ctx context.Context,
+	rows iter.Seq2[dto.MLScoreLoadRow, error],
+	maxErrors int,
+) (dto.MLScoresLoadReport, error) {
+	op := "MLScoresRepo.LoadMLScores"
+
+	tx, err := msr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: db.BeginTxx: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `CREATE TEMP TABLE `+mlScoresStagingTable+` (
+			file_row INTEGER NOT NULL,
+			client_id UUID NOT NULL,
+			advertiser_id UUID NOT NULL,
+			score INTEGER NOT NULL
+		) ON COMMIT DROP`,
+	); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: create staging table: %w", op, err)
+	}
+
+	if err := msr.copyMLScores(ctx, tx, rows); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `ANALYZE `+mlScoresStagingTable); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: analyze staging table: %w", op, err)
+	}
+
+	// advertisers locks wait for concurrent deletion to commit
+	// and prevent deletion until scores are merged
+	if _, err := tx.ExecContext(ctx,
+		`SELECT id FROM advertisers
+		WHERE id IN (SELECT DISTINCT advertiser_id FROM `+mlScoresStagingTable+`) AND deleted_at IS NULL
+		FOR KEY SHARE`,
+	); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: lock advertisers: %w", op, err)
+	}
+
+	// rows with unknown client or unknown or deleted advertiser are not merged
+	const stagingJoin = `FROM ` + mlScoresStagingTable + ` AS staging
+		LEFT JOIN clients ON clients.id = staging.client_id
+		LEFT JOIN advertisers ON advertisers.id = staging.advertiser_id AND advertisers.deleted_at IS NULL`
+	const failedCond = `clients.id IS NULL OR advertisers.id IS NULL`
+
+	var counts struct {
+		Total  int `db:"total"`
+		Failed int `db:"failed"`
+	}
+	if err := tx.GetContext(ctx, &counts,
+		`SELECT count(*) AS total, count(*) FILTER (WHERE `+failedCond+`) AS failed `+stagingJoin,
+	); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: count rows: %w", op, err)
+	}
+
+	var failed []struct {
+		Row           int  `db:"file_row"`
+		ClientMissing bool `db:"client_missing"`
+	}
+	if err := tx.SelectContext(ctx, &failed,
+		`SELECT staging.file_row, clients.id IS NULL AS client_missing `+stagingJoin+`
+		WHERE `+failedCond+`
+		ORDER BY staging.file_row
+		LIMIT $1`,
+		maxErrors,
+	); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: select failed rows: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO ml_scores (client_id, advertiser_id, score)
+		SELECT DISTINCT ON (staging.client_id, staging.advertiser_id)
+			staging.client_id, staging.advertiser_id, staging.score
+		FROM `+mlScoresStagingTable+` AS staging
+		JOIN clients ON clients.id = staging.client_id
+		JOIN advertisers ON advertisers.id = staging.advertiser_id AND advertisers.deleted_at IS NULL
+		ORDER BY staging.client_id, staging.advertiser_id, staging.file_row DESC
+		ON CONFLICT (client_id, advertiser_id)
+			DO UPDATE SET
+			score = EXCLUDED.score`,
+	); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: merge ml scores: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: tx.Commit: %w", op, err)
+	}
+
+	report := dto.MLScoresLoadReport{
+		Loaded: counts.Total - counts.Failed,
+		Failed: counts.Failed,
+		Errors: make([]dto.MLScoreLoadError, 0, len(failed)),
+	}
+	for _, row := range failed {
+		err := models.ErrAdvertiserNotFound
+		if row.ClientMissing {
+			err = models.ErrClientNotFound
+		}
+		report.Errors = append(report.Errors, dto.MLScoreLoadError{Row: row.Row, Err: err})
+	}
+
+	return report, nil
+}
+
+func (msr *MLScoresRepo) copyMLScores(ctx context.Context, tx *sqlx.Tx, rows iter.Seq2[dto.MLScoreLoadRow, error]) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(mlScoresStagingTable, "file_row", "client_id", "advertiser_id", "score"))
+	if err != nil {
+		return fmt.Errorf("prepare copy: %w", err)
+	}
+	defer stmt.Close()
+
+	for row, err := range rows {
+		if err != nil {
+			return fmt.Errorf("read rows: %w", err)
+		}
+		if row.Err != nil {
+			continue
+		}
+
+		if _, err := stmt.ExecContext(ctx, row.Row, row.Score.ClientId, row.Score.AdvertiserId, row.Score.Score); err != nil {
+			return fmt.Errorf("copy row %d: %w", row.Row, err)
+		}
+	}
+
+	// flush copied rows
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return fmt.Errorf("copy: %w", err)
+	}
+
+	return nil
+}
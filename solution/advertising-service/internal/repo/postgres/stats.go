@@ -22,32 +22,43 @@ func NewStatsRepo(db *sqlx.DB) *StatsRepo {
 func (sr *StatsRepo) GetStatsForCampaign(ctx context.Context, campaignId uuid.UUID) (models.Stats, error) {
 	op := "StatsRepo.GetStatsForCampaign"
 
-	// $1 - campaign id
+	// $1 - campaign id, $2 - first price mode, $3 - second price mode
 	query := `
 	WITH
 		impressions_stats AS
 		(
 			SELECT
 				count(*) AS impressions_count,
-				COALESCE(sum(profit), 0) AS spent_impressions
+				COALESCE(sum(impressions.profit), 0) AS spent_impressions,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_impressions_first_price,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_impressions_second_price
 			FROM impressions
-			WHERE campaign_id = $1
+			WHERE impressions.campaign_id = $1
 		),
 		clicks_stats AS
 		(
 			SELECT
 				count(*) AS clicks_count,
-				COALESCE(sum(profit), 0) AS spent_clicks
+				COALESCE(sum(clicks.profit), 0) AS spent_clicks,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_clicks_first_price,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_clicks_second_price
 			FROM clicks
-			WHERE campaign_id = $1
+			JOIN impressions ON impressions.id = clicks.impression_id
+			WHERE clicks.campaign_id = $1
 		)
-	SELECT *
+	SELECT
+		impressions_count,
+		spent_impressions,
+		clicks_count,
+		spent_clicks,
+		spent_impressions_first_price + spent_clicks_first_price AS spent_first_price,
+		spent_impressions_second_price + spent_clicks_second_price AS spent_second_price
 	FROM impressions_stats
 	JOIN clicks_stats ON true
 	`
 
 	var stats models.Stats
-	if err := sr.db.GetContext(ctx, &stats, query, campaignId); err != nil {
+	if err := sr.db.GetContext(ctx, &stats, query, campaignId, models.PricingModeFirstPrice, models.PricingModeSecondPrice); err != nil {
 		return models.Stats{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
 	}
 
@@ -65,34 +76,41 @@ func (sr *StatsRepo) GetStatsForCampaign(ctx context.Context, campaignId uuid.UU
 func (sr *StatsRepo) GetStatsForCampaignDaily(ctx context.Context, campaignId uuid.UUID) ([]models.StatsDaily, error) {
 	op := "StatsRepo.GetStatsForCampaignDaily"
 
-	// $1 - campaign id
+	// $1 - campaign id, $2 - first price mode, $3 - second price mode
 	query := `
 	WITH
 		impressions_stats AS
-    	(
-    		SELECT
+		(
+			SELECT
 				count(*) AS impressions_count,
-				COALESCE(sum(profit), 0) AS spent_impressions,
-				date
+				COALESCE(sum(impressions.profit), 0) AS spent_impressions,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_impressions_first_price,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_impressions_second_price,
+				impressions.date
 			FROM impressions
-			WHERE campaign_id = $1
-     		GROUP BY date
-    	),
-    	clicks_stats AS
-    	(
-    	 	SELECT
+			WHERE impressions.campaign_id = $1
+			GROUP BY impressions.date
+		),
+		clicks_stats AS
+		(
+			SELECT
 				count(*) AS clicks_count,
-				COALESCE(sum(profit), 0) AS spent_clicks,
-				date
+				COALESCE(sum(clicks.profit), 0) AS spent_clicks,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_clicks_first_price,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_clicks_second_price,
+				clicks.date
 			FROM clicks
-			WHERE campaign_id = $1
-    	    GROUP BY date
-    	)
+			JOIN impressions ON impressions.id = clicks.impression_id
+			WHERE clicks.campaign_id = $1
+			GROUP BY clicks.date
+		)
 	SELECT
 		COALESCE(impressions_stats.impressions_count, 0) AS impressions_count,
 		COALESCE(impressions_stats.spent_impressions, 0) AS spent_impressions,
 		COALESCE(clicks_stats.clicks_count, 0) AS clicks_count,
 		COALESCE(clicks_stats.spent_clicks, 0) AS spent_clicks,
+		COALESCE(impressions_stats.spent_impressions_first_price, 0) + COALESCE(clicks_stats.spent_clicks_first_price, 0) AS spent_first_price,
+		COALESCE(impressions_stats.spent_impressions_second_price, 0) + COALESCE(clicks_stats.spent_clicks_second_price, 0) AS spent_second_price,
 		COALESCE(impressions_stats.date, clicks_stats.date) AS date
 	FROM impressions_stats
 	FULL JOIN clicks_stats ON clicks_stats.date = impressions_stats.date
@@ -100,7 +118,7 @@ func (sr *StatsRepo) GetStatsForCampaignDaily(ctx context.Context, campaignId uu
 	`
 
 	dailyStats := []models.StatsDaily{}
-	if err := sr.db.SelectContext(ctx, &dailyStats, query, campaignId); err != nil {
+	if err := sr.db.SelectContext(ctx, &dailyStats, query, campaignId, models.PricingModeFirstPrice, models.PricingModeSecondPrice); err != nil {
 		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
 	}
 
@@ -120,34 +138,45 @@ func (sr *StatsRepo) GetStatsForCampaignDaily(ctx context.Context, campaignId uu
 func (sr *StatsRepo) GetStatsForAdvertiser(ctx context.Context, advertiserId uuid.UUID) (models.Stats, error) {
 	op := "StatsRepo.GetStatsForAdvertiser"
 
-	// $1 - advertiser id
+	// $1 - advertiser id, $2 - first price mode, $3 - second price mode
 	query := `
 	WITH
 		impressions_stats AS
 		(
 			SELECT
 				count(*) AS impressions_count,
-				COALESCE(sum(profit), 0) AS spent_impressions
+				COALESCE(sum(impressions.profit), 0) AS spent_impressions,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_impressions_first_price,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_impressions_second_price
 			FROM impressions
-            JOIN campaigns ON campaigns.id = impressions.campaign_id
+			JOIN campaigns ON campaigns.id = impressions.campaign_id
 			WHERE campaigns.advertiser_id = $1
 		),
 		clicks_stats AS
 		(
 			SELECT
 				count(*) AS clicks_count,
-				COALESCE(sum(profit), 0) AS spent_clicks
+				COALESCE(sum(clicks.profit), 0) AS spent_clicks,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_clicks_first_price,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_clicks_second_price
 			FROM clicks
-            JOIN campaigns ON campaigns.id = clicks.campaign_id
+			JOIN impressions ON impressions.id = clicks.impression_id
+			JOIN campaigns ON campaigns.id = clicks.campaign_id
 			WHERE campaigns.advertiser_id = $1
 		)
-	SELECT *
+	SELECT
+		impressions_count,
+		spent_impressions,
+		clicks_count,
+		spent_clicks,
+		spent_impressions_first_price + spent_clicks_first_price AS spent_first_price,
+		spent_impressions_second_price + spent_clicks_second_price AS spent_second_price
 	FROM impressions_stats
 	JOIN clicks_stats ON true
 	`
 
 	var stats models.Stats
-	if err := sr.db.GetContext(ctx, &stats, query, advertiserId); err != nil {
+	if err := sr.db.GetContext(ctx, &stats, query, advertiserId, models.PricingModeFirstPrice, models.PricingModeSecondPrice); err != nil {
 		return models.Stats{}, fmt.Errorf("%s: db.GetContext: %w", op, err)
 	}
 
@@ -165,36 +194,43 @@ func (sr *StatsRepo) GetStatsForAdvertiser(ctx context.Context, advertiserId uui
 func (sr *StatsRepo) GetStatsForAdvertiserDaily(ctx context.Context, advertiserId uuid.UUID) ([]models.StatsDaily, error) {
 	op := "StatsRepo.GetStatsForAdvertiserDaily"
 
-	// $1 - campaign id
+	// $1 - advertiser id, $2 - first price mode, $3 - second price mode
 	query := `
 	WITH
 		impressions_stats AS
-    	(
-    		SELECT
+		(
+			SELECT
 				count(*) AS impressions_count,
-				COALESCE(sum(profit), 0) AS spent_impressions,
-				date
+				COALESCE(sum(impressions.profit), 0) AS spent_impressions,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_impressions_first_price,
+				COALESCE(sum(impressions.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_impressions_second_price,
+				impressions.date
 			FROM impressions
 			JOIN campaigns ON campaigns.id = impressions.campaign_id
 			WHERE campaigns.advertiser_id = $1
-     		GROUP BY date
-    	),
-    	clicks_stats AS
-    	(
-    	 	SELECT
+			GROUP BY impressions.date
+		),
+		clicks_stats AS
+		(
+			SELECT
 				count(*) AS clicks_count,
-				COALESCE(sum(profit), 0) AS spent_clicks,
-				date
+				COALESCE(sum(clicks.profit), 0) AS spent_clicks,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $2), 0) AS spent_clicks_first_price,
+				COALESCE(sum(clicks.profit) FILTER (WHERE impressions.pricing_mode = $3), 0) AS spent_clicks_second_price,
+				clicks.date
 			FROM clicks
+			JOIN impressions ON impressions.id = clicks.impression_id
 			JOIN campaigns ON campaigns.id = clicks.campaign_id
 			WHERE campaigns.advertiser_id = $1
-    	    GROUP BY date
-    	)
+			GROUP BY clicks.date
+		)
 	SELECT
 		COALESCE(impressions_stats.impressions_count, 0) AS impressions_count,
 		COALESCE(impressions_stats.spent_impressions, 0) AS spent_impressions,
 		COALESCE(clicks_stats.clicks_count, 0) AS clicks_count,
 		COALESCE(clicks_stats.spent_clicks, 0) AS spent_clicks,
+		COALESCE(impressions_stats.spent_impressions_first_price, 0) + COALESCE(clicks_stats.spent_clicks_first_price, 0) AS spent_first_price,
+		COALESCE(impressions_stats.spent_impressions_second_price, 0) + COALESCE(clicks_stats.spent_clicks_second_price, 0) AS spent_second_price,
 		COALESCE(impressions_stats.date, clicks_stats.date) AS date
 	FROM impressions_stats
 	FULL JOIN clicks_stats ON clicks_stats.date = impressions_stats.date
@@ -202,7 +238,7 @@ func (sr *StatsRepo) GetStatsForAdvertiserDaily(ctx context.Context, advertiserI
 	`
 
 	dailyStats := []models.StatsDaily{}
-	if err := sr.db.SelectContext(ctx, &dailyStats, query, advertiserId); err != nil {
+	if err := sr.db.SelectContext(ctx, &dailyStats, query, advertiserId, models.PricingModeFirstPrice, models.PricingModeSecondPrice); err != nil {
 		return nil, fmt.Errorf("%s: db.SelectContext: %w", op, err)
 	}
 
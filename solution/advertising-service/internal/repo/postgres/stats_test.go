@@ -76,6 +76,18 @@ func TestStatsRepo(t *testing.T) {
 	advertiserStatsDailyGot, err := statsRepo.GetStatsForAdvertiserDaily(ctx, advertiserId)
 	require.NoError(t, err)
 	checkStatsDaily(t, advertiserDailyStats, advertiserStatsDailyGot)
+
+	// check advertiser stats are kept after campaign deletion
+	err = NewCampaignsRepo(db).DeleteCampaign(ctx, campaign1Id, 0)
+	require.NoError(t, err)
+
+	advertiserStatsGot, err = statsRepo.GetStatsForAdvertiser(ctx, advertiserId)
+	require.NoError(t, err)
+	checkStats(t, advertiserStats, advertiserStatsGot)
+
+	advertiserStatsDailyGot, err = statsRepo.GetStatsForAdvertiserDaily(ctx, advertiserId)
+	require.NoError(t, err)
+	checkStatsDaily(t, advertiserDailyStats, advertiserStatsDailyGot)
 }
 
 func checkStats(t *testing.T, expected, actual models.Stats) {
@@ -85,6 +97,8 @@ func checkStats(t *testing.T, expected, actual models.Stats) {
 	checkFloat64(t, expected.SpentImpressions, actual.SpentImpressions)
 	checkFloat64(t, expected.SpentClicks, actual.SpentClicks)
 	checkFloat64(t, expected.SpentTotal, actual.SpentTotal)
+	checkFloat64(t, expected.SpentFirstPrice, actual.SpentFirstPrice)
+	checkFloat64(t, expected.SpentSecondPrice, actual.SpentSecondPrice)
 }
 
 func checkStatsDaily(t *testing.T, expected, actual []models.StatsDaily) {
@@ -111,25 +125,28 @@ func seed(t *testing.T, ctx context.Context, db *sqlx.DB) {
 	clientActionsRepo := NewClientActionsRepo(db)
 
 	advertiser := generateAdvertiser()
-	_, err := advertiserRepo.UpsertAdvertisers(ctx, []models.Advertiser{advertiser})
+	_, err := advertiserRepo.UpsertAdvertisers(ctx, dto.NewAdvertisersUpsert([]models.Advertiser{advertiser}))
 	require.NoError(t, err)
 	advertiserId = advertiser.Id
 
 	campaign1 := generateCampaign()
 	campaign1.AdvertiserId = advertiserId
-	campaign1.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign1))
+	campaign1.ImpressionsLimit = 1000
+	campaign1.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign1), 0)
 	campaign1Id = campaign1.Id
 	require.NoError(t, err)
 
 	campaign2 := generateCampaign()
 	campaign2.AdvertiserId = advertiserId
-	campaign2.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign2))
+	campaign2.ImpressionsLimit = 1000
+	campaign2.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign2), 0)
 	campaign2Id = campaign2.Id
 	require.NoError(t, err)
 
 	campaign3 := generateCampaign()
 	campaign3.AdvertiserId = advertiserId
-	campaign3.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign3))
+	campaign3.ImpressionsLimit = 1000
+	campaign3.Id, err = campaignsRepo.CreateCampaign(ctx, advertiserId, dto.CampaignDataFromCampaign(campaign3), 0)
 	campaign3Id = campaign3.Id
 	require.NoError(t, err)
 
@@ -156,37 +173,39 @@ func createCampaignStats(
 	resDaily := []models.StatsDaily{}
 	for _, day := range days {
 		impressionsCount := gofakeit.IntRange(0, 50)
-		clicksCount := gofakeit.IntRange(0, 50)
-
-		clientsToCreate := max(impressionsCount, clicksCount)
-		var impressed int
-		var clicked int
+		clicksCount := gofakeit.IntRange(0, impressionsCount)
+		// pricing mode is switched every day
+		pricingMode := models.PricingModeFirstPrice
+		if day%2 == 1 {
+			pricingMode = models.PricingModeSecondPrice
+		}
 
-		for range clientsToCreate {
+		for i := range impressionsCount {
 			client := generateClient()
 			_, err := clientsRepo.UpsertClients(ctx, []models.Client{client})
 			require.NoError(t, err)
 
-			if impressed < impressionsCount {
-				err = clientActionsRepo.RecordImpression(ctx, models.Impression{
-					ClientId:   client.Id,
-					CampaignId: campaign.Id,
-					Date:       day,
-					Profit:     campaign.CostPerImpression,
-				})
+			err = clientActionsRepo.RecordImpression(ctx, models.Impression{
+				ClientId:    client.Id,
+				CampaignId:  campaign.Id,
+				Date:        day,
+				Profit:      campaign.CostPerImpression,
+				PricingMode: pricingMode,
+			})
+			require.NoError(t, err)
+
+			if i < clicksCount {
+				impression, _, err := clientActionsRepo.CheckImpressed(ctx, client.Id, campaign.Id)
 				require.NoError(t, err)
-				impressed++
-			}
 
-			if clicked < clicksCount {
 				err = clientActionsRepo.RecordClick(ctx, models.Click{
-					ClientId:   client.Id,
-					CampaignId: campaign.Id,
-					Date:       day,
-					Profit:     campaign.CostPerClick,
+					ImpressionId: impression.Id,
+					ClientId:     client.Id,
+					CampaignId:   campaign.Id,
+					Date:         day,
+					Profit:       campaign.CostPerClick,
 				})
 				require.NoError(t, err)
-				clicked++
 			}
 		}
 
@@ -199,6 +218,12 @@ func createCampaignStats(
 			conversion = 0
 		}
 		spentTotal := spentImpressions + spentClicks
+		var spentFirstPrice, spentSecondPrice float64
+		if pricingMode == models.PricingModeFirstPrice {
+			spentFirstPrice = spentTotal
+		} else {
+			spentSecondPrice = spentTotal
+		}
 
 		resDaily = append(resDaily, models.StatsDaily{
 			Stats: models.Stats{
@@ -208,6 +233,8 @@ func createCampaignStats(
 				SpentImpressions: spentImpressions,
 				SpentClicks:      spentClicks,
 				SpentTotal:       spentTotal,
+				SpentFirstPrice:  spentFirstPrice,
+				SpentSecondPrice: spentSecondPrice,
 			},
 			Date: day,
 		})
@@ -216,6 +243,8 @@ func createCampaignStats(
 		res.SpentImpressions += spentImpressions
 		res.SpentClicks += spentClicks
 		res.SpentTotal += spentTotal
+		res.SpentFirstPrice += spentFirstPrice
+		res.SpentSecondPrice += spentSecondPrice
 	}
 
 	if res.ImpressionsCount != 0 {
@@ -244,6 +273,8 @@ func aggrDailyStats(statsRows ...[]models.StatsDaily) (models.Stats, []models.St
 				SpentImpressions: statsWas.SpentImpressions + statsDaily.SpentImpressions,
 				SpentClicks:      statsWas.SpentClicks + statsDaily.SpentClicks,
 				SpentTotal:       statsWas.SpentTotal + statsDaily.SpentTotal,
+				SpentFirstPrice:  statsWas.SpentFirstPrice + statsDaily.SpentFirstPrice,
+				SpentSecondPrice: statsWas.SpentSecondPrice + statsDaily.SpentSecondPrice,
 			}
 			if (statsWas.ImpressionsCount + statsDaily.ImpressionsCount) != 0 {
 				statsBecome.Conversion = float64(statsWas.ClicksCount+statsDaily.ClicksCount) / float64(statsWas.ImpressionsCount+statsDaily.ImpressionsCount) * 100
@@ -257,6 +288,8 @@ func aggrDailyStats(statsRows ...[]models.StatsDaily) (models.Stats, []models.St
 			res.SpentImpressions += statsDaily.SpentImpressions
 			res.SpentClicks += statsDaily.SpentClicks
 			res.SpentTotal += statsDaily.SpentTotal
+			res.SpentFirstPrice += statsDaily.SpentFirstPrice
+			res.SpentSecondPrice += statsDaily.SpentSecondPrice
 		}
 	}
 
@@ -9,5 +9,6 @@ import (
 type StaticRepo interface {
 	SaveStatic(ctx context.Context, name string, static models.Static) error
 	LoadStatic(ctx context.Context, name string) (models.Static, error)
+	CopyStatic(ctx context.Context, from, to string) error
 	DeleteStatic(ctx context.Context, name string) error
 }
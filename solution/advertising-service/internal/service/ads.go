@@ -1,11 +1,13 @@
 package service
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/advertising-service/internal/repo"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 )
@@ -16,6 +18,9 @@ type AdsService struct {
 	campaignsRepo     repo.CampaignsRepo
 	clientActionsRepo repo.ClientActionsRepo
 	timeRepo          repo.TimeRepo
+	ranker            Ranker
+	explorer          Explorer
+	pricer            Pricer
 }
 
 func NewAdsService(
@@ -24,6 +29,9 @@ func NewAdsService(
 	campaignsRepo repo.CampaignsRepo,
 	clientActionsRepo repo.ClientActionsRepo,
 	timeRepo repo.TimeRepo,
+	ranker Ranker,
+	explorer Explorer,
+	pricer Pricer,
 ) *AdsService {
 	return &AdsService{
 		adsRepo:           adsRepo,
@@ -31,45 +39,92 @@ func NewAdsService(
 		campaignsRepo:     campaignsRepo,
 		clientActionsRepo: clientActionsRepo,
 		timeRepo:          timeRepo,
+		ranker:            ranker,
+		explorer:          explorer,
+		pricer:            pricer,
 	}
 }
 
 func (as *AdsService) GetAdForClient(ctx context.Context, clientId uuid.UUID) (models.Ad, error) {
 	op := "AdsService.GetAdForClient"
 
+	ads, err := as.GetAdsForClient(ctx, clientId, 1)
+	if err != nil {
+		return models.Ad{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ads[0], nil
+}
+
+// GetAdsForClient returns up to slots best ads of distinct advertisers
+// and records their impressions atomically
+func (as *AdsService) GetAdsForClient(ctx context.Context, clientId uuid.UUID, slots int) ([]models.Ad, error) {
+	op := "AdsService.GetAdsForClient"
+
 	currentDay, err := as.timeRepo.GetDay(ctx)
 	if err != nil {
-		return models.Ad{}, fmt.Errorf("%s: timeRepo.GetDay: %w", op, err)
+		return nil, fmt.Errorf("%s: timeRepo.GetDay: %w", op, err)
 	}
 
 	client, err := as.clientsRepo.GetClientById(ctx, clientId)
 	if err != nil {
-		return models.Ad{}, fmt.Errorf("%s: clientsRepo.GetClientById: %w", op, err)
+		return nil, fmt.Errorf("%s: clientsRepo.GetClientById: %w", op, err)
 	}
 
-	ad, err := as.adsRepo.GetAdForClient(ctx, client, currentDay)
+	candidates, err := as.adsRepo.GetAdCandidatesForClient(ctx, client, currentDay)
 	if err != nil {
-		return models.Ad{}, fmt.Errorf("%s: adsRepo.GetAdForClient: %w", op, err)
+		return nil, fmt.Errorf("%s: adsRepo.GetAdCandidatesForClient: %w", op, err)
 	}
+	candidates = filterByTargetingRules(candidates, client.Attributes)
+	setDailyImpressionsTargets(candidates, currentDay)
+	candidates = filterByDailyImpressionsTargets(candidates)
 
-	campaign, err := as.campaignsRepo.GetCampaignById(ctx, ad.CampaignId)
-	if err != nil {
-		return models.Ad{}, fmt.Errorf("%s: campaignsRepo.GetCampaignById: %w", op, err)
+	ranked := as.explorer.Explore(as.ranker.Rank(candidates))
+	if len(ranked) == 0 {
+		return nil, models.ErrNoAdsForClient
 	}
 
-	impression := models.Impression{
-		ClientId:   clientId,
-		CampaignId: ad.CampaignId,
-		Date:       currentDay,
-		Profit:     campaign.CostPerImpression,
+	// candidates are read without locks, so concurrent requests may exhaust
+	// campaign limits or daily targets first. In this case repo skips them and takes the next ones,
+	// so prices are set by the repo once it knows which candidates are left
+	reservations := make([]dto.ImpressionReservation, 0, len(ranked))
+	ads := make(map[uuid.UUID]models.Ad, len(ranked))
+	for _, candidate := range ranked {
+		reservations = append(reservations, dto.ImpressionReservation{
+			Impression: models.Impression{
+				ClientId:    clientId,
+				CampaignId:  candidate.CampaignId,
+				Date:        currentDay,
+				Explored:    candidate.Explored,
+				PricingMode: as.pricer.Mode(),
+			},
+			DailyImpressionsTarget: candidate.DailyImpressionsTarget,
+		})
+		ads[candidate.CampaignId] = candidate.Ad
 	}
 
-	err = as.clientActionsRepo.RecordImpression(ctx, impression)
+	pricing := func(winner, runnerUp int) models.AdPrice {
+		if runnerUp < 0 {
+			return as.pricer.Price(ranked[winner], nil)
+		}
+		return as.pricer.Price(ranked[winner], &ranked[runnerUp])
+	}
+
+	recorded, err := as.clientActionsRepo.RecordImpressions(ctx, reservations, slots, pricing)
 	if err != nil {
-		return models.Ad{}, fmt.Errorf("%s: clientActionsRepo.RecordImpression: %w", op, err)
+		return nil, fmt.Errorf("%s: clientActionsRepo.RecordImpressions: %w", op, err)
+	}
+
+	if len(recorded) == 0 {
+		return nil, models.ErrNoAdsForClient
+	}
+
+	res := make([]models.Ad, 0, len(recorded))
+	for _, impression := range recorded {
+		res = append(res, ads[impression.CampaignId])
 	}
 
-	return ad, nil
+	return res, nil
 }
 
 func (as *AdsService) RecordAdClick(ctx context.Context, clientId uuid.UUID, campaignId uuid.UUID) error {
@@ -80,12 +135,13 @@ func (as *AdsService) RecordAdClick(ctx context.Context, clientId uuid.UUID, cam
 		return fmt.Errorf("%s: timeRepo.GetDay: %w", op, err)
 	}
 
-	campaign, err := as.campaignsRepo.GetCampaignById(ctx, campaignId)
+	// check campaign existence
+	_, err = as.campaignsRepo.GetCampaignById(ctx, campaignId)
 	if err != nil {
 		return fmt.Errorf("%s: campaignsRepo.GetCampaignById: %w", op, err)
 	}
 
-	impressed, err := as.clientActionsRepo.CheckImpressed(ctx, clientId, campaignId)
+	impression, impressed, err := as.clientActionsRepo.CheckImpressed(ctx, clientId, campaignId)
 	if err != nil {
 		return fmt.Errorf("%s: clientsActionsRepo.CheckImpressed: %w", op, err)
 	}
@@ -94,11 +150,15 @@ func (as *AdsService) RecordAdClick(ctx context.Context, clientId uuid.UUID, cam
 		return models.ErrNotImpressed
 	}
 
+	// click is attributed to the latest impression
+	// and charged by the price set at the impression.
+	// Clicks over the limit are recorded, but repo doesn`t bill them
 	click := models.Click{
-		ClientId:   clientId,
-		CampaignId: campaignId,
-		Date:       currentDay,
-		Profit:     campaign.CostPerClick,
+		ImpressionId: impression.Id,
+		ClientId:     clientId,
+		CampaignId:   campaignId,
+		Date:         currentDay,
+		Profit:       impression.ClickPrice,
 	}
 
 	err = as.clientActionsRepo.RecordClick(ctx, click)
@@ -111,3 +171,119 @@ func (as *AdsService) RecordAdClick(ctx context.Context, clientId uuid.UUID, cam
 
 	return nil
 }
+
+// ExplainAdForClient checks all campaigns as ad selection does, but doesn`t record impression.
+// If campaignId is set, only its explanation is returned
+func (as *AdsService) ExplainAdForClient(ctx context.Context, clientId uuid.UUID, campaignId *uuid.UUID) (models.AdExplanation, error) {
+	op := "AdsService.ExplainAdForClient"
+
+	currentDay, err := as.timeRepo.GetDay(ctx)
+	if err != nil {
+		return models.AdExplanation{}, fmt.Errorf("%s: timeRepo.GetDay: %w", op, err)
+	}
+
+	client, err := as.clientsRepo.GetClientById(ctx, clientId)
+	if err != nil {
+		return models.AdExplanation{}, fmt.Errorf("%s: clientsRepo.GetClientById: %w", op, err)
+	}
+
+	checks, err := as.adsRepo.GetAdCandidatesChecksForClient(ctx, client, currentDay)
+	if err != nil {
+		return models.AdExplanation{}, fmt.Errorf("%s: adsRepo.GetAdCandidatesChecksForClient: %w", op, err)
+	}
+
+	candidates := []models.AdCandidate{}
+	excluded := []models.CampaignExplanation{}
+	for _, check := range checks {
+		check.DailyImpressionsTarget = dailyImpressionsTarget(
+			check.Pacing,
+			check.ImpressionsLimit,
+			check.ImpressionsCount-check.ImpressionsToday,
+			currentDay,
+			check.EndDate,
+		)
+		check.RulesMatched = matchTargetingRules(check.AdCandidate, client.Attributes)
+
+		reasons := exclusionReasons(check)
+		if len(reasons) == 0 {
+			candidates = append(candidates, check.AdCandidate)
+			continue
+		}
+
+		excluded = append(excluded, models.CampaignExplanation{
+			AdCandidate:      check.AdCandidate,
+			ExclusionReasons: reasons,
+		})
+	}
+
+	// eligible campaigns go first in rank order
+	campaigns := make([]models.CampaignExplanation, 0, len(checks))
+	for i, candidate := range as.ranker.Rank(candidates) {
+		campaigns = append(campaigns, models.CampaignExplanation{
+			AdCandidate:      candidate.AdCandidate,
+			ExclusionReasons: []models.ExclusionReason{},
+			ExpectedProfit:   expectedProfit(candidate.AdCandidate),
+			Rank:             candidate.Rank,
+			Position:         i + 1,
+		})
+	}
+	campaigns = append(campaigns, excluded...)
+
+	if campaignId != nil {
+		idx := slices.IndexFunc(campaigns, func(campaign models.CampaignExplanation) bool {
+			return campaign.CampaignId == *campaignId
+		})
+		if idx == -1 {
+			return models.AdExplanation{}, models.ErrCampaignNotFound
+		}
+		campaigns = campaigns[idx : idx+1]
+	}
+
+	return models.AdExplanation{
+		ClientId:  clientId,
+		Date:      currentDay,
+		Campaigns: campaigns,
+	}, nil
+}
+
+func exclusionReasons(check models.AdCandidateChecks) []models.ExclusionReason {
+	reasons := []models.ExclusionReason{}
+	if !check.StatusMatched {
+		reasons = append(reasons, models.ExclusionReasonCampaignNotActive)
+	}
+	if !check.ModerationMatched {
+		reasons = append(reasons, models.ExclusionReasonNotModerated)
+	}
+	if !check.DateMatched {
+		reasons = append(reasons, models.ExclusionReasonDateWindow)
+	}
+	if !check.GenderMatched {
+		reasons = append(reasons, models.ExclusionReasonTargetingGender)
+	}
+	if !check.LocationMatched {
+		reasons = append(reasons, models.ExclusionReasonTargetingLocation)
+	}
+	if !check.AgeMatched {
+		reasons = append(reasons, models.ExclusionReasonTargetingAge)
+	}
+	if !check.RulesMatched {
+		reasons = append(reasons, models.ExclusionReasonTargetingRules)
+	}
+	if check.ClientImpressionsCount >= check.FrequencyCapImpressions {
+		reasons = append(reasons, models.ExclusionReasonAlreadyImpressed)
+	}
+	if check.ImpressionsCount >= check.MaxImpressions {
+		reasons = append(reasons, models.ExclusionReasonImpressionsLimitReached)
+	}
+	if dailyTargetReached(check.AdCandidate) {
+		reasons = append(reasons, models.ExclusionReasonDailyTargetReached)
+	}
+	if check.ClicksCount >= check.ClicksLimit {
+		reasons = append(reasons, models.ExclusionReasonClicksLimitReached)
+	}
+	if !check.BudgetMatched {
+		reasons = append(reasons, models.ExclusionReasonAdvertiserBudgetReached)
+	}
+
+	return reasons
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/advertising-service/internal/repo/mocks"
 	"context"
@@ -8,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,7 +23,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -31,23 +33,265 @@ func TestAdsService(t *testing.T) {
 		client := models.Client{Id: clientId}
 		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
 
-		ad := models.Ad{CampaignId: uuid.New()}
-		adsRepoMock.On("GetAdForClient", ctx, client, currentDay).Return(ad, nil).Once()
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
 
-		campaign := models.Campaign{CostPerImpression: 100}
-		campaignsRepoMock.On("GetCampaignById", ctx, ad.CampaignId).Return(campaign, nil).Once()
+		// impressions are passed in rank order
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: candidates[1].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+			{ClientId: clientId, CampaignId: candidates[0].CampaignId, Date: currentDay, Profit: candidates[0].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 1, mock.Anything).Return(impressions[:1], nil).Once()
 
-		clientActionsRepoMock.On("RecordImpression", ctx, models.Impression{
-			ClientId:   clientId,
-			CampaignId: ad.CampaignId,
-			Date:       currentDay,
-			Profit:     campaign.CostPerImpression,
-		}).Return(nil).Once()
+		// check
+		actualAd, err := service.GetAdForClient(ctx, clientId)
+		require.NoError(t, err)
+		require.Equal(t, candidates[1].Ad, actualAd)
+	})
+
+	t.Run("get ad for client best candidate limit reached", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
+
+		// repo skipped the best candidate
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: candidates[1].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+			{ClientId: clientId, CampaignId: candidates[0].CampaignId, Date: currentDay, Profit: candidates[0].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 1, mock.Anything).Return(impressions[1:], nil).Once()
 
 		// check
 		actualAd, err := service.GetAdForClient(ctx, clientId)
 		require.NoError(t, err)
-		require.Equal(t, ad, actualAd)
+		require.Equal(t, candidates[0].Ad, actualAd)
+	})
+
+	t.Run("get ads for client several slots", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()}, CostPerImpression: 70, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
+
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: candidates[1].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+			{ClientId: clientId, CampaignId: candidates[2].CampaignId, Date: currentDay, Profit: candidates[2].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+			{ClientId: clientId, CampaignId: candidates[0].CampaignId, Date: currentDay, Profit: candidates[0].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 2, mock.Anything).Return(impressions[:2], nil).Once()
+
+		// check
+		actualAds, err := service.GetAdsForClient(ctx, clientId, 2)
+		require.NoError(t, err)
+		require.Equal(t, []models.Ad{candidates[1].Ad, candidates[2].Ad}, actualAds)
+	})
+
+	t.Run("get ad for client targeting rules", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId, Attributes: models.ClientAttributes{"device": "ios"}}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		// the best candidate targets android clients
+		androidRule := models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "android"}
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay, TargetingRules: &androidRule},
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
+
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: candidates[1].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 1, mock.Anything).Return(impressions, nil).Once()
+
+		// check
+		actualAd, err := service.GetAdForClient(ctx, clientId)
+		require.NoError(t, err)
+		require.Equal(t, candidates[1].Ad, actualAd)
+	})
+
+	t.Run("get ad for client exploration", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		// always explores
+		explorer := NewEpsilonGreedyExplorer(ExplorationParams{Traffic: 1, MaxImpressions: 100}, 42)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), explorer, NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
+
+		// the worse candidate is explored
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[0].CampaignId, Date: currentDay, Profit: candidates[0].CostPerImpression, Explored: true, PricingMode: models.PricingModeFirstPrice},
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: candidates[1].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 1, mock.Anything).Return(impressions[:1], nil).Once()
+
+		// check
+		actualAd, err := service.GetAdForClient(ctx, clientId)
+		require.NoError(t, err)
+		require.Equal(t, candidates[0].Ad, actualAd)
+	})
+
+	t.Run("get ad for client second price", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewSecondPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()}, CostPerImpression: 50, CostPerClick: 10, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()}, CostPerImpression: 100, CostPerClick: 20, ImpressionsLimit: 100, EndDate: currentDay},
+			{Ad: models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()}, CostPerImpression: 80, CostPerClick: 16, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
+
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: 50, ClickPrice: 10, PricingMode: models.PricingModeSecondPrice},
+			{ClientId: clientId, CampaignId: candidates[2].CampaignId, Date: currentDay, PricingMode: models.PricingModeSecondPrice},
+			{ClientId: clientId, CampaignId: candidates[0].CampaignId, Date: currentDay, PricingMode: models.PricingModeSecondPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 1, mock.Anything).
+			Run(func(args mock.Arguments) {
+				pricing := args.Get(3).(dto.ImpressionPricing)
+
+				// winner pays just enough to beat the runner-up
+				require.Equal(t, models.AdPrice{Impression: 80, Click: 16}, pricing(0, 1))
+				// runner-up skipped by the repo, winner is priced against the next candidate left
+				require.Equal(t, models.AdPrice{Impression: 50, Click: 10}, pricing(0, 2))
+				// no candidates left after the winner
+				require.Equal(t, models.AdPrice{Impression: 100, Click: 20}, pricing(0, -1))
+			}).
+			Return(impressions[:1], nil).Once()
+
+		// check
+		actualAd, err := service.GetAdForClient(ctx, clientId)
+		require.NoError(t, err)
+		require.Equal(t, candidates[1].Ad, actualAd)
+	})
+
+	t.Run("get ad for client daily target reached", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		// the best candidate got 25 of 100 impressions for 4 days today
+		candidates := []models.AdCandidate{
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, ImpressionsCount: 25, ImpressionsToday: 25, EndDate: currentDay + 3},
+			{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay},
+		}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(candidates, nil).Once()
+
+		impressions := []models.Impression{
+			{ClientId: clientId, CampaignId: candidates[1].CampaignId, Date: currentDay, Profit: candidates[1].CostPerImpression, PricingMode: models.PricingModeFirstPrice},
+		}
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve(impressions, 100), 1, mock.Anything).Return(impressions, nil).Once()
+
+		// check
+		actualAd, err := service.GetAdForClient(ctx, clientId)
+		require.NoError(t, err)
+		require.Equal(t, candidates[1].Ad, actualAd)
 	})
 
 	t.Run("get ad for client time repo error", func(t *testing.T) {
@@ -59,7 +303,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		expectedError := errors.New("failed to get time")
@@ -80,7 +324,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -105,7 +349,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -115,8 +359,8 @@ func TestAdsService(t *testing.T) {
 		client := models.Client{Id: clientId}
 		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
 
-		expectedError := errors.New("failed to get ad")
-		adsRepoMock.On("GetAdForClient", ctx, client, currentDay).Return(models.Ad{}, expectedError).Once()
+		expectedError := errors.New("failed to get ad candidates")
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return(nil, expectedError).Once()
 
 		// check
 		actualAd, err := service.GetAdForClient(ctx, clientId)
@@ -124,7 +368,7 @@ func TestAdsService(t *testing.T) {
 		require.Equal(t, models.Ad{}, actualAd)
 	})
 
-	t.Run("get ad for client campaigns repo error", func(t *testing.T) {
+	t.Run("get ad for client no candidates", func(t *testing.T) {
 		ctx := context.Background()
 
 		adsRepoMock := mocks.NewAdsRepo(t)
@@ -133,7 +377,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -143,11 +387,44 @@ func TestAdsService(t *testing.T) {
 		client := models.Client{Id: clientId}
 		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
 
-		ad := models.Ad{CampaignId: uuid.New()}
-		adsRepoMock.On("GetAdForClient", ctx, client, currentDay).Return(ad, nil).Once()
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return([]models.AdCandidate{}, nil).Once()
 
-		expectedError := errors.New("failed to get campaign")
-		campaignsRepoMock.On("GetCampaignById", ctx, ad.CampaignId).Return(models.Campaign{}, expectedError).Once()
+		// check
+		actualAd, err := service.GetAdForClient(ctx, clientId)
+		require.ErrorIs(t, err, models.ErrNoAdsForClient)
+		require.Equal(t, models.Ad{}, actualAd)
+	})
+
+	t.Run("get ad for client record impressions error", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		candidate := models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return([]models.AdCandidate{candidate}, nil).Once()
+
+		expectedError := errors.New("failed to record impressions")
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve([]models.Impression{{
+			ClientId:    clientId,
+			CampaignId:  candidate.CampaignId,
+			Date:        currentDay,
+			Profit:      candidate.CostPerImpression,
+			PricingMode: models.PricingModeFirstPrice,
+		}}, 100), 1, mock.Anything).Return(nil, expectedError).Once()
 
 		// check
 		actualAd, err := service.GetAdForClient(ctx, clientId)
@@ -155,7 +432,7 @@ func TestAdsService(t *testing.T) {
 		require.Equal(t, models.Ad{}, actualAd)
 	})
 
-	t.Run("get ad for client record impression error", func(t *testing.T) {
+	t.Run("get ad for client all candidates limit reached", func(t *testing.T) {
 		ctx := context.Background()
 
 		adsRepoMock := mocks.NewAdsRepo(t)
@@ -164,7 +441,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -174,23 +451,20 @@ func TestAdsService(t *testing.T) {
 		client := models.Client{Id: clientId}
 		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
 
-		ad := models.Ad{CampaignId: uuid.New()}
-		adsRepoMock.On("GetAdForClient", ctx, client, currentDay).Return(ad, nil).Once()
-
-		campaign := models.Campaign{CostPerImpression: 100}
-		campaignsRepoMock.On("GetCampaignById", ctx, ad.CampaignId).Return(campaign, nil).Once()
+		candidate := models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay}
+		adsRepoMock.On("GetAdCandidatesForClient", ctx, client, currentDay).Return([]models.AdCandidate{candidate}, nil).Once()
 
-		expectedError := errors.New("failed to record impression")
-		clientActionsRepoMock.On("RecordImpression", ctx, models.Impression{
-			ClientId:   clientId,
-			CampaignId: ad.CampaignId,
-			Date:       currentDay,
-			Profit:     campaign.CostPerImpression,
-		}).Return(expectedError).Once()
+		clientActionsRepoMock.On("RecordImpressions", ctx, reserve([]models.Impression{{
+			ClientId:    clientId,
+			CampaignId:  candidate.CampaignId,
+			Date:        currentDay,
+			Profit:      candidate.CostPerImpression,
+			PricingMode: models.PricingModeFirstPrice,
+		}}, 100), 1, mock.Anything).Return([]models.Impression{}, nil).Once()
 
 		// check
 		actualAd, err := service.GetAdForClient(ctx, clientId)
-		require.ErrorIs(t, err, expectedError)
+		require.ErrorIs(t, err, models.ErrNoAdsForClient)
 		require.Equal(t, models.Ad{}, actualAd)
 	})
 
@@ -203,7 +477,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -212,16 +486,56 @@ func TestAdsService(t *testing.T) {
 		clientId := uuid.New()
 		campaignId := uuid.New()
 
-		campaign := models.Campaign{CostPerClick: 100}
+		campaign := models.Campaign{CostPerClick: 100, ClicksLimit: 10}
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
 
-		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(true, nil).Once()
+		impression := models.Impression{Id: uuid.New(), ClientId: clientId, CampaignId: campaignId, Date: currentDay, ClickPrice: campaign.CostPerClick}
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(impression, true, nil).Once()
 
 		clientActionsRepoMock.On("RecordClick", ctx, models.Click{
-			ClientId:   clientId,
-			CampaignId: campaignId,
-			Date:       currentDay,
-			Profit:     campaign.CostPerClick,
+			ImpressionId: impression.Id,
+			ClientId:     clientId,
+			CampaignId:   campaignId,
+			Date:         currentDay,
+			Profit:       campaign.CostPerClick,
+		}).Return(nil).Once()
+
+		// check
+		err := service.RecordAdClick(ctx, clientId, campaignId)
+		require.NoError(t, err)
+	})
+
+	t.Run("record ad click charged by impression price", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		campaignId := uuid.New()
+
+		campaign := models.Campaign{CostPerClick: 100, ClicksLimit: 10}
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
+
+		// clearing price of the second-price auction
+		impression := models.Impression{Id: uuid.New(), ClientId: clientId, CampaignId: campaignId, Date: currentDay, ClickPrice: 60}
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(impression, true, nil).Once()
+
+		clientActionsRepoMock.On("RecordClick", ctx, models.Click{
+			ImpressionId: impression.Id,
+			ClientId:     clientId,
+			CampaignId:   campaignId,
+			Date:         currentDay,
+			Profit:       impression.ClickPrice,
 		}).Return(nil).Once()
 
 		// check
@@ -238,7 +552,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -247,16 +561,18 @@ func TestAdsService(t *testing.T) {
 		clientId := uuid.New()
 		campaignId := uuid.New()
 
-		campaign := models.Campaign{CostPerClick: 100}
+		campaign := models.Campaign{CostPerClick: 100, ClicksLimit: 10}
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
 
-		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(true, nil).Once()
+		impression := models.Impression{Id: uuid.New(), ClientId: clientId, CampaignId: campaignId, Date: currentDay, ClickPrice: campaign.CostPerClick}
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(impression, true, nil).Once()
 
 		clientActionsRepoMock.On("RecordClick", ctx, models.Click{
-			ClientId:   clientId,
-			CampaignId: campaignId,
-			Date:       currentDay,
-			Profit:     campaign.CostPerClick,
+			ImpressionId: impression.Id,
+			ClientId:     clientId,
+			CampaignId:   campaignId,
+			Date:         currentDay,
+			Profit:       campaign.CostPerClick,
 		}).Return(models.ErrAlreadyClicked).Once()
 
 		// check
@@ -264,6 +580,44 @@ func TestAdsService(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("record ad click record error", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		campaignId := uuid.New()
+
+		campaign := models.Campaign{CostPerClick: 100, ClicksLimit: 10}
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
+
+		impression := models.Impression{Id: uuid.New(), ClientId: clientId, CampaignId: campaignId, Date: currentDay, ClickPrice: campaign.CostPerClick}
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(impression, true, nil).Once()
+
+		expectedError := errors.New("failed to record click")
+		clientActionsRepoMock.On("RecordClick", ctx, models.Click{
+			ImpressionId: impression.Id,
+			ClientId:     clientId,
+			CampaignId:   campaignId,
+			Date:         currentDay,
+			Profit:       campaign.CostPerClick,
+		}).Return(expectedError).Once()
+
+		// check
+		err := service.RecordAdClick(ctx, clientId, campaignId)
+		require.ErrorIs(t, err, expectedError)
+	})
+
 	t.Run("record ad click time repo error", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -273,7 +627,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		expectedError := errors.New("failed to get time")
@@ -293,7 +647,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -319,7 +673,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -332,7 +686,7 @@ func TestAdsService(t *testing.T) {
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
 
 		expectedError := errors.New("failed to check impression")
-		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(false, expectedError).Once()
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(models.Impression{}, false, expectedError).Once()
 
 		// check
 		err := service.RecordAdClick(ctx, clientId, campaignId)
@@ -348,7 +702,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -360,7 +714,7 @@ func TestAdsService(t *testing.T) {
 		campaign := models.Campaign{CostPerClick: 100}
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
 
-		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(false, nil).Once()
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(models.Impression{}, false, nil).Once()
 
 		// check
 		err := service.RecordAdClick(ctx, clientId, campaignId)
@@ -376,7 +730,7 @@ func TestAdsService(t *testing.T) {
 		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 
-		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock)
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
 
 		// setup mocks
 		currentDay := 5
@@ -385,21 +739,283 @@ func TestAdsService(t *testing.T) {
 		clientId := uuid.New()
 		campaignId := uuid.New()
 
-		campaign := models.Campaign{CostPerClick: 100}
+		campaign := models.Campaign{CostPerClick: 100, ClicksLimit: 10}
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
 
-		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(true, nil).Once()
+		impression := models.Impression{Id: uuid.New(), ClientId: clientId, CampaignId: campaignId, Date: currentDay, ClickPrice: campaign.CostPerClick}
+		clientActionsRepoMock.On("CheckImpressed", ctx, clientId, campaignId).Return(impression, true, nil).Once()
 
 		expectedError := errors.New("failed to record click")
 		clientActionsRepoMock.On("RecordClick", ctx, models.Click{
-			ClientId:   clientId,
-			CampaignId: campaignId,
-			Date:       currentDay,
-			Profit:     campaign.CostPerClick,
+			ImpressionId: impression.Id,
+			ClientId:     clientId,
+			CampaignId:   campaignId,
+			Date:         currentDay,
+			Profit:       campaign.CostPerClick,
 		}).Return(expectedError).Once()
 
 		// check
 		err := service.RecordAdClick(ctx, clientId, campaignId)
 		require.ErrorIs(t, err, expectedError)
 	})
+
+	t.Run("explain ad for client success", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		passed := models.AdCandidateChecks{
+			MaxImpressions:          105,
+			StatusMatched:           true,
+			ModerationMatched:       true,
+			DateMatched:             true,
+			GenderMatched:           true,
+			LocationMatched:         true,
+			AgeMatched:              true,
+			FrequencyCapImpressions: 1,
+			BudgetMatched:           true,
+		}
+
+		eligible1 := passed
+		eligible1.AdCandidate = models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay, ClicksLimit: 10}
+		eligible2 := passed
+		eligible2.AdCandidate = models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay, ClicksLimit: 10}
+
+		excluded := passed
+		excluded.AdCandidate = models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, ImpressionsCount: 105, ClicksLimit: 10}
+		excluded.StatusMatched = false
+		excluded.ModerationMatched = false
+		excluded.DateMatched = false
+		excluded.AgeMatched = false
+		excluded.ClientImpressionsCount = 1
+		excluded.BudgetMatched = false
+
+		checks := []models.AdCandidateChecks{eligible1, excluded, eligible2}
+		adsRepoMock.On("GetAdCandidatesChecksForClient", ctx, client, currentDay).Return(checks, nil).Once()
+
+		// check
+		actual, err := service.ExplainAdForClient(ctx, clientId, nil)
+		require.NoError(t, err)
+
+		// eligible campaigns end today, so the whole remaining limit is the daily target
+		eligible1.DailyImpressionsTarget = pointer(100)
+		eligible2.DailyImpressionsTarget = pointer(100)
+		excluded.DailyImpressionsTarget = pointer(0)
+		require.Equal(t, models.AdExplanation{
+			ClientId: clientId,
+			Date:     currentDay,
+			Campaigns: []models.CampaignExplanation{
+				{
+					AdCandidate:      eligible2.AdCandidate,
+					ExclusionReasons: []models.ExclusionReason{},
+					ExpectedProfit:   100,
+					Rank:             100,
+					Position:         1,
+				},
+				{
+					AdCandidate:      eligible1.AdCandidate,
+					ExclusionReasons: []models.ExclusionReason{},
+					ExpectedProfit:   50,
+					Rank:             50,
+					Position:         2,
+				},
+				{
+					AdCandidate: excluded.AdCandidate,
+					ExclusionReasons: []models.ExclusionReason{
+						models.ExclusionReasonCampaignNotActive,
+						models.ExclusionReasonNotModerated,
+						models.ExclusionReasonDateWindow,
+						models.ExclusionReasonTargetingAge,
+						models.ExclusionReasonAlreadyImpressed,
+						models.ExclusionReasonImpressionsLimitReached,
+						models.ExclusionReasonDailyTargetReached,
+						models.ExclusionReasonAdvertiserBudgetReached,
+					},
+				},
+			},
+		}, actual)
+	})
+
+	t.Run("explain ad for client targeting rules", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId, Attributes: models.ClientAttributes{"tier": 1.0}}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		premiumRule := models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpGte, Operand: 3.0}
+		excluded := models.AdCandidateChecks{
+			AdCandidate:             models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, ImpressionsLimit: 100, EndDate: currentDay, ClicksLimit: 10, TargetingRules: &premiumRule},
+			MaxImpressions:          105,
+			StatusMatched:           true,
+			ModerationMatched:       true,
+			DateMatched:             true,
+			GenderMatched:           true,
+			LocationMatched:         true,
+			AgeMatched:              true,
+			FrequencyCapImpressions: 1,
+			BudgetMatched:           true,
+		}
+		adsRepoMock.On("GetAdCandidatesChecksForClient", ctx, client, currentDay).Return([]models.AdCandidateChecks{excluded}, nil).Once()
+
+		// check
+		actual, err := service.ExplainAdForClient(ctx, clientId, nil)
+		require.NoError(t, err)
+		require.Len(t, actual.Campaigns, 1)
+		require.Equal(t, []models.ExclusionReason{models.ExclusionReasonTargetingRules}, actual.Campaigns[0].ExclusionReasons)
+	})
+
+	t.Run("explain ad for client with campaign", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		passed := models.AdCandidateChecks{
+			MaxImpressions:          105,
+			StatusMatched:           true,
+			ModerationMatched:       true,
+			DateMatched:             true,
+			GenderMatched:           true,
+			LocationMatched:         true,
+			AgeMatched:              true,
+			FrequencyCapImpressions: 1,
+			BudgetMatched:           true,
+		}
+
+		eligible1 := passed
+		eligible1.AdCandidate = models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 50, ImpressionsLimit: 100, EndDate: currentDay, ClicksLimit: 10}
+		eligible2 := passed
+		eligible2.AdCandidate = models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, CostPerImpression: 100, ImpressionsLimit: 100, EndDate: currentDay, ClicksLimit: 10}
+
+		excluded := passed
+		excluded.AdCandidate = models.AdCandidate{Ad: models.Ad{CampaignId: uuid.New()}, ImpressionsCount: 105, ClicksLimit: 10}
+		excluded.DateMatched = false
+		excluded.AgeMatched = false
+		excluded.ClientImpressionsCount = 1
+
+		checks := []models.AdCandidateChecks{eligible1, excluded, eligible2}
+		adsRepoMock.On("GetAdCandidatesChecksForClient", ctx, client, currentDay).Return(checks, nil).Once()
+
+		// check
+		actual, err := service.ExplainAdForClient(ctx, clientId, &eligible1.CampaignId)
+		require.NoError(t, err)
+		require.Len(t, actual.Campaigns, 1)
+		require.Equal(t, eligible1.CampaignId, actual.Campaigns[0].CampaignId)
+		require.Equal(t, 2, actual.Campaigns[0].Position)
+
+		// check returns models.ErrCampaignNotFound
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+		adsRepoMock.On("GetAdCandidatesChecksForClient", ctx, client, currentDay).Return(checks, nil).Once()
+
+		unknownCampaignId := uuid.New()
+		_, err = service.ExplainAdForClient(ctx, clientId, &unknownCampaignId)
+		require.ErrorIs(t, err, models.ErrCampaignNotFound)
+	})
+
+	t.Run("explain ad for client clients repo error", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(models.Client{}, models.ErrClientNotFound).Once()
+
+		// check
+		_, err := service.ExplainAdForClient(ctx, clientId, nil)
+		require.ErrorIs(t, err, models.ErrClientNotFound)
+	})
+
+	t.Run("explain ad for client ads repo error", func(t *testing.T) {
+		ctx := context.Background()
+
+		adsRepoMock := mocks.NewAdsRepo(t)
+		clientsRepoMock := mocks.NewClientsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		clientActionsRepoMock := mocks.NewClientActionsRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+
+		service := NewAdsService(adsRepoMock, clientsRepoMock, campaignsRepoMock, clientActionsRepoMock, timeRepoMock, NewRevenueRanker(), NewNoExplorer(), NewFirstPricePricer())
+
+		// setup mocks
+		currentDay := 5
+		timeRepoMock.On("GetDay", ctx).Return(currentDay, nil).Once()
+
+		clientId := uuid.New()
+		client := models.Client{Id: clientId}
+		clientsRepoMock.On("GetClientById", ctx, clientId).Return(client, nil).Once()
+
+		expectedError := errors.New("failed to get checks")
+		adsRepoMock.On("GetAdCandidatesChecksForClient", ctx, client, currentDay).Return(nil, expectedError).Once()
+
+		// check
+		_, err := service.ExplainAdForClient(ctx, clientId, nil)
+		require.ErrorIs(t, err, expectedError)
+	})
+}
+
+// reserve returns reservations of impressions with the same daily target and prices left to the repo
+func reserve(impressions []models.Impression, dailyTarget int) []dto.ImpressionReservation {
+	reservations := make([]dto.ImpressionReservation, 0, len(impressions))
+	for _, impression := range impressions {
+		// prices are set by pricing once repo knows which candidates are left
+		impression.Profit = 0
+		impression.ClickPrice = 0
+		reservations = append(reservations, dto.ImpressionReservation{
+			Impression:             impression,
+			DailyImpressionsTarget: pointer(dailyTarget),
+		})
+	}
+
+	return reservations
 }
@@ -1,23 +1,31 @@
 package service
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/advertising-service/internal/repo"
 	"context"
 	"fmt"
+	"iter"
+	"slices"
 
 	"github.com/google/uuid"
 )
 
+// maxMLScoresLoadErrors limits count of failed rows returned in ml scores load report
+const maxMLScoresLoadErrors = 1000
+
 type AdvertiserService struct {
 	ar  repo.AdvertisersRepo
 	msr repo.MlScoresRepo
+	tr  repo.TimeRepo
 }
 
-func NewAdvertisersService(ar repo.AdvertisersRepo, msr repo.MlScoresRepo) *AdvertiserService {
+func NewAdvertisersService(ar repo.AdvertisersRepo, msr repo.MlScoresRepo, tr repo.TimeRepo) *AdvertiserService {
 	return &AdvertiserService{
 		ar:  ar,
 		msr: msr,
+		tr:  tr,
 	}
 }
 
@@ -32,7 +40,27 @@ func (as *AdvertiserService) GetAdvertiserById(ctx context.Context, id uuid.UUID
 	return advertiser, nil
 }
 
-func (as *AdvertiserService) UpsertAdvertisers(ctx context.Context, advertisers []models.Advertiser) ([]models.Advertiser, error) {
+// ListAdvertisers returns page of advertisers ordered by name, optionally searched by name
+func (as *AdvertiserService) ListAdvertisers(ctx context.Context, params dto.AdvertisersListParams) (dto.AdvertisersPage, error) {
+	op := "AdvertiserService.ListAdvertisers"
+
+	advertisers, err := as.ar.ListAdvertisers(ctx, params)
+	if err != nil {
+		return dto.AdvertisersPage{}, fmt.Errorf("%s: ar.ListAdvertisers: %w", op, err)
+	}
+
+	total, err := as.ar.CountAdvertisers(ctx, params)
+	if err != nil {
+		return dto.AdvertisersPage{}, fmt.Errorf("%s: ar.CountAdvertisers: %w", op, err)
+	}
+
+	return dto.AdvertisersPage{
+		Advertisers: advertisers,
+		Total:       total,
+	}, nil
+}
+
+func (as *AdvertiserService) UpsertAdvertisers(ctx context.Context, advertisers []dto.AdvertiserUpsert) ([]models.Advertiser, error) {
 	op := "AdvertiserService.UpsertAdvertisers"
 
 	advertisersGot, err := as.ar.UpsertAdvertisers(ctx, advertisers)
@@ -43,6 +71,23 @@ func (as *AdvertiserService) UpsertAdvertisers(ctx context.Context, advertisers
 	return advertisersGot, nil
 }
 
+// DeleteAdvertiser deletes advertiser and archives its campaigns, archiving is saved to campaigns history.
+// Advertiser with active campaigns can't be deleted, they have to be paused or archived first
+func (as *AdvertiserService) DeleteAdvertiser(ctx context.Context, id uuid.UUID) error {
+	op := "AdvertiserService.DeleteAdvertiser"
+
+	dayNow, err := as.tr.GetDay(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
+	if err := as.ar.DeleteAdvertiser(ctx, id, dayNow); err != nil {
+		return fmt.Errorf("%s: ar.DeleteAdvertiser: %w", op, err)
+	}
+
+	return nil
+}
+
 func (as *AdvertiserService) UpsertMLScore(ctx context.Context, mlScore models.MLScore) error {
 	op := "AdvertiserService.UpsertMLScore"
 
@@ -53,3 +98,45 @@ func (as *AdvertiserService) UpsertMLScore(ctx context.Context, mlScore models.M
 
 	return nil
 }
+
+// LoadMLScores upserts ml scores read from file. Rows which can`t be read or refer to unknown
+// client or advertiser are reported and don`t prevent loading of other rows.
+// If rows sequence fails, nothing is loaded and the error is returned
+func (as *AdvertiserService) LoadMLScores(ctx context.Context, rows iter.Seq2[dto.MLScoreLoadRow, error]) (dto.MLScoresLoadReport, error) {
+	op := "AdvertiserService.LoadMLScores"
+
+	var (
+		readFailed int
+		readErrors []dto.MLScoreLoadError
+	)
+	valid := func(yield func(dto.MLScoreLoadRow, error) bool) {
+		for row, err := range rows {
+			if err == nil && row.Err != nil {
+				readFailed++
+				if len(readErrors) < maxMLScoresLoadErrors {
+					readErrors = append(readErrors, dto.MLScoreLoadError{Row: row.Row, Err: row.Err})
+				}
+				continue
+			}
+			if !yield(row, err) {
+				return
+			}
+		}
+	}
+
+	report, err := as.msr.LoadMLScores(ctx, valid, maxMLScoresLoadErrors)
+	if err != nil {
+		return dto.MLScoresLoadReport{}, fmt.Errorf("%s: msr.LoadMLScores: %w", op, err)
+	}
+
+	report.Failed += readFailed
+	report.Errors = append(report.Errors, readErrors...)
+	slices.SortFunc(report.Errors, func(a, b dto.MLScoreLoadError) int {
+		return a.Row - b.Row
+	})
+	if len(report.Errors) > maxMLScoresLoadErrors {
+		report.Errors = report.Errors[:maxMLScoresLoadErrors]
+	}
+
+	return report, nil
+}
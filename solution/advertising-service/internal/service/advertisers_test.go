@@ -1,13 +1,16 @@
 package service
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/advertising-service/internal/repo/mocks"
 	"context"
 	"errors"
+	"iter"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,7 +20,8 @@ func TestAdvertisersService(t *testing.T) {
 
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
-		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -39,7 +43,8 @@ func TestAdvertisersService(t *testing.T) {
 
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
-		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -59,7 +64,8 @@ func TestAdvertisersService(t *testing.T) {
 
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
-		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
 
 		// setup mocks
 		advertisers := []models.Advertiser{
@@ -77,11 +83,12 @@ func TestAdvertisersService(t *testing.T) {
 			},
 		}
 		expectedAdvertisers := advertisers
+		upsert := dto.NewAdvertisersUpsert(advertisers)
 
-		advertisersRepoMock.On("UpsertAdvertisers", ctx, advertisers).Return(expectedAdvertisers, nil).Once()
+		advertisersRepoMock.On("UpsertAdvertisers", ctx, upsert).Return(expectedAdvertisers, nil).Once()
 
 		// check
-		actualAdvertisers, err := service.UpsertAdvertisers(ctx, advertisers)
+		actualAdvertisers, err := service.UpsertAdvertisers(ctx, upsert)
 		require.NoError(t, err)
 		require.Equal(t, expectedAdvertisers, actualAdvertisers)
 	})
@@ -91,7 +98,8 @@ func TestAdvertisersService(t *testing.T) {
 
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
-		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
 
 		// setup mocks
 		advertisers := []models.Advertiser{
@@ -110,21 +118,117 @@ func TestAdvertisersService(t *testing.T) {
 		}
 		var expectedAdvertisers []models.Advertiser = nil
 		expectedError := errors.New("failed to upsert advertisers")
+		upsert := dto.NewAdvertisersUpsert(advertisers)
 
-		advertisersRepoMock.On("UpsertAdvertisers", ctx, advertisers).Return(expectedAdvertisers, expectedError).Once()
+		advertisersRepoMock.On("UpsertAdvertisers", ctx, upsert).Return(expectedAdvertisers, expectedError).Once()
 
 		// check
-		actualAdvertisers, actualError := service.UpsertAdvertisers(ctx, advertisers)
+		actualAdvertisers, actualError := service.UpsertAdvertisers(ctx, upsert)
 		require.ErrorIs(t, actualError, expectedError)
 		require.Equal(t, expectedAdvertisers, actualAdvertisers)
 	})
 
+	t.Run("list advertisers success", func(t *testing.T) {
+		ctx := context.Background()
+
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
+
+		// setup mocks
+		name := "name"
+		params := dto.AdvertisersListParams{
+			PaginationParams: dto.PaginationParams{Size: 2, Page: 1},
+			Name:             &name,
+		}
+		advertisers := []models.Advertiser{
+			{
+				Id:   uuid.New(),
+				Name: "name 1",
+			},
+			{
+				Id:   uuid.New(),
+				Name: "name 2",
+			},
+		}
+
+		advertisersRepoMock.On("ListAdvertisers", ctx, params).Return(advertisers, nil).Once()
+		advertisersRepoMock.On("CountAdvertisers", ctx, params).Return(3, nil).Once()
+
+		// check
+		page, err := service.ListAdvertisers(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, dto.AdvertisersPage{Advertisers: advertisers, Total: 3}, page)
+	})
+
+	t.Run("list advertisers error", func(t *testing.T) {
+		ctx := context.Background()
+
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
+
+		// setup mocks
+		params := dto.AdvertisersListParams{
+			PaginationParams: dto.PaginationParams{Size: 2, Page: 1},
+		}
+		expectedError := errors.New("failed to list advertisers")
+
+		advertisersRepoMock.On("ListAdvertisers", ctx, params).Return(nil, expectedError).Once()
+
+		// check
+		page, err := service.ListAdvertisers(ctx, params)
+		require.ErrorIs(t, err, expectedError)
+		require.Equal(t, dto.AdvertisersPage{}, page)
+	})
+
+	t.Run("delete advertiser success", func(t *testing.T) {
+		ctx := context.Background()
+
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
+
+		// setup mocks
+		advertiserId := uuid.New()
+
+		timeRepoMock.On("GetDay", ctx).Return(2, nil).Once()
+		advertisersRepoMock.On("DeleteAdvertiser", ctx, advertiserId, 2).Return(nil).Once()
+
+		// check
+		err := service.DeleteAdvertiser(ctx, advertiserId)
+		require.NoError(t, err)
+	})
+
+	t.Run("delete advertiser with active campaigns", func(t *testing.T) {
+		ctx := context.Background()
+
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
+
+		// setup mocks
+		advertiserId := uuid.New()
+
+		timeRepoMock.On("GetDay", ctx).Return(2, nil).Once()
+		advertisersRepoMock.On("DeleteAdvertiser", ctx, advertiserId, 2).Return(models.ErrAdvertiserHasActiveCampaigns).Once()
+
+		// check
+		err := service.DeleteAdvertiser(ctx, advertiserId)
+		require.ErrorIs(t, err, models.ErrAdvertiserHasActiveCampaigns)
+	})
+
 	t.Run("upsert ml_score success", func(t *testing.T) {
 		ctx := context.Background()
 
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
-		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
 
 		// setup mocks
 		mlScore := models.MLScore{
@@ -145,7 +249,8 @@ func TestAdvertisersService(t *testing.T) {
 
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
-		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
 
 		// setup mocks
 		mlScore := models.MLScore{
@@ -161,4 +266,89 @@ func TestAdvertisersService(t *testing.T) {
 		err := service.UpsertMLScore(ctx, mlScore)
 		require.ErrorIs(t, err, expectedError)
 	})
+
+	t.Run("load ml scores", func(t *testing.T) {
+		ctx := context.Background()
+
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
+
+		// setup mocks
+		readErr := errors.New("score must be integer")
+		rows := []dto.MLScoreLoadRow{
+			{Row: 1, Score: models.MLScore{ClientId: uuid.New(), AdvertiserId: uuid.New(), Score: 10}},
+			{Row: 2, Err: readErr},
+			{Row: 3, Score: models.MLScore{ClientId: uuid.New(), AdvertiserId: uuid.New(), Score: 20}},
+		}
+		rowsSeq := func(yield func(dto.MLScoreLoadRow, error) bool) {
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+
+		mlscoresRepoMock.On("LoadMLScores", ctx, mock.Anything, maxMLScoresLoadErrors).Return(
+			func(ctx context.Context, rowsGot iter.Seq2[dto.MLScoreLoadRow, error], maxErrors int) (dto.MLScoresLoadReport, error) {
+				// only readable rows are loaded
+				loaded := []dto.MLScoreLoadRow{}
+				for row, err := range rowsGot {
+					require.NoError(t, err)
+					loaded = append(loaded, row)
+				}
+				require.Equal(t, []dto.MLScoreLoadRow{rows[0], rows[2]}, loaded)
+
+				return dto.MLScoresLoadReport{
+					Loaded: 1,
+					Failed: 1,
+					Errors: []dto.MLScoreLoadError{{Row: 3, Err: models.ErrClientNotFound}},
+				}, nil
+			},
+		).Once()
+
+		// check
+		report, err := service.LoadMLScores(ctx, rowsSeq)
+		require.NoError(t, err)
+		require.Equal(t, dto.MLScoresLoadReport{
+			Loaded: 1,
+			Failed: 2,
+			Errors: []dto.MLScoreLoadError{
+				{Row: 2, Err: readErr},
+				{Row: 3, Err: models.ErrClientNotFound},
+			},
+		}, report)
+	})
+
+	t.Run("load ml scores from broken file", func(t *testing.T) {
+		ctx := context.Background()
+
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		mlscoresRepoMock := mocks.NewMlScoresRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		service := NewAdvertisersService(advertisersRepoMock, mlscoresRepoMock, timeRepoMock)
+
+		// setup mocks
+		expectedError := errors.New("unexpected EOF")
+		rowsSeq := func(yield func(dto.MLScoreLoadRow, error) bool) {
+			yield(dto.MLScoreLoadRow{}, expectedError)
+		}
+
+		mlscoresRepoMock.On("LoadMLScores", ctx, mock.Anything, maxMLScoresLoadErrors).Return(
+			func(ctx context.Context, rowsGot iter.Seq2[dto.MLScoreLoadRow, error], maxErrors int) (dto.MLScoresLoadReport, error) {
+				for _, err := range rowsGot {
+					if err != nil {
+						return dto.MLScoresLoadReport{}, err
+					}
+				}
+				return dto.MLScoresLoadReport{}, nil
+			},
+		).Once()
+
+		// check
+		report, err := service.LoadMLScores(ctx, rowsSeq)
+		require.ErrorIs(t, err, expectedError)
+		require.Equal(t, dto.MLScoresLoadReport{}, report)
+	})
 }
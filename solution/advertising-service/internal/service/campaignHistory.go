@@ -0,0 +1,47 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func (cs *CampaignsService) ListCampaignHistory(
+	ctx context.Context,
+	advertiserId, campaignId uuid.UUID,
+	params dto.PaginationParams,
+) ([]models.CampaignVersion, error) {
+	op := "CampaignsService.ListCampaignHistory"
+
+	if _, err := cs.GetCampaignById(ctx, advertiserId, campaignId); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	versions, err := cs.hr.ListCampaignVersions(ctx, campaignId, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: hr.ListCampaignVersions: %w", op, err)
+	}
+
+	return versions, nil
+}
+
+// RestoreCampaignVersion updates campaign with parameters of saved version.
+// Restoring follows the same rules as updating, status and image are not restored
+func (cs *CampaignsService) RestoreCampaignVersion(ctx context.Context, advertiserId, campaignId uuid.UUID, version int) (models.Campaign, error) {
+	op := "CampaignsService.RestoreCampaignVersion"
+
+	if _, err := cs.GetCampaignById(ctx, advertiserId, campaignId); err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	saved, err := cs.hr.GetCampaignVersion(ctx, campaignId, version)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: hr.GetCampaignVersion: %w", op, err)
+	}
+
+	data := dto.CampaignDataFromCampaign(models.Campaign(saved.Campaign))
+	return cs.updateCampaign(ctx, advertiserId, campaignId, data, nil, models.CampaignActionRestore)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/advertising-service/internal/repo/mocks"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreCampaignVersion(t *testing.T) {
+	campaignSample := models.Campaign{
+		ImpressionsLimit:  1000,
+		ClicksLimit:       100,
+		CostPerImpression: 100,
+		CostPerClick:      100,
+		AdTitle:           "ad title",
+		AdText:            "ad text",
+		StartDate:         5,
+		EndDate:           10,
+		Status:            models.CampaignStatusActive,
+		ModerationStatus:  models.ModerationStatusApproved,
+		FlaggedPhrases:    pq.StringArray{},
+	}
+
+	t.Run("restore campaign version success", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		campaignWas := campaignSample
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Status = models.CampaignStatusPaused
+
+		saved := campaignWas
+		saved.CostPerClick = 50
+		saved.AdTitle = "old title"
+		saved.Status = models.CampaignStatusActive
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Twice()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Twice()
+		historyRepoMock.On("GetCampaignVersion", ctx, campaignId, 1).Return(models.CampaignVersion{
+			CampaignId: campaignId,
+			Version:    1,
+			Campaign:   models.CampaignSnapshot(saved),
+		}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		// status is not restored
+		expectedCampaign := saved
+		expectedCampaign.Status = models.CampaignStatusPaused
+		expectedCampaign.Version = campaignWas.Version + 1
+
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, dto.CampaignDataFromCampaign(expectedCampaign), campaignWas.Version, 0, models.CampaignActionRestore).Return(nil).Once()
+
+		// check
+		actualCampaign, err := service.RestoreCampaignVersion(ctx, advertiserId, campaignId, 1)
+		require.NoError(t, err)
+		require.Equal(t, expectedCampaign, actualCampaign)
+	})
+
+	t.Run("restore forbidden fields of started campaign", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		campaignWas := campaignSample
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+
+		saved := campaignWas
+		saved.ImpressionsLimit = 500
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Twice()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Twice()
+		historyRepoMock.On("GetCampaignVersion", ctx, campaignId, 1).Return(models.CampaignVersion{
+			CampaignId: campaignId,
+			Version:    1,
+			Campaign:   models.CampaignSnapshot(saved),
+		}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(7, nil).Once()
+
+		// check
+		_, err := service.RestoreCampaignVersion(ctx, advertiserId, campaignId, 1)
+		require.ErrorIs(t, err, models.ErrCantUpdateCampaign)
+	})
+
+	t.Run("restore non-existent version", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		campaignWas := campaignSample
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		historyRepoMock.On("GetCampaignVersion", ctx, campaignId, 42).Return(models.CampaignVersion{}, models.ErrVersionNotFound).Once()
+
+		// check
+		_, err := service.RestoreCampaignVersion(ctx, advertiserId, campaignId, 42)
+		require.ErrorIs(t, err, models.ErrVersionNotFound)
+	})
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CreateCampaignTemplate saves campaign parameters under the name unique for advertiser
+func (cs *CampaignsService) CreateCampaignTemplate(
+	ctx context.Context,
+	advertiserId uuid.UUID,
+	name string,
+	data dto.CampaignData,
+) (models.CampaignTemplate, error) {
+	op := "CampaignsService.CreateCampaignTemplate"
+
+	if data.TargetingRules != nil {
+		if err := validateTargetingRule(*data.TargetingRules); err != nil {
+			return models.CampaignTemplate{}, err
+		}
+	}
+
+	campaign := data.ToCampaign()
+	campaign.AdvertiserId = advertiserId
+
+	template, err := cs.ctr.CreateTemplate(ctx, models.CampaignTemplate{
+		AdvertiserId: advertiserId,
+		Name:         name,
+		Campaign:     models.CampaignSnapshot(campaign),
+	})
+	if err != nil {
+		return models.CampaignTemplate{}, fmt.Errorf("%s: ctr.CreateTemplate: %w", op, err)
+	}
+
+	return template, nil
+}
+
+func (cs *CampaignsService) ListCampaignTemplates(ctx context.Context, advertiserId uuid.UUID) ([]models.CampaignTemplate, error) {
+	op := "CampaignsService.ListCampaignTemplates"
+
+	// check advertiser existence
+	_, err := cs.ar.GetAdvertiserById(ctx, advertiserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+	}
+
+	templates, err := cs.ctr.ListTemplates(ctx, advertiserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: ctr.ListTemplates: %w", op, err)
+	}
+
+	return templates, nil
+}
+
+func (cs *CampaignsService) GetCampaignTemplate(ctx context.Context, advertiserId, templateId uuid.UUID) (models.CampaignTemplate, error) {
+	op := "CampaignsService.GetCampaignTemplate"
+
+	// check advertiser existence
+	_, err := cs.ar.GetAdvertiserById(ctx, advertiserId)
+	if err != nil {
+		return models.CampaignTemplate{}, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+	}
+
+	template, err := cs.ctr.GetTemplate(ctx, templateId)
+	if err != nil {
+		return models.CampaignTemplate{}, fmt.Errorf("%s: ctr.GetTemplate: %w", op, err)
+	}
+
+	if template.AdvertiserId != advertiserId {
+		return models.CampaignTemplate{}, models.ErrTemplateNotFound
+	}
+
+	return template, nil
+}
+
+func (cs *CampaignsService) DeleteCampaignTemplate(ctx context.Context, advertiserId, templateId uuid.UUID) error {
+	op := "CampaignsService.DeleteCampaignTemplate"
+
+	if _, err := cs.GetCampaignTemplate(ctx, advertiserId, templateId); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := cs.ctr.DeleteTemplate(ctx, templateId); err != nil {
+		return fmt.Errorf("%s: ctr.DeleteTemplate: %w", op, err)
+	}
+
+	return nil
+}
+
+// InstantiateCampaignTemplate creates campaign with parameters of template.
+// Dates and limits can be overridden, campaign is validated as a new one
+func (cs *CampaignsService) InstantiateCampaignTemplate(
+	ctx context.Context,
+	advertiserId, templateId uuid.UUID,
+	overrides dto.CampaignOverrides,
+) (models.Campaign, error) {
+	op := "CampaignsService.InstantiateCampaignTemplate"
+
+	template, err := cs.GetCampaignTemplate(ctx, advertiserId, templateId)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data := dto.CampaignDataFromCampaign(models.Campaign(template.Campaign))
+	return cs.createCampaignWithOverrides(ctx, advertiserId, data, overrides)
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/advertising-service/internal/repo/mocks"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCampaign(t *testing.T) {
+	campaignSample := models.Campaign{
+		ImpressionsLimit:  1000,
+		ClicksLimit:       100,
+		CostPerImpression: 100,
+		CostPerClick:      100,
+		AdTitle:           "ad title",
+		AdText:            "ad text",
+		StartDate:         5,
+		EndDate:           10,
+		Pacing:            models.PacingEven,
+		Status:            models.CampaignStatusCompleted,
+		Version:           4,
+		ModerationStatus:  models.ModerationStatusApproved,
+		FlaggedPhrases:    pq.StringArray{},
+	}
+
+	t.Run("clone campaign with image", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		sourceId := uuid.New()
+		cloneId := uuid.New()
+
+		source := campaignSample
+		source.Id = sourceId
+		source.AdvertiserId = advertiserId
+		sourceImageUrl := fmt.Sprintf("http://localhost:8080/static/%s", getCampaignImageName(sourceId))
+		source.AdImageUrl = &sourceImageUrl
+
+		startDate, endDate := 20, 30
+		overrides := dto.CampaignOverrides{
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		}
+
+		expectedData := dto.CampaignDataFromCampaign(source)
+		expectedData.StartDate = startDate
+		expectedData.EndDate = endDate
+		expectedData.Status = models.CampaignStatusActive
+
+		cloneImageUrl := fmt.Sprintf("http://localhost:8080/static/%s", getCampaignImageName(cloneId))
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, sourceId).Return(source, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(15, nil).Twice()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 15).Return(cloneId, nil).Once()
+		staticRepoMock.On("CopyStatic", ctx, getCampaignImageName(sourceId), getCampaignImageName(cloneId)).Return(nil).Once()
+		campaignsRepoMock.On("SetCampaignAdImageUrl", ctx, cloneId, &cloneImageUrl, 15).Return(nil).Once()
+
+		expectedCampaign := expectedData.ToCampaign()
+		expectedCampaign.Id = cloneId
+		expectedCampaign.AdvertiserId = advertiserId
+		expectedCampaign.AdImageUrl = &cloneImageUrl
+		expectedCampaign.Version = 2
+
+		// check
+		actualCampaign, err := service.CloneCampaign(ctx, advertiserId, sourceId, overrides)
+		require.NoError(t, err)
+		require.Equal(t, expectedCampaign, actualCampaign)
+	})
+
+	t.Run("clone campaign removes clone when image copy fails", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		sourceId := uuid.New()
+		cloneId := uuid.New()
+
+		source := campaignSample
+		source.Id = sourceId
+		source.AdvertiserId = advertiserId
+		source.StartDate = 20
+		source.EndDate = 30
+		sourceImageUrl := fmt.Sprintf("http://localhost:8080/static/%s", getCampaignImageName(sourceId))
+		source.AdImageUrl = &sourceImageUrl
+
+		expectedData := dto.CampaignDataFromCampaign(source)
+		expectedData.Status = models.CampaignStatusActive
+
+		copyErr := errors.New("copy failed")
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, sourceId).Return(source, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(15, nil).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 15).Return(cloneId, nil).Once()
+		staticRepoMock.On("CopyStatic", ctx, getCampaignImageName(sourceId), getCampaignImageName(cloneId)).Return(copyErr).Once()
+		campaignsRepoMock.On("PurgeCampaign", ctx, cloneId).Return(nil).Once()
+
+		// check
+		_, err := service.CloneCampaign(ctx, advertiserId, sourceId, dto.CampaignOverrides{})
+		require.ErrorIs(t, err, copyErr)
+	})
+
+	t.Run("clone campaign removes clone and image when image update fails", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		sourceId := uuid.New()
+		cloneId := uuid.New()
+
+		source := campaignSample
+		source.Id = sourceId
+		source.AdvertiserId = advertiserId
+		source.StartDate = 20
+		source.EndDate = 30
+		sourceImageUrl := fmt.Sprintf("http://localhost:8080/static/%s", getCampaignImageName(sourceId))
+		source.AdImageUrl = &sourceImageUrl
+
+		expectedData := dto.CampaignDataFromCampaign(source)
+		expectedData.Status = models.CampaignStatusActive
+
+		setErr := errors.New("update failed")
+		cloneImageUrl := fmt.Sprintf("http://localhost:8080/static/%s", getCampaignImageName(cloneId))
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, sourceId).Return(source, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(15, nil).Twice()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 15).Return(cloneId, nil).Once()
+		staticRepoMock.On("CopyStatic", ctx, getCampaignImageName(sourceId), getCampaignImageName(cloneId)).Return(nil).Once()
+		campaignsRepoMock.On("SetCampaignAdImageUrl", ctx, cloneId, &cloneImageUrl, 15).Return(setErr).Once()
+		campaignsRepoMock.On("PurgeCampaign", ctx, cloneId).Return(nil).Once()
+		staticRepoMock.On("DeleteStatic", ctx, getCampaignImageName(cloneId)).Return(nil).Once()
+
+		// check
+		_, err := service.CloneCampaign(ctx, advertiserId, sourceId, dto.CampaignOverrides{})
+		require.ErrorIs(t, err, setErr)
+	})
+
+	t.Run("clone campaign with invalid limits", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		sourceId := uuid.New()
+
+		source := campaignSample
+		source.Id = sourceId
+		source.AdvertiserId = advertiserId
+
+		impressionsLimit := 10
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, sourceId).Return(source, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		// check
+		_, err := service.CloneCampaign(ctx, advertiserId, sourceId, dto.CampaignOverrides{ImpressionsLimit: &impressionsLimit})
+		require.ErrorIs(t, err, models.ErrInvalidCampaign)
+	})
+
+	t.Run("clone campaign with past start date", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		sourceId := uuid.New()
+
+		source := campaignSample
+		source.Id = sourceId
+		source.AdvertiserId = advertiserId
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, sourceId).Return(source, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(15, nil).Once()
+
+		// check
+		_, err := service.CloneCampaign(ctx, advertiserId, sourceId, dto.CampaignOverrides{})
+		require.ErrorIs(t, err, models.ErrInvalidStartDate)
+	})
+
+	t.Run("clone campaign of another advertiser", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		sourceId := uuid.New()
+
+		source := campaignSample
+		source.Id = sourceId
+		source.AdvertiserId = uuid.New()
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, sourceId).Return(source, nil).Once()
+
+		// check
+		_, err := service.CloneCampaign(ctx, advertiserId, sourceId, dto.CampaignOverrides{})
+		require.ErrorIs(t, err, models.ErrCampaignNotFound)
+	})
+}
+
+func TestCampaignTemplates(t *testing.T) {
+	campaignDataSample := dto.CampaignData{
+		ImpressionsLimit:  1000,
+		ClicksLimit:       100,
+		CostPerImpression: 100,
+		CostPerClick:      100,
+		AdTitle:           "ad title",
+		AdText:            "ad text",
+		StartDate:         5,
+		EndDate:           10,
+		Pacing:            models.PacingEven,
+		ModerationStatus:  models.ModerationStatusApproved,
+		FlaggedPhrases:    pq.StringArray{},
+	}
+
+	t.Run("create campaign template", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+
+		campaign := campaignDataSample.ToCampaign()
+		campaign.AdvertiserId = advertiserId
+		template := models.CampaignTemplate{
+			AdvertiserId: advertiserId,
+			Name:         "spring sale",
+			Campaign:     models.CampaignSnapshot(campaign),
+		}
+
+		expectedTemplate := template
+		expectedTemplate.Id = uuid.New()
+
+		templatesRepoMock.On("CreateTemplate", ctx, template).Return(expectedTemplate, nil).Once()
+
+		// check
+		actualTemplate, err := service.CreateCampaignTemplate(ctx, advertiserId, "spring sale", campaignDataSample)
+		require.NoError(t, err)
+		require.Equal(t, expectedTemplate, actualTemplate)
+	})
+
+	t.Run("instantiate campaign template", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		templateId := uuid.New()
+		campaignId := uuid.New()
+
+		campaign := campaignDataSample.ToCampaign()
+		campaign.AdvertiserId = advertiserId
+
+		clicksLimit := 50
+		overrides := dto.CampaignOverrides{
+			ClicksLimit: &clicksLimit,
+			Draft:       true,
+		}
+
+		expectedData := campaignDataSample
+		expectedData.ClicksLimit = clicksLimit
+		expectedData.Status = models.CampaignStatusDraft
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		templatesRepoMock.On("GetTemplate", ctx, templateId).Return(models.CampaignTemplate{
+			Id:           templateId,
+			AdvertiserId: advertiserId,
+			Name:         "spring sale",
+			Campaign:     models.CampaignSnapshot(campaign),
+		}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 0).Return(campaignId, nil).Once()
+
+		expectedCampaign := expectedData.ToCampaign()
+		expectedCampaign.Id = campaignId
+		expectedCampaign.AdvertiserId = advertiserId
+		expectedCampaign.Version = 1
+
+		// check
+		actualCampaign, err := service.InstantiateCampaignTemplate(ctx, advertiserId, templateId, overrides)
+		require.NoError(t, err)
+		require.Equal(t, expectedCampaign, actualCampaign)
+	})
+
+	t.Run("instantiate template of another advertiser", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		templateId := uuid.New()
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		templatesRepoMock.On("GetTemplate", ctx, templateId).Return(models.CampaignTemplate{
+			Id:           templateId,
+			AdvertiserId: uuid.New(),
+		}, nil).Once()
+
+		// check
+		_, err := service.InstantiateCampaignTemplate(ctx, advertiserId, templateId, dto.CampaignOverrides{})
+		require.ErrorIs(t, err, models.ErrTemplateNotFound)
+	})
+
+	t.Run("delete campaign template", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		templateId := uuid.New()
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		templatesRepoMock.On("GetTemplate", ctx, templateId).Return(models.CampaignTemplate{
+			Id:           templateId,
+			AdvertiserId: advertiserId,
+		}, nil).Once()
+		templatesRepoMock.On("DeleteTemplate", ctx, templateId).Return(nil).Once()
+
+		// check
+		err := service.DeleteCampaignTemplate(ctx, advertiserId, templateId)
+		require.NoError(t, err)
+	})
+}
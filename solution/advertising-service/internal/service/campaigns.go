@@ -4,10 +4,13 @@ import (
 	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/advertising-service/internal/repo"
+	"advertising/pkg/logger"
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 type CampaignsService struct {
@@ -15,6 +18,9 @@ type CampaignsService struct {
 	ar            repo.AdvertisersRepo
 	tr            repo.TimeRepo
 	sr            repo.StaticRepo
+	hr            repo.CampaignHistoryRepo
+	ctr           repo.CampaignTemplatesRepo
+	moderator     Moderator
 	staticBaseUrl string
 }
 
@@ -23,6 +29,9 @@ func NewCampaignsService(
 	ar repo.AdvertisersRepo,
 	tr repo.TimeRepo,
 	sr repo.StaticRepo,
+	hr repo.CampaignHistoryRepo,
+	ctr repo.CampaignTemplatesRepo,
+	moderator Moderator,
 	staticBaseUrl string,
 ) *CampaignsService {
 	return &CampaignsService{
@@ -30,6 +39,9 @@ func NewCampaignsService(
 		ar:            ar,
 		tr:            tr,
 		sr:            sr,
+		hr:            hr,
+		ctr:           ctr,
+		moderator:     moderator,
 		staticBaseUrl: staticBaseUrl,
 	}
 }
@@ -42,11 +54,13 @@ func (cs *CampaignsService) CreateCampaign(ctx context.Context, advertiserId uui
 		return models.Campaign{}, fmt.Errorf("%s: tr.GetDay: %w", op, err)
 	}
 
-	if data.StartDate < dayNow {
-		return models.Campaign{}, models.ErrInvalidStartDate
+	if err := validateNewCampaign(dayNow, data); err != nil {
+		return models.Campaign{}, err
 	}
 
-	createdId, err := cs.cr.CreateCampaign(ctx, advertiserId, data)
+	data = cs.moderateCampaign(ctx, data)
+
+	createdId, err := cs.cr.CreateCampaign(ctx, advertiserId, data, dayNow)
 	if err != nil {
 		return models.Campaign{}, fmt.Errorf("%s: cr.CreateCampaign: %w", op, err)
 	}
@@ -54,28 +68,100 @@ func (cs *CampaignsService) CreateCampaign(ctx context.Context, advertiserId uui
 	campaign := data.ToCampaign()
 	campaign.AdvertiserId = advertiserId
 	campaign.Id = createdId
+	campaign.Version = 1
+
 	return campaign, nil
 }
 
+// validateNewCampaign checks campaign data can be used for campaign creation
+func validateNewCampaign(dayNow int, data dto.CampaignData) error {
+	if data.StartDate < dayNow {
+		return models.ErrInvalidStartDate
+	}
+
+	if data.ClicksLimit > data.ImpressionsLimit {
+		return fmt.Errorf("%w: clicks limit must be not greater than impressions_limit", models.ErrInvalidCampaign)
+	}
+
+	if data.EndDate < data.StartDate {
+		return fmt.Errorf("%w: end_date must be not less than start_date", models.ErrInvalidCampaign)
+	}
+
+	if data.AgeFrom != nil && data.AgeTo != nil && *data.AgeTo < *data.AgeFrom {
+		return fmt.Errorf("%w: age_to must be not less than age_from", models.ErrInvalidCampaign)
+	}
+
+	if data.TargetingRules != nil {
+		if err := validateTargetingRule(*data.TargetingRules); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (cs *CampaignsService) ListCampaignsForAdvertiser(
 	ctx context.Context,
 	advertiserId uuid.UUID,
-	params dto.PaginationParams,
-) ([]models.Campaign, error) {
+	params dto.CampaignsListParams,
+) (dto.CampaignsPage, error) {
 	op := "CampaignsService.ListCampaignsForAdvertiser"
 
+	// cursor is valid only in the sort it was got with
+	if params.Cursor != nil && (params.Cursor.Sort != params.Sort || params.Cursor.Desc != params.Desc) {
+		return dto.CampaignsPage{}, fmt.Errorf("%w: cursor of another sort", models.ErrInvalidCursor)
+	}
+
 	// check advertiser existence
 	_, err := cs.ar.GetAdvertiserById(ctx, advertiserId)
 	if err != nil {
-		return nil, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+		return dto.CampaignsPage{}, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+	}
+
+	campaigns, next, err := cs.cr.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+	if err != nil {
+		return dto.CampaignsPage{}, fmt.Errorf("%s: cr.ListCampaignsForAdvertiser: %w", op, err)
+	}
+
+	total, err := cs.cr.CountCampaignsForAdvertiser(ctx, advertiserId, params.Filter)
+	if err != nil {
+		return dto.CampaignsPage{}, fmt.Errorf("%s: cr.CountCampaignsForAdvertiser: %w", op, err)
+	}
+
+	return dto.CampaignsPage{
+		Campaigns: campaigns,
+		Total:     total,
+		Next:      next,
+	}, nil
+}
+
+// SearchCampaigns finds campaigns by words in title and text,
+// search is limited to campaigns of advertiser if params.AdvertiserId is set
+func (cs *CampaignsService) SearchCampaigns(ctx context.Context, params dto.CampaignsSearchParams) (dto.CampaignsSearchPage, error) {
+	op := "CampaignsService.SearchCampaigns"
+
+	if params.AdvertiserId != nil {
+		// check advertiser existence
+		_, err := cs.ar.GetAdvertiserById(ctx, *params.AdvertiserId)
+		if err != nil {
+			return dto.CampaignsSearchPage{}, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+		}
+	}
+
+	results, err := cs.cr.SearchCampaigns(ctx, params)
+	if err != nil {
+		return dto.CampaignsSearchPage{}, fmt.Errorf("%s: cr.SearchCampaigns: %w", op, err)
 	}
 
-	campaigns, err := cs.cr.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+	total, err := cs.cr.CountSearchedCampaigns(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("%s: cr.ListCampaignsForAdvertiser: %w", op, err)
+		return dto.CampaignsSearchPage{}, fmt.Errorf("%s: cr.CountSearchedCampaigns: %w", op, err)
 	}
 
-	return campaigns, nil
+	return dto.CampaignsSearchPage{
+		Results: results,
+		Total:   total,
+	}, nil
 }
 
 func (cs *CampaignsService) GetCampaignById(
@@ -103,8 +189,27 @@ func (cs *CampaignsService) GetCampaignById(
 	return campaign, nil
 }
 
-func (cs *CampaignsService) UpdateCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID, data dto.CampaignData) (models.Campaign, error) {
-	op := "CampaignsService.UpdateCampaign"
+// UpdateCampaign updates campaign. If version is given, campaign is updated
+// only if it has not been changed since the version
+func (cs *CampaignsService) UpdateCampaign(
+	ctx context.Context,
+	advertiserId, campaignId uuid.UUID,
+	data dto.CampaignData,
+	version *int,
+) (models.Campaign, error) {
+	return cs.updateCampaign(ctx, advertiserId, campaignId, data, version, models.CampaignActionUpdate)
+}
+
+// updateCampaign updates campaign if it is allowed for started campaign
+// and saves new version of campaign with given action
+func (cs *CampaignsService) updateCampaign(
+	ctx context.Context,
+	advertiserId, campaignId uuid.UUID,
+	data dto.CampaignData,
+	version *int,
+	action models.CampaignAction,
+) (models.Campaign, error) {
+	op := "CampaignsService.updateCampaign"
 
 	dayNow, err := cs.tr.GetDay(ctx)
 	if err != nil {
@@ -126,6 +231,14 @@ func (cs *CampaignsService) UpdateCampaign(ctx context.Context, advertiserId, ca
 		return models.Campaign{}, models.ErrCampaignNotFound
 	}
 
+	if version != nil && *version != campaignWas.Version {
+		return models.Campaign{}, models.ErrVersionMismatch
+	}
+
+	if campaignWas.Status == models.CampaignStatusArchived {
+		return models.Campaign{}, models.ErrCantUpdateCampaign
+	}
+
 	if campaignWas.StartDate <= dayNow {
 		if data.ImpressionsLimit != campaignWas.ImpressionsLimit ||
 			data.ClicksLimit != campaignWas.ClicksLimit ||
@@ -139,7 +252,25 @@ func (cs *CampaignsService) UpdateCampaign(ctx context.Context, advertiserId, ca
 		}
 	}
 
-	err = cs.cr.UpdateCampaign(ctx, campaignId, data)
+	if data.TargetingRules != nil {
+		if err := validateTargetingRule(*data.TargetingRules); err != nil {
+			return models.Campaign{}, err
+		}
+	}
+
+	// status is changed only by transitions
+	data.Status = campaignWas.Status
+
+	// title and text are moderated again only if they are changed
+	if data.AdTitle != campaignWas.AdTitle || data.AdText != campaignWas.AdText {
+		data = cs.moderateCampaign(ctx, data)
+	} else {
+		data.ModerationStatus = campaignWas.ModerationStatus
+		data.FlaggedPhrases = campaignWas.FlaggedPhrases
+	}
+
+	// campaign is updated only if it has not been changed since checks above
+	err = cs.cr.UpdateCampaign(ctx, campaignId, data, campaignWas.Version, dayNow, action)
 	if err != nil {
 		return models.Campaign{}, fmt.Errorf("%s: cr.UpdateCampaign: %w", op, err)
 	}
@@ -147,6 +278,8 @@ func (cs *CampaignsService) UpdateCampaign(ctx context.Context, advertiserId, ca
 	res := data.ToCampaign()
 	res.AdvertiserId = advertiserId
 	res.Id = campaignId
+	res.AdImageUrl = campaignWas.AdImageUrl
+	res.Version = campaignWas.Version + 1
 
 	return res, nil
 }
@@ -169,19 +302,54 @@ func (cs *CampaignsService) DeleteCampaign(ctx context.Context, advertiserId uui
 		return models.ErrCampaignNotFound
 	}
 
-	err = cs.cr.DeleteCampaign(ctx, campaignId)
+	dayNow, err := cs.tr.GetDay(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
+	err = cs.cr.DeleteCampaign(ctx, campaignId, dayNow)
 	if err != nil {
 		return fmt.Errorf("%s: cr.DeleteCampaign: %w", op, err)
 	}
 
+	// deleted campaign is not shown, so its image is not needed anymore.
+	// Campaign is already deleted, so failed cleanup only leaves unused image
+	if campaignWas.AdImageUrl != nil {
+		if err := cs.sr.DeleteStatic(ctx, getCampaignImageName(campaignId)); err != nil {
+			logger.FromCtx(ctx).Error("delete campaign image", zap.Stringer("campaign_id", campaignId), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// PurgeCampaign removes campaign with its impressions, clicks and image.
+// Unlike DeleteCampaign it also removes campaigns already deleted by advertiser
+func (cs *CampaignsService) PurgeCampaign(ctx context.Context, campaignId uuid.UUID) error {
+	op := "CampaignsService.PurgeCampaign"
+
+	err := cs.cr.PurgeCampaign(ctx, campaignId)
+	if err != nil {
+		return fmt.Errorf("%s: cr.PurgeCampaign: %w", op, err)
+	}
+
+	if err := cs.sr.DeleteStatic(ctx, getCampaignImageName(campaignId)); err != nil {
+		return fmt.Errorf("%s: sr.DeleteStatic: %w", op, err)
+	}
+
 	return nil
 }
 
 func (cs *CampaignsService) UploadCampaignImage(ctx context.Context, advertiserId, campaignId uuid.UUID, image models.Static) (*string, error) {
 	op := "CampaignsService.UploadCampaignImage"
 
+	dayNow, err := cs.tr.GetDay(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
 	// check advertiser existence
-	_, err := cs.ar.GetAdvertiserById(ctx, advertiserId)
+	_, err = cs.ar.GetAdvertiserById(ctx, advertiserId)
 	if err != nil {
 		return nil, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
 	}
@@ -204,7 +372,7 @@ func (cs *CampaignsService) UploadCampaignImage(ctx context.Context, advertiserI
 			return nil, fmt.Errorf("%s: sr.DeleteStatic: %w", op, err)
 		}
 
-		if err := cs.cr.SetCampaignAdImageUrl(ctx, campaignId, nil); err != nil {
+		if err := cs.cr.SetCampaignAdImageUrl(ctx, campaignId, nil, dayNow); err != nil {
 			return nil, fmt.Errorf("%s: cr.SetCampaignAdImageUrl: %w", op, err)
 		}
 
@@ -217,7 +385,7 @@ func (cs *CampaignsService) UploadCampaignImage(ctx context.Context, advertiserI
 
 	url := fmt.Sprintf("%s/%s", cs.staticBaseUrl, name)
 
-	if err := cs.cr.SetCampaignAdImageUrl(ctx, campaignId, &url); err != nil {
+	if err := cs.cr.SetCampaignAdImageUrl(ctx, campaignId, &url, dayNow); err != nil {
 		return nil, fmt.Errorf("%s: cr.SetCampaignAdImageUrl: %w", op, err)
 	}
 
@@ -225,6 +393,134 @@ func (cs *CampaignsService) UploadCampaignImage(ctx context.Context, advertiserI
 
 }
 
+// CloneCampaign creates campaign with targeting, creative and image of existing campaign.
+// Dates and limits can be overridden, clone is validated as a new campaign
+func (cs *CampaignsService) CloneCampaign(
+	ctx context.Context,
+	advertiserId, campaignId uuid.UUID,
+	overrides dto.CampaignOverrides,
+) (models.Campaign, error) {
+	op := "CampaignsService.CloneCampaign"
+
+	source, err := cs.GetCampaignById(ctx, advertiserId, campaignId)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	campaign, err := cs.createCampaignWithOverrides(ctx, advertiserId, dto.CampaignDataFromCampaign(source), overrides)
+	if err != nil {
+		return models.Campaign{}, err
+	}
+
+	if source.AdImageUrl == nil {
+		return campaign, nil
+	}
+
+	name := getCampaignImageName(campaign.Id)
+	if err := cs.sr.CopyStatic(ctx, getCampaignImageName(source.Id), name); err != nil {
+		cs.discardClone(ctx, campaign.Id, false)
+		return models.Campaign{}, fmt.Errorf("%s: sr.CopyStatic: %w", op, err)
+	}
+
+	url := fmt.Sprintf("%s/%s", cs.staticBaseUrl, name)
+
+	dayNow, err := cs.tr.GetDay(ctx)
+	if err != nil {
+		cs.discardClone(ctx, campaign.Id, true)
+		return models.Campaign{}, fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
+	if err := cs.cr.SetCampaignAdImageUrl(ctx, campaign.Id, &url, dayNow); err != nil {
+		cs.discardClone(ctx, campaign.Id, true)
+		return models.Campaign{}, fmt.Errorf("%s: cr.SetCampaignAdImageUrl: %w", op, err)
+	}
+
+	campaign.AdImageUrl = &url
+	campaign.Version++
+
+	return campaign, nil
+}
+
+// discardClone removes clone which failed to get image of source campaign,
+// so failed request does not leave campaign without image.
+// Cleanup errors are only logged, original error is returned to client
+func (cs *CampaignsService) discardClone(ctx context.Context, campaignId uuid.UUID, imageCopied bool) {
+	if err := cs.cr.PurgeCampaign(ctx, campaignId); err != nil {
+		logger.FromCtx(ctx).Error("purge failed clone", zap.Stringer("campaign_id", campaignId), zap.Error(err))
+	}
+
+	if !imageCopied {
+		return
+	}
+
+	if err := cs.sr.DeleteStatic(ctx, getCampaignImageName(campaignId)); err != nil {
+		logger.FromCtx(ctx).Error("delete failed clone image", zap.Stringer("campaign_id", campaignId), zap.Error(err))
+	}
+}
+
+// createCampaignWithOverrides creates campaign from parameters of another campaign or template
+func (cs *CampaignsService) createCampaignWithOverrides(
+	ctx context.Context,
+	advertiserId uuid.UUID,
+	data dto.CampaignData,
+	overrides dto.CampaignOverrides,
+) (models.Campaign, error) {
+	return cs.CreateCampaign(ctx, advertiserId, overrides.Apply(data))
+}
+
 func getCampaignImageName(campaignId uuid.UUID) string {
 	return fmt.Sprintf("campaign-%s-image", campaignId)
 }
+
+// campaignStatusTransitions are statuses advertiser can move campaign to from each status.
+// Active and paused campaigns are also completed automatically on day advance
+var campaignStatusTransitions = map[models.CampaignStatus][]models.CampaignStatus{
+	models.CampaignStatusDraft:     {models.CampaignStatusActive, models.CampaignStatusArchived},
+	models.CampaignStatusActive:    {models.CampaignStatusPaused, models.CampaignStatusArchived},
+	models.CampaignStatusPaused:    {models.CampaignStatusActive, models.CampaignStatusArchived},
+	models.CampaignStatusCompleted: {models.CampaignStatusArchived},
+}
+
+func (cs *CampaignsService) PauseCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID) (models.Campaign, error) {
+	return cs.transitCampaign(ctx, advertiserId, campaignId, models.CampaignStatusPaused)
+}
+
+func (cs *CampaignsService) ResumeCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID) (models.Campaign, error) {
+	return cs.transitCampaign(ctx, advertiserId, campaignId, models.CampaignStatusActive)
+}
+
+func (cs *CampaignsService) ArchiveCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID) (models.Campaign, error) {
+	return cs.transitCampaign(ctx, advertiserId, campaignId, models.CampaignStatusArchived)
+}
+
+func (cs *CampaignsService) transitCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID, to models.CampaignStatus) (models.Campaign, error) {
+	op := "CampaignsService.transitCampaign"
+
+	campaign, err := cs.GetCampaignById(ctx, advertiserId, campaignId)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !slices.Contains(campaignStatusTransitions[campaign.Status], to) {
+		return models.Campaign{}, fmt.Errorf("%w from %s to %s", models.ErrInvalidTransition, campaign.Status, to)
+	}
+
+	dayNow, err := cs.tr.GetDay(ctx)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
+	if to == models.CampaignStatusActive && campaign.EndDate < dayNow {
+		return models.Campaign{}, models.ErrCampaignEnded
+	}
+
+	err = cs.cr.UpdateCampaignStatus(ctx, campaignId, campaign.Status, to, dayNow)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: cr.UpdateCampaignStatus: %w", op, err)
+	}
+
+	campaign.Status = to
+	campaign.Version++
+
+	return campaign, nil
+}
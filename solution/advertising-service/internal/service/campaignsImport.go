@@ -0,0 +1,158 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// exportPageSize is a count of campaigns loaded at once during export
+const exportPageSize = 500
+
+// ImportCampaigns creates campaigns from import rows, every row is validated as campaign creation request.
+// Rows which can`t be validated or saved are reported with error and don`t prevent creation of other rows.
+// In atomic mode campaigns are created in one transaction and only if all rows are valid
+func (cs *CampaignsService) ImportCampaigns(
+	ctx context.Context,
+	advertiserId uuid.UUID,
+	rows []dto.CampaignImportRow,
+	atomic bool,
+) (dto.CampaignsImportReport, error) {
+	op := "CampaignsService.ImportCampaigns"
+
+	// check advertiser existence
+	_, err := cs.ar.GetAdvertiserById(ctx, advertiserId)
+	if err != nil {
+		return dto.CampaignsImportReport{}, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+	}
+
+	dayNow, err := cs.tr.GetDay(ctx)
+	if err != nil {
+		return dto.CampaignsImportReport{}, fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
+	report := dto.CampaignsImportReport{
+		Results: make([]dto.CampaignImportResult, len(rows)),
+	}
+
+	// indexes of valid rows
+	valid := make([]int, 0, len(rows))
+	for i, row := range rows {
+		report.Results[i].Row = row.Row
+
+		err := row.Err
+		if err == nil {
+			err = validateNewCampaign(dayNow, row.Data)
+		}
+		if err != nil {
+			report.Results[i].Err = err
+			report.Failed++
+			continue
+		}
+
+		valid = append(valid, i)
+	}
+
+	if atomic && (report.Failed > 0 || len(valid) == 0) {
+		return report, nil
+	}
+
+	// moderated data of valid rows
+	validData := make([]dto.CampaignData, 0, len(valid))
+	for _, i := range valid {
+		validData = append(validData, rows[i].Data)
+	}
+
+	moderated := make([]dto.CampaignData, len(rows))
+	for j, data := range cs.moderateCampaigns(ctx, validData) {
+		moderated[valid[j]] = data
+	}
+
+	if atomic {
+		data := make([]dto.CampaignData, 0, len(valid))
+		for _, i := range valid {
+			data = append(data, moderated[i])
+		}
+
+		// campaigns are created together with their history
+		ids, err := cs.cr.CreateCampaigns(ctx, advertiserId, data, dayNow)
+		if err != nil {
+			return dto.CampaignsImportReport{}, fmt.Errorf("%s: cr.CreateCampaigns: %w", op, err)
+		}
+
+		for j, i := range valid {
+			report.Results[i].CampaignId = &ids[j]
+		}
+		report.Created = len(ids)
+
+		return report, nil
+	}
+
+	// every campaign is created with its history in own transaction,
+	// so failed row doesn`t prevent creation of other rows
+	for _, i := range valid {
+		ids, err := cs.cr.CreateCampaigns(ctx, advertiserId, []dto.CampaignData{moderated[i]}, dayNow)
+		if err != nil {
+			if !errors.Is(err, models.ErrAdvertiserNotFound) {
+				logger.FromCtx(ctx).Error("import campaign", zap.Int("row", rows[i].Row), zap.Error(err))
+				err = models.ErrCampaignNotCreated
+			}
+			report.Results[i].Err = err
+			report.Failed++
+			continue
+		}
+
+		report.Results[i].CampaignId = &ids[0]
+		report.Created++
+	}
+
+	return report, nil
+}
+
+// ExportCampaigns returns all campaigns of advertiser in creation order.
+// Campaigns are loaded by pages while the sequence is iterated
+func (cs *CampaignsService) ExportCampaigns(ctx context.Context, advertiserId uuid.UUID) (iter.Seq2[models.Campaign, error], error) {
+	op := "CampaignsService.ExportCampaigns"
+
+	// check advertiser existence
+	_, err := cs.ar.GetAdvertiserById(ctx, advertiserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: ar.GetAdvertiserById: %w", op, err)
+	}
+
+	return func(yield func(models.Campaign, error) bool) {
+		params := dto.CampaignsListParams{
+			PaginationParams: dto.PaginationParams{
+				Size: exportPageSize,
+				Page: 1,
+			},
+			Sort: dto.CampaignsSortCreatedAt,
+		}
+
+		for {
+			campaigns, next, err := cs.cr.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+			if err != nil {
+				yield(models.Campaign{}, fmt.Errorf("%s: cr.ListCampaignsForAdvertiser: %w", op, err))
+				return
+			}
+
+			for _, campaign := range campaigns {
+				if !yield(campaign, nil) {
+					return
+				}
+			}
+
+			if next == nil {
+				return
+			}
+			params.Cursor = next
+		}
+	}, nil
+}
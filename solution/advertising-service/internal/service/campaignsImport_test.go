@@ -0,0 +1,229 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/advertising-service/internal/repo/mocks"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportCampaigns(t *testing.T) {
+	campaignDataSample := dto.CampaignData{
+		ImpressionsLimit:  1000,
+		ClicksLimit:       100,
+		CostPerImpression: 100,
+		CostPerClick:      100,
+		AdTitle:           "ad title",
+		AdText:            "ad text",
+		StartDate:         5,
+		EndDate:           10,
+		Pacing:            models.PacingEven,
+		Status:            models.CampaignStatusActive,
+		ModerationStatus:  models.ModerationStatusApproved,
+		FlaggedPhrases:    pq.StringArray{},
+	}
+
+	invalidData := campaignDataSample
+	invalidData.ClicksLimit = 10000
+
+	rows := []dto.CampaignImportRow{
+		{Row: 1, Data: campaignDataSample},
+		{Row: 2, Data: invalidData},
+		{Row: 3, Err: models.ErrInvalidCampaign},
+	}
+
+	t.Run("import campaigns with invalid rows", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaigns", ctx, advertiserId, []dto.CampaignData{campaignDataSample}, 0).Return([]uuid.UUID{campaignId}, nil).Once()
+
+		// check
+		report, err := service.ImportCampaigns(ctx, advertiserId, rows, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Created)
+		require.Equal(t, 2, report.Failed)
+		require.Len(t, report.Results, 3)
+		require.Equal(t, &campaignId, report.Results[0].CampaignId)
+		require.ErrorIs(t, report.Results[1].Err, models.ErrInvalidCampaign)
+		require.ErrorIs(t, report.Results[2].Err, models.ErrInvalidCampaign)
+	})
+
+	t.Run("import campaigns with repo errors", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		failedData := campaignDataSample
+		failedData.AdTitle = "failed"
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaigns", ctx, advertiserId, []dto.CampaignData{failedData}, 0).Return(nil, errors.New("failed to create campaign")).Once()
+		campaignsRepoMock.On("CreateCampaigns", ctx, advertiserId, []dto.CampaignData{campaignDataSample}, 0).Return([]uuid.UUID{campaignId}, nil).Once()
+
+		// check failed row doesn`t prevent creation of the next one
+		report, err := service.ImportCampaigns(ctx, advertiserId, []dto.CampaignImportRow{
+			{Row: 1, Data: failedData},
+			{Row: 2, Data: campaignDataSample},
+		}, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, report.Created)
+		require.Equal(t, 1, report.Failed)
+		require.ErrorIs(t, report.Results[0].Err, models.ErrCampaignNotCreated)
+		require.Nil(t, report.Results[0].CampaignId)
+		require.Equal(t, &campaignId, report.Results[1].CampaignId)
+	})
+
+	t.Run("atomic import campaigns with invalid rows", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		// check
+		report, err := service.ImportCampaigns(ctx, advertiserId, rows, true)
+		require.NoError(t, err)
+		require.Equal(t, 0, report.Created)
+		require.Equal(t, 2, report.Failed)
+		require.Nil(t, report.Results[0].CampaignId)
+	})
+
+	t.Run("atomic import campaigns", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaigns", ctx, advertiserId, []dto.CampaignData{campaignDataSample, campaignDataSample}, 0).Return(ids, nil).Once()
+
+		// check
+		report, err := service.ImportCampaigns(ctx, advertiserId, []dto.CampaignImportRow{
+			{Row: 1, Data: campaignDataSample},
+			{Row: 2, Data: campaignDataSample},
+		}, true)
+		require.NoError(t, err)
+		require.Equal(t, 2, report.Created)
+		require.Equal(t, 0, report.Failed)
+		require.Equal(t, &ids[0], report.Results[0].CampaignId)
+		require.Equal(t, &ids[1], report.Results[1].CampaignId)
+	})
+
+	t.Run("import campaigns for nonexistent advertiser", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{}, models.ErrAdvertiserNotFound).Once()
+
+		// check
+		_, err := service.ImportCampaigns(ctx, advertiserId, rows, false)
+		require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+	})
+}
+
+func TestExportCampaigns(t *testing.T) {
+	t.Run("export campaigns by pages", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		firstPage := []models.Campaign{{Id: uuid.New()}, {Id: uuid.New()}}
+		secondPage := []models.Campaign{{Id: uuid.New()}}
+		cursor := &dto.CampaignsCursor{Sort: dto.CampaignsSortCreatedAt, Id: firstPage[1].Id}
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("ListCampaignsForAdvertiser", ctx, advertiserId, mock.MatchedBy(func(params dto.CampaignsListParams) bool {
+			return params.Cursor == nil
+		})).Return(firstPage, cursor, nil).Once()
+		campaignsRepoMock.On("ListCampaignsForAdvertiser", ctx, advertiserId, mock.MatchedBy(func(params dto.CampaignsListParams) bool {
+			return params.Cursor == cursor
+		})).Return(secondPage, (*dto.CampaignsCursor)(nil), nil).Once()
+
+		// check
+		campaigns, err := service.ExportCampaigns(ctx, advertiserId)
+		require.NoError(t, err)
+
+		var actual []models.Campaign
+		for campaign, err := range campaigns {
+			require.NoError(t, err)
+			actual = append(actual, campaign)
+		}
+		require.Equal(t, append(firstPage, secondPage...), actual)
+	})
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// moderationConcurrency limits ads moderated at once by campaigns import
+const moderationConcurrency = 8
+
+// moderateCampaign sets moderation verdict of campaign title and text.
+// If moderator fails, campaign is held for admin review instead of failing the request
+func (cs *CampaignsService) moderateCampaign(ctx context.Context, data dto.CampaignData) dto.CampaignData {
+	ok, flaggedPhrases, err := cs.moderator.ModerateAd(ctx, data.AdTitle, data.AdText)
+	flaggedPhrases = slices.DeleteFunc(flaggedPhrases, func(phrase string) bool {
+		return phrase == ""
+	})
+
+	switch {
+	case err != nil:
+		logger.FromCtx(ctx).Warn("moderate campaign", zap.Error(err))
+		data.ModerationStatus = models.ModerationStatusFailed
+		data.FlaggedPhrases = pq.StringArray{}
+	case ok:
+		data.ModerationStatus = models.ModerationStatusApproved
+		data.FlaggedPhrases = pq.StringArray{}
+	case len(flaggedPhrases) == 0:
+		// moderator doubts the ad, but does not say why
+		data.ModerationStatus = models.ModerationStatusPendingReview
+		data.FlaggedPhrases = pq.StringArray{}
+	default:
+		data.ModerationStatus = models.ModerationStatusRejected
+		data.FlaggedPhrases = flaggedPhrases
+	}
+
+	return data
+}
+
+// moderateCampaigns moderates campaigns in parallel, at most moderationConcurrency at once
+func (cs *CampaignsService) moderateCampaigns(ctx context.Context, data []dto.CampaignData) []dto.CampaignData {
+	moderated := make([]dto.CampaignData, len(data))
+
+	sem := make(chan struct{}, moderationConcurrency)
+	var wg sync.WaitGroup
+	for i := range data {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			moderated[i] = cs.moderateCampaign(ctx, data[i])
+		}()
+	}
+	wg.Wait()
+
+	return moderated
+}
+
+// OverrideCampaignModeration sets moderation verdict of campaign by admin,
+// flagged phrases are kept to show what moderation has found
+func (cs *CampaignsService) OverrideCampaignModeration(
+	ctx context.Context,
+	campaignId uuid.UUID,
+	status models.ModerationStatus,
+) (models.Campaign, error) {
+	op := "CampaignsService.OverrideCampaignModeration"
+
+	if status != models.ModerationStatusApproved && status != models.ModerationStatusRejected {
+		return models.Campaign{}, fmt.Errorf("%w: %s", models.ErrInvalidModeration, status)
+	}
+
+	dayNow, err := cs.tr.GetDay(ctx)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: tr.GetDay: %w", op, err)
+	}
+
+	campaignWas, err := cs.cr.GetCampaignById(ctx, campaignId)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: cr.GetCampaignById: %w", op, err)
+	}
+
+	data := dto.CampaignDataFromCampaign(campaignWas)
+	data.ModerationStatus = status
+
+	// campaign is updated only if it has not been changed since it is got
+	err = cs.cr.UpdateCampaign(ctx, campaignId, data, campaignWas.Version, dayNow, models.CampaignActionModeration)
+	if err != nil {
+		return models.Campaign{}, fmt.Errorf("%s: cr.UpdateCampaign: %w", op, err)
+	}
+
+	campaign := campaignWas
+	campaign.ModerationStatus = status
+	campaign.Version++
+
+	return campaign, nil
+}
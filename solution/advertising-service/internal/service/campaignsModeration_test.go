@@ -0,0 +1,349 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/advertising-service/internal/repo/mocks"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// moderatorStub returns the same verdict for any ad and counts calls
+type moderatorStub struct {
+	ok             bool
+	flaggedPhrases []string
+	err            error
+	calls          int
+}
+
+func (ms *moderatorStub) ModerateAd(ctx context.Context, adTitle, adText string) (bool, []string, error) {
+	ms.calls++
+	return ms.ok, ms.flaggedPhrases, ms.err
+}
+
+// titleModeratorStub rejects ads with titles from the list and tracks moderations running at once
+type titleModeratorStub struct {
+	rejected []string
+
+	mu         sync.Mutex
+	running    int
+	maxRunning int
+}
+
+func (ms *titleModeratorStub) ModerateAd(ctx context.Context, adTitle, adText string) (bool, []string, error) {
+	ms.mu.Lock()
+	ms.running++
+	ms.maxRunning = max(ms.maxRunning, ms.running)
+	ms.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	ms.mu.Lock()
+	ms.running--
+	ms.mu.Unlock()
+
+	if slices.Contains(ms.rejected, adTitle) {
+		return false, []string{adTitle}, nil
+	}
+	return true, []string{}, nil
+}
+
+func TestCampaignsModeration(t *testing.T) {
+	campaignDataSample := dto.CampaignData{
+		ImpressionsLimit:  1000,
+		ClicksLimit:       100,
+		CostPerImpression: 100,
+		CostPerClick:      100,
+		AdTitle:           "ad title",
+		AdText:            "ad text",
+		StartDate:         5,
+		EndDate:           10,
+	}
+
+	t.Run("create rejected campaign", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+		moderator := &moderatorStub{flaggedPhrases: []string{"ad title"}}
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, moderator, "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		expectedData := campaignDataSample
+		expectedData.ModerationStatus = models.ModerationStatusRejected
+		expectedData.FlaggedPhrases = pq.StringArray{"ad title"}
+
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 0).Return(campaignId, nil).Once()
+
+		// check
+		campaign, err := service.CreateCampaign(ctx, advertiserId, campaignDataSample)
+		require.NoError(t, err)
+		require.Equal(t, models.ModerationStatusRejected, campaign.ModerationStatus)
+		require.Equal(t, pq.StringArray{"ad title"}, campaign.FlaggedPhrases)
+	})
+
+	t.Run("create campaign moderator error", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+		moderator := &moderatorStub{err: errors.New("moderation failed")}
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, moderator, "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		expectedData := campaignDataSample
+		expectedData.ModerationStatus = models.ModerationStatusFailed
+		expectedData.FlaggedPhrases = pq.StringArray{}
+
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 0).Return(campaignId, nil).Once()
+
+		// check
+		campaign, err := service.CreateCampaign(ctx, advertiserId, campaignDataSample)
+		require.NoError(t, err)
+		require.Equal(t, models.ModerationStatusFailed, campaign.ModerationStatus)
+	})
+
+	t.Run("create campaign held for review", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+		moderator := &moderatorStub{flaggedPhrases: []string{""}}
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, moderator, "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		expectedData := campaignDataSample
+		expectedData.ModerationStatus = models.ModerationStatusPendingReview
+		expectedData.FlaggedPhrases = pq.StringArray{}
+
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, expectedData, 0).Return(campaignId, nil).Once()
+
+		// check
+		campaign, err := service.CreateCampaign(ctx, advertiserId, campaignDataSample)
+		require.NoError(t, err)
+		require.Equal(t, models.ModerationStatusPendingReview, campaign.ModerationStatus)
+	})
+
+	t.Run("import campaigns moderates rows in parallel", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+		moderator := &titleModeratorStub{rejected: []string{"title 3", "title 17"}}
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, moderator, "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+
+		rows := make([]dto.CampaignImportRow, 0, 20)
+		expectedData := make([]dto.CampaignData, 0, 20)
+		ids := make([]uuid.UUID, 0, 20)
+		for i := range 20 {
+			data := campaignDataSample
+			data.AdTitle = fmt.Sprintf("title %d", i)
+			rows = append(rows, dto.CampaignImportRow{Row: i + 1, Data: data})
+
+			data.ModerationStatus = models.ModerationStatusApproved
+			data.FlaggedPhrases = pq.StringArray{}
+			if slices.Contains(moderator.rejected, data.AdTitle) {
+				data.ModerationStatus = models.ModerationStatusRejected
+				data.FlaggedPhrases = pq.StringArray{data.AdTitle}
+			}
+			expectedData = append(expectedData, data)
+			ids = append(ids, uuid.New())
+		}
+
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("CreateCampaigns", ctx, advertiserId, expectedData, 0).Return(ids, nil).Once()
+
+		// check
+		report, err := service.ImportCampaigns(ctx, advertiserId, rows, true)
+		require.NoError(t, err)
+		require.Equal(t, 20, report.Created)
+		require.LessOrEqual(t, moderator.maxRunning, moderationConcurrency)
+	})
+
+	t.Run("update campaign without text change keeps verdict", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+		moderator := &moderatorStub{ok: true}
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, moderator, "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.ModerationStatus = models.ModerationStatusRejected
+		campaignWas.FlaggedPhrases = pq.StringArray{"ad text"}
+		campaignWas.Version = 2
+
+		updatedData := campaignDataSample
+		updatedData.CostPerClick = 200
+
+		expectedData := updatedData
+		expectedData.ModerationStatus = models.ModerationStatusRejected
+		expectedData.FlaggedPhrases = pq.StringArray{"ad text"}
+
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, expectedData, campaignWas.Version, 0, models.CampaignActionUpdate).Return(nil).Once()
+
+		// check
+		campaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData, nil)
+		require.NoError(t, err)
+		require.Equal(t, models.ModerationStatusRejected, campaign.ModerationStatus)
+		require.Equal(t, 0, moderator.calls)
+	})
+
+	t.Run("update campaign text is moderated again", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+		moderator := &moderatorStub{ok: true}
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, moderator, "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		campaignId := uuid.New()
+
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.ModerationStatus = models.ModerationStatusRejected
+		campaignWas.FlaggedPhrases = pq.StringArray{"ad text"}
+		campaignWas.Version = 2
+
+		updatedData := campaignDataSample
+		updatedData.AdText = "new text"
+
+		expectedData := updatedData
+		expectedData.ModerationStatus = models.ModerationStatusApproved
+		expectedData.FlaggedPhrases = pq.StringArray{}
+
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, expectedData, campaignWas.Version, 0, models.CampaignActionUpdate).Return(nil).Once()
+
+		// check
+		campaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData, nil)
+		require.NoError(t, err)
+		require.Equal(t, models.ModerationStatusApproved, campaign.ModerationStatus)
+		require.Equal(t, 1, moderator.calls)
+	})
+
+	t.Run("override campaign moderation", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		campaignId := uuid.New()
+
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = uuid.New()
+		campaignWas.ModerationStatus = models.ModerationStatusRejected
+		campaignWas.FlaggedPhrases = pq.StringArray{"ad text"}
+		campaignWas.Version = 2
+
+		expectedData := dto.CampaignDataFromCampaign(campaignWas)
+		expectedData.ModerationStatus = models.ModerationStatusApproved
+
+		expectedCampaign := campaignWas
+		expectedCampaign.ModerationStatus = models.ModerationStatusApproved
+		expectedCampaign.Version = 3
+
+		timeRepoMock.On("GetDay", ctx).Return(4, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, expectedData, campaignWas.Version, 4, models.CampaignActionModeration).Return(nil).Once()
+
+		// check
+		campaign, err := service.OverrideCampaignModeration(ctx, campaignId, models.ModerationStatusApproved)
+		require.NoError(t, err)
+		require.Equal(t, expectedCampaign, campaign)
+	})
+
+	t.Run("override campaign moderation to pending review", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// check
+		_, err := service.OverrideCampaignModeration(ctx, uuid.New(), models.ModerationStatusPendingReview)
+		require.ErrorIs(t, err, models.ErrInvalidModeration)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +24,8 @@ func TestCampaignsService(t *testing.T) {
 		AdText:            "ad text",
 		StartDate:         5,
 		EndDate:           10,
+		ModerationStatus:  models.ModerationStatusApproved,
+		FlaggedPhrases:    pq.StringArray{},
 	}
 
 	t.Run("create campaign success", func(t *testing.T) {
@@ -32,8 +35,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
@@ -45,8 +50,9 @@ func TestCampaignsService(t *testing.T) {
 		expectedCampaign := campaignData.ToCampaign()
 		expectedCampaign.Id = campaignId
 		expectedCampaign.AdvertiserId = advertiserId
+		expectedCampaign.Version = 1
 
-		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, campaignData).Return(campaignId, nil).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, campaignData, 0).Return(campaignId, nil).Once()
 
 		// check
 		actualCampaign, err := service.CreateCampaign(ctx, advertiserId, campaignData)
@@ -61,8 +67,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		expectedError := errors.New("failed to get time")
@@ -81,8 +89,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(5, nil).Once()
@@ -98,6 +108,34 @@ func TestCampaignsService(t *testing.T) {
 
 	})
 
+	t.Run("create campaign invalid targeting rules", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		campaignData := campaignDataSample
+		campaignData.TargetingRules = &models.TargetingRule{
+			Attribute: "tier",
+			Op:        models.TargetingRuleOpGt,
+			Operand:   "gold",
+		}
+
+		// check
+		actualCampaign, err := service.CreateCampaign(ctx, uuid.New(), campaignData)
+		require.ErrorIs(t, err, models.ErrInvalidTargeting)
+		require.Equal(t, models.Campaign{}, actualCampaign)
+	})
+
 	t.Run("create campaign campaign repo error", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -105,8 +143,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil)
@@ -116,7 +156,7 @@ func TestCampaignsService(t *testing.T) {
 		expectedError := errors.New("failed to create campaign")
 		expectedCampaign := models.Campaign{}
 
-		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, campaignData).Return(uuid.New(), expectedError).Once()
+		campaignsRepoMock.On("CreateCampaign", ctx, advertiserId, campaignData, 0).Return(uuid.New(), expectedError).Once()
 
 		// check
 		actualCampaign, err := service.CreateCampaign(ctx, advertiserId, campaignData)
@@ -131,8 +171,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -161,8 +203,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -184,8 +228,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -211,8 +257,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -241,8 +289,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -260,17 +310,63 @@ func TestCampaignsService(t *testing.T) {
 			campaign.AdvertiserId = advertiserId
 			expectedcampaigns = append(expectedcampaigns, campaign)
 		}
-		paginationParams := dto.PaginationParams{
-			Size: 5,
-			Page: 2,
+		status := models.CampaignStatusActive
+		params := dto.CampaignsListParams{
+			PaginationParams: dto.PaginationParams{
+				Size: 5,
+				Page: 2,
+			},
+			Filter: dto.CampaignsFilter{
+				Status: &status,
+			},
+			Sort: dto.CampaignsSortCreatedAt,
+			Desc: true,
+		}
+		next := &dto.CampaignsCursor{
+			Sort:      dto.CampaignsSortCreatedAt,
+			Desc:      true,
+			SortValue: "2025-01-01 00:00:00",
+			Id:        expectedcampaigns[4].Id,
 		}
 
-		campaignsRepoMock.On("ListCampaignsForAdvertiser", ctx, advertiserId, paginationParams).Return(expectedcampaigns, nil).Once()
+		campaignsRepoMock.On("ListCampaignsForAdvertiser", ctx, advertiserId, params).Return(expectedcampaigns, next, nil).Once()
+		campaignsRepoMock.On("CountCampaignsForAdvertiser", ctx, advertiserId, params.Filter).Return(12, nil).Once()
 
 		// check
-		actualCampaigns, err := service.ListCampaignsForAdvertiser(ctx, advertiserId, paginationParams)
+		actualPage, err := service.ListCampaignsForAdvertiser(ctx, advertiserId, params)
 		require.NoError(t, err)
-		require.Equal(t, expectedcampaigns, actualCampaigns)
+		require.Equal(t, dto.CampaignsPage{
+			Campaigns: expectedcampaigns,
+			Total:     12,
+			Next:      next,
+		}, actualPage)
+	})
+
+	t.Run("list campaigns with cursor of another sort", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		params := dto.CampaignsListParams{
+			PaginationParams: dto.PaginationParams{Size: 5, Page: 1},
+			Sort:             dto.CampaignsSortStartDate,
+			Cursor: &dto.CampaignsCursor{
+				Sort:      dto.CampaignsSortCostPerClick,
+				SortValue: "10",
+				Id:        uuid.New(),
+			},
+		}
+
+		// check
+		_, err := service.ListCampaignsForAdvertiser(ctx, uuid.New(), params)
+		require.ErrorIs(t, err, models.ErrInvalidCursor)
 	})
 
 	t.Run("list campaigns advertisers repo error", func(t *testing.T) {
@@ -280,8 +376,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -289,9 +387,9 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{}, expectedError).Once()
 
 		// check
-		actualCampagins, err := service.ListCampaignsForAdvertiser(ctx, advertiserId, dto.PaginationParams{})
+		actualPage, err := service.ListCampaignsForAdvertiser(ctx, advertiserId, dto.CampaignsListParams{})
 		require.ErrorIs(t, err, expectedError)
-		require.Nil(t, actualCampagins)
+		require.Equal(t, dto.CampaignsPage{}, actualPage)
 	})
 
 	t.Run("list advertisers campaigns repo error", func(t *testing.T) {
@@ -301,8 +399,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -311,17 +411,20 @@ func TestCampaignsService(t *testing.T) {
 			Name: "name",
 		}, nil).Once()
 
-		params := dto.PaginationParams{
-			Size: 4,
-			Page: 2,
+		params := dto.CampaignsListParams{
+			PaginationParams: dto.PaginationParams{
+				Size: 4,
+				Page: 2,
+			},
+			Sort: dto.CampaignsSortCreatedAt,
 		}
 		expectedError := errors.New("failed to list campaigns")
-		campaignsRepoMock.On("ListCampaignsForAdvertiser", ctx, advertiserId, params).Return(nil, expectedError).Once()
+		campaignsRepoMock.On("ListCampaignsForAdvertiser", ctx, advertiserId, params).Return(nil, nil, expectedError).Once()
 
 		// check
-		actualCampaigns, err := service.ListCampaignsForAdvertiser(ctx, advertiserId, params)
+		actualPage, err := service.ListCampaignsForAdvertiser(ctx, advertiserId, params)
 		require.ErrorIs(t, err, expectedError)
-		require.Nil(t, actualCampaigns)
+		require.Equal(t, dto.CampaignsPage{}, actualPage)
 	})
 
 	t.Run("update campaign success", func(t *testing.T) {
@@ -331,8 +434,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
@@ -349,6 +454,7 @@ func TestCampaignsService(t *testing.T) {
 		campaignWas.EndDate = 200
 		campaignWas.Id = campaignId
 		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Version = 3
 
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
 
@@ -361,15 +467,18 @@ func TestCampaignsService(t *testing.T) {
 			AdText:            "new text",
 			StartDate:         20,
 			EndDate:           50,
+			ModerationStatus:  models.ModerationStatusApproved,
+			FlaggedPhrases:    pq.StringArray{},
 		}
 		expectedCampaign := updatedData.ToCampaign()
 		expectedCampaign.Id = campaignId
 		expectedCampaign.AdvertiserId = advertiserId
+		expectedCampaign.Version = campaignWas.Version + 1
 
-		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, updatedData).Return(nil).Once()
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, updatedData, campaignWas.Version, 0, models.CampaignActionUpdate).Return(nil).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData)
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedCampaign, actualCampaign)
 	})
@@ -381,15 +490,17 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		expectedError := errors.New("falied to get time")
 		timeRepoMock.On("GetDay", ctx).Return(0, expectedError).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, uuid.New(), uuid.New(), dto.CampaignData{})
+		actualCampaign, err := service.UpdateCampaign(ctx, uuid.New(), uuid.New(), dto.CampaignData{}, nil)
 		require.ErrorIs(t, err, expectedError)
 		require.Equal(t, models.Campaign{}, actualCampaign)
 	})
@@ -401,8 +512,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
@@ -412,7 +525,7 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{}, expectedError).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, uuid.New(), dto.CampaignData{})
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, uuid.New(), dto.CampaignData{}, nil)
 		require.ErrorIs(t, err, expectedError)
 		require.Equal(t, models.Campaign{}, actualCampaign)
 	})
@@ -424,8 +537,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
@@ -442,7 +557,7 @@ func TestCampaignsService(t *testing.T) {
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(models.Campaign{}, expectedError).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, dto.CampaignData{})
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, dto.CampaignData{}, nil)
 		require.ErrorIs(t, err, expectedError)
 		require.Equal(t, models.Campaign{}, actualCampaign)
 	})
@@ -454,8 +569,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
@@ -484,13 +601,15 @@ func TestCampaignsService(t *testing.T) {
 			AdText:            "new text",
 			StartDate:         20,
 			EndDate:           50,
+			ModerationStatus:  models.ModerationStatusApproved,
+			FlaggedPhrases:    pq.StringArray{},
 		}
 		expectedError := errors.New("failed to update campaign")
 
-		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, updatedData).Return(expectedError).Once()
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, updatedData, campaignWas.Version, 0, models.CampaignActionUpdate).Return(expectedError).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData)
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData, nil)
 		require.ErrorIs(t, err, expectedError)
 		require.Equal(t, models.Campaign{}, actualCampaign)
 	})
@@ -502,8 +621,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
@@ -524,7 +645,7 @@ func TestCampaignsService(t *testing.T) {
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, dto.CampaignData{})
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, dto.CampaignData{}, nil)
 		require.ErrorIs(t, err, models.ErrCampaignNotFound)
 		require.Equal(t, models.Campaign{}, actualCampaign)
 	})
@@ -536,8 +657,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(5, nil).Once()
@@ -566,15 +689,18 @@ func TestCampaignsService(t *testing.T) {
 			AdText:            "new text",
 			StartDate:         campaignWas.StartDate,
 			EndDate:           campaignWas.EndDate,
+			ModerationStatus:  models.ModerationStatusApproved,
+			FlaggedPhrases:    pq.StringArray{},
 		}
 		expectedCampaign := updatedData.ToCampaign()
 		expectedCampaign.Id = campaignId
 		expectedCampaign.AdvertiserId = advertiserId
+		expectedCampaign.Version = campaignWas.Version + 1
 
-		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, updatedData).Return(nil).Once()
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, updatedData, campaignWas.Version, 5, models.CampaignActionUpdate).Return(nil).Once()
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData)
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedCampaign, actualCampaign)
 	})
@@ -586,8 +712,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(5, nil)
@@ -652,7 +780,7 @@ func TestCampaignsService(t *testing.T) {
 
 		// check
 		for _, c := range cases {
-			actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, c)
+			actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, c, nil)
 			require.ErrorIs(t, err, models.ErrCantUpdateCampaign)
 			require.Equal(t, models.Campaign{}, actualCampaign)
 		}
@@ -665,8 +793,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		timeRepoMock.On("GetDay", ctx).Return(5, nil).Once()
@@ -698,7 +828,7 @@ func TestCampaignsService(t *testing.T) {
 		}
 
 		// check
-		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData)
+		actualCampaign, err := service.UpdateCampaign(ctx, advertiserId, campaignId, updatedData, nil)
 		require.ErrorIs(t, err, models.ErrInvalidStartDate)
 		require.Equal(t, models.Campaign{}, actualCampaign)
 	})
@@ -710,8 +840,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -726,13 +858,130 @@ func TestCampaignsService(t *testing.T) {
 		campaignWas.AdvertiserId = advertiserId
 
 		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil)
-		campaignsRepoMock.On("DeleteCampaign", ctx, campaignId).Return(nil)
+
+		timeRepoMock.On("GetDay", ctx).Return(3, nil).Once()
+		campaignsRepoMock.On("DeleteCampaign", ctx, campaignId, 3).Return(nil)
 
 		// check
 		err := service.DeleteCampaign(ctx, advertiserId, campaignId)
 		require.NoError(t, err)
 	})
 
+	t.Run("delete campaign with image", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{
+			Id:   advertiserId,
+			Name: "name",
+		}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		imageUrl := "http://localhost:8080/static/" + getCampaignImageName(campaignId)
+		campaignWas.AdImageUrl = &imageUrl
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("DeleteCampaign", ctx, campaignId, 0).Return(nil).Once()
+		staticRepoMock.On("DeleteStatic", ctx, getCampaignImageName(campaignId)).Return(nil).Once()
+
+		// check
+		err := service.DeleteCampaign(ctx, advertiserId, campaignId)
+		require.NoError(t, err)
+	})
+
+	t.Run("delete campaign static repo error", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{
+			Id:   advertiserId,
+			Name: "name",
+		}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		imageUrl := "http://localhost:8080/static/" + getCampaignImageName(campaignId)
+		campaignWas.AdImageUrl = &imageUrl
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("DeleteCampaign", ctx, campaignId, 0).Return(nil).Once()
+		staticRepoMock.On("DeleteStatic", ctx, getCampaignImageName(campaignId)).Return(errors.New("failed to delete static")).Once()
+
+		// check campaign is deleted even if its image is not
+		err := service.DeleteCampaign(ctx, advertiserId, campaignId)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge campaign success", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		campaignId := uuid.New()
+		campaignsRepoMock.On("PurgeCampaign", ctx, campaignId).Return(nil).Once()
+		staticRepoMock.On("DeleteStatic", ctx, getCampaignImageName(campaignId)).Return(nil).Once()
+
+		// check
+		err := service.PurgeCampaign(ctx, campaignId)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge non-existent campaign", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		campaignId := uuid.New()
+		campaignsRepoMock.On("PurgeCampaign", ctx, campaignId).Return(models.ErrCampaignNotFound).Once()
+
+		// check
+		err := service.PurgeCampaign(ctx, campaignId)
+		require.ErrorIs(t, err, models.ErrCampaignNotFound)
+	})
+
 	t.Run("delete campaign advertisers repo error", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -740,8 +989,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -760,8 +1011,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -787,8 +1040,10 @@ func TestCampaignsService(t *testing.T) {
 		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
 		timeRepoMock := mocks.NewTimeRepo(t)
 		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
 
-		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, "http://localhost:8080/static")
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
 
 		// setup mocks
 		advertiserId := uuid.New()
@@ -808,4 +1063,397 @@ func TestCampaignsService(t *testing.T) {
 		err := service.DeleteCampaign(ctx, advertiserId, campaignId)
 		require.ErrorIs(t, err, models.ErrCampaignNotFound)
 	})
+
+	t.Run("pause campaign success", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{
+			Id:   advertiserId,
+			Name: "name",
+		}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Status = models.CampaignStatusActive
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		campaignsRepoMock.On("UpdateCampaignStatus", ctx, campaignId, models.CampaignStatusActive, models.CampaignStatusPaused, 0).Return(nil).Once()
+
+		expectedCampaign := campaignWas
+		expectedCampaign.Status = models.CampaignStatusPaused
+		expectedCampaign.Version = campaignWas.Version + 1
+
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		// check
+		actualCampaign, err := service.PauseCampaign(ctx, advertiserId, campaignId)
+		require.NoError(t, err)
+		require.Equal(t, expectedCampaign, actualCampaign)
+	})
+
+	t.Run("campaign status transitions", func(t *testing.T) {
+		ctx := context.Background()
+
+		tests := []struct {
+			from    models.CampaignStatus
+			to      models.CampaignStatus
+			allowed bool
+		}{
+			{models.CampaignStatusDraft, models.CampaignStatusActive, true},
+			{models.CampaignStatusDraft, models.CampaignStatusPaused, false},
+			{models.CampaignStatusActive, models.CampaignStatusActive, false},
+			{models.CampaignStatusPaused, models.CampaignStatusActive, true},
+			{models.CampaignStatusPaused, models.CampaignStatusPaused, false},
+			{models.CampaignStatusCompleted, models.CampaignStatusActive, false},
+			{models.CampaignStatusCompleted, models.CampaignStatusArchived, true},
+			{models.CampaignStatusArchived, models.CampaignStatusActive, false},
+			{models.CampaignStatusArchived, models.CampaignStatusArchived, false},
+		}
+
+		for _, tt := range tests {
+			campaignsRepoMock := mocks.NewCampaignsRepo(t)
+			advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+			timeRepoMock := mocks.NewTimeRepo(t)
+			staticRepoMock := mocks.NewStaticRepo(t)
+			historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+			templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+			service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+			advertiserId := uuid.New()
+			advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+			campaignId := uuid.New()
+			campaignWas := campaignDataSample.ToCampaign()
+			campaignWas.Id = campaignId
+			campaignWas.AdvertiserId = advertiserId
+			campaignWas.Status = tt.from
+
+			campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+			if tt.allowed {
+				campaignsRepoMock.On("UpdateCampaignStatus", ctx, campaignId, tt.from, tt.to, 0).Return(nil).Once()
+				timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+			}
+
+			var err error
+			switch tt.to {
+			case models.CampaignStatusActive:
+				_, err = service.ResumeCampaign(ctx, advertiserId, campaignId)
+			case models.CampaignStatusPaused:
+				_, err = service.PauseCampaign(ctx, advertiserId, campaignId)
+			case models.CampaignStatusArchived:
+				_, err = service.ArchiveCampaign(ctx, advertiserId, campaignId)
+			}
+
+			if tt.allowed {
+				require.NoError(t, err, "%s -> %s", tt.from, tt.to)
+			} else {
+				require.ErrorIs(t, err, models.ErrInvalidTransition, "%s -> %s", tt.from, tt.to)
+			}
+		}
+	})
+
+	t.Run("pause campaign concurrent transition", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Status = models.CampaignStatusActive
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+		campaignsRepoMock.On("UpdateCampaignStatus", ctx, campaignId, models.CampaignStatusActive, models.CampaignStatusPaused, 0).Return(models.ErrInvalidTransition).Once()
+
+		// check
+		actualCampaign, err := service.PauseCampaign(ctx, advertiserId, campaignId)
+		require.ErrorIs(t, err, models.ErrInvalidTransition)
+		require.Equal(t, models.Campaign{}, actualCampaign)
+	})
+
+	t.Run("resume ended campaign", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Status = models.CampaignStatusPaused
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(campaignWas.EndDate+1, nil).Once()
+
+		// check
+		actualCampaign, err := service.ResumeCampaign(ctx, advertiserId, campaignId)
+		require.ErrorIs(t, err, models.ErrCampaignEnded)
+		require.Equal(t, models.Campaign{}, actualCampaign)
+	})
+
+	t.Run("resume campaign with reached limit", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Status = models.CampaignStatusPaused
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+		timeRepoMock.On("GetDay", ctx).Return(campaignWas.StartDate, nil).Once()
+		campaignsRepoMock.On("UpdateCampaignStatus", ctx, campaignId, models.CampaignStatusPaused, models.CampaignStatusActive, campaignWas.StartDate).Return(models.ErrImpressionsLimit).Once()
+
+		// check
+		actualCampaign, err := service.ResumeCampaign(ctx, advertiserId, campaignId)
+		require.ErrorIs(t, err, models.ErrImpressionsLimit)
+		require.Equal(t, models.Campaign{}, actualCampaign)
+	})
+
+	t.Run("update archived campaign", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Status = models.CampaignStatusArchived
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+
+		// check
+		_, err := service.UpdateCampaign(ctx, advertiserId, campaignId, campaignDataSample, nil)
+		require.ErrorIs(t, err, models.ErrCantUpdateCampaign)
+	})
+	t.Run("update campaign with stale If-Match version", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.Version = 3
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+
+		// check
+		version := 2
+		_, err := service.UpdateCampaign(ctx, advertiserId, campaignId, campaignDataSample, &version)
+		require.ErrorIs(t, err, models.ErrVersionMismatch)
+	})
+
+	t.Run("update campaign changed concurrently", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		timeRepoMock.On("GetDay", ctx).Return(0, nil).Once()
+
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		campaignId := uuid.New()
+		campaignWas := campaignDataSample.ToCampaign()
+		campaignWas.Id = campaignId
+		campaignWas.AdvertiserId = advertiserId
+		campaignWas.StartDate = 5
+		campaignWas.EndDate = 10
+		campaignWas.Version = 3
+
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaignWas, nil).Once()
+
+		data := dto.CampaignDataFromCampaign(campaignWas)
+		data.AdTitle = "new title"
+		campaignsRepoMock.On("UpdateCampaign", ctx, campaignId, data, campaignWas.Version, 0, models.CampaignActionUpdate).Return(models.ErrVersionMismatch).Once()
+
+		// check
+		version := 3
+		_, err := service.UpdateCampaign(ctx, advertiserId, campaignId, data, &version)
+		require.ErrorIs(t, err, models.ErrVersionMismatch)
+	})
+}
+
+func TestSearchCampaigns(t *testing.T) {
+	t.Run("search advertiser campaigns success", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{Id: advertiserId}, nil).Once()
+
+		params := dto.CampaignsSearchParams{
+			PaginationParams: dto.PaginationParams{Size: 10, Page: 1},
+			Query:            "летняя распродажа",
+			AdvertiserId:     &advertiserId,
+		}
+		results := []models.CampaignSearchResult{
+			{
+				Campaign: models.Campaign{
+					Id:           uuid.New(),
+					AdvertiserId: advertiserId,
+					AdTitle:      "Летняя распродажа",
+					AdText:       "ad text",
+				},
+				Rank:           0.5,
+				TitleHighlight: "<b>Летняя</b> <b>распродажа</b>",
+				TextHighlight:  "ad text",
+			},
+		}
+		campaignsRepoMock.On("SearchCampaigns", ctx, params).Return(results, nil).Once()
+		campaignsRepoMock.On("CountSearchedCampaigns", ctx, params).Return(1, nil).Once()
+
+		// check
+		page, err := service.SearchCampaigns(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, dto.CampaignsSearchPage{Results: results, Total: 1}, page)
+	})
+
+	t.Run("search all campaigns does not check advertiser", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		params := dto.CampaignsSearchParams{
+			PaginationParams: dto.PaginationParams{Size: 10, Page: 1},
+			Query:            "sale",
+		}
+		campaignsRepoMock.On("SearchCampaigns", ctx, params).Return([]models.CampaignSearchResult{}, nil).Once()
+		campaignsRepoMock.On("CountSearchedCampaigns", ctx, params).Return(0, nil).Once()
+
+		// check
+		page, err := service.SearchCampaigns(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, dto.CampaignsSearchPage{Results: []models.CampaignSearchResult{}}, page)
+	})
+
+	t.Run("search campaigns of non-existent advertiser", func(t *testing.T) {
+		ctx := context.Background()
+
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+		timeRepoMock := mocks.NewTimeRepo(t)
+		staticRepoMock := mocks.NewStaticRepo(t)
+		historyRepoMock := mocks.NewCampaignHistoryRepo(t)
+		templatesRepoMock := mocks.NewCampaignTemplatesRepo(t)
+
+		service := NewCampaignsService(campaignsRepoMock, advertisersRepoMock, timeRepoMock, staticRepoMock, historyRepoMock, templatesRepoMock, NewNoModerator(), "http://localhost:8080/static")
+
+		// setup mocks
+		advertiserId := uuid.New()
+		advertisersRepoMock.On("GetAdvertiserById", ctx, advertiserId).Return(models.Advertiser{}, models.ErrAdvertiserNotFound).Once()
+
+		// check
+		_, err := service.SearchCampaigns(ctx, dto.CampaignsSearchParams{
+			PaginationParams: dto.PaginationParams{Size: 10, Page: 1},
+			Query:            "sale",
+			AdvertiserId:     &advertiserId,
+		})
+		require.ErrorIs(t, err, models.ErrAdvertiserNotFound)
+	})
 }
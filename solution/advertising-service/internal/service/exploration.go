@@ -0,0 +1,221 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sync"
+)
+
+const (
+	ExplorationStrategyNone          = "none"
+	ExplorationStrategyEpsilonGreedy = "epsilon_greedy"
+	ExplorationStrategyThompson      = "thompson"
+)
+
+// Explorer reorders ranked ad candidates to give campaigns without history
+// a share of traffic. Candidates moved ahead of their rank are marked as explored
+type Explorer interface {
+	Explore(ranked []models.RankedAdCandidate) []models.RankedAdCandidate
+}
+
+// ExplorationParams limit exploration to low-data campaigns and a share of requests
+type ExplorationParams struct {
+	// Traffic is the share of requests in which a low-data campaign is moved to the first place
+	Traffic float64
+	// MaxImpressions is impressions count below which campaign is considered low-data
+	MaxImpressions int
+}
+
+// NewExplorer returns explorer of the strategy. Explorers with the same seed
+// make the same decisions, zero seed is replaced with a random one
+func NewExplorer(strategy string, params ExplorationParams, seed uint64) (Explorer, error) {
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	if strategy != ExplorationStrategyNone {
+		if params.Traffic < 0 || params.Traffic > 1 {
+			return nil, fmt.Errorf("exploration traffic %v is out of [0, 1]", params.Traffic)
+		}
+		if params.MaxImpressions < 0 {
+			return nil, fmt.Errorf("exploration max impressions %v is negative", params.MaxImpressions)
+		}
+	}
+
+	switch strategy {
+	case ExplorationStrategyNone:
+		return NewNoExplorer(), nil
+	case ExplorationStrategyEpsilonGreedy:
+		return NewEpsilonGreedyExplorer(params, seed), nil
+	case ExplorationStrategyThompson:
+		return NewThompsonExplorer(params, seed), nil
+	}
+
+	return nil, fmt.Errorf("unknown exploration strategy %q", strategy)
+}
+
+// NoExplorer always exploits: candidates are left in rank order
+type NoExplorer struct{}
+
+func NewNoExplorer() *NoExplorer {
+	return &NoExplorer{}
+}
+
+func (ne *NoExplorer) Explore(ranked []models.RankedAdCandidate) []models.RankedAdCandidate {
+	return ranked
+}
+
+// EpsilonGreedyExplorer in the exploration share of requests moves
+// a random low-data candidate other than the best one to the first place
+type EpsilonGreedyExplorer struct {
+	params ExplorationParams
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func NewEpsilonGreedyExplorer(params ExplorationParams, seed uint64) *EpsilonGreedyExplorer {
+	return &EpsilonGreedyExplorer{
+		params: params,
+		rng:    rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (eg *EpsilonGreedyExplorer) Explore(ranked []models.RankedAdCandidate) []models.RankedAdCandidate {
+	lowData := lowDataCandidates(ranked, eg.params.MaxImpressions)
+	if len(lowData) == 0 {
+		return ranked
+	}
+
+	eg.mu.Lock()
+	explore := eg.rng.Float64() < eg.params.Traffic
+	idx := lowData[eg.rng.IntN(len(lowData))]
+	eg.mu.Unlock()
+
+	if !explore {
+		return ranked
+	}
+
+	return moveToFront(ranked, idx)
+}
+
+// thompsonPriorImpressions is the weight of the prior CTR
+// in impressions of a campaign without history
+const thompsonPriorImpressions = 10
+
+// ThompsonExplorer in the exploration share of requests moves to the first place
+// the low-data candidate with the best expected profit, where click probability is
+// sampled from beta distribution of campaign observed clicks and impressions,
+// so campaigns with few impressions get a chance to show their CTR.
+// The prior is the average CTR of all candidates
+type ThompsonExplorer struct {
+	params ExplorationParams
+
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func NewThompsonExplorer(params ExplorationParams, seed uint64) *ThompsonExplorer {
+	return &ThompsonExplorer{
+		params: params,
+		rng:    rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (te *ThompsonExplorer) Explore(ranked []models.RankedAdCandidate) []models.RankedAdCandidate {
+	lowData := lowDataCandidates(ranked, te.params.MaxImpressions)
+	if len(lowData) == 0 {
+		return ranked
+	}
+
+	totalClicks, totalImpressions := 0, 0
+	for _, candidate := range ranked {
+		totalClicks += min(candidate.ClicksCount, candidate.ImpressionsCount)
+		totalImpressions += candidate.ImpressionsCount
+	}
+	priorCtr := float64(totalClicks+1) / float64(totalImpressions+2)
+	priorClicks := priorCtr * thompsonPriorImpressions
+	priorSkips := (1 - priorCtr) * thompsonPriorImpressions
+
+	te.mu.Lock()
+	defer te.mu.Unlock()
+
+	if te.rng.Float64() >= te.params.Traffic {
+		return ranked
+	}
+
+	best, bestProfit := lowData[0], -1.0
+	for _, i := range lowData {
+		candidate := ranked[i]
+		clicks := min(candidate.ClicksCount, candidate.ImpressionsCount)
+		ctr := sampleBeta(te.rng,
+			priorClicks+float64(clicks),
+			priorSkips+float64(candidate.ImpressionsCount-clicks),
+		)
+
+		if profit := candidate.CostPerImpression + ctr*candidate.CostPerClick; profit > bestProfit {
+			best, bestProfit = i, profit
+		}
+	}
+
+	return moveToFront(ranked, best)
+}
+
+// lowDataCandidates returns indexes of candidates other than the best one
+// which have got less than maxImpressions impressions
+func lowDataCandidates(ranked []models.RankedAdCandidate, maxImpressions int) []int {
+	res := []int{}
+	for i := 1; i < len(ranked); i++ {
+		if ranked[i].ImpressionsCount < maxImpressions {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
+
+// moveToFront returns candidates with the candidate at idx marked as explored and moved to the first place,
+// the rest candidates keep rank order
+func moveToFront(ranked []models.RankedAdCandidate, idx int) []models.RankedAdCandidate {
+	explored := ranked[idx]
+	explored.Explored = true
+
+	res := make([]models.RankedAdCandidate, 0, len(ranked))
+	res = append(res, explored)
+	res = append(res, ranked[:idx]...)
+	res = append(res, ranked[idx+1:]...)
+
+	return res
+}
+
+// sampleBeta samples beta distribution as ratio of gamma distributed values
+func sampleBeta(rng *rand.Rand, alpha, beta float64) float64 {
+	x := sampleGamma(rng, alpha)
+	y := sampleGamma(rng, beta)
+	return x / (x + y)
+}
+
+// sampleGamma samples gamma distribution with unit scale by Marsaglia and Tsang method
+func sampleGamma(rng *rand.Rand, shape float64) float64 {
+	if shape < 1 {
+		return sampleGamma(rng, shape+1) * math.Pow(rng.Float64(), 1/shape)
+	}
+
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := rng.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+
+		v = v * v * v
+		u := rng.Float64()
+		if math.Log(u) < 0.5*x*x+d-d*v+d*math.Log(v) {
+			return d * v
+		}
+	}
+}
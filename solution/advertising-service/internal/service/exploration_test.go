@@ -0,0 +1,172 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplorers(t *testing.T) {
+	params := ExplorationParams{Traffic: 0.2, MaxImpressions: 100}
+
+	// established campaigns with known CTR
+	established := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New()},
+		CostPerImpression: 10,
+		CostPerClick:      100,
+		ImpressionsCount:  1000,
+		ClicksCount:       100,
+	}
+	veteran := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New()},
+		CostPerImpression: 10,
+		CostPerClick:      100,
+		ImpressionsCount:  1000,
+		ClicksCount:       10,
+	}
+	// new campaign without history
+	fresh := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New()},
+		CostPerImpression: 10,
+		CostPerClick:      100,
+	}
+	ranked := []models.RankedAdCandidate{
+		{AdCandidate: established, Rank: 3},
+		{AdCandidate: veteran, Rank: 2},
+		{AdCandidate: fresh, Rank: 1},
+	}
+	// fresh candidate is moved to the first place, the rest keep rank order
+	exploredRanked := []models.RankedAdCandidate{
+		{AdCandidate: fresh, Rank: 1, Explored: true},
+		{AdCandidate: established, Rank: 3},
+		{AdCandidate: veteran, Rank: 2},
+	}
+
+	t.Run("no explorer", func(t *testing.T) {
+		require.Equal(t, ranked, NewNoExplorer().Explore(ranked))
+	})
+
+	t.Run("epsilon greedy explorer", func(t *testing.T) {
+		explorer := NewEpsilonGreedyExplorer(params, 42)
+
+		explored := 0
+		for range 1000 {
+			res := explorer.Explore(ranked)
+			if res[0].Explored {
+				require.Equal(t, exploredRanked, res)
+				explored++
+			} else {
+				require.Equal(t, ranked, res)
+			}
+		}
+		require.InDelta(t, 200, explored, 50)
+
+		// never explores with zero traffic
+		explorer = NewEpsilonGreedyExplorer(ExplorationParams{MaxImpressions: 100}, 42)
+		for range 100 {
+			require.Equal(t, ranked, explorer.Explore(ranked))
+		}
+
+		// never explores campaigns with enough impressions
+		explorer = NewEpsilonGreedyExplorer(ExplorationParams{Traffic: 1}, 42)
+		for range 100 {
+			require.Equal(t, ranked, explorer.Explore(ranked))
+		}
+	})
+
+	t.Run("thompson explorer", func(t *testing.T) {
+		explorer := NewThompsonExplorer(params, 42)
+
+		explored := 0
+		for range 1000 {
+			res := explorer.Explore(ranked)
+			if res[0].Explored {
+				require.Equal(t, exploredRanked, res)
+				explored++
+			} else {
+				require.Equal(t, ranked, res)
+			}
+		}
+		require.InDelta(t, 200, explored, 50)
+
+		// low-data campaign with better observed CTR is explored more often
+		promising := fresh
+		promising.CampaignId = uuid.New()
+		promising.ImpressionsCount = 50
+		promising.ClicksCount = 25
+		withPromising := append(slices.Clone(ranked), models.RankedAdCandidate{AdCandidate: promising})
+
+		explorer = NewThompsonExplorer(ExplorationParams{Traffic: 1, MaxImpressions: 100}, 42)
+		promisingExplored := 0
+		for range 1000 {
+			res := explorer.Explore(withPromising)
+			require.True(t, res[0].Explored)
+			require.Contains(t, []uuid.UUID{fresh.CampaignId, promising.CampaignId}, res[0].CampaignId)
+			if res[0].CampaignId == promising.CampaignId {
+				promisingExplored++
+			}
+		}
+		require.Greater(t, promisingExplored, 500)
+	})
+
+	t.Run("explorers are reproducible with seed", func(t *testing.T) {
+		for _, strategy := range []string{ExplorationStrategyEpsilonGreedy, ExplorationStrategyThompson} {
+			first, err := NewExplorer(strategy, ExplorationParams{Traffic: 0.5, MaxImpressions: 100}, 7)
+			require.NoError(t, err)
+			second, err := NewExplorer(strategy, ExplorationParams{Traffic: 0.5, MaxImpressions: 100}, 7)
+			require.NoError(t, err)
+
+			for range 100 {
+				require.Equal(t, first.Explore(ranked), second.Explore(ranked))
+			}
+		}
+	})
+
+	t.Run("new explorer", func(t *testing.T) {
+		explorer, err := NewExplorer(ExplorationStrategyNone, ExplorationParams{}, 0)
+		require.NoError(t, err)
+		require.IsType(t, &NoExplorer{}, explorer)
+
+		explorer, err = NewExplorer(ExplorationStrategyEpsilonGreedy, params, 0)
+		require.NoError(t, err)
+		require.IsType(t, &EpsilonGreedyExplorer{}, explorer)
+
+		explorer, err = NewExplorer(ExplorationStrategyThompson, params, 0)
+		require.NoError(t, err)
+		require.IsType(t, &ThompsonExplorer{}, explorer)
+
+		_, err = NewExplorer(ExplorationStrategyEpsilonGreedy, ExplorationParams{Traffic: 2}, 0)
+		require.Error(t, err)
+
+		_, err = NewExplorer(ExplorationStrategyThompson, ExplorationParams{Traffic: 0.1, MaxImpressions: -1}, 0)
+		require.Error(t, err)
+
+		_, err = NewExplorer("unknown", params, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestSampleBeta(t *testing.T) {
+	explorer := NewThompsonExplorer(ExplorationParams{}, 42)
+
+	sum := 0.0
+	for range 10000 {
+		x := sampleBeta(explorer.rng, 3, 7)
+		require.GreaterOrEqual(t, x, 0.0)
+		require.LessOrEqual(t, x, 1.0)
+		sum += x
+	}
+
+	// mean of beta distribution is alpha / (alpha + beta)
+	require.InDelta(t, 0.3, sum/10000, 0.01)
+
+	// shape less than 1
+	sum = 0.0
+	for range 10000 {
+		sum += sampleBeta(explorer.rng, 0.5, 4.5)
+	}
+	require.InDelta(t, 0.1, sum/10000, 0.01)
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	ModerationModeOff = "off"
+	ModerationModeAI  = "ai"
+)
+
+// Moderator checks ad title and text for obscene or illegal phrases
+type Moderator interface {
+	// ModerateAd returns whether ad is approved and phrases it is rejected for
+	ModerateAd(ctx context.Context, adTitle, adText string) (bool, []string, error)
+}
+
+// NewModerator returns moderator of the mode, ai moderator uses ai service
+// and gives up on ad after timeout
+func NewModerator(mode string, ais *AIService, timeout time.Duration) (Moderator, error) {
+	switch mode {
+	case ModerationModeOff:
+		return NewNoModerator(), nil
+	case ModerationModeAI:
+		return NewAIModerator(ais, timeout), nil
+	}
+
+	return nil, fmt.Errorf("unknown moderation mode %q", mode)
+}
+
+// NoModerator approves any ad
+type NoModerator struct{}
+
+func NewNoModerator() *NoModerator {
+	return &NoModerator{}
+}
+
+func (nm *NoModerator) ModerateAd(ctx context.Context, adTitle, adText string) (bool, []string, error) {
+	return true, []string{}, nil
+}
+
+// AIModerator moderates ad title and text together with language model
+type AIModerator struct {
+	ais     *AIService
+	timeout time.Duration
+}
+
+func NewAIModerator(ais *AIService, timeout time.Duration) *AIModerator {
+	return &AIModerator{
+		ais:     ais,
+		timeout: timeout,
+	}
+}
+
+func (am *AIModerator) ModerateAd(ctx context.Context, adTitle, adText string) (bool, []string, error) {
+	// slow model must not hold campaign creation, ad is sent to admin review instead
+	ctx, cancel := context.WithTimeout(ctx, am.timeout)
+	defer cancel()
+
+	return am.ais.ModerateAdText(ctx, adTitle+"\n"+adText)
+}
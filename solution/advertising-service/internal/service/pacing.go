@@ -0,0 +1,74 @@
+package service
+
+import "advertising/advertising-service/internal/models"
+
+// dailyImpressionsTarget returns how many impressions the campaign should get
+// on the day to spread the rest of its impressions limit over the rest of its days.
+// Campaign without pacing has no target
+func dailyImpressionsTarget(pacing models.Pacing, impressionsLimit, impressionsBefore, day, endDate int) *int {
+	if pacing == models.PacingNone {
+		return nil
+	}
+
+	remaining := impressionsLimit - impressionsBefore
+	remainingDays := endDate - day + 1
+	if remaining <= 0 || remainingDays <= 0 {
+		return pointer(0)
+	}
+
+	switch pacing {
+	case models.PacingFrontLoaded:
+		// the rest days get linearly decreasing shares n, n-1, ..., 1,
+		// so the day gets n / (n * (n+1) / 2)
+		return pointer(ceilDiv(remaining*2, remainingDays+1))
+	default:
+		return pointer(ceilDiv(remaining, remainingDays))
+	}
+}
+
+// setDailyImpressionsTargets sets today targets of candidates
+func setDailyImpressionsTargets(candidates []models.AdCandidate, currentDay int) {
+	for i, candidate := range candidates {
+		candidates[i].DailyImpressionsTarget = dailyImpressionsTarget(
+			candidate.Pacing,
+			candidate.ImpressionsLimit,
+			candidate.ImpressionsCount-candidate.ImpressionsToday,
+			currentDay,
+			candidate.EndDate,
+		)
+	}
+}
+
+// filterByDailyImpressionsTargets returns candidates that have not got today impressions target yet
+func filterByDailyImpressionsTargets(candidates []models.AdCandidate) []models.AdCandidate {
+	res := make([]models.AdCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !dailyTargetReached(candidate) {
+			res = append(res, candidate)
+		}
+	}
+
+	return res
+}
+
+// dailyTargetReached checks the candidate has got today impressions target, candidate without target never reaches it
+func dailyTargetReached(candidate models.AdCandidate) bool {
+	return candidate.DailyImpressionsTarget != nil && candidate.ImpressionsToday >= *candidate.DailyImpressionsTarget
+}
+
+// pacingRatio is the share of today impressions target the candidate has already got.
+// Candidate without target is considered on target, so pacing does not raise it in ranking
+func pacingRatio(candidate models.AdCandidate) float64 {
+	if candidate.DailyImpressionsTarget == nil || *candidate.DailyImpressionsTarget == 0 {
+		return 1
+	}
+	return float64(candidate.ImpressionsToday) / float64(*candidate.DailyImpressionsTarget)
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
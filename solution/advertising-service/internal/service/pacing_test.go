@@ -0,0 +1,66 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyImpressionsTarget(t *testing.T) {
+	testCases := []struct {
+		name              string
+		pacing            models.Pacing
+		impressionsLimit  int
+		impressionsBefore int
+		day               int
+		endDate           int
+		expected          *int
+	}{
+		{"even first day", models.PacingEven, 100, 0, 1, 4, pointer(25)},
+		{"even rounds up", models.PacingEven, 100, 0, 1, 3, pointer(34)},
+		{"even catches up", models.PacingEven, 100, 10, 2, 4, pointer(30)},
+		{"even last day", models.PacingEven, 100, 90, 4, 4, pointer(10)},
+		{"front loaded first day", models.PacingFrontLoaded, 100, 0, 1, 4, pointer(40)},
+		{"front loaded last day", models.PacingFrontLoaded, 100, 90, 4, 4, pointer(10)},
+		{"limit reached", models.PacingEven, 100, 100, 2, 4, pointer(0)},
+		{"campaign ended", models.PacingEven, 100, 50, 5, 4, pointer(0)},
+		{"no pacing", models.PacingNone, 100, 50, 2, 4, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := dailyImpressionsTarget(tc.pacing, tc.impressionsLimit, tc.impressionsBefore, tc.day, tc.endDate)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSetDailyImpressionsTargets(t *testing.T) {
+	candidates := []models.AdCandidate{
+		{ImpressionsLimit: 100, ImpressionsCount: 30, ImpressionsToday: 10, EndDate: 4, Pacing: models.PacingEven},
+		{ImpressionsLimit: 100, ImpressionsCount: 0, EndDate: 4, Pacing: models.PacingFrontLoaded},
+	}
+
+	// day 3: 80 impressions for 2 days and 100 impressions for 2 days
+	setDailyImpressionsTargets(candidates, 3)
+	require.Equal(t, pointer(40), candidates[0].DailyImpressionsTarget)
+	require.Equal(t, pointer(67), candidates[1].DailyImpressionsTarget)
+
+	require.InDelta(t, 0.25, pacingRatio(candidates[0]), 1e-9)
+	require.InDelta(t, 0, pacingRatio(candidates[1]), 1e-9)
+	require.InDelta(t, 1, pacingRatio(models.AdCandidate{ImpressionsToday: 5, DailyImpressionsTarget: pointer(0)}), 1e-9)
+}
+
+func TestNoPacing(t *testing.T) {
+	candidates := []models.AdCandidate{
+		{ImpressionsLimit: 100, ImpressionsCount: 90, ImpressionsToday: 90, EndDate: 4, Pacing: models.PacingNone},
+		{ImpressionsLimit: 100, ImpressionsCount: 50, ImpressionsToday: 50, EndDate: 4, Pacing: models.PacingEven},
+	}
+
+	// campaign without pacing has no daily target and is not raised by pacing in ranking
+	setDailyImpressionsTargets(candidates, 3)
+	require.Nil(t, candidates[0].DailyImpressionsTarget)
+	require.Equal(t, []models.AdCandidate{candidates[0]}, filterByDailyImpressionsTargets(candidates))
+	require.InDelta(t, 1, pacingRatio(candidates[0]), 1e-9)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"fmt"
+)
+
+// Pricer sets prices of impression and click for the winning ad candidate.
+// Runner-up is the next candidate of another advertiser that could be shown instead of the winner,
+// nil if there is none
+type Pricer interface {
+	Mode() models.PricingMode
+	Price(winner models.RankedAdCandidate, runnerUp *models.RankedAdCandidate) models.AdPrice
+}
+
+func NewPricer(mode string) (Pricer, error) {
+	switch models.PricingMode(mode) {
+	case models.PricingModeFirstPrice:
+		return NewFirstPricePricer(), nil
+	case models.PricingModeSecondPrice:
+		return NewSecondPricePricer(), nil
+	}
+
+	return nil, fmt.Errorf("unknown pricing mode %q", mode)
+}
+
+// FirstPricePricer charges advertisers their full bids
+type FirstPricePricer struct{}
+
+func NewFirstPricePricer() *FirstPricePricer {
+	return &FirstPricePricer{}
+}
+
+func (fp *FirstPricePricer) Mode() models.PricingMode {
+	return models.PricingModeFirstPrice
+}
+
+func (fp *FirstPricePricer) Price(winner models.RankedAdCandidate, _ *models.RankedAdCandidate) models.AdPrice {
+	return models.AdPrice{
+		Impression: winner.CostPerImpression,
+		Click:      winner.CostPerClick,
+	}
+}
+
+// SecondPricePricer implements generalized second-price auction:
+// bids of the winner are scaled down, so that its expected profit
+// equals expected profit of the runner-up.
+// Winner without runner-up pays its full bids
+type SecondPricePricer struct{}
+
+func NewSecondPricePricer() *SecondPricePricer {
+	return &SecondPricePricer{}
+}
+
+func (sp *SecondPricePricer) Mode() models.PricingMode {
+	return models.PricingModeSecondPrice
+}
+
+func (sp *SecondPricePricer) Price(winner models.RankedAdCandidate, runnerUp *models.RankedAdCandidate) models.AdPrice {
+	factor := 1.0
+	if bid := expectedProfit(winner.AdCandidate); bid > 0 && runnerUp != nil {
+		// winner of non-revenue ranking may have lower bid than runner-up,
+		// it is never charged more than its bids
+		factor = min(1, expectedProfit(runnerUp.AdCandidate)/bid)
+	}
+
+	return models.AdPrice{
+		Impression: winner.CostPerImpression * factor,
+		Click:      winner.CostPerClick * factor,
+	}
+}
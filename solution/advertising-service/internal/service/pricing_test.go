@@ -0,0 +1,73 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricers(t *testing.T) {
+	// expected profit 100 + 0.5*50 = 125
+	winner := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()},
+		CostPerImpression: 100,
+		CostPerClick:      50,
+		Score:             100,
+		MaxScore:          100,
+	}
+	// expected profit 40 + 0.5*20 = 50
+	runnerUp := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New(), AdvertiserId: uuid.New()},
+		CostPerImpression: 40,
+		CostPerClick:      20,
+		Score:             100,
+		MaxScore:          100,
+	}
+	ranked := NewRevenueRanker().Rank([]models.AdCandidate{runnerUp, winner})
+
+	t.Run("first price pricer", func(t *testing.T) {
+		pricer := NewFirstPricePricer()
+		require.Equal(t, models.PricingModeFirstPrice, pricer.Mode())
+		require.Equal(t, models.AdPrice{Impression: 100, Click: 50}, pricer.Price(ranked[0], &ranked[1]))
+		require.Equal(t, models.AdPrice{Impression: 40, Click: 20}, pricer.Price(ranked[1], nil))
+	})
+
+	t.Run("second price pricer", func(t *testing.T) {
+		pricer := NewSecondPricePricer()
+		require.Equal(t, models.PricingModeSecondPrice, pricer.Mode())
+
+		// winner pays runner-up expected profit
+		price := pricer.Price(ranked[0], &ranked[1])
+		require.InDelta(t, 40, price.Impression, 1e-9)
+		require.InDelta(t, 20, price.Click, 1e-9)
+		require.InDelta(t, 50, expectedProfit(models.AdCandidate{
+			CostPerImpression: price.Impression,
+			CostPerClick:      price.Click,
+			Score:             winner.Score,
+			MaxScore:          winner.MaxScore,
+		}), 1e-9)
+
+		// candidate without runner-up has no competitors
+		require.Equal(t, models.AdPrice{Impression: 100, Click: 50}, pricer.Price(ranked[0], nil))
+	})
+
+	t.Run("second price pricer never charges more than bid", func(t *testing.T) {
+		price := NewSecondPricePricer().Price(ranked[1], &ranked[0])
+		require.Equal(t, models.AdPrice{Impression: 40, Click: 20}, price)
+	})
+
+	t.Run("new pricer", func(t *testing.T) {
+		pricer, err := NewPricer(string(models.PricingModeFirstPrice))
+		require.NoError(t, err)
+		require.IsType(t, &FirstPricePricer{}, pricer)
+
+		pricer, err = NewPricer(string(models.PricingModeSecondPrice))
+		require.NoError(t, err)
+		require.IsType(t, &SecondPricePricer{}, pricer)
+
+		_, err = NewPricer("unknown")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+const (
+	RankingStrategyWeighted  = "weighted"
+	RankingStrategyRevenue   = "revenue"
+	RankingStrategyRelevance = "relevance"
+)
+
+// Ranker orders ad candidates from the most to the least suitable for client
+type Ranker interface {
+	Rank(candidates []models.AdCandidate) []models.RankedAdCandidate
+}
+
+type RankingWeights struct {
+	Profit float64
+	Score  float64
+	Limits float64
+	Pacing float64
+}
+
+func NewRanker(strategy string, weights RankingWeights) (Ranker, error) {
+	switch strategy {
+	case RankingStrategyWeighted:
+		return NewWeightedRanker(weights), nil
+	case RankingStrategyRevenue:
+		return NewRevenueRanker(), nil
+	case RankingStrategyRelevance:
+		return NewRelevanceRanker(), nil
+	}
+
+	return nil, fmt.Errorf("unknown ranking strategy %q", strategy)
+}
+
+// WeightedRanker combines normalized expected profit, ml score,
+// distance to impressions limit and lag behind daily impressions target
+// with configured weights
+type WeightedRanker struct {
+	weights RankingWeights
+}
+
+func NewWeightedRanker(weights RankingWeights) *WeightedRanker {
+	return &WeightedRanker{
+		weights: weights,
+	}
+}
+
+func (wr *WeightedRanker) Rank(candidates []models.AdCandidate) []models.RankedAdCandidate {
+	maxProfit, maxScore, maxLimitsDiff := 0.0, 0, 0
+	for _, candidate := range candidates {
+		maxProfit = max(maxProfit, expectedProfit(candidate))
+		maxScore = max(maxScore, candidate.Score)
+		maxLimitsDiff = max(maxLimitsDiff, limitsDiff(candidate))
+	}
+
+	if maxProfit == 0 {
+		maxProfit = 0.1
+	}
+	if maxScore == 0 {
+		maxScore = 1
+	}
+	if maxLimitsDiff == 0 {
+		maxLimitsDiff = 1
+	}
+
+	ranked := make([]models.RankedAdCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		profitNormalized := expectedProfit(candidate) / maxProfit
+		scoreNormalized := float64(candidate.Score) / float64(maxScore)
+		limitsCompliance := float64(limitsDiff(candidate)) / float64(maxLimitsDiff)
+		pacingCompliance := max(0, 1-pacingRatio(candidate))
+
+		ranked = append(ranked, models.RankedAdCandidate{
+			AdCandidate: candidate,
+			Rank: profitNormalized*wr.weights.Profit +
+				scoreNormalized*wr.weights.Score +
+				limitsCompliance*wr.weights.Limits +
+				pacingCompliance*wr.weights.Pacing,
+		})
+	}
+
+	sortRanked(ranked)
+	return ranked
+}
+
+// RevenueRanker orders candidates by expected profit only
+type RevenueRanker struct{}
+
+func NewRevenueRanker() *RevenueRanker {
+	return &RevenueRanker{}
+}
+
+func (rr *RevenueRanker) Rank(candidates []models.AdCandidate) []models.RankedAdCandidate {
+	ranked := make([]models.RankedAdCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		ranked = append(ranked, models.RankedAdCandidate{
+			AdCandidate: candidate,
+			Rank:        expectedProfit(candidate),
+		})
+	}
+
+	sortRanked(ranked)
+	return ranked
+}
+
+// RelevanceRanker orders candidates by ml score,
+// candidates with equal score are ordered by expected profit
+type RelevanceRanker struct{}
+
+func NewRelevanceRanker() *RelevanceRanker {
+	return &RelevanceRanker{}
+}
+
+func (rr *RelevanceRanker) Rank(candidates []models.AdCandidate) []models.RankedAdCandidate {
+	ranked := make([]models.RankedAdCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		ranked = append(ranked, models.RankedAdCandidate{
+			AdCandidate: candidate,
+			Rank:        float64(candidate.Score),
+		})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b models.RankedAdCandidate) int {
+		if c := cmp.Compare(b.Rank, a.Rank); c != 0 {
+			return c
+		}
+		return cmp.Compare(expectedProfit(b.AdCandidate), expectedProfit(a.AdCandidate))
+	})
+	return ranked
+}
+
+// expected profit of one impression: its cost plus cost of click,
+// weighted with click probability estimated from ml score
+func expectedProfit(candidate models.AdCandidate) float64 {
+	maxScore := max(candidate.MaxScore, 1)
+	return candidate.CostPerImpression +
+		float64(candidate.Score)/float64(maxScore)*0.5*candidate.CostPerClick
+}
+
+func limitsDiff(candidate models.AdCandidate) int {
+	diff := candidate.ImpressionsLimit - candidate.ImpressionsCount
+	if diff < 0 {
+		return -diff
+	}
+	return diff
+}
+
+func sortRanked(ranked []models.RankedAdCandidate) {
+	slices.SortStableFunc(ranked, func(a, b models.RankedAdCandidate) int {
+		return cmp.Compare(b.Rank, a.Rank)
+	})
+}
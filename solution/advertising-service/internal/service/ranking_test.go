@@ -0,0 +1,122 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankers(t *testing.T) {
+	// expensive campaign without ml score
+	expensive := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New()},
+		CostPerImpression: 100,
+		CostPerClick:      100,
+		ImpressionsLimit:  1000,
+		MaxScore:          100,
+	}
+	// cheap campaign with high ml score
+	relevant := models.AdCandidate{
+		Ad:                models.Ad{CampaignId: uuid.New()},
+		CostPerImpression: 10,
+		CostPerClick:      10,
+		ImpressionsLimit:  1000,
+		Score:             100,
+		MaxScore:          100,
+	}
+	candidates := []models.AdCandidate{relevant, expensive}
+
+	t.Run("weighted ranker", func(t *testing.T) {
+		ranker := NewWeightedRanker(RankingWeights{Profit: 1, Score: 0.25, Limits: 0.1})
+
+		ranked := ranker.Rank(candidates)
+		require.Len(t, ranked, 2)
+		require.Equal(t, expensive.CampaignId, ranked[0].CampaignId)
+		require.InDelta(t, 1.1, ranked[0].Rank, 1e-9)
+		require.InDelta(t, 15.0/100+0.25+0.1, ranked[1].Rank, 1e-9)
+
+		// with big score weight relevant campaign wins
+		ranker = NewWeightedRanker(RankingWeights{Profit: 1, Score: 1, Limits: 0.1})
+
+		ranked = ranker.Rank(candidates)
+		require.Equal(t, relevant.CampaignId, ranked[0].CampaignId)
+	})
+
+	t.Run("weighted ranker prefers campaign far from limit", func(t *testing.T) {
+		ranker := NewWeightedRanker(RankingWeights{Profit: 1, Score: 0.25, Limits: 0.1})
+
+		nearLimit := expensive
+		nearLimit.CampaignId = uuid.New()
+		nearLimit.ImpressionsCount = 999
+
+		ranked := ranker.Rank([]models.AdCandidate{nearLimit, expensive})
+		require.Equal(t, expensive.CampaignId, ranked[0].CampaignId)
+	})
+
+	t.Run("weighted ranker prefers campaign behind daily target", func(t *testing.T) {
+		ranker := NewWeightedRanker(RankingWeights{Profit: 1, Score: 0.25, Limits: 0.1, Pacing: 0.2})
+
+		aheadTarget := expensive
+		aheadTarget.CampaignId = uuid.New()
+		aheadTarget.ImpressionsToday = 10
+		aheadTarget.DailyImpressionsTarget = pointer(10)
+
+		behindTarget := expensive
+		behindTarget.CampaignId = uuid.New()
+		behindTarget.DailyImpressionsTarget = pointer(10)
+
+		ranked := ranker.Rank([]models.AdCandidate{aheadTarget, behindTarget})
+		require.Equal(t, behindTarget.CampaignId, ranked[0].CampaignId)
+		require.InDelta(t, 0.2, ranked[0].Rank-ranked[1].Rank, 1e-9)
+	})
+
+	t.Run("revenue ranker", func(t *testing.T) {
+		ranked := NewRevenueRanker().Rank(candidates)
+		require.Len(t, ranked, 2)
+		require.Equal(t, expensive.CampaignId, ranked[0].CampaignId)
+		require.InDelta(t, 100.0, ranked[0].Rank, 1e-9)
+		require.InDelta(t, 15.0, ranked[1].Rank, 1e-9)
+	})
+
+	t.Run("relevance ranker", func(t *testing.T) {
+		ranked := NewRelevanceRanker().Rank(candidates)
+		require.Len(t, ranked, 2)
+		require.Equal(t, relevant.CampaignId, ranked[0].CampaignId)
+
+		// equal scores are ordered by profit
+		ranked = NewRelevanceRanker().Rank([]models.AdCandidate{relevant, expensive, {
+			Ad:                models.Ad{CampaignId: uuid.New()},
+			CostPerImpression: 1000,
+			Score:             100,
+			MaxScore:          100,
+		}})
+		require.Len(t, ranked, 3)
+		require.Equal(t, 1000.0, ranked[0].CostPerImpression)
+		require.Equal(t, relevant.CampaignId, ranked[1].CampaignId)
+	})
+
+	t.Run("rankers handle no candidates", func(t *testing.T) {
+		require.Empty(t, NewWeightedRanker(RankingWeights{Profit: 1}).Rank(nil))
+		require.Empty(t, NewRevenueRanker().Rank(nil))
+		require.Empty(t, NewRelevanceRanker().Rank(nil))
+	})
+
+	t.Run("new ranker", func(t *testing.T) {
+		ranker, err := NewRanker(RankingStrategyWeighted, RankingWeights{Profit: 1})
+		require.NoError(t, err)
+		require.IsType(t, &WeightedRanker{}, ranker)
+
+		ranker, err = NewRanker(RankingStrategyRevenue, RankingWeights{})
+		require.NoError(t, err)
+		require.IsType(t, &RevenueRanker{}, ranker)
+
+		ranker, err = NewRanker(RankingStrategyRelevance, RankingWeights{})
+		require.NoError(t, err)
+		require.IsType(t, &RelevanceRanker{}, ranker)
+
+		_, err = NewRanker("unknown", RankingWeights{})
+		require.Error(t, err)
+	})
+}
@@ -27,28 +27,32 @@ func NewStatsService(
 	}
 }
 
-func (ss *StatsService) GetStatsForCampaign(ctx context.Context, campaignId uuid.UUID) (models.Stats, error) {
+func (ss *StatsService) GetStatsForCampaign(ctx context.Context, campaignId uuid.UUID) (models.CampaignStats, error) {
 	op := "StatsService.GetStatsForCampaign"
 
-	// check campaign existence
-	_, err := ss.cr.GetCampaignById(ctx, campaignId)
+	campaign, err := ss.cr.GetCampaignById(ctx, campaignId)
 	if err != nil {
-		return models.Stats{}, fmt.Errorf("%s: cr.GetCampaignById: %w", op, err)
+		return models.CampaignStats{}, fmt.Errorf("%s: cr.GetCampaignById: %w", op, err)
 	}
 
 	stats, err := ss.sr.GetStatsForCampaign(ctx, campaignId)
 	if err != nil {
-		return models.Stats{}, fmt.Errorf("%s: sr.GetStatsForCampaign: %w", op, err)
+		return models.CampaignStats{}, fmt.Errorf("%s: sr.GetStatsForCampaign: %w", op, err)
 	}
 
-	return stats, nil
+	return models.CampaignStats{
+		Stats:              stats,
+		ClicksLimitReached: stats.ClicksCount >= campaign.ClicksLimit,
+	}, nil
 }
 
-func (ss *StatsService) GetStatsForCampaignDaily(ctx context.Context, campaignId uuid.UUID) ([]models.StatsDaily, error) {
+// GetStatsForCampaignDaily returns daily stats of the campaign
+// together with daily impressions targets of its pacing.
+// Every day of the campaign dates is present, days without actions have zero stats
+func (ss *StatsService) GetStatsForCampaignDaily(ctx context.Context, campaignId uuid.UUID) ([]models.CampaignStatsDaily, error) {
 	op := "StatsService.GetStatsForCampaignDaily"
 
-	// check campaign existence
-	_, err := ss.cr.GetCampaignById(ctx, campaignId)
+	campaign, err := ss.cr.GetCampaignById(ctx, campaignId)
 	if err != nil {
 		return nil, fmt.Errorf("%s: cr.GetCampaignById: %w", op, err)
 	}
@@ -58,7 +62,43 @@ func (ss *StatsService) GetStatsForCampaignDaily(ctx context.Context, campaignId
 		return nil, fmt.Errorf("%s: sr.GetStatsForCampaignDaily: %w", op, err)
 	}
 
-	return stats, nil
+	// stats are ordered by date, so they are merged with campaign dates
+	// and impressions before the day are accumulated
+	res := make([]models.CampaignStatsDaily, 0, len(stats)+campaign.EndDate-campaign.StartDate+1)
+	impressionsBefore := 0
+	appendDay := func(dayStats models.StatsDaily) {
+		campaignStats := models.CampaignStatsDaily{StatsDaily: dayStats}
+		if dayStats.Date >= campaign.StartDate && dayStats.Date <= campaign.EndDate {
+			campaignStats.ImpressionsTarget = dailyImpressionsTarget(
+				campaign.Pacing,
+				campaign.ImpressionsLimit,
+				impressionsBefore,
+				dayStats.Date,
+				campaign.EndDate,
+			)
+		}
+
+		res = append(res, campaignStats)
+		impressionsBefore += dayStats.ImpressionsCount
+	}
+
+	i := 0
+	for ; i < len(stats) && stats[i].Date < campaign.StartDate; i++ {
+		appendDay(stats[i])
+	}
+	for day := campaign.StartDate; day <= campaign.EndDate; day++ {
+		if i < len(stats) && stats[i].Date == day {
+			appendDay(stats[i])
+			i++
+			continue
+		}
+		appendDay(models.StatsDaily{Date: day})
+	}
+	for ; i < len(stats); i++ {
+		appendDay(stats[i])
+	}
+
+	return res, nil
 }
 
 func (ss *StatsService) GetStatsForAdvertiser(ctx context.Context, advertiser uuid.UUID) (models.Stats, error) {
@@ -23,15 +23,37 @@ func TestStatsService(t *testing.T) {
 
 		// setup mocks
 		campaignId := uuid.New()
-		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(models.Campaign{}, nil).Once()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(models.Campaign{ClicksLimit: 10}, nil).Once()
 
-		expectedStats := models.Stats{}
-		statsRepoMock.On("GetStatsForCampaign", ctx, campaignId).Return(expectedStats, nil).Once()
+		stats := models.Stats{ImpressionsCount: 20, ClicksCount: 5}
+		statsRepoMock.On("GetStatsForCampaign", ctx, campaignId).Return(stats, nil).Once()
 
 		// check
 		actualStats, err := service.GetStatsForCampaign(ctx, campaignId)
 		require.NoError(t, err)
-		require.Equal(t, expectedStats, actualStats)
+		require.Equal(t, models.CampaignStats{Stats: stats, ClicksLimitReached: false}, actualStats)
+	})
+
+	t.Run("get stats for campaign clicks limit reached", func(t *testing.T) {
+		ctx := context.Background()
+
+		statsRepoMock := mocks.NewStatsRepo(t)
+		campaignsRepoMock := mocks.NewCampaignsRepo(t)
+		advertisersRepoMock := mocks.NewAdvertisersRepo(t)
+
+		service := NewStatsService(statsRepoMock, campaignsRepoMock, advertisersRepoMock)
+
+		// setup mocks
+		campaignId := uuid.New()
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(models.Campaign{ClicksLimit: 10}, nil).Once()
+
+		stats := models.Stats{ImpressionsCount: 20, ClicksCount: 10}
+		statsRepoMock.On("GetStatsForCampaign", ctx, campaignId).Return(stats, nil).Once()
+
+		// check
+		actualStats, err := service.GetStatsForCampaign(ctx, campaignId)
+		require.NoError(t, err)
+		require.Equal(t, models.CampaignStats{Stats: stats, ClicksLimitReached: true}, actualStats)
 	})
 
 	t.Run("get stats for campaign campaigns repo error", func(t *testing.T) {
@@ -51,7 +73,7 @@ func TestStatsService(t *testing.T) {
 		// check
 		actualStats, err := service.GetStatsForCampaign(ctx, campaignId)
 		require.ErrorIs(t, err, expectedError)
-		require.Equal(t, models.Stats{}, actualStats)
+		require.Equal(t, models.CampaignStats{}, actualStats)
 	})
 
 	t.Run("get stats for campaign stats repo error", func(t *testing.T) {
@@ -73,7 +95,7 @@ func TestStatsService(t *testing.T) {
 		// check
 		actualStats, err := service.GetStatsForCampaign(ctx, campaignId)
 		require.ErrorIs(t, err, expectedError)
-		require.Equal(t, models.Stats{}, actualStats)
+		require.Equal(t, models.CampaignStats{}, actualStats)
 	})
 
 	t.Run("get stats for campaign daily success", func(t *testing.T) {
@@ -87,10 +109,34 @@ func TestStatsService(t *testing.T) {
 
 		// setup mocks
 		campaignId := uuid.New()
-		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(models.Campaign{}, nil).Once()
-
-		expectedStats := []models.StatsDaily{}
-		statsRepoMock.On("GetStatsForCampaignDaily", ctx, campaignId).Return(expectedStats, nil).Once()
+		campaign := models.Campaign{
+			StartDate:        1,
+			EndDate:          4,
+			ImpressionsLimit: 100,
+			Pacing:           models.PacingEven,
+		}
+		campaignsRepoMock.On("GetCampaignById", ctx, campaignId).Return(campaign, nil).Once()
+
+		stats := []models.StatsDaily{
+			{Date: 1, Stats: models.Stats{ImpressionsCount: 30}},
+			{Date: 3, Stats: models.Stats{ImpressionsCount: 20}},
+			{Date: 5, Stats: models.Stats{ClicksCount: 1}},
+		}
+		statsRepoMock.On("GetStatsForCampaignDaily", ctx, campaignId).Return(stats, nil).Once()
+
+		// day 1: 100 impressions for 4 days
+		// day 2: no impressions, 70 impressions for 3 days
+		// day 3: 70 impressions for 2 days
+		// day 4: no impressions, 50 impressions for 1 day
+		// day 5: out of campaign dates
+		firstTarget, secondTarget, thirdTarget, fourthTarget := 25, 24, 35, 50
+		expectedStats := []models.CampaignStatsDaily{
+			{StatsDaily: stats[0], ImpressionsTarget: &firstTarget},
+			{StatsDaily: models.StatsDaily{Date: 2}, ImpressionsTarget: &secondTarget},
+			{StatsDaily: stats[1], ImpressionsTarget: &thirdTarget},
+			{StatsDaily: models.StatsDaily{Date: 4}, ImpressionsTarget: &fourthTarget},
+			{StatsDaily: stats[2]},
+		}
 
 		// check
 		actualStats, err := service.GetStatsForCampaignDaily(ctx, campaignId)
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"fmt"
+	"slices"
+)
+
+// maxTargetingRuleDepth limits nesting of targeting rules
+const maxTargetingRuleDepth = 8
+
+// validateTargetingRule checks that rule is well-formed,
+// so it can be evaluated without errors during ad selection
+func validateTargetingRule(rule models.TargetingRule) error {
+	return validateTargetingRuleDepth(rule, 1)
+}
+
+func validateTargetingRuleDepth(rule models.TargetingRule, depth int) error {
+	if depth > maxTargetingRuleDepth {
+		return fmt.Errorf("%w: rules are nested deeper than %d", models.ErrInvalidTargeting, maxTargetingRuleDepth)
+	}
+
+	kinds := 0
+	if rule.And != nil {
+		kinds++
+	}
+	if rule.Or != nil {
+		kinds++
+	}
+	if rule.Attribute != "" || rule.Op != "" {
+		kinds++
+	}
+	if kinds != 1 {
+		return fmt.Errorf("%w: rule must have exactly one of and, or, attribute", models.ErrInvalidTargeting)
+	}
+
+	switch {
+	case rule.And != nil:
+		return validateTargetingRules(rule.And, "and", depth)
+	case rule.Or != nil:
+		return validateTargetingRules(rule.Or, "or", depth)
+	}
+
+	return validateTargetingComparison(rule)
+}
+
+func validateTargetingRules(rules []models.TargetingRule, name string, depth int) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("%w: %s must have at least one rule", models.ErrInvalidTargeting, name)
+	}
+	for _, rule := range rules {
+		if err := validateTargetingRuleDepth(rule, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateTargetingComparison(rule models.TargetingRule) error {
+	if rule.Attribute == "" {
+		return fmt.Errorf("%w: op %q must have attribute", models.ErrInvalidTargeting, rule.Op)
+	}
+
+	switch rule.Op {
+	case models.TargetingRuleOpEq, models.TargetingRuleOpNe:
+		if !isAttributeScalar(rule.Operand) {
+			return fmt.Errorf("%w: op %q must have string, number or boolean value", models.ErrInvalidTargeting, rule.Op)
+		}
+	case models.TargetingRuleOpGt, models.TargetingRuleOpGte, models.TargetingRuleOpLt, models.TargetingRuleOpLte:
+		if _, ok := rule.Operand.(float64); !ok {
+			return fmt.Errorf("%w: op %q must have number value", models.ErrInvalidTargeting, rule.Op)
+		}
+	case models.TargetingRuleOpIn:
+		if len(rule.Operands) == 0 {
+			return fmt.Errorf("%w: op %q must have values", models.ErrInvalidTargeting, rule.Op)
+		}
+		for _, operand := range rule.Operands {
+			if !isAttributeScalar(operand) {
+				return fmt.Errorf("%w: op %q must have string, number or boolean values", models.ErrInvalidTargeting, rule.Op)
+			}
+		}
+	case models.TargetingRuleOpExists:
+	default:
+		return fmt.Errorf("%w: unknown op %q", models.ErrInvalidTargeting, rule.Op)
+	}
+
+	return nil
+}
+
+func isAttributeScalar(value any) bool {
+	switch value.(type) {
+	case string, float64, bool:
+		return true
+	}
+	return false
+}
+
+// matchTargetingRules reports whether candidate rules are matched by client attributes.
+// Candidate without rules is matched by any client
+func matchTargetingRules(candidate models.AdCandidate, attributes models.ClientAttributes) bool {
+	return candidate.TargetingRules == nil || matchTargetingRule(*candidate.TargetingRules, attributes)
+}
+
+// filterByTargetingRules returns candidates whose rules are matched by client attributes
+func filterByTargetingRules(candidates []models.AdCandidate, attributes models.ClientAttributes) []models.AdCandidate {
+	res := make([]models.AdCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if matchTargetingRules(candidate, attributes) {
+			res = append(res, candidate)
+		}
+	}
+
+	return res
+}
+
+func matchTargetingRule(rule models.TargetingRule, attributes models.ClientAttributes) bool {
+	var matched bool
+	switch {
+	case rule.And != nil:
+		matched = true
+		for _, nested := range rule.And {
+			if !matchTargetingRule(nested, attributes) {
+				matched = false
+				break
+			}
+		}
+	case rule.Or != nil:
+		for _, nested := range rule.Or {
+			if matchTargetingRule(nested, attributes) {
+				matched = true
+				break
+			}
+		}
+	default:
+		matched = matchTargetingComparison(rule, attributes)
+	}
+
+	return matched != rule.Negate
+}
+
+// matchTargetingComparison compares client attribute with rule value.
+// Array attribute matches if any of its elements matches, ne - if none equals.
+// Missing attribute matches only ne
+func matchTargetingComparison(rule models.TargetingRule, attributes models.ClientAttributes) bool {
+	value, ok := attributes[rule.Attribute]
+	if rule.Op == models.TargetingRuleOpExists {
+		return ok
+	}
+	if !ok {
+		return rule.Op == models.TargetingRuleOpNe
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+
+	if rule.Op == models.TargetingRuleOpNe {
+		return !slices.Contains(values, rule.Operand)
+	}
+
+	return slices.ContainsFunc(values, func(value any) bool {
+		switch rule.Op {
+		case models.TargetingRuleOpEq:
+			return value == rule.Operand
+		case models.TargetingRuleOpIn:
+			return slices.Contains(rule.Operands, value)
+		}
+
+		x, ok := value.(float64)
+		if !ok {
+			return false
+		}
+		y, _ := rule.Operand.(float64)
+
+		switch rule.Op {
+		case models.TargetingRuleOpGt:
+			return x > y
+		case models.TargetingRuleOpGte:
+			return x >= y
+		case models.TargetingRuleOpLt:
+			return x < y
+		case models.TargetingRuleOpLte:
+			return x <= y
+		}
+		return false
+	})
+}
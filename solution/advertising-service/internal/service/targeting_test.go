@@ -0,0 +1,111 @@
+package service
+
+import (
+	"advertising/advertising-service/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTargetingRule(t *testing.T) {
+	valid := []models.TargetingRule{
+		{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "ios"},
+		{Attribute: "tier", Op: models.TargetingRuleOpGte, Operand: 2.0},
+		{Attribute: "interests", Op: models.TargetingRuleOpIn, Operands: []any{"sport", "music"}},
+		{Attribute: "premium", Op: models.TargetingRuleOpExists, Negate: true},
+		{
+			And: []models.TargetingRule{
+				{Attribute: "tier", Op: models.TargetingRuleOpGt, Operand: 1.0},
+				{Or: []models.TargetingRule{
+					{Attribute: "device", Op: models.TargetingRuleOpNe, Operand: "android"},
+					{Attribute: "beta", Op: models.TargetingRuleOpEq, Operand: true},
+				}},
+			},
+		},
+	}
+	for _, rule := range valid {
+		require.NoError(t, validateTargetingRule(rule), rule)
+	}
+
+	deep := models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpExists}
+	for range maxTargetingRuleDepth {
+		deep = models.TargetingRule{And: []models.TargetingRule{deep}}
+	}
+
+	invalid := []models.TargetingRule{
+		{},
+		{And: []models.TargetingRule{}},
+		{And: []models.TargetingRule{{Attribute: "tier", Op: models.TargetingRuleOpExists}}, Attribute: "tier"},
+		{Op: models.TargetingRuleOpExists},
+		{Attribute: "tier"},
+		{Attribute: "tier", Op: "like", Operand: "gold"},
+		{Attribute: "tier", Op: models.TargetingRuleOpEq},
+		{Attribute: "tier", Op: models.TargetingRuleOpEq, Operand: []any{"gold"}},
+		{Attribute: "tier", Op: models.TargetingRuleOpLt, Operand: "gold"},
+		{Attribute: "tier", Op: models.TargetingRuleOpIn},
+		{Attribute: "tier", Op: models.TargetingRuleOpIn, Operands: []any{map[string]any{}}},
+		{Or: []models.TargetingRule{{Attribute: "tier", Op: models.TargetingRuleOpGt}}},
+		deep,
+	}
+	for _, rule := range invalid {
+		require.ErrorIs(t, validateTargetingRule(rule), models.ErrInvalidTargeting, rule)
+	}
+}
+
+func TestMatchTargetingRule(t *testing.T) {
+	attributes := models.ClientAttributes{
+		"device":    "ios",
+		"tier":      3.0,
+		"beta":      true,
+		"interests": []any{"sport", "music"},
+	}
+
+	tests := []struct {
+		name    string
+		rule    models.TargetingRule
+		matched bool
+	}{
+		{"eq", models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "ios"}, true},
+		{"eq mismatch", models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "android"}, false},
+		{"eq bool", models.TargetingRule{Attribute: "beta", Op: models.TargetingRuleOpEq, Operand: true}, true},
+		{"eq array", models.TargetingRule{Attribute: "interests", Op: models.TargetingRuleOpEq, Operand: "music"}, true},
+		{"ne", models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpNe, Operand: "android"}, true},
+		{"ne array", models.TargetingRule{Attribute: "interests", Op: models.TargetingRuleOpNe, Operand: "sport"}, false},
+		{"ne missing", models.TargetingRule{Attribute: "os", Op: models.TargetingRuleOpNe, Operand: "linux"}, true},
+		{"gt", models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpGt, Operand: 2.0}, true},
+		{"gte", models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpGte, Operand: 3.0}, true},
+		{"lt", models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpLt, Operand: 3.0}, false},
+		{"lte", models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpLte, Operand: 3.0}, true},
+		{"gt not number", models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpGt, Operand: 1.0}, false},
+		{"gt missing", models.TargetingRule{Attribute: "age", Op: models.TargetingRuleOpGt, Operand: 1.0}, false},
+		{"in", models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpIn, Operands: []any{"ios", "android"}}, true},
+		{"in array", models.TargetingRule{Attribute: "interests", Op: models.TargetingRuleOpIn, Operands: []any{"cars", "music"}}, true},
+		{"in mismatch", models.TargetingRule{Attribute: "tier", Op: models.TargetingRuleOpIn, Operands: []any{1.0, 2.0}}, false},
+		{"exists", models.TargetingRule{Attribute: "beta", Op: models.TargetingRuleOpExists}, true},
+		{"not exists", models.TargetingRule{Attribute: "premium", Op: models.TargetingRuleOpExists, Negate: true}, true},
+		{"and", models.TargetingRule{And: []models.TargetingRule{
+			{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "ios"},
+			{Attribute: "tier", Op: models.TargetingRuleOpGte, Operand: 5.0},
+		}}, false},
+		{"or", models.TargetingRule{Or: []models.TargetingRule{
+			{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "android"},
+			{Attribute: "tier", Op: models.TargetingRuleOpGte, Operand: 2.0},
+		}}, true},
+		{"not or", models.TargetingRule{Negate: true, Or: []models.TargetingRule{
+			{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "android"},
+			{Attribute: "beta", Op: models.TargetingRuleOpEq, Operand: false},
+		}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.matched, matchTargetingRule(tt.rule, attributes))
+		})
+	}
+
+	t.Run("client without attributes", func(t *testing.T) {
+		rule := models.TargetingRule{Attribute: "device", Op: models.TargetingRuleOpEq, Operand: "ios"}
+		require.False(t, matchTargetingRule(rule, nil))
+		require.True(t, matchTargetingRules(models.AdCandidate{}, nil))
+	})
+}
@@ -8,14 +8,18 @@ import (
 
 type TimeService struct {
 	tr repo.TimeRepo
+	cr repo.CampaignsRepo
 }
 
-func NewTimeService(tr repo.TimeRepo) *TimeService {
+func NewTimeService(tr repo.TimeRepo, cr repo.CampaignsRepo) *TimeService {
 	return &TimeService{
 		tr: tr,
+		cr: cr,
 	}
 }
 
+// AdvanceDay sets current day or increments it
+// and completes campaigns that have ended or reached their limits
 func (ts *TimeService) AdvanceDay(ctx context.Context, currentDay *int) (int, error) {
 	op := "TimeService.AdvanceDay"
 
@@ -25,7 +29,7 @@ func (ts *TimeService) AdvanceDay(ctx context.Context, currentDay *int) (int, er
 			return 0, fmt.Errorf("%s: set day: %w", op, err)
 		}
 
-		return *currentDay, nil
+		return ts.completeCampaigns(ctx, *currentDay)
 	}
 
 	curDay, err := ts.tr.GetDay(ctx)
@@ -38,5 +42,15 @@ func (ts *TimeService) AdvanceDay(ctx context.Context, currentDay *int) (int, er
 		return 0, fmt.Errorf("%s: increment day: %w", op, err)
 	}
 
-	return curDay + 1, nil
+	return ts.completeCampaigns(ctx, curDay+1)
+}
+
+func (ts *TimeService) completeCampaigns(ctx context.Context, currentDay int) (int, error) {
+	op := "TimeService.completeCampaigns"
+
+	if _, err := ts.cr.CompleteCampaigns(ctx, currentDay); err != nil {
+		return 0, fmt.Errorf("%s: cr.CompleteCampaigns: %w", op, err)
+	}
+
+	return currentDay, nil
 }
@@ -14,11 +14,13 @@ func TestTimeService(t *testing.T) {
 	ctx := context.Background()
 
 	tr := mocks.NewTimeRepo(t)
-	ts := NewTimeService(tr)
+	cr := mocks.NewCampaignsRepo(t)
+	ts := NewTimeService(tr, cr)
 
 	// check increment
 	tr.On("GetDay", mock.Anything).Return(0, nil).Once()
 	tr.On("SetDay", mock.Anything, 1).Return(nil).Once()
+	cr.On("CompleteCampaigns", mock.Anything, 1).Return(2, nil).Once()
 
 	curDay, err := ts.AdvanceDay(ctx, nil)
 	require.NoError(t, err, "increment day")
@@ -26,6 +28,7 @@ func TestTimeService(t *testing.T) {
 
 	// check set day
 	tr.On("SetDay", mock.Anything, 42).Return(nil).Once()
+	cr.On("CompleteCampaigns", mock.Anything, 42).Return(0, nil).Once()
 
 	setDay := 42
 	curDay, err = ts.AdvanceDay(ctx, &setDay)
@@ -50,4 +53,10 @@ func TestTimeService(t *testing.T) {
 	_, err = ts.AdvanceDay(ctx, &setDay)
 	require.ErrorIs(t, err, targetError)
 
+	// check completing campaigns error
+	tr.On("SetDay", mock.Anything, setDay).Return(nil).Once()
+	cr.On("CompleteCampaigns", mock.Anything, setDay).Return(0, targetError).Once()
+
+	_, err = ts.AdvanceDay(ctx, &setDay)
+	require.ErrorIs(t, err, targetError)
 }
@@ -7,6 +7,7 @@ type Handler struct {
 	api.AdvertisersHandler
 	api.CampaignsHandler
 	api.ClientsHandler
+	api.LocationsHandler
 	api.StatisticsHandler
 	api.TimeHandler
 	api.AIHandler
@@ -17,6 +18,7 @@ func NewHandler(
 	advertisersHandler api.AdvertisersHandler,
 	campaignsHandler api.CampaignsHandler,
 	clientsHandler api.ClientsHandler,
+	locationsHandler api.LocationsHandler,
 	statisticsHandler api.StatisticsHandler,
 	timeHandler api.TimeHandler,
 	aiHandler api.AIHandler,
@@ -26,6 +28,7 @@ func NewHandler(
 		AdvertisersHandler: advertisersHandler,
 		CampaignsHandler:   campaignsHandler,
 		ClientsHandler:     clientsHandler,
+		LocationsHandler:   locationsHandler,
 		StatisticsHandler:  statisticsHandler,
 		TimeHandler:        timeHandler,
 		AIHandler:          aiHandler,
@@ -13,7 +13,9 @@ import (
 
 type AdsUsecase interface {
 	GetAdForClient(ctx context.Context, clientId uuid.UUID) (models.Ad, error)
+	GetAdsForClient(ctx context.Context, clientId uuid.UUID, slots int) ([]models.Ad, error)
 	RecordAdClick(ctx context.Context, clientId uuid.UUID, campaignId uuid.UUID) error
+	ExplainAdForClient(ctx context.Context, clientId uuid.UUID, campaignId *uuid.UUID) (models.AdExplanation, error)
 }
 
 type AdsHandler struct {
@@ -33,7 +35,26 @@ func NewAdsHandler(au AdsUsecase) *AdsHandler {
 //
 // GET /ads
 func (ah *AdsHandler) GetAdForClient(ctx context.Context, params api.GetAdForClientParams) (api.GetAdForClientRes, error) {
-	ad, err := ah.au.GetAdForClient(ctx, params.ClientID)
+	var res api.GetAdForClientOK
+	var err error
+	if slots, ok := params.Slots.Get(); ok {
+		var ads []models.Ad
+		ads, err = ah.au.GetAdsForClient(ctx, params.ClientID, slots)
+		if err == nil {
+			apiAds := make([]api.Ad, 0, len(ads))
+			for _, ad := range ads {
+				apiAds = append(apiAds, modelsAdToApiAd(ad))
+			}
+			res = api.NewAdArrayGetAdForClientOK(apiAds)
+		}
+	} else {
+		var ad models.Ad
+		ad, err = ah.au.GetAdForClient(ctx, params.ClientID)
+		if err == nil {
+			res = api.NewAdGetAdForClientOK(modelsAdToApiAd(ad))
+		}
+	}
+
 	if err != nil {
 		if errors.Is(err, models.ErrClientNotFound) {
 			return &api.Response404{
@@ -51,17 +72,6 @@ func (ah *AdsHandler) GetAdForClient(ctx context.Context, params api.GetAdForCli
 		return nil, err
 	}
 
-	res := api.Ad{
-		AdID:         ad.CampaignId,
-		AdvertiserID: ad.AdvertiserId,
-		AdTitle:      ad.AdTitle,
-		AdText:       ad.AdText,
-	}
-
-	if ad.AdImageUrl != nil {
-		res.AdImageURL = api.NewOptString(*ad.AdImageUrl)
-	}
-
 	return &res, nil
 }
 
@@ -91,3 +101,96 @@ func (ah *AdsHandler) RecordAdClick(ctx context.Context, req *api.RecordAdClickR
 
 	return &api.RecordAdClickNoContent{}, nil
 }
+
+// ExplainAdForClient implements explainAdForClient operation.
+//
+// Возвращает все рекламные кампании с причинами, по
+// которым они не подходят клиенту, и разбор ранжирования
+// подходящих кампаний. Показ не записывается.
+//
+// GET /admin/ads/explain
+func (ah *AdsHandler) ExplainAdForClient(ctx context.Context, params api.ExplainAdForClientParams) (api.ExplainAdForClientRes, error) {
+	var campaignId *uuid.UUID
+	if id, ok := params.CampaignID.Get(); ok {
+		campaignId = &id
+	}
+
+	explanation, err := ah.au.ExplainAdForClient(ctx, params.ClientID, campaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrClientNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumClient,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("explain ad for client", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.AdExplanation{
+		ClientID:    explanation.ClientId,
+		CurrentDate: api.Date(explanation.Date),
+		Campaigns:   make([]api.CampaignExplanation, 0, len(explanation.Campaigns)),
+	}
+	for _, campaign := range explanation.Campaigns {
+		res.Campaigns = append(res.Campaigns, modelsCampaignExplanationToApiCampaignExplanation(campaign))
+	}
+
+	return &res, nil
+}
+
+func modelsCampaignExplanationToApiCampaignExplanation(campaign models.CampaignExplanation) api.CampaignExplanation {
+	res := api.CampaignExplanation{
+		CampaignID:       campaign.CampaignId,
+		AdvertiserID:     campaign.AdvertiserId,
+		AdTitle:          campaign.AdTitle,
+		Eligible:         len(campaign.ExclusionReasons) == 0,
+		ExclusionReasons: make([]api.ExclusionReason, 0, len(campaign.ExclusionReasons)),
+	}
+	for _, reason := range campaign.ExclusionReasons {
+		res.ExclusionReasons = append(res.ExclusionReasons, api.ExclusionReason(reason))
+	}
+
+	if res.Eligible {
+		score := api.ScoreBreakdown{
+			Position:          campaign.Position,
+			Rank:              campaign.Rank,
+			ExpectedProfit:    campaign.ExpectedProfit,
+			CostPerImpression: campaign.CostPerImpression,
+			CostPerClick:      campaign.CostPerClick,
+			MlScore:           campaign.Score,
+			MaxMlScore:        campaign.MaxScore,
+			ImpressionsCount:  campaign.ImpressionsCount,
+			ImpressionsLimit:  campaign.ImpressionsLimit,
+			ClicksCount:       campaign.ClicksCount,
+			ClicksLimit:       campaign.ClicksLimit,
+			ImpressionsToday:  campaign.ImpressionsToday,
+		}
+		if campaign.DailyImpressionsTarget != nil {
+			score.DailyImpressionsTarget = api.NewOptInt(*campaign.DailyImpressionsTarget)
+		}
+		res.Score = api.NewOptScoreBreakdown(score)
+	}
+
+	return res
+}
+
+func modelsAdToApiAd(ad models.Ad) api.Ad {
+	res := api.Ad{
+		AdID:         ad.CampaignId,
+		AdvertiserID: ad.AdvertiserId,
+		AdTitle:      ad.AdTitle,
+		AdText:       ad.AdText,
+	}
+
+	if ad.AdImageUrl != nil {
+		res.AdImageURL = api.NewOptString(*ad.AdImageUrl)
+	}
+
+	return res
+}
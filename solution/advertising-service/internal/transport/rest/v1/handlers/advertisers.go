@@ -1,11 +1,13 @@
 package handlers
 
 import (
+	"advertising/advertising-service/internal/dto"
 	"advertising/advertising-service/internal/models"
 	"advertising/pkg/logger"
 	api "advertising/pkg/ogen/advertising-service"
 	"context"
 	"errors"
+	"iter"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -13,8 +15,11 @@ import (
 
 type AdvertisersUsecase interface {
 	GetAdvertiserById(ctx context.Context, id uuid.UUID) (models.Advertiser, error)
-	UpsertAdvertisers(ctx context.Context, advertisers []models.Advertiser) ([]models.Advertiser, error)
+	ListAdvertisers(ctx context.Context, params dto.AdvertisersListParams) (dto.AdvertisersPage, error)
+	UpsertAdvertisers(ctx context.Context, advertisers []dto.AdvertiserUpsert) ([]models.Advertiser, error)
+	DeleteAdvertiser(ctx context.Context, id uuid.UUID) error
 	UpsertMLScore(ctx context.Context, mlScore models.MLScore) error
+	LoadMLScores(ctx context.Context, rows iter.Seq2[dto.MLScoreLoadRow, error]) (dto.MLScoresLoadReport, error)
 }
 
 type AdvertisersHandler struct {
@@ -49,20 +54,90 @@ func (ah *AdvertisersHandler) GetAdvertiserById(ctx context.Context, params api.
 	return &res, nil
 }
 
+// ListAdvertisers implements listAdvertisers operation.
+//
+// Возвращает рекламодателей, отсортированных по имени, с
+// пагинацией и поиском по части имени.
+//
+// GET /advertisers
+func (ah *AdvertisersHandler) ListAdvertisers(ctx context.Context, params api.ListAdvertisersParams) (api.ListAdvertisersRes, error) {
+	listParams := dto.AdvertisersListParams{
+		PaginationParams: dto.PaginationParams{
+			Size: params.Size.Or(50),
+			Page: params.Page.Or(1),
+		},
+	}
+	if name, ok := params.Name.Get(); ok {
+		listParams.Name = &name
+	}
+
+	page, err := ah.au.ListAdvertisers(ctx, listParams)
+	if err != nil {
+		logger.FromCtx(ctx).Error("list advertisers", zap.Error(err))
+		return nil, err
+	}
+
+	res := make([]api.Advertiser, 0, len(page.Advertisers))
+	for _, advertiser := range page.Advertisers {
+		res = append(res, modelsAdvertiserToApiAdvertiser(advertiser))
+	}
+
+	return &api.ListAdvertisersOKHeaders{
+		XTotalCount: api.NewOptInt(page.Total),
+		Response:    res,
+	}, nil
+}
+
+// DeleteAdvertiser implements deleteAdvertiser operation.
+//
+// Удаляет рекламодателя и архивирует все его рекламные
+// кампании.
+//
+// DELETE /advertisers/{advertiserId}
+func (ah *AdvertisersHandler) DeleteAdvertiser(ctx context.Context, params api.DeleteAdvertiserParams) (api.DeleteAdvertiserRes, error) {
+	err := ah.au.DeleteAdvertiser(ctx, params.AdvertiserId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrAdvertiserHasActiveCampaigns) {
+			return &api.DeleteAdvertiserConflict{
+				Message: api.NewOptString("advertiser has active campaigns"),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete advertiser", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteAdvertiserNoContent{}, nil
+}
+
 // UpsertAdvertisers implements upsertAdvertisers operation.
 //
 // Создаёт новых или обновляет существующих
-// рекламодателей.
+// рекламодателей. Не переданные бюджеты существующего
+// рекламодателя не меняются, `null` снимает ограничение.
+// Удалённых рекламодателей восстановить нельзя.
 //
 // POST /advertisers/bulk
 func (ah *AdvertisersHandler) UpsertAdvertisers(ctx context.Context, req []api.AdvertiserUpsert) (api.UpsertAdvertisersRes, error) {
-	advertisers := make([]models.Advertiser, 0, len(req))
+	advertisers := make([]dto.AdvertiserUpsert, 0, len(req))
 	for _, advertiser := range req {
-		advertisers = append(advertisers, apiAdvertiserUpsertToModelsAdvertiser(advertiser))
+		advertisers = append(advertisers, apiAdvertiserUpsertToDtoAdvertiserUpsert(advertiser))
 	}
 
 	advertisersGot, err := ah.au.UpsertAdvertisers(ctx, advertisers)
 	if err != nil {
+		var deletedErr dto.DeletedAdvertisersError
+		if errors.As(err, &deletedErr) {
+			return &api.UpsertAdvertisersConflict{
+				Message: api.NewOptString(deletedErr.Error()),
+			}, nil
+		}
+
 		logger.FromCtx(ctx).Error("upsert advertisers", zap.Error(err))
 		return nil, err
 	}
@@ -106,15 +181,37 @@ func (ah *AdvertisersHandler) UpsertMLScore(ctx context.Context, req *api.MLScor
 }
 
 func modelsAdvertiserToApiAdvertiser(advertiser models.Advertiser) api.Advertiser {
-	return api.Advertiser{
+	res := api.Advertiser{
 		AdvertiserID: advertiser.Id,
 		Name:         advertiser.Name,
 	}
+
+	if advertiser.DailyBudget != nil {
+		res.DailyBudget = api.NewOptNilFloat64(*advertiser.DailyBudget)
+	}
+	if advertiser.TotalBudget != nil {
+		res.TotalBudget = api.NewOptNilFloat64(*advertiser.TotalBudget)
+	}
+
+	return res
 }
 
-func apiAdvertiserUpsertToModelsAdvertiser(advertiser api.AdvertiserUpsert) models.Advertiser {
-	return models.Advertiser{
-		Id:   advertiser.GetAdvertiserID(),
-		Name: advertiser.GetName(),
+func apiAdvertiserUpsertToDtoAdvertiserUpsert(advertiser api.AdvertiserUpsert) dto.AdvertiserUpsert {
+	res := dto.AdvertiserUpsert{
+		Advertiser: models.Advertiser{
+			Id:   advertiser.GetAdvertiserID(),
+			Name: advertiser.GetName(),
+		},
+		DailyBudgetSet: advertiser.GetDailyBudget().IsSet(),
+		TotalBudgetSet: advertiser.GetTotalBudget().IsSet(),
+	}
+
+	if advertiser.GetDailyBudget().IsSet() && !advertiser.GetDailyBudget().IsNull() {
+		res.Advertiser.DailyBudget = pointer(advertiser.GetDailyBudget().Value)
 	}
+	if advertiser.GetTotalBudget().IsSet() && !advertiser.GetTotalBudget().IsNull() {
+		res.Advertiser.TotalBudget = pointer(advertiser.GetTotalBudget().Value)
+	}
+
+	return res
 }
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	api "advertising/pkg/ogen/advertising-service"
+	"context"
+	"errors"
+
+	"github.com/go-faster/jx"
+	"go.uber.org/zap"
+)
+
+// ListCampaignHistory implements listCampaignHistory operation.
+//
+// Возвращает версии рекламной кампании, начиная с
+// последней.
+//
+// GET /advertisers/{advertiserId}/campaigns/{campaignId}/history
+func (ch *CampaignsHandler) ListCampaignHistory(ctx context.Context, params api.ListCampaignHistoryParams) (api.ListCampaignHistoryRes, error) {
+	paginateParams := dto.PaginationParams{
+		Size: params.Size.Or(50),
+		Page: params.Page.Or(1),
+	}
+
+	versions, err := ch.cu.ListCampaignHistory(ctx, params.AdvertiserId, params.CampaignId, paginateParams)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("list campaign history", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.ListCampaignHistoryOKApplicationJSON(make(api.ListCampaignHistoryOKApplicationJSON, 0, len(versions)))
+	for _, version := range versions {
+		res = append(res, modelsCampaignVersionToApiCampaignVersion(version))
+	}
+
+	return &res, nil
+}
+
+// RestoreCampaignVersion implements restoreCampaignVersion operation.
+//
+// Возвращает параметры рекламной кампании к сохранённой
+// версии.
+//
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/history/{version}/restore
+func (ch *CampaignsHandler) RestoreCampaignVersion(ctx context.Context, params api.RestoreCampaignVersionParams) (api.RestoreCampaignVersionRes, error) {
+	campaign, err := ch.cu.RestoreCampaignVersion(ctx, params.AdvertiserId, params.CampaignId, params.Version)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+		if errors.Is(err, models.ErrVersionNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaignVersion,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidStartDate) {
+			return &api.Response400{
+				Message: api.NewOptString("start_date must be not in past"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, models.ErrCantUpdateCampaign) {
+			return &api.RestoreCampaignVersionForbidden{}, nil
+		}
+
+		logger.FromCtx(ctx).Error("restore campaign version", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
+func modelsCampaignVersionToApiCampaignVersion(version models.CampaignVersion) api.CampaignVersion {
+	res := api.CampaignVersion{
+		Version:   version.Version,
+		Action:    api.CampaignAction(version.Action),
+		Day:       version.Day,
+		CreatedAt: version.CreatedAt,
+		Campaign:  modelsCampaignToApiCampaign(models.Campaign(version.Campaign)),
+		Changes:   make([]api.CampaignChange, 0, len(version.Changes)),
+	}
+
+	for _, change := range version.Changes {
+		res.Changes = append(res.Changes, api.CampaignChange{
+			Field: change.Field,
+			Old:   jx.Raw(change.Old),
+			New:   jx.Raw(change.New),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	api "advertising/pkg/ogen/advertising-service"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// CreateCampaignTemplate implements createCampaignTemplate operation.
+//
+// Сохраняет параметры рекламной кампании под
+// названием, уникальным для рекламодателя.
+//
+// POST /advertisers/{advertiserId}/campaign-templates
+func (ch *CampaignsHandler) CreateCampaignTemplate(ctx context.Context, req *api.CampaignTemplateCreate, params api.CreateCampaignTemplateParams) (api.CreateCampaignTemplateRes, error) {
+	data, err := apiCampaignUpdateToDtoCampaignData(&req.Campaign)
+	if err != nil {
+		return &api.Response400{
+			Message: api.NewOptString(err.Error()),
+		}, nil
+	}
+
+	template, err := ch.cu.CreateCampaignTemplate(ctx, params.AdvertiserId, req.GetName(), data)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrTemplateExists) {
+			return &api.CreateCampaignTemplateConflict{
+				Message: api.NewOptString("template with this name already exists"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("create campaign template", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignTemplateToApiCampaignTemplate(template)
+	return &res, nil
+}
+
+// ListCampaignTemplates implements listCampaignTemplates operation.
+//
+// Возвращает шаблоны рекламных кампаний рекламодателя,
+// упорядоченные по названию.
+//
+// GET /advertisers/{advertiserId}/campaign-templates
+func (ch *CampaignsHandler) ListCampaignTemplates(ctx context.Context, params api.ListCampaignTemplatesParams) (api.ListCampaignTemplatesRes, error) {
+	templates, err := ch.cu.ListCampaignTemplates(ctx, params.AdvertiserId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("list campaign templates", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.ListCampaignTemplatesOKApplicationJSON(make(api.ListCampaignTemplatesOKApplicationJSON, 0, len(templates)))
+	for _, template := range templates {
+		res = append(res, modelsCampaignTemplateToApiCampaignTemplate(template))
+	}
+
+	return &res, nil
+}
+
+// GetCampaignTemplate implements getCampaignTemplate operation.
+//
+// Получение шаблона рекламной кампании.
+//
+// GET /advertisers/{advertiserId}/campaign-templates/{templateId}
+func (ch *CampaignsHandler) GetCampaignTemplate(ctx context.Context, params api.GetCampaignTemplateParams) (api.GetCampaignTemplateRes, error) {
+	template, err := ch.cu.GetCampaignTemplate(ctx, params.AdvertiserId, params.TemplateId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrTemplateNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaignTemplate,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("get campaign template", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignTemplateToApiCampaignTemplate(template)
+	return &res, nil
+}
+
+// DeleteCampaignTemplate implements deleteCampaignTemplate operation.
+//
+// Удаляет шаблон. Кампании, созданные из шаблона, не
+// изменяются.
+//
+// DELETE /advertisers/{advertiserId}/campaign-templates/{templateId}
+func (ch *CampaignsHandler) DeleteCampaignTemplate(ctx context.Context, params api.DeleteCampaignTemplateParams) (api.DeleteCampaignTemplateRes, error) {
+	err := ch.cu.DeleteCampaignTemplate(ctx, params.AdvertiserId, params.TemplateId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrTemplateNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaignTemplate,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("delete campaign template", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.DeleteCampaignTemplateNoContent{}, nil
+}
+
+// InstantiateCampaignTemplate implements instantiateCampaignTemplate operation.
+//
+// Создаёт рекламную кампанию с параметрами шаблона.
+//
+// POST /advertisers/{advertiserId}/campaign-templates/{templateId}/campaigns
+func (ch *CampaignsHandler) InstantiateCampaignTemplate(ctx context.Context, req api.OptCampaignOverrides, params api.InstantiateCampaignTemplateParams) (api.InstantiateCampaignTemplateRes, error) {
+	campaign, err := ch.cu.InstantiateCampaignTemplate(ctx, params.AdvertiserId, params.TemplateId, apiCampaignOverridesToDto(req))
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrTemplateNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaignTemplate,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidStartDate) {
+			return &api.Response400{
+				Message: api.NewOptString("start_date must be not in past"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidCampaign) || errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("instantiate campaign template", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
+func modelsCampaignTemplateToApiCampaignTemplate(template models.CampaignTemplate) api.CampaignTemplate {
+	campaign := modelsCampaignToApiCampaign(models.Campaign(template.Campaign))
+
+	return api.CampaignTemplate{
+		TemplateID:   template.Id,
+		AdvertiserID: template.AdvertiserId,
+		Name:         template.Name,
+		CreatedAt:    template.CreatedAt,
+		Campaign: api.CampaignUpdate{
+			ImpressionsLimit:  campaign.ImpressionsLimit,
+			ClicksLimit:       campaign.ClicksLimit,
+			CostPerImpression: campaign.CostPerImpression,
+			CostPerClick:      campaign.CostPerClick,
+			AdTitle:           campaign.AdTitle,
+			AdText:            campaign.AdText,
+			StartDate:         campaign.StartDate,
+			EndDate:           campaign.EndDate,
+			Targeting:         api.NewOptTargeting(campaign.Targeting),
+			FrequencyCap:      campaign.FrequencyCap,
+			Pacing:            api.NewOptPacing(campaign.Pacing),
+		},
+	}
+}
@@ -7,8 +7,13 @@ import (
 	api "advertising/pkg/ogen/advertising-service"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"iter"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -16,11 +21,27 @@ import (
 
 type CampaignsUsecase interface {
 	CreateCampaign(ctx context.Context, advertiserId uuid.UUID, data dto.CampaignData) (models.Campaign, error)
-	ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.PaginationParams) ([]models.Campaign, error)
+	ListCampaignsForAdvertiser(ctx context.Context, advertiserId uuid.UUID, params dto.CampaignsListParams) (dto.CampaignsPage, error)
 	GetCampaignById(ctx context.Context, advertiserId uuid.UUID, campaignId uuid.UUID) (models.Campaign, error)
-	UpdateCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID, data dto.CampaignData) (models.Campaign, error)
+	SearchCampaigns(ctx context.Context, params dto.CampaignsSearchParams) (dto.CampaignsSearchPage, error)
+	UpdateCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID, data dto.CampaignData, version *int) (models.Campaign, error)
 	DeleteCampaign(ctx context.Context, advertiserId uuid.UUID, campaignId uuid.UUID) error
+	PurgeCampaign(ctx context.Context, campaignId uuid.UUID) error
+	OverrideCampaignModeration(ctx context.Context, campaignId uuid.UUID, status models.ModerationStatus) (models.Campaign, error)
 	UploadCampaignImage(ctx context.Context, advertiserId, campaignId uuid.UUID, image models.Static) (*string, error)
+	PauseCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID) (models.Campaign, error)
+	ResumeCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID) (models.Campaign, error)
+	ArchiveCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID) (models.Campaign, error)
+	ListCampaignHistory(ctx context.Context, advertiserId, campaignId uuid.UUID, params dto.PaginationParams) ([]models.CampaignVersion, error)
+	RestoreCampaignVersion(ctx context.Context, advertiserId, campaignId uuid.UUID, version int) (models.Campaign, error)
+	CloneCampaign(ctx context.Context, advertiserId, campaignId uuid.UUID, overrides dto.CampaignOverrides) (models.Campaign, error)
+	CreateCampaignTemplate(ctx context.Context, advertiserId uuid.UUID, name string, data dto.CampaignData) (models.CampaignTemplate, error)
+	ListCampaignTemplates(ctx context.Context, advertiserId uuid.UUID) ([]models.CampaignTemplate, error)
+	GetCampaignTemplate(ctx context.Context, advertiserId, templateId uuid.UUID) (models.CampaignTemplate, error)
+	DeleteCampaignTemplate(ctx context.Context, advertiserId, templateId uuid.UUID) error
+	InstantiateCampaignTemplate(ctx context.Context, advertiserId, templateId uuid.UUID, overrides dto.CampaignOverrides) (models.Campaign, error)
+	ImportCampaigns(ctx context.Context, advertiserId uuid.UUID, rows []dto.CampaignImportRow, atomic bool) (dto.CampaignsImportReport, error)
+	ExportCampaigns(ctx context.Context, advertiserId uuid.UUID) (iter.Seq2[models.Campaign, error], error)
 }
 
 type CampaignsHandler struct {
@@ -56,8 +77,10 @@ func (ch *CampaignsHandler) GetCampaign(ctx context.Context, params api.GetCampa
 		return nil, err
 	}
 
-	res := modelsCampaignToApiCampaign(campaign)
-	return &res, nil
+	return &api.CampaignHeaders{
+		ETag:     api.NewOptString(campaignETag(campaign.Version)),
+		Response: modelsCampaignToApiCampaign(campaign),
+	}, nil
 }
 
 // CreateCampaign implements createCampaign operation.
@@ -103,6 +126,34 @@ func (ch *CampaignsHandler) CreateCampaign(ctx context.Context, req *api.Campaig
 		if targeting.GetLocation().IsSet() && !targeting.GetLocation().IsNull() {
 			data.Location = pointer(targeting.GetLocation().Value)
 		}
+		if targeting.GetLocations().IsSet() && !targeting.GetLocations().IsNull() {
+			data.Locations = targeting.GetLocations().Value
+		}
+		if targeting.GetRules().IsSet() {
+			rules, err := apiTargetingRuleToModelsTargetingRule(targeting.GetRules().Value)
+			if err != nil {
+				return &api.Response400{
+					Message: api.NewOptString(err.Error()),
+				}, nil
+			}
+			data.TargetingRules = &rules
+		}
+	}
+
+	if req.GetFrequencyCap().IsSet() {
+		frequencyCap := req.GetFrequencyCap().Value
+
+		data.FrequencyCapImpressions = pointer(frequencyCap.GetImpressions())
+		if frequencyCap.GetDays().IsSet() && !frequencyCap.GetDays().IsNull() {
+			data.FrequencyCapDays = pointer(frequencyCap.GetDays().Value)
+		}
+	}
+
+	data.Pacing = models.Pacing(req.GetPacing().Or(api.PacingNONE))
+
+	data.Status = models.CampaignStatusActive
+	if req.GetDraft().Or(false) {
+		data.Status = models.CampaignStatusDraft
 	}
 
 	if req.GetClicksLimit() > req.GetImpressionsLimit() {
@@ -129,6 +180,11 @@ func (ch *CampaignsHandler) CreateCampaign(ctx context.Context, req *api.Campaig
 				Message: api.NewOptString("start_date must be not in past"),
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("create campaign", zap.Error(err))
 		return nil, err
@@ -165,6 +221,65 @@ func (ch *CampaignsHandler) DeleteCampaign(ctx context.Context, params api.Delet
 	return &api.DeleteCampaignNoContent{}, nil
 }
 
+// PurgeCampaign implements purgeCampaign operation.
+//
+// Удаляет рекламную кампанию, в том числе уже удалённую
+// рекламодателем, вместе с её показами, переходами и
+// изображением объявления.
+//
+// DELETE /admin/campaigns/{campaignId}
+func (ch *CampaignsHandler) PurgeCampaign(ctx context.Context, params api.PurgeCampaignParams) (api.PurgeCampaignRes, error) {
+	err := ch.cu.PurgeCampaign(ctx, params.CampaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("purge campaign", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.PurgeCampaignNoContent{}, nil
+}
+
+// OverrideCampaignModeration implements overrideCampaignModeration operation.
+//
+// Одобряет или отклоняет рекламную кампанию вместо автоматической модерации.
+//
+// POST /admin/campaigns/{campaignId}/moderation
+func (ch *CampaignsHandler) OverrideCampaignModeration(
+	ctx context.Context,
+	req *api.OverrideCampaignModerationReq,
+	params api.OverrideCampaignModerationParams,
+) (api.OverrideCampaignModerationRes, error) {
+	campaign, err := ch.cu.OverrideCampaignModeration(ctx, params.CampaignId, models.ModerationStatus(req.GetModerationStatus()))
+	if err != nil {
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidModeration) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return &api.OverrideCampaignModerationConflict{
+				Message: api.NewOptString("campaign has been changed"),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("override campaign moderation", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
 // ListCampaigns implements listCampaigns operation.
 //
 // Возвращает список рекламных кампаний для указанного
@@ -172,29 +287,73 @@ func (ch *CampaignsHandler) DeleteCampaign(ctx context.Context, params api.Delet
 //
 // GET /advertisers/{advertiserId}/campaigns
 func (ch *CampaignsHandler) ListCampaigns(ctx context.Context, params api.ListCampaignsParams) (api.ListCampaignsRes, error) {
-	paginateParams := dto.PaginationParams{
-		Size: params.Size.Or(50),
-		Page: params.Page.Or(1),
+	listParams := dto.CampaignsListParams{
+		PaginationParams: dto.PaginationParams{
+			Size: params.Size.Or(50),
+			Page: params.Page.Or(1),
+		},
+		Sort: dto.CampaignsSort(params.Sort.Or(api.ListCampaignsSortCreatedAt)),
+		Desc: params.Order.Or(api.ListCampaignsOrderDesc) == api.ListCampaignsOrderDesc,
+	}
+
+	if status, ok := params.Status.Get(); ok {
+		listParams.Filter.Status = pointer(models.CampaignStatus(status))
+	}
+	if status, ok := params.ModerationStatus.Get(); ok {
+		listParams.Filter.ModerationStatus = pointer(models.ModerationStatus(status))
+	}
+	if day, ok := params.ActiveOn.Get(); ok {
+		listParams.Filter.ActiveOn = pointer(int(day))
+	}
+	if location, ok := params.Location.Get(); ok {
+		listParams.Filter.Location = &location
+	}
+	if gender, ok := params.Gender.Get(); ok {
+		listParams.Filter.Gender = pointer(models.Gender(gender))
+	}
+	if title, ok := params.Title.Get(); ok {
+		listParams.Filter.Title = &title
+	}
+
+	if cursor, ok := params.Cursor.Get(); ok {
+		decoded, err := dto.DecodeCampaignsCursor(cursor)
+		if err != nil {
+			return &api.Response400{
+				Message: api.NewOptString("invalid cursor"),
+			}, nil
+		}
+		listParams.Cursor = &decoded
 	}
 
-	campaigns, err := ch.cu.ListCampaignsForAdvertiser(ctx, params.AdvertiserId, paginateParams)
+	page, err := ch.cu.ListCampaignsForAdvertiser(ctx, params.AdvertiserId, listParams)
 	if err != nil {
 		if errors.Is(err, models.ErrAdvertiserNotFound) {
 			return &api.Response404{
 				Resource: api.ResourceEnumAdvertiser,
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidCursor) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
 
 		logger.FromCtx(ctx).Error("list campaigns", zap.Error(err))
 		return nil, err
 	}
 
-	res := api.ListCampaignsOKApplicationJSON(make(api.ListCampaignsOKApplicationJSON, 0, len(campaigns)))
-	for _, campaign := range campaigns {
-		res = append(res, modelsCampaignToApiCampaign(campaign))
+	res := &api.ListCampaignsOKHeaders{
+		XTotalCount: api.NewOptInt(page.Total),
+		Response:    make([]api.Campaign, 0, len(page.Campaigns)),
+	}
+	if page.Next != nil {
+		res.XNextCursor = api.NewOptString(page.Next.Encode())
+	}
+	for _, campaign := range page.Campaigns {
+		res.Response = append(res.Response, modelsCampaignToApiCampaign(campaign))
 	}
 
-	return &res, nil
+	return res, nil
 }
 
 // UpdateCampaign implements updateCampaign operation.
@@ -204,57 +363,139 @@ func (ch *CampaignsHandler) ListCampaigns(ctx context.Context, params api.ListCa
 //
 // PUT /advertisers/{advertiserId}/campaigns/{campaignId}
 func (ch *CampaignsHandler) UpdateCampaign(ctx context.Context, req *api.CampaignUpdate, params api.UpdateCampaignParams) (api.UpdateCampaignRes, error) {
-	data := dto.CampaignData{
-		ImpressionsLimit:  req.GetImpressionsLimit(),
-		ClicksLimit:       req.GetClicksLimit(),
-		CostPerImpression: float64(req.GetCostPerImpression()),
-		CostPerClick:      float64(req.GetCostPerClick()),
-		AdTitle:           req.GetAdTitle(),
-		AdText:            req.GetAdText(),
-		StartDate:         int(req.GetStartDate()),
-		EndDate:           int(req.GetEndDate()),
+	data, err := apiCampaignUpdateToDtoCampaignData(req)
+	if err != nil {
+		return &api.Response400{
+			Message: api.NewOptString(err.Error()),
+		}, nil
 	}
-	if req.GetTargeting().IsSet() {
-		targeting := req.GetTargeting().Value
 
-		if targeting.GetAgeFrom().IsSet() &&
-			!targeting.GetAgeFrom().IsNull() &&
-			targeting.GetAgeTo().IsSet() &&
-			!targeting.GetAgeTo().IsNull() {
-			if targeting.GetAgeTo().Value < targeting.GetAgeFrom().Value {
-				return &api.Response400{
-					Message: api.NewOptString("age_to must be not less than age_from"),
+	var version *int
+	if ifMatch, ok := params.IfMatch.Get(); ok {
+		version, err = parseIfMatch(ifMatch)
+		if err != nil {
+			if errors.Is(err, models.ErrVersionMismatch) {
+				return &api.UpdateCampaignPreconditionFailed{
+					Message: api.NewOptString("campaign has been changed"),
 				}, nil
 			}
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
 		}
+	}
 
-		if targeting.GetGender().IsSet() && !targeting.GetGender().IsNull() {
-			data.Gender = pointer(models.Gender((targeting.GetGender().Value)))
+	campaign, err := ch.cu.UpdateCampaign(ctx, params.AdvertiserId, params.CampaignId, data, version)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
 		}
-		if targeting.GetAgeFrom().IsSet() && !targeting.GetAgeFrom().IsNull() {
-			data.AgeFrom = pointer(int(targeting.GetAgeFrom().Value))
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
 		}
-		if targeting.GetAgeTo().IsSet() && !targeting.GetAgeTo().IsNull() {
-			data.AgeTo = pointer(int(targeting.GetAgeTo().Value))
+		if errors.Is(err, models.ErrInvalidStartDate) {
+			return &api.Response400{
+				Message: api.NewOptString("start_date must be not in past"),
+			}, nil
 		}
-		if targeting.GetLocation().IsSet() && !targeting.GetLocation().IsNull() {
-			data.Location = pointer(targeting.GetLocation().Value)
+		if errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, models.ErrCantUpdateCampaign) {
+			return &api.UpdateCampaignForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return &api.UpdateCampaignPreconditionFailed{
+				Message: api.NewOptString("campaign has been changed"),
+			}, nil
 		}
+
+		logger.FromCtx(ctx).Error("update campaign", zap.Error(err))
+		return nil, err
 	}
 
-	if req.GetClicksLimit() > req.GetImpressionsLimit() {
+	return &api.CampaignHeaders{
+		ETag:     api.NewOptString(campaignETag(campaign.Version)),
+		Response: modelsCampaignToApiCampaign(campaign),
+	}, nil
+}
+
+// PatchCampaign implements patchCampaign operation.
+//
+// Изменяет только переданные параметры рекламной
+// кампании по правилам JSON Merge Patch (RFC 7396).
+//
+// PATCH /advertisers/{advertiserId}/campaigns/{campaignId}
+func (ch *CampaignsHandler) PatchCampaign(ctx context.Context, req api.PatchCampaignReq, params api.PatchCampaignParams) (api.PatchCampaignRes, error) {
+	var version *int
+	if ifMatch, ok := params.IfMatch.Get(); ok {
+		var err error
+		version, err = parseIfMatch(ifMatch)
+		if err != nil {
+			if errors.Is(err, models.ErrVersionMismatch) {
+				return &api.PatchCampaignPreconditionFailed{
+					Message: api.NewOptString("campaign has been changed"),
+				}, nil
+			}
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+	}
+
+	campaignWas, err := ch.cu.GetCampaignById(ctx, params.AdvertiserId, params.CampaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("get campaign", zap.Error(err))
+		return nil, err
+	}
+
+	if version != nil && *version != campaignWas.Version {
+		return &api.PatchCampaignPreconditionFailed{
+			Message: api.NewOptString("campaign has been changed"),
+		}, nil
+	}
+
+	update, err := mergeCampaignPatch(campaignWas, req)
+	if err != nil {
 		return &api.Response400{
-			Message: api.NewOptString("clicks limit must be not greater than impressions_limit"),
+			Message: api.NewOptString(err.Error()),
 		}, nil
 	}
 
-	if req.GetEndDate() < req.GetStartDate() {
+	data, err := apiCampaignUpdateToDtoCampaignData(&update)
+	if err != nil {
 		return &api.Response400{
-			Message: api.NewOptString("end_date must be not less than start_date"),
+			Message: api.NewOptString(err.Error()),
 		}, nil
 	}
 
-	campaign, err := ch.cu.UpdateCampaign(ctx, params.AdvertiserId, params.CampaignId, data)
+	// costs are float32 in api, so unchanged costs keep stored precision
+	if float32(data.CostPerImpression) == float32(campaignWas.CostPerImpression) {
+		data.CostPerImpression = campaignWas.CostPerImpression
+	}
+	if float32(data.CostPerClick) == float32(campaignWas.CostPerClick) {
+		data.CostPerClick = campaignWas.CostPerClick
+	}
+
+	// patch is applied to read version, so concurrent changes are not overwritten
+	campaign, err := ch.cu.UpdateCampaign(ctx, params.AdvertiserId, params.CampaignId, data, &campaignWas.Version)
 	if err != nil {
 		if errors.Is(err, models.ErrAdvertiserNotFound) {
 			return &api.Response404{
@@ -271,16 +512,28 @@ func (ch *CampaignsHandler) UpdateCampaign(ctx context.Context, req *api.Campaig
 				Message: api.NewOptString("start_date must be not in past"),
 			}, nil
 		}
+		if errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
 		if errors.Is(err, models.ErrCantUpdateCampaign) {
-			return &api.UpdateCampaignForbidden{}, nil
+			return &api.PatchCampaignForbidden{}, nil
+		}
+		if errors.Is(err, models.ErrVersionMismatch) {
+			return &api.PatchCampaignPreconditionFailed{
+				Message: api.NewOptString("campaign has been changed"),
+			}, nil
 		}
 
-		logger.FromCtx(ctx).Error("update campaign", zap.Error(err))
+		logger.FromCtx(ctx).Error("patch campaign", zap.Error(err))
 		return nil, err
 	}
 
-	res := modelsCampaignToApiCampaign(campaign)
-	return &res, nil
+	return &api.CampaignHeaders{
+		ETag:     api.NewOptString(campaignETag(campaign.Version)),
+		Response: modelsCampaignToApiCampaign(campaign),
+	}, nil
 }
 
 // UploadCampaignImage implements uploadCampaignImage operation.
@@ -338,6 +591,244 @@ func (ch *CampaignsHandler) UploadCampaignImage(ctx context.Context, req api.Upl
 	}, nil
 }
 
+// PauseCampaign implements pauseCampaign operation.
+//
+// Переводит активную кампанию в статус PAUSED, объявления
+// кампании перестают показываться клиентам.
+//
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/pause
+func (ch *CampaignsHandler) PauseCampaign(ctx context.Context, params api.PauseCampaignParams) (api.PauseCampaignRes, error) {
+	campaign, err := ch.cu.PauseCampaign(ctx, params.AdvertiserId, params.CampaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidTransition) {
+			return &api.Response409{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("pause campaign", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
+// ResumeCampaign implements resumeCampaign operation.
+//
+// Переводит черновик или приостановленную кампанию в
+// статус ACTIVE.
+//
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/resume
+func (ch *CampaignsHandler) ResumeCampaign(ctx context.Context, params api.ResumeCampaignParams) (api.ResumeCampaignRes, error) {
+	campaign, err := ch.cu.ResumeCampaign(ctx, params.AdvertiserId, params.CampaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidTransition) {
+			return &api.Response409{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignEnded) ||
+			errors.Is(err, models.ErrImpressionsLimit) ||
+			errors.Is(err, models.ErrClicksLimit) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("resume campaign", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
+// ArchiveCampaign implements archiveCampaign operation.
+//
+// Переводит кампанию в статус ARCHIVED. Архивная кампания
+// не показывается и не изменяется, но её статистика
+// сохраняется.
+//
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/archive
+func (ch *CampaignsHandler) ArchiveCampaign(ctx context.Context, params api.ArchiveCampaignParams) (api.ArchiveCampaignRes, error) {
+	campaign, err := ch.cu.ArchiveCampaign(ctx, params.AdvertiserId, params.CampaignId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidTransition) {
+			return &api.Response409{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("archive campaign", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
+// CloneCampaign implements cloneCampaign operation.
+//
+// Создаёт новую рекламную кампанию с таргетированием,
+// текстом и изображением указанной кампании.
+//
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/clone
+func (ch *CampaignsHandler) CloneCampaign(ctx context.Context, req api.OptCampaignOverrides, params api.CloneCampaignParams) (api.CloneCampaignRes, error) {
+	campaign, err := ch.cu.CloneCampaign(ctx, params.AdvertiserId, params.CampaignId, apiCampaignOverridesToDto(req))
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+		if errors.Is(err, models.ErrCampaignNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumCampaign,
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidStartDate) {
+			return &api.Response400{
+				Message: api.NewOptString("start_date must be not in past"),
+			}, nil
+		}
+		if errors.Is(err, models.ErrInvalidCampaign) || errors.Is(err, models.ErrInvalidTargeting) {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("clone campaign", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsCampaignToApiCampaign(campaign)
+	return &res, nil
+}
+
+func apiCampaignOverridesToDto(req api.OptCampaignOverrides) dto.CampaignOverrides {
+	overrides := req.Value
+
+	res := dto.CampaignOverrides{
+		Draft: overrides.GetDraft().Or(false),
+	}
+	if overrides.GetStartDate().IsSet() {
+		res.StartDate = pointer(int(overrides.GetStartDate().Value))
+	}
+	if overrides.GetEndDate().IsSet() {
+		res.EndDate = pointer(int(overrides.GetEndDate().Value))
+	}
+	if overrides.GetImpressionsLimit().IsSet() {
+		res.ImpressionsLimit = pointer(overrides.GetImpressionsLimit().Value)
+	}
+	if overrides.GetClicksLimit().IsSet() {
+		res.ClicksLimit = pointer(overrides.GetClicksLimit().Value)
+	}
+
+	return res
+}
+
+// apiCampaignUpdateToDtoCampaignData converts and validates campaign update,
+// returned error is a message for client
+func apiCampaignUpdateToDtoCampaignData(req *api.CampaignUpdate) (dto.CampaignData, error) {
+	data := dto.CampaignData{
+		ImpressionsLimit:  req.GetImpressionsLimit(),
+		ClicksLimit:       req.GetClicksLimit(),
+		CostPerImpression: float64(req.GetCostPerImpression()),
+		CostPerClick:      float64(req.GetCostPerClick()),
+		AdTitle:           req.GetAdTitle(),
+		AdText:            req.GetAdText(),
+		StartDate:         int(req.GetStartDate()),
+		EndDate:           int(req.GetEndDate()),
+	}
+	if req.GetTargeting().IsSet() {
+		targeting := req.GetTargeting().Value
+
+		if targeting.GetAgeFrom().IsSet() &&
+			!targeting.GetAgeFrom().IsNull() &&
+			targeting.GetAgeTo().IsSet() &&
+			!targeting.GetAgeTo().IsNull() {
+			if targeting.GetAgeTo().Value < targeting.GetAgeFrom().Value {
+				return dto.CampaignData{}, errors.New("age_to must be not less than age_from")
+			}
+		}
+
+		if targeting.GetGender().IsSet() && !targeting.GetGender().IsNull() {
+			data.Gender = pointer(models.Gender((targeting.GetGender().Value)))
+		}
+		if targeting.GetAgeFrom().IsSet() && !targeting.GetAgeFrom().IsNull() {
+			data.AgeFrom = pointer(int(targeting.GetAgeFrom().Value))
+		}
+		if targeting.GetAgeTo().IsSet() && !targeting.GetAgeTo().IsNull() {
+			data.AgeTo = pointer(int(targeting.GetAgeTo().Value))
+		}
+		if targeting.GetLocation().IsSet() && !targeting.GetLocation().IsNull() {
+			data.Location = pointer(targeting.GetLocation().Value)
+		}
+		if targeting.GetLocations().IsSet() && !targeting.GetLocations().IsNull() {
+			data.Locations = targeting.GetLocations().Value
+		}
+		if targeting.GetRules().IsSet() {
+			rules, err := apiTargetingRuleToModelsTargetingRule(targeting.GetRules().Value)
+			if err != nil {
+				return dto.CampaignData{}, err
+			}
+			data.TargetingRules = &rules
+		}
+	}
+
+	if req.GetFrequencyCap().IsSet() {
+		frequencyCap := req.GetFrequencyCap().Value
+
+		data.FrequencyCapImpressions = pointer(frequencyCap.GetImpressions())
+		if frequencyCap.GetDays().IsSet() && !frequencyCap.GetDays().IsNull() {
+			data.FrequencyCapDays = pointer(frequencyCap.GetDays().Value)
+		}
+	}
+
+	data.Pacing = models.Pacing(req.GetPacing().Or(api.PacingNONE))
+
+	if req.GetClicksLimit() > req.GetImpressionsLimit() {
+		return dto.CampaignData{}, errors.New("clicks limit must be not greater than impressions_limit")
+	}
+
+	if req.GetEndDate() < req.GetStartDate() {
+		return dto.CampaignData{}, errors.New("end_date must be not less than start_date")
+	}
+
+	return data, nil
+}
+
 func modelsCampaignToApiCampaign(campaign models.Campaign) api.Campaign {
 	targetting := api.Targeting{}
 	if campaign.Gender != nil {
@@ -360,6 +851,12 @@ func modelsCampaignToApiCampaign(campaign models.Campaign) api.Campaign {
 	} else {
 		targetting.Location.SetToNull()
 	}
+	if campaign.Locations != nil {
+		targetting.Locations = api.NewOptNilStringArray(campaign.Locations)
+	}
+	if campaign.TargetingRules != nil {
+		targetting.Rules = api.NewOptTargetingRule(modelsTargetingRuleToApiTargetingRule(*campaign.TargetingRules))
+	}
 
 	res := api.Campaign{
 		CampaignID:        campaign.Id,
@@ -373,15 +870,198 @@ func modelsCampaignToApiCampaign(campaign models.Campaign) api.Campaign {
 		StartDate:         api.Date(campaign.StartDate),
 		EndDate:           api.Date(campaign.EndDate),
 		Targeting:         targetting,
+		Pacing:            api.Pacing(campaign.Pacing),
+		Status:            api.CampaignStatus(campaign.Status),
+		ModerationStatus:  api.ModerationStatusAPPROVED,
+		FlaggedPhrases:    campaign.FlaggedPhrases,
+	}
+
+	// versions saved before moderation have no verdict, such campaigns are approved
+	if campaign.ModerationStatus != "" {
+		res.ModerationStatus = api.ModerationStatus(campaign.ModerationStatus)
+	}
+	if res.FlaggedPhrases == nil {
+		res.FlaggedPhrases = []string{}
 	}
 
 	if campaign.AdImageUrl != nil {
 		res.AdImageURL = api.NewOptString(*campaign.AdImageUrl)
 	}
 
+	if campaign.FrequencyCapImpressions != nil {
+		frequencyCap := api.FrequencyCap{
+			Impressions: *campaign.FrequencyCapImpressions,
+		}
+		if campaign.FrequencyCapDays != nil {
+			frequencyCap.Days = api.NewOptNilInt(*campaign.FrequencyCapDays)
+		} else {
+			frequencyCap.Days.SetToNull()
+		}
+		res.FrequencyCap = api.NewOptFrequencyCap(frequencyCap)
+	}
+
+	return res
+}
+
+func apiTargetingRuleToModelsTargetingRule(rule api.TargetingRule) (models.TargetingRule, error) {
+	res := models.TargetingRule{
+		Negate:    rule.GetNegate().Or(false),
+		Attribute: rule.GetAttribute().Or(""),
+		Op:        models.TargetingRuleOp(rule.GetOp().Or("")),
+	}
+
+	for _, nested := range rule.GetAnd() {
+		data, err := apiTargetingRuleToModelsTargetingRule(nested)
+		if err != nil {
+			return models.TargetingRule{}, err
+		}
+		res.And = append(res.And, data)
+	}
+	for _, nested := range rule.GetOr() {
+		data, err := apiTargetingRuleToModelsTargetingRule(nested)
+		if err != nil {
+			return models.TargetingRule{}, err
+		}
+		res.Or = append(res.Or, data)
+	}
+
+	if len(rule.GetValue()) != 0 {
+		if err := json.Unmarshal(rule.GetValue(), &res.Operand); err != nil {
+			return models.TargetingRule{}, fmt.Errorf("rule value: %w", err)
+		}
+	}
+	for _, raw := range rule.GetValues() {
+		var operand any
+		if err := json.Unmarshal(raw, &operand); err != nil {
+			return models.TargetingRule{}, fmt.Errorf("rule values: %w", err)
+		}
+		res.Operands = append(res.Operands, operand)
+	}
+
+	return res, nil
+}
+
+func modelsTargetingRuleToApiTargetingRule(rule models.TargetingRule) api.TargetingRule {
+	res := api.TargetingRule{}
+	for _, nested := range rule.And {
+		res.And = append(res.And, modelsTargetingRuleToApiTargetingRule(nested))
+	}
+	for _, nested := range rule.Or {
+		res.Or = append(res.Or, modelsTargetingRuleToApiTargetingRule(nested))
+	}
+	if rule.Negate {
+		res.Negate = api.NewOptBool(true)
+	}
+	if rule.Attribute != "" {
+		res.Attribute = api.NewOptString(rule.Attribute)
+	}
+	if rule.Op != "" {
+		res.Op = api.NewOptTargetingRuleOp(api.TargetingRuleOp(rule.Op))
+	}
+	if rule.Operand != nil {
+		res.Value, _ = json.Marshal(rule.Operand)
+	}
+	for _, operand := range rule.Operands {
+		raw, _ := json.Marshal(operand)
+		res.Values = append(res.Values, raw)
+	}
+
 	return res
 }
 
+// mergeCampaignPatch applies JSON Merge Patch to campaign update fields of campaign,
+// returned error is a message for client
+func mergeCampaignPatch(campaign models.Campaign, patch api.PatchCampaignReq) (api.CampaignUpdate, error) {
+	apiCampaign := modelsCampaignToApiCampaign(campaign)
+	current, err := apiCampaign.MarshalJSON()
+	if err != nil {
+		return api.CampaignUpdate{}, err
+	}
+
+	var target any
+	if err := json.Unmarshal(current, &target); err != nil {
+		return api.CampaignUpdate{}, err
+	}
+
+	patchObject := make(map[string]any, len(patch))
+	for field, raw := range patch {
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return api.CampaignUpdate{}, fmt.Errorf("invalid value of %s: %w", field, err)
+		}
+		patchObject[field] = value
+	}
+
+	merged, err := json.Marshal(mergePatch(target, patchObject))
+	if err != nil {
+		return api.CampaignUpdate{}, err
+	}
+
+	var update api.CampaignUpdate
+	if err := update.UnmarshalJSON(merged); err != nil {
+		return api.CampaignUpdate{}, err
+	}
+	if err := update.Validate(); err != nil {
+		return api.CampaignUpdate{}, err
+	}
+
+	return update, nil
+}
+
+// mergePatch implements MergePatch function of RFC 7396
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for field, value := range patchObject {
+		if value == nil {
+			delete(targetObject, field)
+			continue
+		}
+		targetObject[field] = mergePatch(targetObject[field], value)
+	}
+
+	return targetObject
+}
+
+// campaignETag returns strong entity tag of campaign version
+func campaignETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseIfMatch returns campaign version from If-Match header, nil version matches any version.
+// Weak entity tags never match, because If-Match uses strong comparison
+func parseIfMatch(header string) (*int, error) {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return nil, nil
+	}
+	if strings.HasPrefix(header, `W/"`) {
+		return nil, models.ErrVersionMismatch
+	}
+
+	errInvalid := errors.New("If-Match must be campaign ETag or *")
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return nil, errInvalid
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil {
+		return nil, errInvalid
+	}
+
+	return &version, nil
+}
+
 func pointer[T any](v T) *T {
 	return &v
 }
@@ -0,0 +1,558 @@
+package handlers
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	api "advertising/pkg/ogen/advertising-service"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxImportRows limits count of campaigns in one import file
+const maxImportRows = 10000
+
+// maxNdjsonLineSize limits size of one campaign in ndjson file
+const maxNdjsonLineSize = 1 << 20
+
+// csvListSeparator separates values of list columns in csv file
+const csvListSeparator = "|"
+
+// campaignRecordColumns are columns of campaigns csv file, ndjson objects have fields with the same names
+var campaignRecordColumns = []string{
+	"campaign_id", "impressions_limit", "clicks_limit",
+	"cost_per_impression", "cost_per_click",
+	"ad_title", "ad_text", "start_date", "end_date",
+	"gender", "age_from", "age_to", "location", "locations",
+	"frequency_cap_impressions", "frequency_cap_days",
+	"pacing", "status",
+}
+
+// campaignRecordRequiredColumns must be present in imported csv file
+var campaignRecordRequiredColumns = []string{
+	"impressions_limit", "clicks_limit",
+	"cost_per_impression", "cost_per_click",
+	"ad_title", "ad_text", "start_date", "end_date",
+}
+
+// campaignRecord is campaign in import and export files.
+// Targeting rules are not supported in files, campaign_id is ignored on import
+type campaignRecord struct {
+	CampaignId              *uuid.UUID `json:"campaign_id,omitempty"`
+	ImpressionsLimit        *int       `json:"impressions_limit"`
+	ClicksLimit             *int       `json:"clicks_limit"`
+	CostPerImpression       *float64   `json:"cost_per_impression"`
+	CostPerClick            *float64   `json:"cost_per_click"`
+	AdTitle                 *string    `json:"ad_title"`
+	AdText                  *string    `json:"ad_text"`
+	StartDate               *int       `json:"start_date"`
+	EndDate                 *int       `json:"end_date"`
+	Gender                  *string    `json:"gender"`
+	AgeFrom                 *int       `json:"age_from"`
+	AgeTo                   *int       `json:"age_to"`
+	Location                *string    `json:"location"`
+	Locations               []string   `json:"locations"`
+	FrequencyCapImpressions *int       `json:"frequency_cap_impressions"`
+	FrequencyCapDays        *int       `json:"frequency_cap_days"`
+	Pacing                  *string    `json:"pacing"`
+	Status                  *string    `json:"status"`
+}
+
+// ImportCampaigns implements importCampaigns operation.
+//
+// Создаёт рекламные кампании из файла CSV или NDJSON.
+//
+// POST /advertisers/{advertiserId}/campaigns/import
+func (ch *CampaignsHandler) ImportCampaigns(ctx context.Context, req api.ImportCampaignsReq, params api.ImportCampaignsParams) (api.ImportCampaignsRes, error) {
+	var (
+		rows []dto.CampaignImportRow
+		err  error
+	)
+	switch v := req.(type) {
+	case *api.ImportCampaignsReqTextCsv:
+		rows, err = readCampaignsCsv(v.Data)
+	case *api.ImportCampaignsReqApplicationXNdjson:
+		rows, err = readCampaignsNdjson(v.Data)
+	}
+	if err != nil {
+		return &api.Response400{
+			Message: api.NewOptString(err.Error()),
+		}, nil
+	}
+
+	report, err := ch.cu.ImportCampaigns(ctx, params.AdvertiserId, rows, params.Atomic.Or(false))
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("import campaigns", zap.Error(err))
+		return nil, err
+	}
+
+	res := &api.CampaignsImportReport{
+		Created: report.Created,
+		Failed:  report.Failed,
+		Rows:    make([]api.CampaignImportRow, 0, len(report.Results)),
+	}
+	for _, result := range report.Results {
+		row := api.CampaignImportRow{
+			Row: result.Row,
+		}
+		if result.CampaignId != nil {
+			row.CampaignID = api.NewOptUUID(*result.CampaignId)
+		}
+		if result.Err != nil {
+			row.Error = api.NewOptString(campaignImportErrorMessage(result.Err))
+		}
+		res.Rows = append(res.Rows, row)
+	}
+
+	return res, nil
+}
+
+// ExportCampaigns implements exportCampaigns operation.
+//
+// Возвращает все рекламные кампании рекламодателя в
+// порядке создания в формате CSV или NDJSON.
+//
+// GET /advertisers/{advertiserId}/campaigns/export
+func (ch *CampaignsHandler) ExportCampaigns(ctx context.Context, params api.ExportCampaignsParams) (api.ExportCampaignsRes, error) {
+	campaigns, err := ch.cu.ExportCampaigns(ctx, params.AdvertiserId)
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("export campaigns", zap.Error(err))
+		return nil, err
+	}
+
+	format := params.Format.Or(api.ExportCampaignsFormatCsv)
+
+	// campaigns are written to response while they are loaded
+	pr, pw := io.Pipe()
+	stop := context.AfterFunc(ctx, func() {
+		pr.CloseWithError(ctx.Err())
+	})
+	go func() {
+		defer stop()
+
+		err := writeCampaigns(pw, format, campaigns)
+		if err != nil {
+			logger.FromCtx(ctx).Error("write exported campaigns", zap.Error(err))
+		}
+		pw.CloseWithError(err)
+	}()
+
+	if format == api.ExportCampaignsFormatNdjson {
+		return &api.ExportCampaignsOKApplicationXNdjson{Data: pr}, nil
+	}
+	return &api.ExportCampaignsOKTextCsv{Data: pr}, nil
+}
+
+func campaignImportErrorMessage(err error) string {
+	if errors.Is(err, models.ErrInvalidStartDate) {
+		return "start_date must be not in past"
+	}
+	return err.Error()
+}
+
+func readCampaignsCsv(r io.Reader) ([]dto.CampaignImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv header is missing")
+		}
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+		if !slices.Contains(campaignRecordColumns, column) {
+			return nil, fmt.Errorf("unknown csv column %q", column)
+		}
+		columns[column] = i
+	}
+	for _, column := range campaignRecordRequiredColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("csv column %q is missing", column)
+		}
+	}
+	reader.FieldsPerRecord = len(header)
+
+	rows := []dto.CampaignImportRow{}
+	for {
+		values, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		row := dto.CampaignImportRow{Row: len(rows) + 1}
+		if row.Row > maxImportRows {
+			return nil, fmt.Errorf("file must contain at most %d campaigns", maxImportRows)
+		}
+
+		var parseErr *csv.ParseError
+		switch {
+		case errors.As(err, &parseErr):
+			row.Err = parseErr.Err
+		case err != nil:
+			return nil, fmt.Errorf("read csv: %w", err)
+		default:
+			record, err := campaignRecordFromCsv(columns, values)
+			if err != nil {
+				row.Err = err
+			} else {
+				row.Data, row.Err = campaignRecordToDtoCampaignData(record)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func readCampaignsNdjson(r io.Reader) ([]dto.CampaignImportRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNdjsonLineSize)
+
+	rows := []dto.CampaignImportRow{}
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		row := dto.CampaignImportRow{Row: len(rows) + 1}
+		if row.Row > maxImportRows {
+			return nil, fmt.Errorf("file must contain at most %d campaigns", maxImportRows)
+		}
+
+		var record campaignRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			row.Err = fmt.Errorf("invalid json: %w", err)
+		} else {
+			row.Data, row.Err = campaignRecordToDtoCampaignData(record)
+		}
+
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ndjson: %w", err)
+	}
+
+	return rows, nil
+}
+
+func writeCampaigns(w io.Writer, format api.ExportCampaignsFormat, campaigns iter.Seq2[models.Campaign, error]) error {
+	if format == api.ExportCampaignsFormatNdjson {
+		encoder := json.NewEncoder(w)
+		for campaign, err := range campaigns {
+			if err != nil {
+				return err
+			}
+			if err := encoder.Encode(modelsCampaignToCampaignRecord(campaign)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(campaignRecordColumns); err != nil {
+		return err
+	}
+	for campaign, err := range campaigns {
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(modelsCampaignToCampaignRecord(campaign).csvValues()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// campaignRecordToDtoCampaignData converts and validates campaign record,
+// returned error is a message for client
+func campaignRecordToDtoCampaignData(record campaignRecord) (dto.CampaignData, error) {
+	required := []struct {
+		column string
+		set    bool
+	}{
+		{"impressions_limit", record.ImpressionsLimit != nil},
+		{"clicks_limit", record.ClicksLimit != nil},
+		{"cost_per_impression", record.CostPerImpression != nil},
+		{"cost_per_click", record.CostPerClick != nil},
+		{"ad_title", record.AdTitle != nil},
+		{"ad_text", record.AdText != nil},
+		{"start_date", record.StartDate != nil},
+		{"end_date", record.EndDate != nil},
+	}
+	for _, field := range required {
+		if !field.set {
+			return dto.CampaignData{}, fmt.Errorf("%s is required", field.column)
+		}
+	}
+
+	nonNegative := []struct {
+		column string
+		value  float64
+	}{
+		{"impressions_limit", float64(*record.ImpressionsLimit)},
+		{"clicks_limit", float64(*record.ClicksLimit)},
+		{"cost_per_impression", *record.CostPerImpression},
+		{"cost_per_click", *record.CostPerClick},
+		{"start_date", float64(*record.StartDate)},
+		{"end_date", float64(*record.EndDate)},
+	}
+	if record.AgeFrom != nil {
+		nonNegative = append(nonNegative, struct {
+			column string
+			value  float64
+		}{"age_from", float64(*record.AgeFrom)})
+	}
+	if record.AgeTo != nil {
+		nonNegative = append(nonNegative, struct {
+			column string
+			value  float64
+		}{"age_to", float64(*record.AgeTo)})
+	}
+	for _, field := range nonNegative {
+		if field.value < 0 {
+			return dto.CampaignData{}, fmt.Errorf("%s must be not negative", field.column)
+		}
+	}
+
+	data := dto.CampaignData{
+		ImpressionsLimit:  *record.ImpressionsLimit,
+		ClicksLimit:       *record.ClicksLimit,
+		CostPerImpression: *record.CostPerImpression,
+		CostPerClick:      *record.CostPerClick,
+		AdTitle:           *record.AdTitle,
+		AdText:            *record.AdText,
+		StartDate:         *record.StartDate,
+		EndDate:           *record.EndDate,
+		AgeFrom:           record.AgeFrom,
+		AgeTo:             record.AgeTo,
+		Location:          record.Location,
+		Locations:         record.Locations,
+		Pacing:            models.PacingNone,
+		Status:            models.CampaignStatusActive,
+	}
+
+	if record.Gender != nil {
+		gender := models.Gender(*record.Gender)
+		if !slices.Contains([]models.Gender{models.GenderMale, models.GenderFemale, models.GenderAll}, gender) {
+			return dto.CampaignData{}, errors.New("gender must be one of MALE, FEMALE, ALL")
+		}
+		data.Gender = &gender
+	}
+
+	if record.FrequencyCapImpressions != nil {
+		if *record.FrequencyCapImpressions < 1 {
+			return dto.CampaignData{}, errors.New("frequency_cap_impressions must be positive")
+		}
+		data.FrequencyCapImpressions = record.FrequencyCapImpressions
+	}
+	if record.FrequencyCapDays != nil {
+		if record.FrequencyCapImpressions == nil {
+			return dto.CampaignData{}, errors.New("frequency_cap_days requires frequency_cap_impressions")
+		}
+		if *record.FrequencyCapDays < 1 {
+			return dto.CampaignData{}, errors.New("frequency_cap_days must be positive")
+		}
+		data.FrequencyCapDays = record.FrequencyCapDays
+	}
+
+	if record.Pacing != nil {
+		pacing := models.Pacing(*record.Pacing)
+		if !slices.Contains([]models.Pacing{models.PacingNone, models.PacingEven, models.PacingFrontLoaded}, pacing) {
+			return dto.CampaignData{}, errors.New("pacing must be one of NONE, EVEN, FRONT_LOADED")
+		}
+		data.Pacing = pacing
+	}
+
+	if record.Status != nil {
+		status := models.CampaignStatus(*record.Status)
+		if status != models.CampaignStatusActive && status != models.CampaignStatusDraft {
+			return dto.CampaignData{}, errors.New("status must be one of ACTIVE, DRAFT")
+		}
+		data.Status = status
+	}
+
+	return data, nil
+}
+
+func modelsCampaignToCampaignRecord(campaign models.Campaign) campaignRecord {
+	record := campaignRecord{
+		CampaignId:              &campaign.Id,
+		ImpressionsLimit:        &campaign.ImpressionsLimit,
+		ClicksLimit:             &campaign.ClicksLimit,
+		CostPerImpression:       &campaign.CostPerImpression,
+		CostPerClick:            &campaign.CostPerClick,
+		AdTitle:                 &campaign.AdTitle,
+		AdText:                  &campaign.AdText,
+		StartDate:               &campaign.StartDate,
+		EndDate:                 &campaign.EndDate,
+		AgeFrom:                 campaign.AgeFrom,
+		AgeTo:                   campaign.AgeTo,
+		Location:                campaign.Location,
+		Locations:               campaign.Locations,
+		FrequencyCapImpressions: campaign.FrequencyCapImpressions,
+		FrequencyCapDays:        campaign.FrequencyCapDays,
+		Pacing:                  pointer(string(campaign.Pacing)),
+		Status:                  pointer(string(campaign.Status)),
+	}
+	if campaign.Gender != nil {
+		record.Gender = pointer(string(*campaign.Gender))
+	}
+
+	return record
+}
+
+// campaignRecordFromCsv parses csv row, empty values of optional columns are treated as absent
+func campaignRecordFromCsv(columns map[string]int, values []string) (campaignRecord, error) {
+	var (
+		record campaignRecord
+		err    error
+	)
+
+	value := func(column string) (string, bool) {
+		i, ok := columns[column]
+		if !ok {
+			return "", false
+		}
+		return values[i], true
+	}
+	parseInt := func(column string) *int {
+		v, ok := value(column)
+		if !ok || v == "" || err != nil {
+			return nil
+		}
+		n, parseErr := strconv.Atoi(strings.TrimSpace(v))
+		if parseErr != nil {
+			err = fmt.Errorf("%s must be integer", column)
+			return nil
+		}
+		return &n
+	}
+	parseFloat := func(column string) *float64 {
+		v, ok := value(column)
+		if !ok || v == "" || err != nil {
+			return nil
+		}
+		n, parseErr := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if parseErr != nil {
+			err = fmt.Errorf("%s must be number", column)
+			return nil
+		}
+		return &n
+	}
+	parseString := func(column string, required bool) *string {
+		v, ok := value(column)
+		if !ok || (v == "" && !required) {
+			return nil
+		}
+		return &v
+	}
+
+	record.ImpressionsLimit = parseInt("impressions_limit")
+	record.ClicksLimit = parseInt("clicks_limit")
+	record.CostPerImpression = parseFloat("cost_per_impression")
+	record.CostPerClick = parseFloat("cost_per_click")
+	record.AdTitle = parseString("ad_title", true)
+	record.AdText = parseString("ad_text", true)
+	record.StartDate = parseInt("start_date")
+	record.EndDate = parseInt("end_date")
+	record.Gender = parseString("gender", false)
+	record.AgeFrom = parseInt("age_from")
+	record.AgeTo = parseInt("age_to")
+	record.Location = parseString("location", false)
+	if locations := parseString("locations", false); locations != nil {
+		record.Locations = strings.Split(*locations, csvListSeparator)
+	}
+	record.FrequencyCapImpressions = parseInt("frequency_cap_impressions")
+	record.FrequencyCapDays = parseInt("frequency_cap_days")
+	record.Pacing = parseString("pacing", false)
+	record.Status = parseString("status", false)
+
+	if err != nil {
+		return campaignRecord{}, err
+	}
+
+	return record, nil
+}
+
+// csvValues returns values of record in order of campaignRecordColumns
+func (r campaignRecord) csvValues() []string {
+	formatInt := func(v *int) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.Itoa(*v)
+	}
+	formatFloat := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+	formatString := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+
+	campaignId := ""
+	if r.CampaignId != nil {
+		campaignId = r.CampaignId.String()
+	}
+
+	return []string{
+		campaignId,
+		formatInt(r.ImpressionsLimit),
+		formatInt(r.ClicksLimit),
+		formatFloat(r.CostPerImpression),
+		formatFloat(r.CostPerClick),
+		formatString(r.AdTitle),
+		formatString(r.AdText),
+		formatInt(r.StartDate),
+		formatInt(r.EndDate),
+		formatString(r.Gender),
+		formatInt(r.AgeFrom),
+		formatInt(r.AgeTo),
+		formatString(r.Location),
+		strings.Join(r.Locations, csvListSeparator),
+		formatInt(r.FrequencyCapImpressions),
+		formatInt(r.FrequencyCapDays),
+		formatString(r.Pacing),
+		formatString(r.Status),
+	}
+}
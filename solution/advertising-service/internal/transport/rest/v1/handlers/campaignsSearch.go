@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	api "advertising/pkg/ogen/advertising-service"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// SearchAdvertiserCampaigns implements searchAdvertiserCampaigns operation.
+//
+// Ищет кампании рекламодателя по словам в названии и
+// тексте объявления.
+//
+// GET /advertisers/{advertiserId}/campaigns/search
+func (ch *CampaignsHandler) SearchAdvertiserCampaigns(ctx context.Context, params api.SearchAdvertiserCampaignsParams) (api.SearchAdvertiserCampaignsRes, error) {
+	page, err := ch.cu.SearchCampaigns(ctx, dto.CampaignsSearchParams{
+		PaginationParams: dto.PaginationParams{
+			Size: params.Size.Or(50),
+			Page: params.Page.Or(1),
+		},
+		Query:        params.Q,
+		AdvertiserId: &params.AdvertiserId,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrAdvertiserNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumAdvertiser,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("search advertiser campaigns", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.SearchAdvertiserCampaignsOKHeaders{
+		XTotalCount: api.NewOptInt(page.Total),
+		Response:    modelsCampaignSearchResultsToApi(page.Results),
+	}, nil
+}
+
+// SearchCampaigns implements searchCampaigns operation.
+//
+// Ищет кампании всех рекламодателей по словам в
+// названии и тексте объявления.
+//
+// GET /admin/campaigns/search
+func (ch *CampaignsHandler) SearchCampaigns(ctx context.Context, params api.SearchCampaignsParams) (api.SearchCampaignsRes, error) {
+	page, err := ch.cu.SearchCampaigns(ctx, dto.CampaignsSearchParams{
+		PaginationParams: dto.PaginationParams{
+			Size: params.Size.Or(50),
+			Page: params.Page.Or(1),
+		},
+		Query: params.Q,
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("search campaigns", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.SearchCampaignsOKHeaders{
+		XTotalCount: api.NewOptInt(page.Total),
+		Response:    modelsCampaignSearchResultsToApi(page.Results),
+	}, nil
+}
+
+func modelsCampaignSearchResultsToApi(results []models.CampaignSearchResult) []api.CampaignSearchResult {
+	res := make([]api.CampaignSearchResult, 0, len(results))
+	for _, result := range results {
+		res = append(res, api.CampaignSearchResult{
+			Campaign:       modelsCampaignToApiCampaign(result.Campaign),
+			Rank:           result.Rank,
+			TitleHighlight: result.TitleHighlight,
+			TextHighlight:  result.TextHighlight,
+		})
+	}
+
+	return res
+}
@@ -5,7 +5,9 @@ import (
 	"advertising/pkg/logger"
 	api "advertising/pkg/ogen/advertising-service"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -14,6 +16,8 @@ import (
 type ClientsUsecase interface {
 	GetClientById(ctx context.Context, id uuid.UUID) (models.Client, error)
 	UpsertClients(ctx context.Context, clients []models.Client) ([]models.Client, error)
+	ExportClientData(ctx context.Context, id uuid.UUID) (models.ClientData, error)
+	EraseClient(ctx context.Context, id uuid.UUID) error
 }
 
 type ClientsHandler struct {
@@ -56,7 +60,13 @@ func (ch *ClientsHandler) GetClientById(ctx context.Context, params api.GetClien
 func (ch *ClientsHandler) UpsertClients(ctx context.Context, req []api.ClientUpsert) (api.UpsertClientsRes, error) {
 	clients := make([]models.Client, 0, len(req))
 	for _, client := range req {
-		clients = append(clients, apiClientUpsertToModelsClient(client))
+		data, err := apiClientUpsertToModelsClient(client)
+		if err != nil {
+			return &api.Response400{
+				Message: api.NewOptString(err.Error()),
+			}, nil
+		}
+		clients = append(clients, data)
 	}
 
 	clientsGot, err := ch.cu.UpsertClients(ctx, clients)
@@ -72,22 +82,147 @@ func (ch *ClientsHandler) UpsertClients(ctx context.Context, req []api.ClientUps
 	return &res, nil
 }
 
+// ExportClientData implements exportClientData operation.
+//
+// Возвращает все хранящиеся данные о клиенте - профиль, ML
+// скоры, показы и переходы.
+//
+// GET /clients/{clientId}/export
+func (ch *ClientsHandler) ExportClientData(ctx context.Context, params api.ExportClientDataParams) (api.ExportClientDataRes, error) {
+	data, err := ch.cu.ExportClientData(ctx, params.ClientId)
+	if err != nil {
+		if errors.Is(err, models.ErrClientNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumClient,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("export client data", zap.Error(err))
+		return nil, err
+	}
+
+	res := modelsClientDataToApiClientDataExport(data)
+	return &res, nil
+}
+
+// EraseClient implements eraseClient operation.
+//
+// Удаляет клиента и его ML скоры, показы и переходы
+// клиента обезличиваются.
+//
+// DELETE /clients/{clientId}
+func (ch *ClientsHandler) EraseClient(ctx context.Context, params api.EraseClientParams) (api.EraseClientRes, error) {
+	if err := ch.cu.EraseClient(ctx, params.ClientId); err != nil {
+		if errors.Is(err, models.ErrClientNotFound) {
+			return &api.Response404{
+				Resource: api.ResourceEnumClient,
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("erase client", zap.Error(err))
+		return nil, err
+	}
+
+	return &api.EraseClientNoContent{}, nil
+}
+
+func modelsClientDataToApiClientDataExport(data models.ClientData) api.ClientDataExport {
+	res := api.ClientDataExport{
+		Client:      modelsClientToApiClientModel(data.Client),
+		MlScores:    make([]api.MLScore, 0, len(data.MLScores)),
+		Impressions: make([]api.ClientImpression, 0, len(data.Impressions)),
+		Clicks:      make([]api.ClientClick, 0, len(data.Clicks)),
+	}
+
+	for _, mlScore := range data.MLScores {
+		res.MlScores = append(res.MlScores, api.MLScore{
+			ClientID:     mlScore.ClientId,
+			AdvertiserID: mlScore.AdvertiserId,
+			Score:        mlScore.Score,
+		})
+	}
+	for _, impression := range data.Impressions {
+		res.Impressions = append(res.Impressions, api.ClientImpression{
+			ImpressionID: impression.Id,
+			CampaignID:   impression.CampaignId,
+			Date:         impression.Date,
+			Price:        impression.Profit,
+			ClickPrice:   impression.ClickPrice,
+			Explored:     impression.Explored,
+		})
+	}
+	for _, click := range data.Clicks {
+		res.Clicks = append(res.Clicks, api.ClientClick{
+			ImpressionID: click.ImpressionId,
+			CampaignID:   click.CampaignId,
+			Date:         click.Date,
+			Price:        click.Profit,
+		})
+	}
+
+	return res
+}
+
 func modelsClientToApiClientModel(client models.Client) api.ClientModel {
-	return api.ClientModel{
+	res := api.ClientModel{
 		ClientID: client.Id,
 		Login:    client.Login,
 		Age:      client.Age,
 		Location: client.Location,
 		Gender:   api.ClientModelGender(client.Gender),
 	}
+	if len(client.Attributes) != 0 {
+		attributes := make(api.ClientAttributes, len(client.Attributes))
+		for name, value := range client.Attributes {
+			raw, _ := json.Marshal(value)
+			attributes[name] = raw
+		}
+		res.Attributes = api.NewOptClientAttributes(attributes)
+	}
+
+	return res
 }
 
-func apiClientUpsertToModelsClient(client api.ClientUpsert) models.Client {
-	return models.Client{
+func apiClientUpsertToModelsClient(client api.ClientUpsert) (models.Client, error) {
+	res := models.Client{
 		Id:       client.GetClientID(),
 		Login:    client.GetLogin(),
 		Age:      int(client.GetAge()),
 		Location: client.GetLocation(),
 		Gender:   models.Gender(client.GetGender()),
 	}
+
+	if client.GetAttributes().IsSet() && len(client.GetAttributes().Value) != 0 {
+		res.Attributes = make(models.ClientAttributes, len(client.GetAttributes().Value))
+		for name, raw := range client.GetAttributes().Value {
+			var value any
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return models.Client{}, fmt.Errorf("attribute %q: %w", name, err)
+			}
+			if !isAttributeValue(value) {
+				return models.Client{}, fmt.Errorf("attribute %q must be string, number, boolean or array of them", name)
+			}
+			res.Attributes[name] = value
+		}
+	}
+
+	return res, nil
+}
+
+// isAttributeValue reports whether value is string, number, boolean or array of them
+func isAttributeValue(value any) bool {
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+
+	for _, value := range values {
+		switch value.(type) {
+		case string, float64, bool:
+		default:
+			return false
+		}
+	}
+
+	return true
 }
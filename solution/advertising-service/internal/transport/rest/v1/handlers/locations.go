@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	api "advertising/pkg/ogen/advertising-service"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+type LocationsUsecase interface {
+	ListLocations(ctx context.Context) ([]models.Location, error)
+	UpsertLocations(ctx context.Context, locations []models.Location) ([]models.Location, error)
+}
+
+type LocationsHandler struct {
+	lu LocationsUsecase
+}
+
+func NewLocationsHandler(lu LocationsUsecase) *LocationsHandler {
+	return &LocationsHandler{
+		lu: lu,
+	}
+}
+
+// ListLocations implements listLocations operation.
+//
+// Возвращает все локации справочника.
+//
+// GET /locations
+func (lh *LocationsHandler) ListLocations(ctx context.Context) ([]api.Location, error) {
+	locations, err := lh.lu.ListLocations(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("list locations", zap.Error(err))
+		return nil, err
+	}
+
+	res := make([]api.Location, 0, len(locations))
+	for _, location := range locations {
+		res = append(res, modelsLocationToApiLocation(location))
+	}
+
+	return res, nil
+}
+
+// UpsertLocations implements upsertLocations operation.
+//
+// Создаёт новые или обновляет существующие локации справочника.
+//
+// POST /locations/bulk
+func (lh *LocationsHandler) UpsertLocations(ctx context.Context, req []api.Location) (api.UpsertLocationsRes, error) {
+	locations := make([]models.Location, 0, len(req))
+	for _, location := range req {
+		data := apiLocationToModelsLocation(location)
+
+		if data.Parent != nil && data.Type == models.LocationTypeCountry {
+			return &api.Response400{
+				Message: api.NewOptString(fmt.Sprintf("country %q can`t have parent", data.Name)),
+			}, nil
+		}
+		if data.Parent != nil && *data.Parent == data.Name {
+			return &api.Response400{
+				Message: api.NewOptString(fmt.Sprintf("location %q can`t be parent of itself", data.Name)),
+			}, nil
+		}
+
+		locations = append(locations, data)
+	}
+
+	locationsGot, err := lh.lu.UpsertLocations(ctx, locations)
+	if err != nil {
+		if errors.Is(err, models.ErrLocationNotFound) {
+			return &api.Response400{
+				Message: api.NewOptString("parent location not found"),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("upsert locations", zap.Error(err))
+		return nil, err
+	}
+
+	res := api.UpsertLocationsCreatedApplicationJSON(make([]api.Location, 0, len(locationsGot)))
+	for _, location := range locationsGot {
+		res = append(res, modelsLocationToApiLocation(location))
+	}
+
+	return &res, nil
+}
+
+func modelsLocationToApiLocation(location models.Location) api.Location {
+	res := api.Location{
+		Name: location.Name,
+		Type: api.LocationType(location.Type),
+	}
+	if location.Parent != nil {
+		res.Parent = api.NewOptNilString(*location.Parent)
+	} else {
+		res.Parent.SetToNull()
+	}
+
+	return res
+}
+
+func apiLocationToModelsLocation(location api.Location) models.Location {
+	res := models.Location{
+		Name: location.GetName(),
+		Type: models.LocationType(location.GetType()),
+	}
+	if location.GetParent().IsSet() && !location.GetParent().IsNull() {
+		res.Parent = pointer(location.GetParent().Value)
+	}
+
+	return res
+}
@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"advertising/advertising-service/internal/dto"
+	"advertising/advertising-service/internal/models"
+	"advertising/pkg/logger"
+	api "advertising/pkg/ogen/advertising-service"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// mlScoreRecordColumns are columns of ml scores csv file, all of them are required
+var mlScoreRecordColumns = []string{"client_id", "advertiser_id", "score"}
+
+// mlScoreRecord is ml score in ndjson load file
+type mlScoreRecord struct {
+	ClientId     *uuid.UUID `json:"client_id"`
+	AdvertiserId *uuid.UUID `json:"advertiser_id"`
+	Score        *int       `json:"score"`
+}
+
+// LoadMLScores implements loadMLScores operation.
+//
+// Добавляет или обновляет ML скоры из файла CSV или
+// NDJSON, файл может быть сжат gzip.
+//
+// POST /ml-scores/bulk
+func (ah *AdvertisersHandler) LoadMLScores(ctx context.Context, req api.LoadMLScoresReq) (api.LoadMLScoresRes, error) {
+	var rows iter.Seq2[dto.MLScoreLoadRow, error]
+	switch v := req.(type) {
+	case *api.LoadMLScoresReqTextCsv:
+		rows = readMLScoresCsv(v.Data)
+	case *api.LoadMLScoresReqApplicationXNdjson:
+		rows = readMLScoresNdjson(v.Data)
+	}
+
+	// file is read while it is loaded, so broken file is known only after loading fails
+	var fileErr error
+	report, err := ah.au.LoadMLScores(ctx, func(yield func(dto.MLScoreLoadRow, error) bool) {
+		for row, err := range rows {
+			if err != nil {
+				fileErr = err
+			}
+			if !yield(row, err) {
+				return
+			}
+		}
+	})
+	if err != nil {
+		if fileErr != nil {
+			return &api.Response400{
+				Message: api.NewOptString(fileErr.Error()),
+			}, nil
+		}
+
+		logger.FromCtx(ctx).Error("load ml scores", zap.Error(err))
+		return nil, err
+	}
+
+	res := &api.MLScoresLoadReport{
+		Loaded: report.Loaded,
+		Failed: report.Failed,
+		Errors: make([]api.MLScoreLoadError, 0, len(report.Errors)),
+	}
+	for _, loadErr := range report.Errors {
+		res.Errors = append(res.Errors, api.MLScoreLoadError{
+			Row:   loadErr.Row,
+			Error: loadErr.Err.Error(),
+		})
+	}
+
+	return res, nil
+}
+
+// decompressed returns reader of data decompressed by gzip if it has gzip header, other data is read as is
+func decompressed(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("read gzip: %w", err)
+		}
+		return gr, nil
+	}
+
+	return br, nil
+}
+
+func readMLScoresCsv(r io.Reader) iter.Seq2[dto.MLScoreLoadRow, error] {
+	return func(yield func(dto.MLScoreLoadRow, error) bool) {
+		r, err := decompressed(r)
+		if err != nil {
+			yield(dto.MLScoreLoadRow{}, err)
+			return
+		}
+
+		reader := csv.NewReader(r)
+		reader.ReuseRecord = true
+
+		header, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = errors.New("csv header is missing")
+			} else {
+				err = fmt.Errorf("read csv header: %w", err)
+			}
+			yield(dto.MLScoreLoadRow{}, err)
+			return
+		}
+
+		columns := make(map[string]int, len(header))
+		for i, column := range header {
+			column = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+			if !slices.Contains(mlScoreRecordColumns, column) {
+				yield(dto.MLScoreLoadRow{}, fmt.Errorf("unknown csv column %q", column))
+				return
+			}
+			columns[column] = i
+		}
+		for _, column := range mlScoreRecordColumns {
+			if _, ok := columns[column]; !ok {
+				yield(dto.MLScoreLoadRow{}, fmt.Errorf("csv column %q is missing", column))
+				return
+			}
+		}
+		reader.FieldsPerRecord = len(header)
+
+		for rowNumber := 1; ; rowNumber++ {
+			values, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			row := dto.MLScoreLoadRow{Row: rowNumber}
+
+			var parseErr *csv.ParseError
+			switch {
+			case errors.As(err, &parseErr):
+				row.Err = parseErr.Err
+			case err != nil:
+				yield(dto.MLScoreLoadRow{}, fmt.Errorf("read csv: %w", err))
+				return
+			default:
+				row.Score, row.Err = mlScoreFromCsv(columns, values)
+			}
+
+			if !yield(row, nil) {
+				return
+			}
+		}
+	}
+}
+
+func readMLScoresNdjson(r io.Reader) iter.Seq2[dto.MLScoreLoadRow, error] {
+	return func(yield func(dto.MLScoreLoadRow, error) bool) {
+		r, err := decompressed(r)
+		if err != nil {
+			yield(dto.MLScoreLoadRow{}, err)
+			return
+		}
+
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxNdjsonLineSize)
+
+		rowNumber := 0
+		for scanner.Scan() {
+			line := bytes.TrimSpace(scanner.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+
+			rowNumber++
+			row := dto.MLScoreLoadRow{Row: rowNumber}
+
+			var record mlScoreRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				row.Err = fmt.Errorf("invalid json: %w", err)
+			} else {
+				row.Score, row.Err = mlScoreRecordToModelsMLScore(record)
+			}
+
+			if !yield(row, nil) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(dto.MLScoreLoadRow{}, fmt.Errorf("read ndjson: %w", err))
+		}
+	}
+}
+
+// mlScoreFromCsv parses csv row, returned error is a message for client
+func mlScoreFromCsv(columns map[string]int, values []string) (models.MLScore, error) {
+	var record mlScoreRecord
+
+	clientId, err := uuid.Parse(strings.TrimSpace(values[columns["client_id"]]))
+	if err != nil {
+		return models.MLScore{}, errors.New("client_id must be uuid")
+	}
+	record.ClientId = &clientId
+
+	advertiserId, err := uuid.Parse(strings.TrimSpace(values[columns["advertiser_id"]]))
+	if err != nil {
+		return models.MLScore{}, errors.New("advertiser_id must be uuid")
+	}
+	record.AdvertiserId = &advertiserId
+
+	score, err := strconv.Atoi(strings.TrimSpace(values[columns["score"]]))
+	if err != nil {
+		return models.MLScore{}, errors.New("score must be integer")
+	}
+	record.Score = &score
+
+	return mlScoreRecordToModelsMLScore(record)
+}
+
+// mlScoreRecordToModelsMLScore validates ml score record, returned error is a message for client
+func mlScoreRecordToModelsMLScore(record mlScoreRecord) (models.MLScore, error) {
+	switch {
+	case record.ClientId == nil:
+		return models.MLScore{}, errors.New("client_id is required")
+	case record.AdvertiserId == nil:
+		return models.MLScore{}, errors.New("advertiser_id is required")
+	case record.Score == nil:
+		return models.MLScore{}, errors.New("score is required")
+	case *record.Score < 0:
+		return models.MLScore{}, errors.New("score must be not negative")
+	}
+
+	return models.MLScore{
+		ClientId:     *record.ClientId,
+		AdvertiserId: *record.AdvertiserId,
+		Score:        *record.Score,
+	}, nil
+}
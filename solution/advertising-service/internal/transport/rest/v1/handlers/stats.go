@@ -12,8 +12,8 @@ import (
 )
 
 type StatsUsecase interface {
-	GetStatsForCampaign(ctx context.Context, campaignId uuid.UUID) (models.Stats, error)
-	GetStatsForCampaignDaily(ctx context.Context, campaignId uuid.UUID) ([]models.StatsDaily, error)
+	GetStatsForCampaign(ctx context.Context, campaignId uuid.UUID) (models.CampaignStats, error)
+	GetStatsForCampaignDaily(ctx context.Context, campaignId uuid.UUID) ([]models.CampaignStatsDaily, error)
 	GetStatsForAdvertiser(ctx context.Context, advertiserId uuid.UUID) (models.Stats, error)
 	GetStatsForAdvertiserDaily(ctx context.Context, advertiserId uuid.UUID) ([]models.StatsDaily, error)
 }
@@ -93,7 +93,7 @@ func (sh *StatsHandler) GetCampaignDailyStats(ctx context.Context, params api.Ge
 		return nil, err
 	}
 
-	res := api.GetCampaignDailyStatsOKApplicationJSON(modelsStatsDailyToApiDailyStats(stats))
+	res := api.GetCampaignDailyStatsOKApplicationJSON(modelsCampaignStatsDailyToApiCampaignDailyStats(stats))
 	return &res, nil
 }
 
@@ -117,7 +117,7 @@ func (sh *StatsHandler) GetCampaignStats(ctx context.Context, params api.GetCamp
 		return nil, err
 	}
 
-	res := modelsStatsToApiStats(stats)
+	res := modelsCampaignStatsToApiCampaignStats(stats)
 	return &res, nil
 }
 
@@ -129,6 +129,22 @@ func modelsStatsToApiStats(stats models.Stats) api.Stats {
 		SpentImpressions: stats.SpentImpressions,
 		SpentClicks:      stats.SpentClicks,
 		SpentTotal:       stats.SpentTotal,
+		SpentFirstPrice:  stats.SpentFirstPrice,
+		SpentSecondPrice: stats.SpentSecondPrice,
+	}
+}
+
+func modelsCampaignStatsToApiCampaignStats(stats models.CampaignStats) api.CampaignStats {
+	return api.CampaignStats{
+		ImpressionsCount:   stats.ImpressionsCount,
+		ClicksCount:        stats.ClicksCount,
+		Conversion:         stats.Conversion,
+		SpentImpressions:   stats.SpentImpressions,
+		SpentClicks:        stats.SpentClicks,
+		SpentTotal:         stats.SpentTotal,
+		SpentFirstPrice:    stats.SpentFirstPrice,
+		SpentSecondPrice:   stats.SpentSecondPrice,
+		ClicksLimitReached: stats.ClicksLimitReached,
 	}
 }
 
@@ -142,8 +158,34 @@ func modelsStatsDailyToApiDailyStats(statsDaily []models.StatsDaily) []api.Daily
 			SpentImpressions: stats.SpentImpressions,
 			SpentClicks:      stats.SpentClicks,
 			SpentTotal:       stats.SpentTotal,
+			SpentFirstPrice:  stats.SpentFirstPrice,
+			SpentSecondPrice: stats.SpentSecondPrice,
 			Date:             api.Date(stats.Date),
 		})
 	}
 	return res
 }
+
+func modelsCampaignStatsDailyToApiCampaignDailyStats(statsDaily []models.CampaignStatsDaily) []api.CampaignDailyStats {
+	res := make([]api.CampaignDailyStats, 0, len(statsDaily))
+	for _, stats := range statsDaily {
+		campaignStats := api.CampaignDailyStats{
+			ImpressionsCount: stats.ImpressionsCount,
+			ClicksCount:      stats.ClicksCount,
+			Conversion:       stats.Conversion,
+			SpentImpressions: stats.SpentImpressions,
+			SpentClicks:      stats.SpentClicks,
+			SpentTotal:       stats.SpentTotal,
+			SpentFirstPrice:  stats.SpentFirstPrice,
+			SpentSecondPrice: stats.SpentSecondPrice,
+			Date:             api.Date(stats.Date),
+		}
+		if stats.ImpressionsTarget != nil {
+			campaignStats.ImpressionsTarget = api.NewNilInt(*stats.ImpressionsTarget)
+		} else {
+			campaignStats.ImpressionsTarget.SetToNull()
+		}
+		res = append(res, campaignStats)
+	}
+	return res
+}
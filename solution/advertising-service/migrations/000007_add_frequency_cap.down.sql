@@ -0,0 +1,31 @@
+ALTER TABLE clicks
+    DROP CONSTRAINT IF EXISTS clicks_impression_id_key,
+    DROP COLUMN IF EXISTS impression_id;
+
+DELETE FROM clicks
+WHERE ctid NOT IN (
+    SELECT min(ctid)
+    FROM clicks
+    GROUP BY campaign_id, client_id
+);
+
+ALTER TABLE clicks
+    ADD CONSTRAINT clicks_campaign_id_client_id_key UNIQUE (campaign_id, client_id);
+
+DROP INDEX IF EXISTS impressions_campaign_id_client_id_date_idx;
+
+DELETE FROM impressions
+WHERE ctid NOT IN (
+    SELECT min(ctid)
+    FROM impressions
+    GROUP BY campaign_id, client_id
+);
+
+ALTER TABLE impressions
+    DROP COLUMN IF EXISTS id,
+    DROP COLUMN IF EXISTS created_at,
+    ADD CONSTRAINT impressions_campaign_id_client_id_key UNIQUE (campaign_id, client_id);
+
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS frequency_cap_impressions,
+    DROP COLUMN IF EXISTS frequency_cap_days;
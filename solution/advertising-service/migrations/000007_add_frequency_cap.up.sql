@@ -0,0 +1,25 @@
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS frequency_cap_impressions INTEGER,
+    ADD COLUMN IF NOT EXISTS frequency_cap_days INTEGER;
+
+ALTER TABLE impressions
+    ADD COLUMN IF NOT EXISTS id UUID DEFAULT (gen_random_uuid()) PRIMARY KEY,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP DEFAULT (now()),
+    DROP CONSTRAINT IF EXISTS impressions_campaign_id_client_id_key;
+
+CREATE INDEX IF NOT EXISTS impressions_campaign_id_client_id_date_idx ON impressions (campaign_id, client_id, date);
+
+ALTER TABLE clicks
+    ADD COLUMN IF NOT EXISTS impression_id UUID REFERENCES impressions(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS clicks_campaign_id_client_id_key;
+
+UPDATE clicks
+SET impression_id = impressions.id
+FROM impressions
+WHERE
+    impressions.campaign_id = clicks.campaign_id AND
+    impressions.client_id = clicks.client_id;
+
+ALTER TABLE clicks
+    ALTER COLUMN impression_id SET NOT NULL,
+    ADD CONSTRAINT clicks_impression_id_key UNIQUE (impression_id);
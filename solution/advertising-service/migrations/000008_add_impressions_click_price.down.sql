@@ -0,0 +1,2 @@
+ALTER TABLE impressions
+    DROP COLUMN IF EXISTS click_price;
@@ -0,0 +1,10 @@
+ALTER TABLE impressions
+    ADD COLUMN IF NOT EXISTS click_price DOUBLE PRECISION;
+
+UPDATE impressions
+SET click_price = campaigns.cost_per_click
+FROM campaigns
+WHERE campaigns.id = impressions.campaign_id;
+
+ALTER TABLE impressions
+    ALTER COLUMN click_price SET NOT NULL;
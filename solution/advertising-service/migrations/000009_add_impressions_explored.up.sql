@@ -0,0 +1,2 @@
+ALTER TABLE impressions
+    ADD COLUMN IF NOT EXISTS explored BOOLEAN NOT NULL DEFAULT false;
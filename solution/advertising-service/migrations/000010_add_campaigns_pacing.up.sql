@@ -0,0 +1,2 @@
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS pacing VARCHAR(31) NOT NULL DEFAULT 'EVEN';
@@ -0,0 +1,3 @@
+ALTER TABLE advertisers
+    DROP COLUMN IF EXISTS daily_budget,
+    DROP COLUMN IF EXISTS total_budget;
@@ -0,0 +1,3 @@
+ALTER TABLE advertisers
+    ADD COLUMN IF NOT EXISTS daily_budget DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS total_budget DOUBLE PRECISION;
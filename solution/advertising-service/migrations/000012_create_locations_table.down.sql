@@ -0,0 +1,4 @@
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS locations;
+
+DROP TABLE IF EXISTS locations;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS locations (
+    name TEXT PRIMARY KEY,
+    type VARCHAR(31) NOT NULL,
+    parent TEXT REFERENCES locations(name)
+);
+
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS locations TEXT[];
@@ -0,0 +1,5 @@
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS targeting_rules;
+
+ALTER TABLE clients
+    DROP COLUMN IF EXISTS attributes;
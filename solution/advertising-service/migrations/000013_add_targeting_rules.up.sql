@@ -0,0 +1,5 @@
+ALTER TABLE clients
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS targeting_rules JSONB;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS campaigns_status_idx;
+
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,4 @@
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS status VARCHAR(31) NOT NULL DEFAULT 'ACTIVE';
+
+CREATE INDEX IF NOT EXISTS campaigns_status_idx ON campaigns (status);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS campaigns_not_deleted_idx;
+
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS deleted_at;
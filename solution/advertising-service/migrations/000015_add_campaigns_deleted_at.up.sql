@@ -0,0 +1,4 @@
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS campaigns_not_deleted_idx ON campaigns (advertiser_id) WHERE deleted_at IS NULL;
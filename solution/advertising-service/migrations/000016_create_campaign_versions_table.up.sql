@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS campaign_versions (
+    campaign_id UUID NOT NULL REFERENCES campaigns(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    action VARCHAR(31) NOT NULL,
+    day INTEGER NOT NULL,
+    snapshot JSONB NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    PRIMARY KEY (campaign_id, version)
+);
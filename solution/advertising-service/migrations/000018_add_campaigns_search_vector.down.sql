@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS campaigns_search_vector_idx;
+
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS search_vector;
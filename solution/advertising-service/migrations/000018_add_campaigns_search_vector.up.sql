@@ -0,0 +1,11 @@
+-- title and text are indexed with both russian and english morphology,
+-- title matches are ranked higher than text ones
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', ad_title), 'A') ||
+        setweight(to_tsvector('english', ad_title), 'A') ||
+        setweight(to_tsvector('russian', ad_text), 'B') ||
+        setweight(to_tsvector('english', ad_text), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS campaigns_search_vector_idx ON campaigns USING GIN (search_vector);
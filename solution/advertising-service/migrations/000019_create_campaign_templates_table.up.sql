@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS campaign_templates (
+    id UUID DEFAULT (gen_random_uuid()) PRIMARY KEY,
+    advertiser_id UUID NOT NULL REFERENCES advertisers(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    campaign JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now()),
+    UNIQUE (advertiser_id, name)
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS campaigns_moderation_status_idx;
+
+ALTER TABLE campaigns
+    DROP COLUMN IF EXISTS flagged_phrases,
+    DROP COLUMN IF EXISTS moderation_status;
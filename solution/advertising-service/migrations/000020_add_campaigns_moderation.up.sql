@@ -0,0 +1,6 @@
+-- campaigns created before moderation are considered approved
+ALTER TABLE campaigns
+    ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(31) NOT NULL DEFAULT 'APPROVED',
+    ADD COLUMN IF NOT EXISTS flagged_phrases TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS campaigns_moderation_status_idx ON campaigns (moderation_status);
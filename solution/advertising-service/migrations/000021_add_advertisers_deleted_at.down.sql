@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS advertisers_name_idx;
+
+ALTER TABLE advertisers
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,4 @@
+ALTER TABLE advertisers
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS advertisers_name_idx ON advertisers (name, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+DELETE FROM clicks WHERE client_id IS NULL;
+DELETE FROM impressions WHERE client_id IS NULL;
+
+ALTER TABLE clicks
+    ALTER COLUMN client_id SET NOT NULL;
+
+ALTER TABLE impressions
+    ALTER COLUMN client_id SET NOT NULL;
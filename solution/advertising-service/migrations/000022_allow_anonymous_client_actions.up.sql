@@ -0,0 +1,6 @@
+-- impressions and clicks of erased clients are kept for advertisers stats with client_id set to NULL
+ALTER TABLE impressions
+    ALTER COLUMN client_id DROP NOT NULL;
+
+ALTER TABLE clicks
+    ALTER COLUMN client_id DROP NOT NULL;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS advertisers_daily_spend;
@@ -0,0 +1,19 @@
+-- spend counters of advertisers by day, updated with each paid impression and click
+CREATE TABLE IF NOT EXISTS advertisers_daily_spend (
+    advertiser_id UUID NOT NULL REFERENCES advertisers(id) ON DELETE CASCADE,
+    date INTEGER NOT NULL,
+    spent DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY (advertiser_id, date)
+);
+
+INSERT INTO advertisers_daily_spend (advertiser_id, date, spent)
+SELECT campaigns.advertiser_id, spends.date, sum(spends.profit)
+FROM
+(
+    SELECT campaign_id, date, profit FROM impressions
+    UNION ALL
+    SELECT campaign_id, date, profit FROM clicks
+) spends
+JOIN campaigns ON campaigns.id = spends.campaign_id
+GROUP BY campaigns.advertiser_id, spends.date
+ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+UPDATE campaigns SET moderation_status = 'PENDING_REVIEW' WHERE moderation_status = 'MODERATION_FAILED';
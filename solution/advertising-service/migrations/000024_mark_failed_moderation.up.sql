@@ -0,0 +1,2 @@
+-- before MODERATION_FAILED campaigns were held for review only when moderator failed
+UPDATE campaigns SET moderation_status = 'MODERATION_FAILED' WHERE moderation_status = 'PENDING_REVIEW';
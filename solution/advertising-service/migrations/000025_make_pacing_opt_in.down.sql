@@ -0,0 +1,4 @@
+UPDATE campaigns SET pacing = 'EVEN' WHERE pacing = 'NONE';
+
+ALTER TABLE campaigns
+    ALTER COLUMN pacing SET DEFAULT 'EVEN';
@@ -0,0 +1,5 @@
+-- pacing is opt-in: campaigns which got EVEN by default are shown without daily targets again
+ALTER TABLE campaigns
+    ALTER COLUMN pacing SET DEFAULT 'NONE';
+
+UPDATE campaigns SET pacing = 'NONE' WHERE pacing = 'EVEN';
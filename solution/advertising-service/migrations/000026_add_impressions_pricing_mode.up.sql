@@ -0,0 +1,3 @@
+-- mode of impressions recorded before is unknown, they are counted as first price ones
+ALTER TABLE impressions
+    ADD COLUMN IF NOT EXISTS pricing_mode VARCHAR(31) NOT NULL DEFAULT 'first_price';
@@ -26,6 +26,7 @@ type Invoker interface {
 	AdvertisersInvoker
 	CampaignsInvoker
 	ClientsInvoker
+	LocationsInvoker
 	StatisticsInvoker
 	TimeInvoker
 }
@@ -52,6 +53,15 @@ type AIInvoker interface {
 //
 // x-gen-operation-group: Ads
 type AdsInvoker interface {
+	// ExplainAdForClient invokes explainAdForClient operation.
+	//
+	// Возвращает все рекламные кампании с причинами, по
+	// которым они не подходят клиенту, и разбор
+	// ранжирования подходящих кампаний. Показ не
+	// записывается.
+	//
+	// GET /admin/ads/explain
+	ExplainAdForClient(ctx context.Context, params ExplainAdForClientParams) (ExplainAdForClientRes, error)
 	// GetAdForClient invokes getAdForClient operation.
 	//
 	// Возвращает рекламное объявление, подходящее для
@@ -72,16 +82,48 @@ type AdsInvoker interface {
 //
 // x-gen-operation-group: Advertisers
 type AdvertisersInvoker interface {
+	// DeleteAdvertiser invokes deleteAdvertiser operation.
+	//
+	// Удаляет рекламодателя и архивирует все его рекламные
+	// кампании. Рекламодателя с активными кампаниями
+	// удалить нельзя, их нужно сначала приостановить или
+	// архивировать. Показы и переходы кампаний сохраняются,
+	//  повторное создание рекламодателя с тем же ID
+	// восстанавливает его без восстановления кампаний.
+	//
+	// DELETE /advertisers/{advertiserId}
+	DeleteAdvertiser(ctx context.Context, params DeleteAdvertiserParams) (DeleteAdvertiserRes, error)
 	// GetAdvertiserById invokes getAdvertiserById operation.
 	//
 	// Возвращает информацию о рекламодателе по его ID.
 	//
 	// GET /advertisers/{advertiserId}
 	GetAdvertiserById(ctx context.Context, params GetAdvertiserByIdParams) (GetAdvertiserByIdRes, error)
+	// ListAdvertisers invokes listAdvertisers operation.
+	//
+	// Возвращает рекламодателей, отсортированных по имени,
+	// с пагинацией и поиском по части имени без учёта
+	// регистра. Удалённые рекламодатели не возвращаются.
+	//
+	// GET /advertisers
+	ListAdvertisers(ctx context.Context, params ListAdvertisersParams) (ListAdvertisersRes, error)
+	// LoadMLScores invokes loadMLScores operation.
+	//
+	// Добавляет или обновляет ML скоры из файла CSV или NDJSON,
+	// файл может быть сжат gzip. Файл читается потоково и
+	// загружается в базу через COPY. Строки с ошибками не
+	// мешают загрузке остальных, при повторе пары
+	// клиент-рекламодатель сохраняется скор из последней
+	// строки.
+	//
+	// POST /ml-scores/bulk
+	LoadMLScores(ctx context.Context, request LoadMLScoresReq) (LoadMLScoresRes, error)
 	// UpsertAdvertisers invokes upsertAdvertisers operation.
 	//
 	// Создаёт новых или обновляет существующих
-	// рекламодателей.
+	// рекламодателей. Не переданные бюджеты существующего
+	// рекламодателя не меняются, `null` снимает ограничение.
+	// Удалённых рекламодателей восстановить нельзя.
 	//
 	// POST /advertisers/bulk
 	UpsertAdvertisers(ctx context.Context, request []AdvertiserUpsert) (UpsertAdvertisersRes, error)
@@ -98,6 +140,23 @@ type AdvertisersInvoker interface {
 //
 // x-gen-operation-group: Campaigns
 type CampaignsInvoker interface {
+	// ArchiveCampaign invokes archiveCampaign operation.
+	//
+	// Переводит кампанию в статус ARCHIVED. Архивная кампания
+	// не показывается и не изменяется, но её статистика
+	// сохраняется.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/archive
+	ArchiveCampaign(ctx context.Context, params ArchiveCampaignParams) (ArchiveCampaignRes, error)
+	// CloneCampaign invokes cloneCampaign operation.
+	//
+	// Создаёт новую рекламную кампанию с таргетированием,
+	// текстом и изображением указанной кампании. Даты и
+	// лимиты можно переопределить, копия проверяется так
+	// же, как при создании кампании.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/clone
+	CloneCampaign(ctx context.Context, request OptCampaignOverrides, params CloneCampaignParams) (CloneCampaignRes, error)
 	// CreateCampaign invokes createCampaign operation.
 	//
 	// Создаёт новую рекламную кампанию для указанного
@@ -105,26 +164,161 @@ type CampaignsInvoker interface {
 	//
 	// POST /advertisers/{advertiserId}/campaigns
 	CreateCampaign(ctx context.Context, request *CampaignCreate, params CreateCampaignParams) (CreateCampaignRes, error)
+	// CreateCampaignTemplate invokes createCampaignTemplate operation.
+	//
+	// Сохраняет параметры рекламной кампании под
+	// названием, уникальным для рекламодателя. Из шаблона
+	// можно создавать кампании.
+	//
+	// POST /advertisers/{advertiserId}/campaign-templates
+	CreateCampaignTemplate(ctx context.Context, request *CampaignTemplateCreate, params CreateCampaignTemplateParams) (CreateCampaignTemplateRes, error)
 	// DeleteCampaign invokes deleteCampaign operation.
 	//
 	// Удаляет рекламную кампанию рекламодателя по
-	// заданному campaignId.
+	// заданному campaignId. Кампания перестаёт показываться и
+	// возвращаться, изображение объявления удаляется, но
+	// показы и переходы кампании сохраняются в статистике
+	// рекламодателя.
 	//
 	// DELETE /advertisers/{advertiserId}/campaigns/{campaignId}
 	DeleteCampaign(ctx context.Context, params DeleteCampaignParams) (DeleteCampaignRes, error)
+	// DeleteCampaignTemplate invokes deleteCampaignTemplate operation.
+	//
+	// Удаляет шаблон. Кампании, созданные из шаблона, не
+	// изменяются.
+	//
+	// DELETE /advertisers/{advertiserId}/campaign-templates/{templateId}
+	DeleteCampaignTemplate(ctx context.Context, params DeleteCampaignTemplateParams) (DeleteCampaignTemplateRes, error)
+	// ExportCampaigns invokes exportCampaigns operation.
+	//
+	// Возвращает все рекламные кампании рекламодателя в
+	// порядке создания в формате CSV или NDJSON. Файл в том же
+	// формате можно передать в импорт.
+	//
+	// GET /advertisers/{advertiserId}/campaigns/export
+	ExportCampaigns(ctx context.Context, params ExportCampaignsParams) (ExportCampaignsRes, error)
 	// GetCampaign invokes getCampaign operation.
 	//
 	// Получение кампании по ID.
 	//
 	// GET /advertisers/{advertiserId}/campaigns/{campaignId}
 	GetCampaign(ctx context.Context, params GetCampaignParams) (GetCampaignRes, error)
+	// GetCampaignTemplate invokes getCampaignTemplate operation.
+	//
+	// Получение шаблона рекламной кампании.
+	//
+	// GET /advertisers/{advertiserId}/campaign-templates/{templateId}
+	GetCampaignTemplate(ctx context.Context, params GetCampaignTemplateParams) (GetCampaignTemplateRes, error)
+	// ImportCampaigns invokes importCampaigns operation.
+	//
+	// Создаёт рекламные кампании из файла CSV или NDJSON. Каждая
+	// строка проверяется так же, как запрос на создание
+	// кампании, результат возвращается по каждой строке.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/import
+	ImportCampaigns(ctx context.Context, request ImportCampaignsReq, params ImportCampaignsParams) (ImportCampaignsRes, error)
+	// InstantiateCampaignTemplate invokes instantiateCampaignTemplate operation.
+	//
+	// Создаёт рекламную кампанию с параметрами шаблона.
+	// Даты и лимиты можно переопределить, кампания
+	// проверяется так же, как при обычном создании.
+	//
+	// POST /advertisers/{advertiserId}/campaign-templates/{templateId}/campaigns
+	InstantiateCampaignTemplate(ctx context.Context, request OptCampaignOverrides, params InstantiateCampaignTemplateParams) (InstantiateCampaignTemplateRes, error)
+	// ListCampaignHistory invokes listCampaignHistory operation.
+	//
+	// Возвращает версии рекламной кампании, начиная с
+	// последней. Версия сохраняется при создании,
+	// обновлении, изменении изображения и статуса,
+	// удалении и восстановлении кампании.
+	//
+	// GET /advertisers/{advertiserId}/campaigns/{campaignId}/history
+	ListCampaignHistory(ctx context.Context, params ListCampaignHistoryParams) (ListCampaignHistoryRes, error)
+	// ListCampaignTemplates invokes listCampaignTemplates operation.
+	//
+	// Возвращает шаблоны рекламных кампаний рекламодателя,
+	//  упорядоченные по названию.
+	//
+	// GET /advertisers/{advertiserId}/campaign-templates
+	ListCampaignTemplates(ctx context.Context, params ListCampaignTemplatesParams) (ListCampaignTemplatesRes, error)
 	// ListCampaigns invokes listCampaigns operation.
 	//
 	// Возвращает список рекламных кампаний для указанного
-	// рекламодателя с пагинацией.
+	// рекламодателя с фильтрами, сортировкой и пагинацией.
+	// Для постраничного обхода большого списка
+	// используйте курсор из заголовка X-Next-Cursor, он устойчив
+	// к созданию и удалению кампаний между запросами.
 	//
 	// GET /advertisers/{advertiserId}/campaigns
 	ListCampaigns(ctx context.Context, params ListCampaignsParams) (ListCampaignsRes, error)
+	// OverrideCampaignModeration invokes overrideCampaignModeration operation.
+	//
+	// Одобряет или отклоняет рекламную кампанию вместо
+	// автоматической модерации. Найденные модерацией
+	// фразы сохраняются, решение действует до следующего
+	// изменения названия или текста объявления.
+	//
+	// POST /admin/campaigns/{campaignId}/moderation
+	OverrideCampaignModeration(ctx context.Context, request *OverrideCampaignModerationReq, params OverrideCampaignModerationParams) (OverrideCampaignModerationRes, error)
+	// PatchCampaign invokes patchCampaign operation.
+	//
+	// Изменяет только переданные параметры рекламной
+	// кампании по правилам JSON Merge Patch (RFC 7396). Значение null
+	// удаляет необязательное поле, например параметр
+	// таргетирования. К результату применяются те же
+	// проверки, что и при полном обновлении.
+	//
+	// PATCH /advertisers/{advertiserId}/campaigns/{campaignId}
+	PatchCampaign(ctx context.Context, request PatchCampaignReq, params PatchCampaignParams) (PatchCampaignRes, error)
+	// PauseCampaign invokes pauseCampaign operation.
+	//
+	// Переводит активную кампанию в статус PAUSED, объявления
+	// кампании перестают показываться клиентам.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/pause
+	PauseCampaign(ctx context.Context, params PauseCampaignParams) (PauseCampaignRes, error)
+	// PurgeCampaign invokes purgeCampaign operation.
+	//
+	// Удаляет рекламную кампанию, в том числе уже удалённую
+	// рекламодателем, вместе с её показами, переходами и
+	// изображением объявления.
+	//
+	// DELETE /admin/campaigns/{campaignId}
+	PurgeCampaign(ctx context.Context, params PurgeCampaignParams) (PurgeCampaignRes, error)
+	// RestoreCampaignVersion invokes restoreCampaignVersion operation.
+	//
+	// Возвращает параметры рекламной кампании к
+	// сохранённой версии. Действуют те же ограничения, что
+	// и при обновлении кампании, статус и изображение не
+	// восстанавливаются.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/history/{version}/restore
+	RestoreCampaignVersion(ctx context.Context, params RestoreCampaignVersionParams) (RestoreCampaignVersionRes, error)
+	// ResumeCampaign invokes resumeCampaign operation.
+	//
+	// Переводит черновик или приостановленную кампанию в
+	// статус ACTIVE.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/resume
+	ResumeCampaign(ctx context.Context, params ResumeCampaignParams) (ResumeCampaignRes, error)
+	// SearchAdvertiserCampaigns invokes searchAdvertiserCampaigns operation.
+	//
+	// Ищет кампании рекламодателя по словам в названии и
+	// тексте объявления с учётом морфологии русского и
+	// английского языков. Совпадения в названии важнее
+	// совпадений в тексте.
+	//
+	// GET /advertisers/{advertiserId}/campaigns/search
+	SearchAdvertiserCampaigns(ctx context.Context, params SearchAdvertiserCampaignsParams) (SearchAdvertiserCampaignsRes, error)
+	// SearchCampaigns invokes searchCampaigns operation.
+	//
+	// Ищет кампании всех рекламодателей по словам в
+	// названии и тексте объявления с учётом морфологии
+	// русского и английского языков. Совпадения в названии
+	// важнее совпадений в тексте.
+	//
+	// GET /admin/campaigns/search
+	SearchCampaigns(ctx context.Context, params SearchCampaignsParams) (SearchCampaignsRes, error)
 	// UpdateCampaign invokes updateCampaign operation.
 	//
 	// Обновляет разрешённые параметры рекламной кампании
@@ -145,6 +339,22 @@ type CampaignsInvoker interface {
 //
 // x-gen-operation-group: Clients
 type ClientsInvoker interface {
+	// EraseClient invokes eraseClient operation.
+	//
+	// Удаляет клиента и его ML скоры в одной транзакции.
+	// Показы и переходы клиента обезличиваются -
+	// сохраняются без ссылки на клиента, поэтому
+	// статистика и расходы рекламодателей не меняются.
+	//
+	// DELETE /clients/{clientId}
+	EraseClient(ctx context.Context, params EraseClientParams) (EraseClientRes, error)
+	// ExportClientData invokes exportClientData operation.
+	//
+	// Возвращает все хранящиеся данные о клиенте - профиль,
+	// ML скоры, показы и переходы.
+	//
+	// GET /clients/{clientId}/export
+	ExportClientData(ctx context.Context, params ExportClientDataParams) (ExportClientDataRes, error)
 	// GetClientById invokes getClientById operation.
 	//
 	// Возвращает информацию о клиенте по его ID.
@@ -159,6 +369,26 @@ type ClientsInvoker interface {
 	UpsertClients(ctx context.Context, request []ClientUpsert) (UpsertClientsRes, error)
 }
 
+// LocationsInvoker invokes operations described by OpenAPI v3 specification.
+//
+// x-gen-operation-group: Locations
+type LocationsInvoker interface {
+	// ListLocations invokes listLocations operation.
+	//
+	// Возвращает все локации справочника.
+	//
+	// GET /locations
+	ListLocations(ctx context.Context) ([]Location, error)
+	// UpsertLocations invokes upsertLocations operation.
+	//
+	// Создаёт новые или обновляет существующие локации
+	// справочника. Родительская локация должна
+	// существовать или передаваться в том же запросе.
+	//
+	// POST /locations/bulk
+	UpsertLocations(ctx context.Context, request []Location) (UpsertLocationsRes, error)
+}
+
 // StatisticsInvoker invokes operations described by OpenAPI v3 specification.
 //
 // x-gen-operation-group: Statistics
@@ -180,7 +410,10 @@ type StatisticsInvoker interface {
 	// GetCampaignDailyStats invokes getCampaignDailyStats operation.
 	//
 	// Возвращает массив ежедневной статистики для
-	// указанной рекламной кампании.
+	// указанной рекламной кампании. Каждый день от даты
+	// начала до даты окончания кампании присутствует в
+	// массиве, дни без показов и переходов возвращаются с
+	// нулевой статистикой.
 	//
 	// GET /stats/campaigns/{campaignId}/daily
 	GetCampaignDailyStats(ctx context.Context, params GetCampaignDailyStatsParams) (GetCampaignDailyStatsRes, error)
@@ -288,21 +521,22 @@ func (c *Client) sendAdvanceDay(ctx context.Context, request OptAdvanceDayReq) (
 	return result, nil
 }
 
-// CreateCampaign invokes createCampaign operation.
+// ArchiveCampaign invokes archiveCampaign operation.
 //
-// Создаёт новую рекламную кампанию для указанного
-// рекламодателя.
+// Переводит кампанию в статус ARCHIVED. Архивная кампания
+// не показывается и не изменяется, но её статистика
+// сохраняется.
 //
-// POST /advertisers/{advertiserId}/campaigns
-func (c *Client) CreateCampaign(ctx context.Context, request *CampaignCreate, params CreateCampaignParams) (CreateCampaignRes, error) {
-	res, err := c.sendCreateCampaign(ctx, request, params)
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/archive
+func (c *Client) ArchiveCampaign(ctx context.Context, params ArchiveCampaignParams) (ArchiveCampaignRes, error) {
+	res, err := c.sendArchiveCampaign(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCreateCampaign(ctx context.Context, request *CampaignCreate, params CreateCampaignParams) (res CreateCampaignRes, err error) {
+func (c *Client) sendArchiveCampaign(ctx context.Context, params ArchiveCampaignParams) (res ArchiveCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
+	var pathParts [5]string
 	pathParts[0] = "/advertisers/"
 	{
 		// Encode "advertiserId" parameter.
@@ -322,16 +556,32 @@ func (c *Client) sendCreateCampaign(ctx context.Context, request *CampaignCreate
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/campaigns"
+	pathParts[2] = "/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/archive"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateCampaignRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -339,7 +589,7 @@ func (c *Client) sendCreateCampaign(ctx context.Context, request *CampaignCreate
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeCreateCampaignResponse(resp)
+	result, err := decodeArchiveCampaignResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -347,21 +597,23 @@ func (c *Client) sendCreateCampaign(ctx context.Context, request *CampaignCreate
 	return result, nil
 }
 
-// DeleteCampaign invokes deleteCampaign operation.
+// CloneCampaign invokes cloneCampaign operation.
 //
-// Удаляет рекламную кампанию рекламодателя по
-// заданному campaignId.
+// Создаёт новую рекламную кампанию с таргетированием,
+// текстом и изображением указанной кампании. Даты и
+// лимиты можно переопределить, копия проверяется так
+// же, как при создании кампании.
 //
-// DELETE /advertisers/{advertiserId}/campaigns/{campaignId}
-func (c *Client) DeleteCampaign(ctx context.Context, params DeleteCampaignParams) (DeleteCampaignRes, error) {
-	res, err := c.sendDeleteCampaign(ctx, params)
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/clone
+func (c *Client) CloneCampaign(ctx context.Context, request OptCampaignOverrides, params CloneCampaignParams) (CloneCampaignRes, error) {
+	res, err := c.sendCloneCampaign(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendDeleteCampaign(ctx context.Context, params DeleteCampaignParams) (res DeleteCampaignRes, err error) {
+func (c *Client) sendCloneCampaign(ctx context.Context, request OptCampaignOverrides, params CloneCampaignParams) (res CloneCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [5]string
 	pathParts[0] = "/advertisers/"
 	{
 		// Encode "advertiserId" parameter.
@@ -400,12 +652,16 @@ func (c *Client) sendDeleteCampaign(ctx context.Context, params DeleteCampaignPa
 		}
 		pathParts[3] = encoded
 	}
+	pathParts[4] = "/clone"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCloneCampaignRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -413,7 +669,7 @@ func (c *Client) sendDeleteCampaign(ctx context.Context, params DeleteCampaignPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDeleteCampaignResponse(resp)
+	result, err := decodeCloneCampaignResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -421,28 +677,48 @@ func (c *Client) sendDeleteCampaign(ctx context.Context, params DeleteCampaignPa
 	return result, nil
 }
 
-// GenerateAdText invokes generateAdText operation.
+// CreateCampaign invokes createCampaign operation.
 //
-// Генерирует текст рекламного объявления.
+// Создаёт новую рекламную кампанию для указанного
+// рекламодателя.
 //
-// POST /ai/generate-ad-text
-func (c *Client) GenerateAdText(ctx context.Context, request *GenerateAdTextReq) (GenerateAdTextRes, error) {
-	res, err := c.sendGenerateAdText(ctx, request)
+// POST /advertisers/{advertiserId}/campaigns
+func (c *Client) CreateCampaign(ctx context.Context, request *CampaignCreate, params CreateCampaignParams) (CreateCampaignRes, error) {
+	res, err := c.sendCreateCampaign(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGenerateAdText(ctx context.Context, request *GenerateAdTextReq) (res GenerateAdTextRes, err error) {
+func (c *Client) sendCreateCampaign(ctx context.Context, request *CampaignCreate, params CreateCampaignParams) (res CreateCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/ai/generate-ad-text"
+	var pathParts [3]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeGenerateAdTextRequest(request, r); err != nil {
+	if err := encodeCreateCampaignRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -452,7 +728,7 @@ func (c *Client) sendGenerateAdText(ctx context.Context, request *GenerateAdText
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGenerateAdTextResponse(resp)
+	result, err := decodeCreateCampaignResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -460,45 +736,51 @@ func (c *Client) sendGenerateAdText(ctx context.Context, request *GenerateAdText
 	return result, nil
 }
 
-// GetAdForClient invokes getAdForClient operation.
+// CreateCampaignTemplate invokes createCampaignTemplate operation.
 //
-// Возвращает рекламное объявление, подходящее для
-// показа клиенту с учетом таргетинга и ML скора.
+// Сохраняет параметры рекламной кампании под
+// названием, уникальным для рекламодателя. Из шаблона
+// можно создавать кампании.
 //
-// GET /ads
-func (c *Client) GetAdForClient(ctx context.Context, params GetAdForClientParams) (GetAdForClientRes, error) {
-	res, err := c.sendGetAdForClient(ctx, params)
+// POST /advertisers/{advertiserId}/campaign-templates
+func (c *Client) CreateCampaignTemplate(ctx context.Context, request *CampaignTemplateCreate, params CreateCampaignTemplateParams) (CreateCampaignTemplateRes, error) {
+	res, err := c.sendCreateCampaignTemplate(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendGetAdForClient(ctx context.Context, params GetAdForClientParams) (res GetAdForClientRes, err error) {
+func (c *Client) sendCreateCampaignTemplate(ctx context.Context, request *CampaignTemplateCreate, params CreateCampaignTemplateParams) (res CreateCampaignTemplateRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/ads"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
+	var pathParts [3]string
+	pathParts[0] = "/advertisers/"
 	{
-		// Encode "client_id" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "client_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.UUIDToString(params.ClientID))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/campaign-templates"
+	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeCreateCampaignTemplateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -506,7 +788,7 @@ func (c *Client) sendGetAdForClient(ctx context.Context, params GetAdForClientPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAdForClientResponse(resp)
+	result, err := decodeCreateCampaignTemplateResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -514,17 +796,24 @@ func (c *Client) sendGetAdForClient(ctx context.Context, params GetAdForClientPa
 	return result, nil
 }
 
-// GetAdvertiserById invokes getAdvertiserById operation.
+// DeleteAdvertiser invokes deleteAdvertiser operation.
 //
-// Возвращает информацию о рекламодателе по его ID.
+// Удаляет рекламодателя и архивирует все его рекламные
+// кампании. Рекламодателя с активными кампаниями
+// удалить нельзя, их нужно сначала приостановить или
+// архивировать. Показы и переходы кампаний сохраняются,
 //
-// GET /advertisers/{advertiserId}
-func (c *Client) GetAdvertiserById(ctx context.Context, params GetAdvertiserByIdParams) (GetAdvertiserByIdRes, error) {
-	res, err := c.sendGetAdvertiserById(ctx, params)
+//	повторное создание рекламодателя с тем же ID
+//
+// восстанавливает его без восстановления кампаний.
+//
+// DELETE /advertisers/{advertiserId}
+func (c *Client) DeleteAdvertiser(ctx context.Context, params DeleteAdvertiserParams) (DeleteAdvertiserRes, error) {
+	res, err := c.sendDeleteAdvertiser(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAdvertiserById(ctx context.Context, params GetAdvertiserByIdParams) (res GetAdvertiserByIdRes, err error) {
+func (c *Client) sendDeleteAdvertiser(ctx context.Context, params DeleteAdvertiserParams) (res DeleteAdvertiserRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
@@ -549,7 +838,7 @@ func (c *Client) sendGetAdvertiserById(ctx context.Context, params GetAdvertiser
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -560,7 +849,7 @@ func (c *Client) sendGetAdvertiserById(ctx context.Context, params GetAdvertiser
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAdvertiserByIdResponse(resp)
+	result, err := decodeDeleteAdvertiserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -568,22 +857,25 @@ func (c *Client) sendGetAdvertiserById(ctx context.Context, params GetAdvertiser
 	return result, nil
 }
 
-// GetAdvertiserCampaignsStats invokes getAdvertiserCampaignsStats operation.
+// DeleteCampaign invokes deleteCampaign operation.
 //
-// Возвращает сводную статистику по всем рекламным
-// кампаниям, принадлежащим заданному рекламодателю.
+// Удаляет рекламную кампанию рекламодателя по
+// заданному campaignId. Кампания перестаёт показываться и
+// возвращаться, изображение объявления удаляется, но
+// показы и переходы кампании сохраняются в статистике
+// рекламодателя.
 //
-// GET /stats/advertisers/{advertiserId}/campaigns
-func (c *Client) GetAdvertiserCampaignsStats(ctx context.Context, params GetAdvertiserCampaignsStatsParams) (GetAdvertiserCampaignsStatsRes, error) {
-	res, err := c.sendGetAdvertiserCampaignsStats(ctx, params)
+// DELETE /advertisers/{advertiserId}/campaigns/{campaignId}
+func (c *Client) DeleteCampaign(ctx context.Context, params DeleteCampaignParams) (DeleteCampaignRes, error) {
+	res, err := c.sendDeleteCampaign(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAdvertiserCampaignsStats(ctx context.Context, params GetAdvertiserCampaignsStatsParams) (res GetAdvertiserCampaignsStatsRes, err error) {
+func (c *Client) sendDeleteCampaign(ctx context.Context, params DeleteCampaignParams) (res DeleteCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/stats/advertisers/"
+	var pathParts [4]string
+	pathParts[0] = "/advertisers/"
 	{
 		// Encode "advertiserId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -602,10 +894,28 @@ func (c *Client) sendGetAdvertiserCampaignsStats(ctx context.Context, params Get
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/campaigns"
+	pathParts[2] = "/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -616,7 +926,7 @@ func (c *Client) sendGetAdvertiserCampaignsStats(ctx context.Context, params Get
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAdvertiserCampaignsStatsResponse(resp)
+	result, err := decodeDeleteCampaignResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -624,22 +934,22 @@ func (c *Client) sendGetAdvertiserCampaignsStats(ctx context.Context, params Get
 	return result, nil
 }
 
-// GetAdvertiserDailyStats invokes getAdvertiserDailyStats operation.
+// DeleteCampaignTemplate invokes deleteCampaignTemplate operation.
 //
-// Возвращает массив ежедневной сводной статистики по
-// всем рекламным кампаниям заданного рекламодателя.
+// Удаляет шаблон. Кампании, созданные из шаблона, не
+// изменяются.
 //
-// GET /stats/advertisers/{advertiserId}/campaigns/daily
-func (c *Client) GetAdvertiserDailyStats(ctx context.Context, params GetAdvertiserDailyStatsParams) (GetAdvertiserDailyStatsRes, error) {
-	res, err := c.sendGetAdvertiserDailyStats(ctx, params)
+// DELETE /advertisers/{advertiserId}/campaign-templates/{templateId}
+func (c *Client) DeleteCampaignTemplate(ctx context.Context, params DeleteCampaignTemplateParams) (DeleteCampaignTemplateRes, error) {
+	res, err := c.sendDeleteCampaignTemplate(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetAdvertiserDailyStats(ctx context.Context, params GetAdvertiserDailyStatsParams) (res GetAdvertiserDailyStatsRes, err error) {
+func (c *Client) sendDeleteCampaignTemplate(ctx context.Context, params DeleteCampaignTemplateParams) (res DeleteCampaignTemplateRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/stats/advertisers/"
+	var pathParts [4]string
+	pathParts[0] = "/advertisers/"
 	{
 		// Encode "advertiserId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -658,13 +968,1639 @@ func (c *Client) sendGetAdvertiserDailyStats(ctx context.Context, params GetAdve
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/campaigns/daily"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	r, err := ht.NewRequest(ctx, "GET", u)
-	if err != nil {
+	pathParts[2] = "/campaign-templates/"
+	{
+		// Encode "templateId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "templateId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDeleteCampaignTemplateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// EraseClient invokes eraseClient operation.
+//
+// Удаляет клиента и его ML скоры в одной транзакции.
+// Показы и переходы клиента обезличиваются -
+// сохраняются без ссылки на клиента, поэтому
+// статистика и расходы рекламодателей не меняются.
+//
+// DELETE /clients/{clientId}
+func (c *Client) EraseClient(ctx context.Context, params EraseClientParams) (EraseClientRes, error) {
+	res, err := c.sendEraseClient(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendEraseClient(ctx context.Context, params EraseClientParams) (res EraseClientRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/clients/"
+	{
+		// Encode "clientId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "clientId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ClientId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeEraseClientResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ExplainAdForClient invokes explainAdForClient operation.
+//
+// Возвращает все рекламные кампании с причинами, по
+// которым они не подходят клиенту, и разбор
+// ранжирования подходящих кампаний. Показ не
+// записывается.
+//
+// GET /admin/ads/explain
+func (c *Client) ExplainAdForClient(ctx context.Context, params ExplainAdForClientParams) (ExplainAdForClientRes, error) {
+	res, err := c.sendExplainAdForClient(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendExplainAdForClient(ctx context.Context, params ExplainAdForClientParams) (res ExplainAdForClientRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/admin/ads/explain"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "client_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "client_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.UUIDToString(params.ClientID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "campaign_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "campaign_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.CampaignID.Get(); ok {
+				return e.EncodeValue(conv.UUIDToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeExplainAdForClientResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ExportCampaigns invokes exportCampaigns operation.
+//
+// Возвращает все рекламные кампании рекламодателя в
+// порядке создания в формате CSV или NDJSON. Файл в том же
+// формате можно передать в импорт.
+//
+// GET /advertisers/{advertiserId}/campaigns/export
+func (c *Client) ExportCampaigns(ctx context.Context, params ExportCampaignsParams) (ExportCampaignsRes, error) {
+	res, err := c.sendExportCampaigns(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendExportCampaigns(ctx context.Context, params ExportCampaignsParams) (res ExportCampaignsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "format" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Format.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeExportCampaignsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ExportClientData invokes exportClientData operation.
+//
+// Возвращает все хранящиеся данные о клиенте - профиль,
+// ML скоры, показы и переходы.
+//
+// GET /clients/{clientId}/export
+func (c *Client) ExportClientData(ctx context.Context, params ExportClientDataParams) (ExportClientDataRes, error) {
+	res, err := c.sendExportClientData(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendExportClientData(ctx context.Context, params ExportClientDataParams) (res ExportClientDataRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/clients/"
+	{
+		// Encode "clientId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "clientId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ClientId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/export"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeExportClientDataResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GenerateAdText invokes generateAdText operation.
+//
+// Генерирует текст рекламного объявления.
+//
+// POST /ai/generate-ad-text
+func (c *Client) GenerateAdText(ctx context.Context, request *GenerateAdTextReq) (GenerateAdTextRes, error) {
+	res, err := c.sendGenerateAdText(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendGenerateAdText(ctx context.Context, request *GenerateAdTextReq) (res GenerateAdTextRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/ai/generate-ad-text"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGenerateAdTextRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGenerateAdTextResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAdForClient invokes getAdForClient operation.
+//
+// Возвращает рекламное объявление, подходящее для
+// показа клиенту с учетом таргетинга и ML скора.
+//
+// GET /ads
+func (c *Client) GetAdForClient(ctx context.Context, params GetAdForClientParams) (GetAdForClientRes, error) {
+	res, err := c.sendGetAdForClient(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAdForClient(ctx context.Context, params GetAdForClientParams) (res GetAdForClientRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/ads"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "client_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "client_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.UUIDToString(params.ClientID))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "slots" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "slots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Slots.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetAdForClientResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAdvertiserById invokes getAdvertiserById operation.
+//
+// Возвращает информацию о рекламодателе по его ID.
+//
+// GET /advertisers/{advertiserId}
+func (c *Client) GetAdvertiserById(ctx context.Context, params GetAdvertiserByIdParams) (GetAdvertiserByIdRes, error) {
+	res, err := c.sendGetAdvertiserById(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAdvertiserById(ctx context.Context, params GetAdvertiserByIdParams) (res GetAdvertiserByIdRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetAdvertiserByIdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAdvertiserCampaignsStats invokes getAdvertiserCampaignsStats operation.
+//
+// Возвращает сводную статистику по всем рекламным
+// кампаниям, принадлежащим заданному рекламодателю.
+//
+// GET /stats/advertisers/{advertiserId}/campaigns
+func (c *Client) GetAdvertiserCampaignsStats(ctx context.Context, params GetAdvertiserCampaignsStatsParams) (GetAdvertiserCampaignsStatsRes, error) {
+	res, err := c.sendGetAdvertiserCampaignsStats(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAdvertiserCampaignsStats(ctx context.Context, params GetAdvertiserCampaignsStatsParams) (res GetAdvertiserCampaignsStatsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/stats/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetAdvertiserCampaignsStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetAdvertiserDailyStats invokes getAdvertiserDailyStats operation.
+//
+// Возвращает массив ежедневной сводной статистики по
+// всем рекламным кампаниям заданного рекламодателя.
+//
+// GET /stats/advertisers/{advertiserId}/campaigns/daily
+func (c *Client) GetAdvertiserDailyStats(ctx context.Context, params GetAdvertiserDailyStatsParams) (GetAdvertiserDailyStatsRes, error) {
+	res, err := c.sendGetAdvertiserDailyStats(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetAdvertiserDailyStats(ctx context.Context, params GetAdvertiserDailyStatsParams) (res GetAdvertiserDailyStatsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/stats/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/daily"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetAdvertiserDailyStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCampaign invokes getCampaign operation.
+//
+// Получение кампании по ID.
+//
+// GET /advertisers/{advertiserId}/campaigns/{campaignId}
+func (c *Client) GetCampaign(ctx context.Context, params GetCampaignParams) (GetCampaignRes, error) {
+	res, err := c.sendGetCampaign(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCampaign(ctx context.Context, params GetCampaignParams) (res GetCampaignRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetCampaignResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCampaignDailyStats invokes getCampaignDailyStats operation.
+//
+// Возвращает массив ежедневной статистики для
+// указанной рекламной кампании. Каждый день от даты
+// начала до даты окончания кампании присутствует в
+// массиве, дни без показов и переходов возвращаются с
+// нулевой статистикой.
+//
+// GET /stats/campaigns/{campaignId}/daily
+func (c *Client) GetCampaignDailyStats(ctx context.Context, params GetCampaignDailyStatsParams) (GetCampaignDailyStatsRes, error) {
+	res, err := c.sendGetCampaignDailyStats(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCampaignDailyStats(ctx context.Context, params GetCampaignDailyStatsParams) (res GetCampaignDailyStatsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/stats/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/daily"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetCampaignDailyStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCampaignStats invokes getCampaignStats operation.
+//
+// Возвращает агрегированную статистику (показы,
+// переходы, затраты и конверсию) для заданной рекламной
+// кампании.
+//
+// GET /stats/campaigns/{campaignId}
+func (c *Client) GetCampaignStats(ctx context.Context, params GetCampaignStatsParams) (GetCampaignStatsRes, error) {
+	res, err := c.sendGetCampaignStats(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCampaignStats(ctx context.Context, params GetCampaignStatsParams) (res GetCampaignStatsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/stats/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetCampaignStatsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetCampaignTemplate invokes getCampaignTemplate operation.
+//
+// Получение шаблона рекламной кампании.
+//
+// GET /advertisers/{advertiserId}/campaign-templates/{templateId}
+func (c *Client) GetCampaignTemplate(ctx context.Context, params GetCampaignTemplateParams) (GetCampaignTemplateRes, error) {
+	res, err := c.sendGetCampaignTemplate(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetCampaignTemplate(ctx context.Context, params GetCampaignTemplateParams) (res GetCampaignTemplateRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaign-templates/"
+	{
+		// Encode "templateId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "templateId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetCampaignTemplateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetClientById invokes getClientById operation.
+//
+// Возвращает информацию о клиенте по его ID.
+//
+// GET /clients/{clientId}
+func (c *Client) GetClientById(ctx context.Context, params GetClientByIdParams) (GetClientByIdRes, error) {
+	res, err := c.sendGetClientById(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetClientById(ctx context.Context, params GetClientByIdParams) (res GetClientByIdRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/clients/"
+	{
+		// Encode "clientId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "clientId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.ClientId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGetClientByIdResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ImportCampaigns invokes importCampaigns operation.
+//
+// Создаёт рекламные кампании из файла CSV или NDJSON. Каждая
+// строка проверяется так же, как запрос на создание
+// кампании, результат возвращается по каждой строке.
+//
+// POST /advertisers/{advertiserId}/campaigns/import
+func (c *Client) ImportCampaigns(ctx context.Context, request ImportCampaignsReq, params ImportCampaignsParams) (ImportCampaignsRes, error) {
+	res, err := c.sendImportCampaigns(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendImportCampaigns(ctx context.Context, request ImportCampaignsReq, params ImportCampaignsParams) (res ImportCampaignsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/import"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "atomic" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "atomic",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Atomic.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeImportCampaignsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeImportCampaignsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InstantiateCampaignTemplate invokes instantiateCampaignTemplate operation.
+//
+// Создаёт рекламную кампанию с параметрами шаблона.
+// Даты и лимиты можно переопределить, кампания
+// проверяется так же, как при обычном создании.
+//
+// POST /advertisers/{advertiserId}/campaign-templates/{templateId}/campaigns
+func (c *Client) InstantiateCampaignTemplate(ctx context.Context, request OptCampaignOverrides, params InstantiateCampaignTemplateParams) (InstantiateCampaignTemplateRes, error) {
+	res, err := c.sendInstantiateCampaignTemplate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendInstantiateCampaignTemplate(ctx context.Context, request OptCampaignOverrides, params InstantiateCampaignTemplateParams) (res InstantiateCampaignTemplateRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaign-templates/"
+	{
+		// Encode "templateId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "templateId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.TemplateId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/campaigns"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeInstantiateCampaignTemplateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeInstantiateCampaignTemplateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAdvertisers invokes listAdvertisers operation.
+//
+// Возвращает рекламодателей, отсортированных по имени,
+// с пагинацией и поиском по части имени без учёта
+// регистра. Удалённые рекламодатели не возвращаются.
+//
+// GET /advertisers
+func (c *Client) ListAdvertisers(ctx context.Context, params ListAdvertisersParams) (ListAdvertisersRes, error) {
+	res, err := c.sendListAdvertisers(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListAdvertisers(ctx context.Context, params ListAdvertisersParams) (res ListAdvertisersRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/advertisers"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Size.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListAdvertisersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCampaignHistory invokes listCampaignHistory operation.
+//
+// Возвращает версии рекламной кампании, начиная с
+// последней. Версия сохраняется при создании,
+// обновлении, изменении изображения и статуса,
+// удалении и восстановлении кампании.
+//
+// GET /advertisers/{advertiserId}/campaigns/{campaignId}/history
+func (c *Client) ListCampaignHistory(ctx context.Context, params ListCampaignHistoryParams) (ListCampaignHistoryRes, error) {
+	res, err := c.sendListCampaignHistory(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCampaignHistory(ctx context.Context, params ListCampaignHistoryParams) (res ListCampaignHistoryRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [5]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/history"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Size.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListCampaignHistoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCampaignTemplates invokes listCampaignTemplates operation.
+//
+// Возвращает шаблоны рекламных кампаний рекламодателя,
+//
+//	упорядоченные по названию.
+//
+// GET /advertisers/{advertiserId}/campaign-templates
+func (c *Client) ListCampaignTemplates(ctx context.Context, params ListCampaignTemplatesParams) (ListCampaignTemplatesRes, error) {
+	res, err := c.sendListCampaignTemplates(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCampaignTemplates(ctx context.Context, params ListCampaignTemplatesParams) (res ListCampaignTemplatesRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaign-templates"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListCampaignTemplatesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCampaigns invokes listCampaigns operation.
+//
+// Возвращает список рекламных кампаний для указанного
+// рекламодателя с фильтрами, сортировкой и пагинацией.
+// Для постраничного обхода большого списка
+// используйте курсор из заголовка X-Next-Cursor, он устойчив
+// к созданию и удалению кампаний между запросами.
+//
+// GET /advertisers/{advertiserId}/campaigns
+func (c *Client) ListCampaigns(ctx context.Context, params ListCampaignsParams) (ListCampaignsRes, error) {
+	res, err := c.sendListCampaigns(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListCampaigns(ctx context.Context, params ListCampaignsParams) (res ListCampaignsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Size.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "cursor" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Cursor.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "moderation_status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "moderation_status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ModerationStatus.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "active_on" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "active_on",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ActiveOn.Get(); ok {
+				if unwrapped := int32(val); true {
+					return e.EncodeValue(conv.Int32ToString(unwrapped))
+				}
+				return nil
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "location" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "location",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Location.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "gender" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "gender",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Gender.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "title" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "title",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Title.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "sort" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Sort.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "order" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Order.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListCampaignsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListLocations invokes listLocations operation.
+//
+// Возвращает все локации справочника.
+//
+// GET /locations
+func (c *Client) ListLocations(ctx context.Context) ([]Location, error) {
+	res, err := c.sendListLocations(ctx)
+	return res, err
+}
+
+func (c *Client) sendListLocations(ctx context.Context) (res []Location, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/locations"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeListLocationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// LoadMLScores invokes loadMLScores operation.
+//
+// Добавляет или обновляет ML скоры из файла CSV или NDJSON,
+// файл может быть сжат gzip. Файл читается потоково и
+// загружается в базу через COPY. Строки с ошибками не
+// мешают загрузке остальных, при повторе пары
+// клиент-рекламодатель сохраняется скор из последней
+// строки.
+//
+// POST /ml-scores/bulk
+func (c *Client) LoadMLScores(ctx context.Context, request LoadMLScoresReq) (LoadMLScoresRes, error) {
+	res, err := c.sendLoadMLScores(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendLoadMLScores(ctx context.Context, request LoadMLScoresReq) (res LoadMLScoresRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/ml-scores/bulk"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeLoadMLScoresRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -672,7 +2608,7 @@ func (c *Client) sendGetAdvertiserDailyStats(ctx context.Context, params GetAdve
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetAdvertiserDailyStatsResponse(resp)
+	result, err := decodeLoadMLScoresResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -680,17 +2616,121 @@ func (c *Client) sendGetAdvertiserDailyStats(ctx context.Context, params GetAdve
 	return result, nil
 }
 
-// GetCampaign invokes getCampaign operation.
+// ModerateAdText invokes moderateAdText operation.
 //
-// Получение кампании по ID.
+// Модерирует текст рекламного объявления.
 //
-// GET /advertisers/{advertiserId}/campaigns/{campaignId}
-func (c *Client) GetCampaign(ctx context.Context, params GetCampaignParams) (GetCampaignRes, error) {
-	res, err := c.sendGetCampaign(ctx, params)
+// POST /ai/moderate-ad-text
+func (c *Client) ModerateAdText(ctx context.Context, request *ModerateAdTextReq) (ModerateAdTextRes, error) {
+	res, err := c.sendModerateAdText(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendGetCampaign(ctx context.Context, params GetCampaignParams) (res GetCampaignRes, err error) {
+func (c *Client) sendModerateAdText(ctx context.Context, request *ModerateAdTextReq) (res ModerateAdTextRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/ai/moderate-ad-text"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeModerateAdTextRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeModerateAdTextResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// OverrideCampaignModeration invokes overrideCampaignModeration operation.
+//
+// Одобряет или отклоняет рекламную кампанию вместо
+// автоматической модерации. Найденные модерацией
+// фразы сохраняются, решение действует до следующего
+// изменения названия или текста объявления.
+//
+// POST /admin/campaigns/{campaignId}/moderation
+func (c *Client) OverrideCampaignModeration(ctx context.Context, request *OverrideCampaignModerationReq, params OverrideCampaignModerationParams) (OverrideCampaignModerationRes, error) {
+	res, err := c.sendOverrideCampaignModeration(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendOverrideCampaignModeration(ctx context.Context, request *OverrideCampaignModerationReq, params OverrideCampaignModerationParams) (res OverrideCampaignModerationRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/admin/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/moderation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeOverrideCampaignModerationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeOverrideCampaignModerationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PatchCampaign invokes patchCampaign operation.
+//
+// Изменяет только переданные параметры рекламной
+// кампании по правилам JSON Merge Patch (RFC 7396). Значение null
+// удаляет необязательное поле, например параметр
+// таргетирования. К результату применяются те же
+// проверки, что и при полном обновлении.
+//
+// PATCH /advertisers/{advertiserId}/campaigns/{campaignId}
+func (c *Client) PatchCampaign(ctx context.Context, request PatchCampaignReq, params PatchCampaignParams) (PatchCampaignRes, error) {
+	res, err := c.sendPatchCampaign(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPatchCampaign(ctx context.Context, request PatchCampaignReq, params PatchCampaignParams) (res PatchCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [4]string
@@ -734,10 +2774,29 @@ func (c *Client) sendGetCampaign(ctx context.Context, params GetCampaignParams)
 	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PATCH", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePatchCampaignRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -745,7 +2804,7 @@ func (c *Client) sendGetCampaign(ctx context.Context, params GetCampaignParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetCampaignResponse(resp)
+	result, err := decodePatchCampaignResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -753,22 +2812,98 @@ func (c *Client) sendGetCampaign(ctx context.Context, params GetCampaignParams)
 	return result, nil
 }
 
-// GetCampaignDailyStats invokes getCampaignDailyStats operation.
+// PauseCampaign invokes pauseCampaign operation.
 //
-// Возвращает массив ежедневной статистики для
-// указанной рекламной кампании.
+// Переводит активную кампанию в статус PAUSED, объявления
+// кампании перестают показываться клиентам.
 //
-// GET /stats/campaigns/{campaignId}/daily
-func (c *Client) GetCampaignDailyStats(ctx context.Context, params GetCampaignDailyStatsParams) (GetCampaignDailyStatsRes, error) {
-	res, err := c.sendGetCampaignDailyStats(ctx, params)
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/pause
+func (c *Client) PauseCampaign(ctx context.Context, params PauseCampaignParams) (PauseCampaignRes, error) {
+	res, err := c.sendPauseCampaign(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCampaignDailyStats(ctx context.Context, params GetCampaignDailyStatsParams) (res GetCampaignDailyStatsRes, err error) {
+func (c *Client) sendPauseCampaign(ctx context.Context, params PauseCampaignParams) (res PauseCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/stats/campaigns/"
+	var pathParts [5]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/pause"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePauseCampaignResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PurgeCampaign invokes purgeCampaign operation.
+//
+// Удаляет рекламную кампанию, в том числе уже удалённую
+// рекламодателем, вместе с её показами, переходами и
+// изображением объявления.
+//
+// DELETE /admin/campaigns/{campaignId}
+func (c *Client) PurgeCampaign(ctx context.Context, params PurgeCampaignParams) (PurgeCampaignRes, error) {
+	res, err := c.sendPurgeCampaign(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPurgeCampaign(ctx context.Context, params PurgeCampaignParams) (res PurgeCampaignRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/admin/campaigns/"
 	{
 		// Encode "campaignId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -787,13 +2922,71 @@ func (c *Client) sendGetCampaignDailyStats(ctx context.Context, params GetCampai
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/daily"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePurgeCampaignResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RecordAdClick invokes recordAdClick operation.
+//
+// Фиксирует клик (переход) клиента по рекламному
+// объявлению.
+//
+// POST /ads/{adId}/click
+func (c *Client) RecordAdClick(ctx context.Context, request *RecordAdClickReq, params RecordAdClickParams) (RecordAdClickRes, error) {
+	res, err := c.sendRecordAdClick(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendRecordAdClick(ctx context.Context, request *RecordAdClickReq, params RecordAdClickParams) (res RecordAdClickRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/ads/"
+	{
+		// Encode "adId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "adId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/click"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeRecordAdClickRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -801,7 +2994,7 @@ func (c *Client) sendGetCampaignDailyStats(ctx context.Context, params GetCampai
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetCampaignDailyStatsResponse(resp)
+	result, err := decodeRecordAdClickResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -809,23 +3002,43 @@ func (c *Client) sendGetCampaignDailyStats(ctx context.Context, params GetCampai
 	return result, nil
 }
 
-// GetCampaignStats invokes getCampaignStats operation.
+// RestoreCampaignVersion invokes restoreCampaignVersion operation.
 //
-// Возвращает агрегированную статистику (показы,
-// переходы, затраты и конверсию) для заданной рекламной
-// кампании.
+// Возвращает параметры рекламной кампании к
+// сохранённой версии. Действуют те же ограничения, что
+// и при обновлении кампании, статус и изображение не
+// восстанавливаются.
 //
-// GET /stats/campaigns/{campaignId}
-func (c *Client) GetCampaignStats(ctx context.Context, params GetCampaignStatsParams) (GetCampaignStatsRes, error) {
-	res, err := c.sendGetCampaignStats(ctx, params)
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/history/{version}/restore
+func (c *Client) RestoreCampaignVersion(ctx context.Context, params RestoreCampaignVersionParams) (RestoreCampaignVersionRes, error) {
+	res, err := c.sendRestoreCampaignVersion(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetCampaignStats(ctx context.Context, params GetCampaignStatsParams) (res GetCampaignStatsRes, err error) {
+func (c *Client) sendRestoreCampaignVersion(ctx context.Context, params RestoreCampaignVersionParams) (res RestoreCampaignVersionRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/stats/campaigns/"
+	var pathParts [7]string
+	pathParts[0] = "/advertisers/"
+	{
+		// Encode "advertiserId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "advertiserId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/campaigns/"
 	{
 		// Encode "campaignId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -842,11 +3055,31 @@ func (c *Client) sendGetCampaignStats(ctx context.Context, params GetCampaignSta
 		if err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
-		pathParts[1] = encoded
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/history/"
+	{
+		// Encode "version" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "version",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.Version))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[5] = encoded
 	}
+	pathParts[6] = "/restore"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -857,7 +3090,7 @@ func (c *Client) sendGetCampaignStats(ctx context.Context, params GetCampaignSta
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetCampaignStatsResponse(resp)
+	result, err := decodeRestoreCampaignVersionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -865,30 +3098,31 @@ func (c *Client) sendGetCampaignStats(ctx context.Context, params GetCampaignSta
 	return result, nil
 }
 
-// GetClientById invokes getClientById operation.
+// ResumeCampaign invokes resumeCampaign operation.
 //
-// Возвращает информацию о клиенте по его ID.
+// Переводит черновик или приостановленную кампанию в
+// статус ACTIVE.
 //
-// GET /clients/{clientId}
-func (c *Client) GetClientById(ctx context.Context, params GetClientByIdParams) (GetClientByIdRes, error) {
-	res, err := c.sendGetClientById(ctx, params)
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/resume
+func (c *Client) ResumeCampaign(ctx context.Context, params ResumeCampaignParams) (ResumeCampaignRes, error) {
+	res, err := c.sendResumeCampaign(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetClientById(ctx context.Context, params GetClientByIdParams) (res GetClientByIdRes, err error) {
+func (c *Client) sendResumeCampaign(ctx context.Context, params ResumeCampaignParams) (res ResumeCampaignRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/clients/"
+	var pathParts [5]string
+	pathParts[0] = "/advertisers/"
 	{
-		// Encode "clientId" parameter.
+		// Encode "advertiserId" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "clientId",
+			Param:   "advertiserId",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.ClientId))
+			return e.EncodeValue(conv.UUIDToString(params.AdvertiserId))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -898,9 +3132,29 @@ func (c *Client) sendGetClientById(ctx context.Context, params GetClientByIdPara
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/campaigns/"
+	{
+		// Encode "campaignId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "campaignId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.UUIDToString(params.CampaignId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/resume"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -911,7 +3165,7 @@ func (c *Client) sendGetClientById(ctx context.Context, params GetClientByIdPara
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGetClientByIdResponse(resp)
+	result, err := decodeResumeCampaignResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -919,18 +3173,20 @@ func (c *Client) sendGetClientById(ctx context.Context, params GetClientByIdPara
 	return result, nil
 }
 
-// ListCampaigns invokes listCampaigns operation.
+// SearchAdvertiserCampaigns invokes searchAdvertiserCampaigns operation.
 //
-// Возвращает список рекламных кампаний для указанного
-// рекламодателя с пагинацией.
+// Ищет кампании рекламодателя по словам в названии и
+// тексте объявления с учётом морфологии русского и
+// английского языков. Совпадения в названии важнее
+// совпадений в тексте.
 //
-// GET /advertisers/{advertiserId}/campaigns
-func (c *Client) ListCampaigns(ctx context.Context, params ListCampaignsParams) (ListCampaignsRes, error) {
-	res, err := c.sendListCampaigns(ctx, params)
+// GET /advertisers/{advertiserId}/campaigns/search
+func (c *Client) SearchAdvertiserCampaigns(ctx context.Context, params SearchAdvertiserCampaignsParams) (SearchAdvertiserCampaignsRes, error) {
+	res, err := c.sendSearchAdvertiserCampaigns(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendListCampaigns(ctx context.Context, params ListCampaignsParams) (res ListCampaignsRes, err error) {
+func (c *Client) sendSearchAdvertiserCampaigns(ctx context.Context, params SearchAdvertiserCampaignsParams) (res SearchAdvertiserCampaignsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
@@ -953,10 +3209,24 @@ func (c *Client) sendListCampaigns(ctx context.Context, params ListCampaignsPara
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/campaigns"
+	pathParts[2] = "/campaigns/search"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Q))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	{
 		// Encode "size" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -1004,7 +3274,7 @@ func (c *Client) sendListCampaigns(ctx context.Context, params ListCampaignsPara
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeListCampaignsResponse(resp)
+	result, err := decodeSearchAdvertiserCampaignsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1012,89 +3282,81 @@ func (c *Client) sendListCampaigns(ctx context.Context, params ListCampaignsPara
 	return result, nil
 }
 
-// ModerateAdText invokes moderateAdText operation.
+// SearchCampaigns invokes searchCampaigns operation.
 //
-// Модерирует текст рекламного объявления.
+// Ищет кампании всех рекламодателей по словам в
+// названии и тексте объявления с учётом морфологии
+// русского и английского языков. Совпадения в названии
+// важнее совпадений в тексте.
 //
-// POST /ai/moderate-ad-text
-func (c *Client) ModerateAdText(ctx context.Context, request *ModerateAdTextReq) (ModerateAdTextRes, error) {
-	res, err := c.sendModerateAdText(ctx, request)
+// GET /admin/campaigns/search
+func (c *Client) SearchCampaigns(ctx context.Context, params SearchCampaignsParams) (SearchCampaignsRes, error) {
+	res, err := c.sendSearchCampaigns(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendModerateAdText(ctx context.Context, request *ModerateAdTextReq) (res ModerateAdTextRes, err error) {
+func (c *Client) sendSearchCampaigns(ctx context.Context, params SearchCampaignsParams) (res SearchCampaignsRes, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/ai/moderate-ad-text"
+	pathParts[0] = "/admin/campaigns/search"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "POST", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-	if err := encodeModerateAdTextRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "q" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	resp, err := c.cfg.Client.Do(r)
-	if err != nil {
-		return res, errors.Wrap(err, "do request")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Q))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-	defer resp.Body.Close()
+	{
+		// Encode "size" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	result, err := decodeModerateAdTextResponse(resp)
-	if err != nil {
-		return res, errors.Wrap(err, "decode response")
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Size.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
 	}
-
-	return result, nil
-}
-
-// RecordAdClick invokes recordAdClick operation.
-//
-// Фиксирует клик (переход) клиента по рекламному
-// объявлению.
-//
-// POST /ads/{adId}/click
-func (c *Client) RecordAdClick(ctx context.Context, request *RecordAdClickReq, params RecordAdClickParams) (RecordAdClickRes, error) {
-	res, err := c.sendRecordAdClick(ctx, request, params)
-	return res, err
-}
-
-func (c *Client) sendRecordAdClick(ctx context.Context, request *RecordAdClickReq, params RecordAdClickParams) (res RecordAdClickRes, err error) {
-
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/ads/"
 	{
-		// Encode "adId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "adId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.UUIDToString(params.AdId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "page" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Page.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/click"
-	uri.AddPathParts(u, pathParts[:]...)
+	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeRecordAdClickRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -1102,7 +3364,7 @@ func (c *Client) sendRecordAdClick(ctx context.Context, request *RecordAdClickRe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeRecordAdClickResponse(resp)
+	result, err := decodeSearchCampaignsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1173,6 +3435,22 @@ func (c *Client) sendUpdateCampaign(ctx context.Context, request *CampaignUpdate
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.IfMatch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
 		return res, errors.Wrap(err, "do request")
@@ -1268,7 +3546,9 @@ func (c *Client) sendUploadCampaignImage(ctx context.Context, request UploadCamp
 // UpsertAdvertisers invokes upsertAdvertisers operation.
 //
 // Создаёт новых или обновляет существующих
-// рекламодателей.
+// рекламодателей. Не переданные бюджеты существующего
+// рекламодателя не меняются, `null` снимает ограничение.
+// Удалённых рекламодателей восстановить нельзя.
 //
 // POST /advertisers/bulk
 func (c *Client) UpsertAdvertisers(ctx context.Context, request []AdvertiserUpsert) (UpsertAdvertisersRes, error) {
@@ -1344,6 +3624,47 @@ func (c *Client) sendUpsertClients(ctx context.Context, request []ClientUpsert)
 	return result, nil
 }
 
+// UpsertLocations invokes upsertLocations operation.
+//
+// Создаёт новые или обновляет существующие локации
+// справочника. Родительская локация должна
+// существовать или передаваться в том же запросе.
+//
+// POST /locations/bulk
+func (c *Client) UpsertLocations(ctx context.Context, request []Location) (UpsertLocationsRes, error) {
+	res, err := c.sendUpsertLocations(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUpsertLocations(ctx context.Context, request []Location) (res UpsertLocationsRes, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/locations/bulk"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpsertLocationsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeUpsertLocationsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // UpsertMLScore invokes upsertMLScore operation.
 //
 // Добавляет или обновляет ML скор для указанной пары
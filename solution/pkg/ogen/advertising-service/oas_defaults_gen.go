@@ -0,0 +1,47 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package api
+
+// setDefaults set default value of fields.
+func (s *Campaign) setDefaults() {
+	{
+		val := Pacing("NONE")
+		s.Pacing = val
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CampaignCreate) setDefaults() {
+	{
+		val := Pacing("NONE")
+		s.Pacing.SetTo(val)
+	}
+	{
+		val := bool(false)
+		s.Draft.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CampaignOverrides) setDefaults() {
+	{
+		val := bool(false)
+		s.Draft.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *CampaignUpdate) setDefaults() {
+	{
+		val := Pacing("NONE")
+		s.Pacing.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *TargetingRule) setDefaults() {
+	{
+		val := bool(false)
+		s.Negate.SetTo(val)
+	}
+}
@@ -106,13 +106,14 @@ func (s *Server) handleAdvanceDayRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCreateCampaignRequest handles createCampaign operation.
+// handleArchiveCampaignRequest handles archiveCampaign operation.
 //
-// Создаёт новую рекламную кампанию для указанного
-// рекламодателя.
+// Переводит кампанию в статус ARCHIVED. Архивная кампания
+// не показывается и не изменяется, но её статистика
+// сохраняется.
 //
-// POST /advertisers/{advertiserId}/campaigns
-func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/archive
+func (s *Server) handleArchiveCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -120,11 +121,11 @@ func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateCampaignOperation,
-			ID:   "createCampaign",
+			Name: ArchiveCampaignOperation,
+			ID:   "archiveCampaign",
 		}
 	)
-	params, err := decodeCreateCampaignParams(args, argsEscaped, r)
+	params, err := decodeArchiveCampaignParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -134,43 +135,32 @@ func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeCreateCampaignRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CreateCampaignRes
+	var response ArchiveCampaignRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateCampaignOperation,
-			OperationSummary: "Создание рекламной кампании",
-			OperationID:      "createCampaign",
-			Body:             request,
+			OperationName:    ArchiveCampaignOperation,
+			OperationSummary: "Архивирование рекламной кампании",
+			OperationID:      "archiveCampaign",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "advertiserId",
 					In:   "path",
 				}: params.AdvertiserId,
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CampaignCreate
-			Params   = CreateCampaignParams
-			Response = CreateCampaignRes
+			Request  = struct{}
+			Params   = ArchiveCampaignParams
+			Response = ArchiveCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -179,14 +169,14 @@ func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackCreateCampaignParams,
+			unpackArchiveCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateCampaign(ctx, request, params)
+				response, err = s.h.ArchiveCampaign(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateCampaign(ctx, request, params)
+		response, err = s.h.ArchiveCampaign(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -194,7 +184,7 @@ func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCreateCampaignResponse(response, w); err != nil {
+	if err := encodeArchiveCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -203,13 +193,15 @@ func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleDeleteCampaignRequest handles deleteCampaign operation.
+// handleCloneCampaignRequest handles cloneCampaign operation.
 //
-// Удаляет рекламную кампанию рекламодателя по
-// заданному campaignId.
+// Создаёт новую рекламную кампанию с таргетированием,
+// текстом и изображением указанной кампании. Даты и
+// лимиты можно переопределить, копия проверяется так
+// же, как при создании кампании.
 //
-// DELETE /advertisers/{advertiserId}/campaigns/{campaignId}
-func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/clone
+func (s *Server) handleCloneCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -217,11 +209,11 @@ func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteCampaignOperation,
-			ID:   "deleteCampaign",
+			Name: CloneCampaignOperation,
+			ID:   "cloneCampaign",
 		}
 	)
-	params, err := decodeDeleteCampaignParams(args, argsEscaped, r)
+	params, err := decodeCloneCampaignParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -231,15 +223,30 @@ func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCloneCampaignRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DeleteCampaignRes
+	var response CloneCampaignRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteCampaignOperation,
-			OperationSummary: "Удаление рекламной кампании",
-			OperationID:      "deleteCampaign",
-			Body:             nil,
+			OperationName:    CloneCampaignOperation,
+			OperationSummary: "Копирование рекламной кампании",
+			OperationID:      "cloneCampaign",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "advertiserId",
@@ -254,9 +261,9 @@ func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteCampaignParams
-			Response = DeleteCampaignRes
+			Request  = OptCampaignOverrides
+			Params   = CloneCampaignParams
+			Response = CloneCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -265,14 +272,14 @@ func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDeleteCampaignParams,
+			unpackCloneCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteCampaign(ctx, params)
+				response, err = s.h.CloneCampaign(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteCampaign(ctx, params)
+		response, err = s.h.CloneCampaign(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -280,7 +287,7 @@ func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeleteCampaignResponse(response, w); err != nil {
+	if err := encodeCloneCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -289,12 +296,13 @@ func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w
 	}
 }
 
-// handleGenerateAdTextRequest handles generateAdText operation.
+// handleCreateCampaignRequest handles createCampaign operation.
 //
-// Генерирует текст рекламного объявления.
+// Создаёт новую рекламную кампанию для указанного
+// рекламодателя.
 //
-// POST /ai/generate-ad-text
-func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /advertisers/{advertiserId}/campaigns
+func (s *Server) handleCreateCampaignRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -302,11 +310,21 @@ func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GenerateAdTextOperation,
-			ID:   "generateAdText",
+			Name: CreateCampaignOperation,
+			ID:   "createCampaign",
 		}
 	)
-	request, close, err := s.decodeGenerateAdTextRequest(r)
+	params, err := decodeCreateCampaignParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCreateCampaignRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -322,22 +340,27 @@ func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response GenerateAdTextRes
+	var response CreateCampaignRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GenerateAdTextOperation,
-			OperationSummary: "Генерация текста рекламного объявления",
-			OperationID:      "generateAdText",
+			OperationName:    CreateCampaignOperation,
+			OperationSummary: "Создание рекламной кампании",
+			OperationID:      "createCampaign",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *GenerateAdTextReq
-			Params   = struct{}
-			Response = GenerateAdTextRes
+			Request  = *CampaignCreate
+			Params   = CreateCampaignParams
+			Response = CreateCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -346,14 +369,14 @@ func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackCreateCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GenerateAdText(ctx, request)
+				response, err = s.h.CreateCampaign(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GenerateAdText(ctx, request)
+		response, err = s.h.CreateCampaign(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -361,7 +384,7 @@ func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGenerateAdTextResponse(response, w); err != nil {
+	if err := encodeCreateCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -370,13 +393,14 @@ func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetAdForClientRequest handles getAdForClient operation.
+// handleCreateCampaignTemplateRequest handles createCampaignTemplate operation.
 //
-// Возвращает рекламное объявление, подходящее для
-// показа клиенту с учетом таргетинга и ML скора.
+// Сохраняет параметры рекламной кампании под
+// названием, уникальным для рекламодателя. Из шаблона
+// можно создавать кампании.
 //
-// GET /ads
-func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /advertisers/{advertiserId}/campaign-templates
+func (s *Server) handleCreateCampaignTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -384,11 +408,11 @@ func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAdForClientOperation,
-			ID:   "getAdForClient",
+			Name: CreateCampaignTemplateOperation,
+			ID:   "createCampaignTemplate",
 		}
 	)
-	params, err := decodeGetAdForClientParams(args, argsEscaped, r)
+	params, err := decodeCreateCampaignTemplateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -398,28 +422,43 @@ func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeCreateCampaignTemplateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetAdForClientRes
+	var response CreateCampaignTemplateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAdForClientOperation,
-			OperationSummary: "Получение рекламного объявления для клиента",
-			OperationID:      "getAdForClient",
-			Body:             nil,
+			OperationName:    CreateCampaignTemplateOperation,
+			OperationSummary: "Создание шаблона рекламной кампании",
+			OperationID:      "createCampaignTemplate",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "client_id",
-					In:   "query",
-				}: params.ClientID,
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetAdForClientParams
-			Response = GetAdForClientRes
+			Request  = *CampaignTemplateCreate
+			Params   = CreateCampaignTemplateParams
+			Response = CreateCampaignTemplateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -428,14 +467,14 @@ func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetAdForClientParams,
+			unpackCreateCampaignTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAdForClient(ctx, params)
+				response, err = s.h.CreateCampaignTemplate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAdForClient(ctx, params)
+		response, err = s.h.CreateCampaignTemplate(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -443,7 +482,7 @@ func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetAdForClientResponse(response, w); err != nil {
+	if err := encodeCreateCampaignTemplateResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -452,12 +491,19 @@ func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetAdvertiserByIdRequest handles getAdvertiserById operation.
+// handleDeleteAdvertiserRequest handles deleteAdvertiser operation.
 //
-// Возвращает информацию о рекламодателе по его ID.
+// Удаляет рекламодателя и архивирует все его рекламные
+// кампании. Рекламодателя с активными кампаниями
+// удалить нельзя, их нужно сначала приостановить или
+// архивировать. Показы и переходы кампаний сохраняются,
 //
-// GET /advertisers/{advertiserId}
-func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+//	повторное создание рекламодателя с тем же ID
+//
+// восстанавливает его без восстановления кампаний.
+//
+// DELETE /advertisers/{advertiserId}
+func (s *Server) handleDeleteAdvertiserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -465,11 +511,11 @@ func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAdvertiserByIdOperation,
-			ID:   "getAdvertiserById",
+			Name: DeleteAdvertiserOperation,
+			ID:   "deleteAdvertiser",
 		}
 	)
-	params, err := decodeGetAdvertiserByIdParams(args, argsEscaped, r)
+	params, err := decodeDeleteAdvertiserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -480,13 +526,13 @@ func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	var response GetAdvertiserByIdRes
+	var response DeleteAdvertiserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAdvertiserByIdOperation,
-			OperationSummary: "Получение рекламодателя по ID",
-			OperationID:      "getAdvertiserById",
+			OperationName:    DeleteAdvertiserOperation,
+			OperationSummary: "Удаление рекламодателя",
+			OperationID:      "deleteAdvertiser",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -499,8 +545,8 @@ func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool
 
 		type (
 			Request  = struct{}
-			Params   = GetAdvertiserByIdParams
-			Response = GetAdvertiserByIdRes
+			Params   = DeleteAdvertiserParams
+			Response = DeleteAdvertiserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -509,14 +555,14 @@ func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetAdvertiserByIdParams,
+			unpackDeleteAdvertiserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAdvertiserById(ctx, params)
+				response, err = s.h.DeleteAdvertiser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAdvertiserById(ctx, params)
+		response, err = s.h.DeleteAdvertiser(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -524,7 +570,7 @@ func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetAdvertiserByIdResponse(response, w); err != nil {
+	if err := encodeDeleteAdvertiserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -533,13 +579,16 @@ func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetAdvertiserCampaignsStatsRequest handles getAdvertiserCampaignsStats operation.
+// handleDeleteCampaignRequest handles deleteCampaign operation.
 //
-// Возвращает сводную статистику по всем рекламным
-// кампаниям, принадлежащим заданному рекламодателю.
+// Удаляет рекламную кампанию рекламодателя по
+// заданному campaignId. Кампания перестаёт показываться и
+// возвращаться, изображение объявления удаляется, но
+// показы и переходы кампании сохраняются в статистике
+// рекламодателя.
 //
-// GET /stats/advertisers/{advertiserId}/campaigns
-func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /advertisers/{advertiserId}/campaigns/{campaignId}
+func (s *Server) handleDeleteCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -547,11 +596,11 @@ func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEs
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAdvertiserCampaignsStatsOperation,
-			ID:   "getAdvertiserCampaignsStats",
+			Name: DeleteCampaignOperation,
+			ID:   "deleteCampaign",
 		}
 	)
-	params, err := decodeGetAdvertiserCampaignsStatsParams(args, argsEscaped, r)
+	params, err := decodeDeleteCampaignParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -562,27 +611,31 @@ func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEs
 		return
 	}
 
-	var response GetAdvertiserCampaignsStatsRes
+	var response DeleteCampaignRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAdvertiserCampaignsStatsOperation,
-			OperationSummary: "Получение агрегированной статистики по всем кампаниям рекламодателя",
-			OperationID:      "getAdvertiserCampaignsStats",
+			OperationName:    DeleteCampaignOperation,
+			OperationSummary: "Удаление рекламной кампании",
+			OperationID:      "deleteCampaign",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "advertiserId",
 					In:   "path",
 				}: params.AdvertiserId,
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAdvertiserCampaignsStatsParams
-			Response = GetAdvertiserCampaignsStatsRes
+			Params   = DeleteCampaignParams
+			Response = DeleteCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -591,14 +644,14 @@ func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEs
 		](
 			m,
 			mreq,
-			unpackGetAdvertiserCampaignsStatsParams,
+			unpackDeleteCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAdvertiserCampaignsStats(ctx, params)
+				response, err = s.h.DeleteCampaign(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAdvertiserCampaignsStats(ctx, params)
+		response, err = s.h.DeleteCampaign(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -606,7 +659,7 @@ func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEs
 		return
 	}
 
-	if err := encodeGetAdvertiserCampaignsStatsResponse(response, w); err != nil {
+	if err := encodeDeleteCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -615,13 +668,13 @@ func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEs
 	}
 }
 
-// handleGetAdvertiserDailyStatsRequest handles getAdvertiserDailyStats operation.
+// handleDeleteCampaignTemplateRequest handles deleteCampaignTemplate operation.
 //
-// Возвращает массив ежедневной сводной статистики по
-// всем рекламным кампаниям заданного рекламодателя.
+// Удаляет шаблон. Кампании, созданные из шаблона, не
+// изменяются.
 //
-// GET /stats/advertisers/{advertiserId}/campaigns/daily
-func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /advertisers/{advertiserId}/campaign-templates/{templateId}
+func (s *Server) handleDeleteCampaignTemplateRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -629,11 +682,11 @@ func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscape
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAdvertiserDailyStatsOperation,
-			ID:   "getAdvertiserDailyStats",
+			Name: DeleteCampaignTemplateOperation,
+			ID:   "deleteCampaignTemplate",
 		}
 	)
-	params, err := decodeGetAdvertiserDailyStatsParams(args, argsEscaped, r)
+	params, err := decodeDeleteCampaignTemplateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -644,27 +697,31 @@ func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscape
 		return
 	}
 
-	var response GetAdvertiserDailyStatsRes
+	var response DeleteCampaignTemplateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAdvertiserDailyStatsOperation,
-			OperationSummary: "Получение ежедневной агрегированной статистики по всем кампаниям рекламодателя",
-			OperationID:      "getAdvertiserDailyStats",
+			OperationName:    DeleteCampaignTemplateOperation,
+			OperationSummary: "Удаление шаблона рекламной кампании",
+			OperationID:      "deleteCampaignTemplate",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "advertiserId",
 					In:   "path",
 				}: params.AdvertiserId,
+				{
+					Name: "templateId",
+					In:   "path",
+				}: params.TemplateId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetAdvertiserDailyStatsParams
-			Response = GetAdvertiserDailyStatsRes
+			Params   = DeleteCampaignTemplateParams
+			Response = DeleteCampaignTemplateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -673,14 +730,14 @@ func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetAdvertiserDailyStatsParams,
+			unpackDeleteCampaignTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAdvertiserDailyStats(ctx, params)
+				response, err = s.h.DeleteCampaignTemplate(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAdvertiserDailyStats(ctx, params)
+		response, err = s.h.DeleteCampaignTemplate(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -688,7 +745,7 @@ func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeGetAdvertiserDailyStatsResponse(response, w); err != nil {
+	if err := encodeDeleteCampaignTemplateResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -697,12 +754,15 @@ func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscape
 	}
 }
 
-// handleGetCampaignRequest handles getCampaign operation.
+// handleEraseClientRequest handles eraseClient operation.
 //
-// Получение кампании по ID.
+// Удаляет клиента и его ML скоры в одной транзакции.
+// Показы и переходы клиента обезличиваются -
+// сохраняются без ссылки на клиента, поэтому
+// статистика и расходы рекламодателей не меняются.
 //
-// GET /advertisers/{advertiserId}/campaigns/{campaignId}
-func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /clients/{clientId}
+func (s *Server) handleEraseClientRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -710,11 +770,2015 @@ func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w ht
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCampaignOperation,
+			Name: EraseClientOperation,
+			ID:   "eraseClient",
+		}
+	)
+	params, err := decodeEraseClientParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response EraseClientRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    EraseClientOperation,
+			OperationSummary: "Удаление данных клиента",
+			OperationID:      "eraseClient",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "clientId",
+					In:   "path",
+				}: params.ClientId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = EraseClientParams
+			Response = EraseClientRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackEraseClientParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EraseClient(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EraseClient(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEraseClientResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleExplainAdForClientRequest handles explainAdForClient operation.
+//
+// Возвращает все рекламные кампании с причинами, по
+// которым они не подходят клиенту, и разбор
+// ранжирования подходящих кампаний. Показ не
+// записывается.
+//
+// GET /admin/ads/explain
+func (s *Server) handleExplainAdForClientRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ExplainAdForClientOperation,
+			ID:   "explainAdForClient",
+		}
+	)
+	params, err := decodeExplainAdForClientParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ExplainAdForClientRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ExplainAdForClientOperation,
+			OperationSummary: "Объяснение подбора рекламного объявления для клиента",
+			OperationID:      "explainAdForClient",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "client_id",
+					In:   "query",
+				}: params.ClientID,
+				{
+					Name: "campaign_id",
+					In:   "query",
+				}: params.CampaignID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ExplainAdForClientParams
+			Response = ExplainAdForClientRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackExplainAdForClientParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ExplainAdForClient(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ExplainAdForClient(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeExplainAdForClientResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleExportCampaignsRequest handles exportCampaigns operation.
+//
+// Возвращает все рекламные кампании рекламодателя в
+// порядке создания в формате CSV или NDJSON. Файл в том же
+// формате можно передать в импорт.
+//
+// GET /advertisers/{advertiserId}/campaigns/export
+func (s *Server) handleExportCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ExportCampaignsOperation,
+			ID:   "exportCampaigns",
+		}
+	)
+	params, err := decodeExportCampaignsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ExportCampaignsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ExportCampaignsOperation,
+			OperationSummary: "Экспорт рекламных кампаний",
+			OperationID:      "exportCampaigns",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ExportCampaignsParams
+			Response = ExportCampaignsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackExportCampaignsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ExportCampaigns(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ExportCampaigns(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeExportCampaignsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleExportClientDataRequest handles exportClientData operation.
+//
+// Возвращает все хранящиеся данные о клиенте - профиль,
+// ML скоры, показы и переходы.
+//
+// GET /clients/{clientId}/export
+func (s *Server) handleExportClientDataRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ExportClientDataOperation,
+			ID:   "exportClientData",
+		}
+	)
+	params, err := decodeExportClientDataParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ExportClientDataRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ExportClientDataOperation,
+			OperationSummary: "Выгрузка данных клиента",
+			OperationID:      "exportClientData",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "clientId",
+					In:   "path",
+				}: params.ClientId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ExportClientDataParams
+			Response = ExportClientDataRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackExportClientDataParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ExportClientData(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ExportClientData(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeExportClientDataResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGenerateAdTextRequest handles generateAdText operation.
+//
+// Генерирует текст рекламного объявления.
+//
+// POST /ai/generate-ad-text
+func (s *Server) handleGenerateAdTextRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GenerateAdTextOperation,
+			ID:   "generateAdText",
+		}
+	)
+	request, close, err := s.decodeGenerateAdTextRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GenerateAdTextRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GenerateAdTextOperation,
+			OperationSummary: "Генерация текста рекламного объявления",
+			OperationID:      "generateAdText",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GenerateAdTextReq
+			Params   = struct{}
+			Response = GenerateAdTextRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GenerateAdText(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GenerateAdText(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGenerateAdTextResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAdForClientRequest handles getAdForClient operation.
+//
+// Возвращает рекламное объявление, подходящее для
+// показа клиенту с учетом таргетинга и ML скора.
+//
+// GET /ads
+func (s *Server) handleGetAdForClientRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAdForClientOperation,
+			ID:   "getAdForClient",
+		}
+	)
+	params, err := decodeGetAdForClientParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAdForClientRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAdForClientOperation,
+			OperationSummary: "Получение рекламного объявления для клиента",
+			OperationID:      "getAdForClient",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "client_id",
+					In:   "query",
+				}: params.ClientID,
+				{
+					Name: "slots",
+					In:   "query",
+				}: params.Slots,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAdForClientParams
+			Response = GetAdForClientRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAdForClientParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAdForClient(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAdForClient(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAdForClientResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAdvertiserByIdRequest handles getAdvertiserById operation.
+//
+// Возвращает информацию о рекламодателе по его ID.
+//
+// GET /advertisers/{advertiserId}
+func (s *Server) handleGetAdvertiserByIdRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAdvertiserByIdOperation,
+			ID:   "getAdvertiserById",
+		}
+	)
+	params, err := decodeGetAdvertiserByIdParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAdvertiserByIdRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAdvertiserByIdOperation,
+			OperationSummary: "Получение рекламодателя по ID",
+			OperationID:      "getAdvertiserById",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAdvertiserByIdParams
+			Response = GetAdvertiserByIdRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAdvertiserByIdParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAdvertiserById(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAdvertiserById(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAdvertiserByIdResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAdvertiserCampaignsStatsRequest handles getAdvertiserCampaignsStats operation.
+//
+// Возвращает сводную статистику по всем рекламным
+// кампаниям, принадлежащим заданному рекламодателю.
+//
+// GET /stats/advertisers/{advertiserId}/campaigns
+func (s *Server) handleGetAdvertiserCampaignsStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAdvertiserCampaignsStatsOperation,
+			ID:   "getAdvertiserCampaignsStats",
+		}
+	)
+	params, err := decodeGetAdvertiserCampaignsStatsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAdvertiserCampaignsStatsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAdvertiserCampaignsStatsOperation,
+			OperationSummary: "Получение агрегированной статистики по всем кампаниям рекламодателя",
+			OperationID:      "getAdvertiserCampaignsStats",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAdvertiserCampaignsStatsParams
+			Response = GetAdvertiserCampaignsStatsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAdvertiserCampaignsStatsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAdvertiserCampaignsStats(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAdvertiserCampaignsStats(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAdvertiserCampaignsStatsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAdvertiserDailyStatsRequest handles getAdvertiserDailyStats operation.
+//
+// Возвращает массив ежедневной сводной статистики по
+// всем рекламным кампаниям заданного рекламодателя.
+//
+// GET /stats/advertisers/{advertiserId}/campaigns/daily
+func (s *Server) handleGetAdvertiserDailyStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAdvertiserDailyStatsOperation,
+			ID:   "getAdvertiserDailyStats",
+		}
+	)
+	params, err := decodeGetAdvertiserDailyStatsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAdvertiserDailyStatsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAdvertiserDailyStatsOperation,
+			OperationSummary: "Получение ежедневной агрегированной статистики по всем кампаниям рекламодателя",
+			OperationID:      "getAdvertiserDailyStats",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAdvertiserDailyStatsParams
+			Response = GetAdvertiserDailyStatsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAdvertiserDailyStatsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAdvertiserDailyStats(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAdvertiserDailyStats(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAdvertiserDailyStatsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCampaignRequest handles getCampaign operation.
+//
+// Получение кампании по ID.
+//
+// GET /advertisers/{advertiserId}/campaigns/{campaignId}
+func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCampaignOperation,
 			ID:   "getCampaign",
 		}
 	)
-	params, err := decodeGetCampaignParams(args, argsEscaped, r)
+	params, err := decodeGetCampaignParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetCampaignRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCampaignOperation,
+			OperationSummary: "Получение кампании по ID",
+			OperationID:      "getCampaign",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetCampaignParams
+			Response = GetCampaignRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetCampaignParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCampaign(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCampaign(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetCampaignResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCampaignDailyStatsRequest handles getCampaignDailyStats operation.
+//
+// Возвращает массив ежедневной статистики для
+// указанной рекламной кампании. Каждый день от даты
+// начала до даты окончания кампании присутствует в
+// массиве, дни без показов и переходов возвращаются с
+// нулевой статистикой.
+//
+// GET /stats/campaigns/{campaignId}/daily
+func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCampaignDailyStatsOperation,
+			ID:   "getCampaignDailyStats",
+		}
+	)
+	params, err := decodeGetCampaignDailyStatsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetCampaignDailyStatsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCampaignDailyStatsOperation,
+			OperationSummary: "Получение ежедневной статистики по рекламной кампании",
+			OperationID:      "getCampaignDailyStats",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetCampaignDailyStatsParams
+			Response = GetCampaignDailyStatsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetCampaignDailyStatsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCampaignDailyStats(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCampaignDailyStats(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetCampaignDailyStatsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCampaignStatsRequest handles getCampaignStats operation.
+//
+// Возвращает агрегированную статистику (показы,
+// переходы, затраты и конверсию) для заданной рекламной
+// кампании.
+//
+// GET /stats/campaigns/{campaignId}
+func (s *Server) handleGetCampaignStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCampaignStatsOperation,
+			ID:   "getCampaignStats",
+		}
+	)
+	params, err := decodeGetCampaignStatsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetCampaignStatsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCampaignStatsOperation,
+			OperationSummary: "Получение статистики по рекламной кампании",
+			OperationID:      "getCampaignStats",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetCampaignStatsParams
+			Response = GetCampaignStatsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetCampaignStatsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCampaignStats(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCampaignStats(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetCampaignStatsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCampaignTemplateRequest handles getCampaignTemplate operation.
+//
+// Получение шаблона рекламной кампании.
+//
+// GET /advertisers/{advertiserId}/campaign-templates/{templateId}
+func (s *Server) handleGetCampaignTemplateRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCampaignTemplateOperation,
+			ID:   "getCampaignTemplate",
+		}
+	)
+	params, err := decodeGetCampaignTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetCampaignTemplateRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCampaignTemplateOperation,
+			OperationSummary: "Получение шаблона рекламной кампании",
+			OperationID:      "getCampaignTemplate",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "templateId",
+					In:   "path",
+				}: params.TemplateId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetCampaignTemplateParams
+			Response = GetCampaignTemplateRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetCampaignTemplateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCampaignTemplate(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCampaignTemplate(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetCampaignTemplateResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetClientByIdRequest handles getClientById operation.
+//
+// Возвращает информацию о клиенте по его ID.
+//
+// GET /clients/{clientId}
+func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetClientByIdOperation,
+			ID:   "getClientById",
+		}
+	)
+	params, err := decodeGetClientByIdParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetClientByIdRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetClientByIdOperation,
+			OperationSummary: "Получение клиента по ID",
+			OperationID:      "getClientById",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "clientId",
+					In:   "path",
+				}: params.ClientId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetClientByIdParams
+			Response = GetClientByIdRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetClientByIdParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetClientById(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetClientById(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetClientByIdResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleImportCampaignsRequest handles importCampaigns operation.
+//
+// Создаёт рекламные кампании из файла CSV или NDJSON. Каждая
+// строка проверяется так же, как запрос на создание
+// кампании, результат возвращается по каждой строке.
+//
+// POST /advertisers/{advertiserId}/campaigns/import
+func (s *Server) handleImportCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ImportCampaignsOperation,
+			ID:   "importCampaigns",
+		}
+	)
+	params, err := decodeImportCampaignsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeImportCampaignsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ImportCampaignsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ImportCampaignsOperation,
+			OperationSummary: "Массовый импорт рекламных кампаний",
+			OperationID:      "importCampaigns",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "atomic",
+					In:   "query",
+				}: params.Atomic,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = ImportCampaignsReq
+			Params   = ImportCampaignsParams
+			Response = ImportCampaignsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackImportCampaignsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ImportCampaigns(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ImportCampaigns(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeImportCampaignsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleInstantiateCampaignTemplateRequest handles instantiateCampaignTemplate operation.
+//
+// Создаёт рекламную кампанию с параметрами шаблона.
+// Даты и лимиты можно переопределить, кампания
+// проверяется так же, как при обычном создании.
+//
+// POST /advertisers/{advertiserId}/campaign-templates/{templateId}/campaigns
+func (s *Server) handleInstantiateCampaignTemplateRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: InstantiateCampaignTemplateOperation,
+			ID:   "instantiateCampaignTemplate",
+		}
+	)
+	params, err := decodeInstantiateCampaignTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeInstantiateCampaignTemplateRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response InstantiateCampaignTemplateRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    InstantiateCampaignTemplateOperation,
+			OperationSummary: "Создание рекламной кампании из шаблона",
+			OperationID:      "instantiateCampaignTemplate",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "templateId",
+					In:   "path",
+				}: params.TemplateId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = OptCampaignOverrides
+			Params   = InstantiateCampaignTemplateParams
+			Response = InstantiateCampaignTemplateRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackInstantiateCampaignTemplateParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.InstantiateCampaignTemplate(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.InstantiateCampaignTemplate(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeInstantiateCampaignTemplateResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAdvertisersRequest handles listAdvertisers operation.
+//
+// Возвращает рекламодателей, отсортированных по имени,
+// с пагинацией и поиском по части имени без учёта
+// регистра. Удалённые рекламодатели не возвращаются.
+//
+// GET /advertisers
+func (s *Server) handleListAdvertisersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListAdvertisersOperation,
+			ID:   "listAdvertisers",
+		}
+	)
+	params, err := decodeListAdvertisersParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListAdvertisersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListAdvertisersOperation,
+			OperationSummary: "Получение списка рекламодателей",
+			OperationID:      "listAdvertisers",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListAdvertisersParams
+			Response = ListAdvertisersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListAdvertisersParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAdvertisers(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAdvertisers(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAdvertisersResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListCampaignHistoryRequest handles listCampaignHistory operation.
+//
+// Возвращает версии рекламной кампании, начиная с
+// последней. Версия сохраняется при создании,
+// обновлении, изменении изображения и статуса,
+// удалении и восстановлении кампании.
+//
+// GET /advertisers/{advertiserId}/campaigns/{campaignId}/history
+func (s *Server) handleListCampaignHistoryRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListCampaignHistoryOperation,
+			ID:   "listCampaignHistory",
+		}
+	)
+	params, err := decodeListCampaignHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListCampaignHistoryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListCampaignHistoryOperation,
+			OperationSummary: "История изменений рекламной кампании",
+			OperationID:      "listCampaignHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListCampaignHistoryParams
+			Response = ListCampaignHistoryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListCampaignHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListCampaignHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListCampaignHistory(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListCampaignHistoryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListCampaignTemplatesRequest handles listCampaignTemplates operation.
+//
+// Возвращает шаблоны рекламных кампаний рекламодателя,
+//
+//	упорядоченные по названию.
+//
+// GET /advertisers/{advertiserId}/campaign-templates
+func (s *Server) handleListCampaignTemplatesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListCampaignTemplatesOperation,
+			ID:   "listCampaignTemplates",
+		}
+	)
+	params, err := decodeListCampaignTemplatesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListCampaignTemplatesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListCampaignTemplatesOperation,
+			OperationSummary: "Получение шаблонов рекламных кампаний",
+			OperationID:      "listCampaignTemplates",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListCampaignTemplatesParams
+			Response = ListCampaignTemplatesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListCampaignTemplatesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListCampaignTemplates(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListCampaignTemplates(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListCampaignTemplatesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListCampaignsRequest handles listCampaigns operation.
+//
+// Возвращает список рекламных кампаний для указанного
+// рекламодателя с фильтрами, сортировкой и пагинацией.
+// Для постраничного обхода большого списка
+// используйте курсор из заголовка X-Next-Cursor, он устойчив
+// к созданию и удалению кампаний между запросами.
+//
+// GET /advertisers/{advertiserId}/campaigns
+func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListCampaignsOperation,
+			ID:   "listCampaigns",
+		}
+	)
+	params, err := decodeListCampaignsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ListCampaignsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListCampaignsOperation,
+			OperationSummary: "Получение рекламных кампаний рекламодателя c пагинацией",
+			OperationID:      "listCampaigns",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "moderation_status",
+					In:   "query",
+				}: params.ModerationStatus,
+				{
+					Name: "active_on",
+					In:   "query",
+				}: params.ActiveOn,
+				{
+					Name: "location",
+					In:   "query",
+				}: params.Location,
+				{
+					Name: "gender",
+					In:   "query",
+				}: params.Gender,
+				{
+					Name: "title",
+					In:   "query",
+				}: params.Title,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = ListCampaignsParams
+			Response = ListCampaignsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackListCampaignsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListCampaigns(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListCampaigns(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListCampaignsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListLocationsRequest handles listLocations operation.
+//
+// Возвращает все локации справочника.
+//
+// GET /locations
+func (s *Server) handleListLocationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err error
+	)
+
+	var response []Location
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ListLocationsOperation,
+			OperationSummary: "Получение справочника локаций",
+			OperationID:      "listLocations",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []Location
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListLocations(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListLocations(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListLocationsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoadMLScoresRequest handles loadMLScores operation.
+//
+// Добавляет или обновляет ML скоры из файла CSV или NDJSON,
+// файл может быть сжат gzip. Файл читается потоково и
+// загружается в базу через COPY. Строки с ошибками не
+// мешают загрузке остальных, при повторе пары
+// клиент-рекламодатель сохраняется скор из последней
+// строки.
+//
+// POST /ml-scores/bulk
+func (s *Server) handleLoadMLScoresRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: LoadMLScoresOperation,
+			ID:   "loadMLScores",
+		}
+	)
+	request, close, err := s.decodeLoadMLScoresRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response LoadMLScoresRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    LoadMLScoresOperation,
+			OperationSummary: "Массовая загрузка ML скоров",
+			OperationID:      "loadMLScores",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = LoadMLScoresReq
+			Params   = struct{}
+			Response = LoadMLScoresRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.LoadMLScores(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.LoadMLScores(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLoadMLScoresResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleModerateAdTextRequest handles moderateAdText operation.
+//
+// Модерирует текст рекламного объявления.
+//
+// POST /ai/moderate-ad-text
+func (s *Server) handleModerateAdTextRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ModerateAdTextOperation,
+			ID:   "moderateAdText",
+		}
+	)
+	request, close, err := s.decodeModerateAdTextRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ModerateAdTextRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ModerateAdTextOperation,
+			OperationSummary: "Модерация текста рекламного объявления",
+			OperationID:      "moderateAdText",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *ModerateAdTextReq
+			Params   = struct{}
+			Response = ModerateAdTextRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ModerateAdText(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ModerateAdText(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeModerateAdTextResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleOverrideCampaignModerationRequest handles overrideCampaignModeration operation.
+//
+// Одобряет или отклоняет рекламную кампанию вместо
+// автоматической модерации. Найденные модерацией
+// фразы сохраняются, решение действует до следующего
+// изменения названия или текста объявления.
+//
+// POST /admin/campaigns/{campaignId}/moderation
+func (s *Server) handleOverrideCampaignModerationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: OverrideCampaignModerationOperation,
+			ID:   "overrideCampaignModeration",
+		}
+	)
+	params, err := decodeOverrideCampaignModerationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -724,14 +2788,219 @@ func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w ht
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeOverrideCampaignModerationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetCampaignRes
+	var response OverrideCampaignModerationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCampaignOperation,
-			OperationSummary: "Получение кампании по ID",
-			OperationID:      "getCampaign",
+			OperationName:    OverrideCampaignModerationOperation,
+			OperationSummary: "Изменение решения модерации рекламной кампании",
+			OperationID:      "overrideCampaignModeration",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *OverrideCampaignModerationReq
+			Params   = OverrideCampaignModerationParams
+			Response = OverrideCampaignModerationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackOverrideCampaignModerationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.OverrideCampaignModeration(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.OverrideCampaignModeration(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeOverrideCampaignModerationResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePatchCampaignRequest handles patchCampaign operation.
+//
+// Изменяет только переданные параметры рекламной
+// кампании по правилам JSON Merge Patch (RFC 7396). Значение null
+// удаляет необязательное поле, например параметр
+// таргетирования. К результату применяются те же
+// проверки, что и при полном обновлении.
+//
+// PATCH /advertisers/{advertiserId}/campaigns/{campaignId}
+func (s *Server) handlePatchCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PatchCampaignOperation,
+			ID:   "patchCampaign",
+		}
+	)
+	params, err := decodePatchCampaignParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodePatchCampaignRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PatchCampaignRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PatchCampaignOperation,
+			OperationSummary: "Частичное обновление рекламной кампании",
+			OperationID:      "patchCampaign",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = PatchCampaignReq
+			Params   = PatchCampaignParams
+			Response = PatchCampaignRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackPatchCampaignParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PatchCampaign(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PatchCampaign(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePatchCampaignResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePauseCampaignRequest handles pauseCampaign operation.
+//
+// Переводит активную кампанию в статус PAUSED, объявления
+// кампании перестают показываться клиентам.
+//
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/pause
+func (s *Server) handlePauseCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PauseCampaignOperation,
+			ID:   "pauseCampaign",
+		}
+	)
+	params, err := decodePauseCampaignParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response PauseCampaignRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    PauseCampaignOperation,
+			OperationSummary: "Приостановка рекламной кампании",
+			OperationID:      "pauseCampaign",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -748,8 +3017,8 @@ func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w ht
 
 		type (
 			Request  = struct{}
-			Params   = GetCampaignParams
-			Response = GetCampaignRes
+			Params   = PauseCampaignParams
+			Response = PauseCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -758,14 +3027,14 @@ func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetCampaignParams,
+			unpackPauseCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCampaign(ctx, params)
+				response, err = s.h.PauseCampaign(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCampaign(ctx, params)
+		response, err = s.h.PauseCampaign(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -773,7 +3042,7 @@ func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetCampaignResponse(response, w); err != nil {
+	if err := encodePauseCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -782,13 +3051,14 @@ func (s *Server) handleGetCampaignRequest(args [2]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetCampaignDailyStatsRequest handles getCampaignDailyStats operation.
+// handlePurgeCampaignRequest handles purgeCampaign operation.
 //
-// Возвращает массив ежедневной статистики для
-// указанной рекламной кампании.
+// Удаляет рекламную кампанию, в том числе уже удалённую
+// рекламодателем, вместе с её показами, переходами и
+// изображением объявления.
 //
-// GET /stats/campaigns/{campaignId}/daily
-func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /admin/campaigns/{campaignId}
+func (s *Server) handlePurgeCampaignRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -796,11 +3066,11 @@ func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCampaignDailyStatsOperation,
-			ID:   "getCampaignDailyStats",
+			Name: PurgeCampaignOperation,
+			ID:   "purgeCampaign",
 		}
 	)
-	params, err := decodeGetCampaignDailyStatsParams(args, argsEscaped, r)
+	params, err := decodePurgeCampaignParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -811,13 +3081,13 @@ func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	var response GetCampaignDailyStatsRes
+	var response PurgeCampaignRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCampaignDailyStatsOperation,
-			OperationSummary: "Получение ежедневной статистики по рекламной кампании",
-			OperationID:      "getCampaignDailyStats",
+			OperationName:    PurgeCampaignOperation,
+			OperationSummary: "Окончательное удаление рекламной кампании",
+			OperationID:      "purgeCampaign",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -830,8 +3100,8 @@ func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped
 
 		type (
 			Request  = struct{}
-			Params   = GetCampaignDailyStatsParams
-			Response = GetCampaignDailyStatsRes
+			Params   = PurgeCampaignParams
+			Response = PurgeCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -840,14 +3110,14 @@ func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetCampaignDailyStatsParams,
+			unpackPurgeCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCampaignDailyStats(ctx, params)
+				response, err = s.h.PurgeCampaign(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCampaignDailyStats(ctx, params)
+		response, err = s.h.PurgeCampaign(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -855,7 +3125,7 @@ func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetCampaignDailyStatsResponse(response, w); err != nil {
+	if err := encodePurgeCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -864,14 +3134,13 @@ func (s *Server) handleGetCampaignDailyStatsRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetCampaignStatsRequest handles getCampaignStats operation.
+// handleRecordAdClickRequest handles recordAdClick operation.
 //
-// Возвращает агрегированную статистику (показы,
-// переходы, затраты и конверсию) для заданной рекламной
-// кампании.
+// Фиксирует клик (переход) клиента по рекламному
+// объявлению.
 //
-// GET /stats/campaigns/{campaignId}
-func (s *Server) handleGetCampaignStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /ads/{adId}/click
+func (s *Server) handleRecordAdClickRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -879,42 +3148,57 @@ func (s *Server) handleGetCampaignStatsRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCampaignStatsOperation,
-			ID:   "getCampaignStats",
+			Name: RecordAdClickOperation,
+			ID:   "recordAdClick",
 		}
 	)
-	params, err := decodeGetCampaignStatsParams(args, argsEscaped, r)
+	params, err := decodeRecordAdClickParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeRecordAdClickRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetCampaignStatsRes
+	var response RecordAdClickRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCampaignStatsOperation,
-			OperationSummary: "Получение статистики по рекламной кампании",
-			OperationID:      "getCampaignStats",
-			Body:             nil,
+			OperationName:    RecordAdClickOperation,
+			OperationSummary: "Фиксация перехода по рекламному объявлению",
+			OperationID:      "recordAdClick",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "campaignId",
+					Name: "adId",
 					In:   "path",
-				}: params.CampaignId,
+				}: params.AdId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetCampaignStatsParams
-			Response = GetCampaignStatsRes
+			Request  = *RecordAdClickReq
+			Params   = RecordAdClickParams
+			Response = RecordAdClickRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -923,14 +3207,14 @@ func (s *Server) handleGetCampaignStatsRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackGetCampaignStatsParams,
+			unpackRecordAdClickParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCampaignStats(ctx, params)
+				response, err = s.h.RecordAdClick(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCampaignStats(ctx, params)
+		response, err = s.h.RecordAdClick(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -938,7 +3222,7 @@ func (s *Server) handleGetCampaignStatsRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetCampaignStatsResponse(response, w); err != nil {
+	if err := encodeRecordAdClickResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -947,12 +3231,15 @@ func (s *Server) handleGetCampaignStatsRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetClientByIdRequest handles getClientById operation.
+// handleRestoreCampaignVersionRequest handles restoreCampaignVersion operation.
 //
-// Возвращает информацию о клиенте по его ID.
+// Возвращает параметры рекламной кампании к
+// сохранённой версии. Действуют те же ограничения, что
+// и при обновлении кампании, статус и изображение не
+// восстанавливаются.
 //
-// GET /clients/{clientId}
-func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/history/{version}/restore
+func (s *Server) handleRestoreCampaignVersionRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -960,11 +3247,11 @@ func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetClientByIdOperation,
-			ID:   "getClientById",
+			Name: RestoreCampaignVersionOperation,
+			ID:   "restoreCampaignVersion",
 		}
 	)
-	params, err := decodeGetClientByIdParams(args, argsEscaped, r)
+	params, err := decodeRestoreCampaignVersionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -975,27 +3262,35 @@ func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response GetClientByIdRes
+	var response RestoreCampaignVersionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetClientByIdOperation,
-			OperationSummary: "Получение клиента по ID",
-			OperationID:      "getClientById",
+			OperationName:    RestoreCampaignVersionOperation,
+			OperationSummary: "Восстановление версии рекламной кампании",
+			OperationID:      "restoreCampaignVersion",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "clientId",
+					Name: "advertiserId",
 					In:   "path",
-				}: params.ClientId,
+				}: params.AdvertiserId,
+				{
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
+				{
+					Name: "version",
+					In:   "path",
+				}: params.Version,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetClientByIdParams
-			Response = GetClientByIdRes
+			Params   = RestoreCampaignVersionParams
+			Response = RestoreCampaignVersionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1004,14 +3299,14 @@ func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetClientByIdParams,
+			unpackRestoreCampaignVersionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetClientById(ctx, params)
+				response, err = s.h.RestoreCampaignVersion(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetClientById(ctx, params)
+		response, err = s.h.RestoreCampaignVersion(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1019,7 +3314,7 @@ func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetClientByIdResponse(response, w); err != nil {
+	if err := encodeRestoreCampaignVersionResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1028,13 +3323,13 @@ func (s *Server) handleGetClientByIdRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleListCampaignsRequest handles listCampaigns operation.
+// handleResumeCampaignRequest handles resumeCampaign operation.
 //
-// Возвращает список рекламных кампаний для указанного
-// рекламодателя с пагинацией.
+// Переводит черновик или приостановленную кампанию в
+// статус ACTIVE.
 //
-// GET /advertisers/{advertiserId}/campaigns
-func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /advertisers/{advertiserId}/campaigns/{campaignId}/resume
+func (s *Server) handleResumeCampaignRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1042,11 +3337,11 @@ func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListCampaignsOperation,
-			ID:   "listCampaigns",
+			Name: ResumeCampaignOperation,
+			ID:   "resumeCampaign",
 		}
 	)
-	params, err := decodeListCampaignsParams(args, argsEscaped, r)
+	params, err := decodeResumeCampaignParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1057,13 +3352,13 @@ func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	var response ListCampaignsRes
+	var response ResumeCampaignRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListCampaignsOperation,
-			OperationSummary: "Получение рекламных кампаний рекламодателя c пагинацией",
-			OperationID:      "listCampaigns",
+			OperationName:    ResumeCampaignOperation,
+			OperationSummary: "Запуск рекламной кампании",
+			OperationID:      "resumeCampaign",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -1071,21 +3366,17 @@ func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w
 					In:   "path",
 				}: params.AdvertiserId,
 				{
-					Name: "size",
-					In:   "query",
-				}: params.Size,
-				{
-					Name: "page",
-					In:   "query",
-				}: params.Page,
+					Name: "campaignId",
+					In:   "path",
+				}: params.CampaignId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ListCampaignsParams
-			Response = ListCampaignsRes
+			Params   = ResumeCampaignParams
+			Response = ResumeCampaignRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1094,14 +3385,14 @@ func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackListCampaignsParams,
+			unpackResumeCampaignParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListCampaigns(ctx, params)
+				response, err = s.h.ResumeCampaign(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListCampaigns(ctx, params)
+		response, err = s.h.ResumeCampaign(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1109,7 +3400,7 @@ func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListCampaignsResponse(response, w); err != nil {
+	if err := encodeResumeCampaignResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1118,12 +3409,15 @@ func (s *Server) handleListCampaignsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleModerateAdTextRequest handles moderateAdText operation.
+// handleSearchAdvertiserCampaignsRequest handles searchAdvertiserCampaigns operation.
 //
-// Модерирует текст рекламного объявления.
+// Ищет кампании рекламодателя по словам в названии и
+// тексте объявления с учётом морфологии русского и
+// английского языков. Совпадения в названии важнее
+// совпадений в тексте.
 //
-// POST /ai/moderate-ad-text
-func (s *Server) handleModerateAdTextRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /advertisers/{advertiserId}/campaigns/search
+func (s *Server) handleSearchAdvertiserCampaignsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1131,42 +3425,54 @@ func (s *Server) handleModerateAdTextRequest(args [0]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ModerateAdTextOperation,
-			ID:   "moderateAdText",
+			Name: SearchAdvertiserCampaignsOperation,
+			ID:   "searchAdvertiserCampaigns",
 		}
 	)
-	request, close, err := s.decodeModerateAdTextRequest(r)
+	params, err := decodeSearchAdvertiserCampaignsParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response ModerateAdTextRes
+	var response SearchAdvertiserCampaignsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ModerateAdTextOperation,
-			OperationSummary: "Модерация текста рекламного объявления",
-			OperationID:      "moderateAdText",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    SearchAdvertiserCampaignsOperation,
+			OperationSummary: "Полнотекстовый поиск рекламных кампаний рекламодателя",
+			OperationID:      "searchAdvertiserCampaigns",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "advertiserId",
+					In:   "path",
+				}: params.AdvertiserId,
+				{
+					Name: "q",
+					In:   "query",
+				}: params.Q,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *ModerateAdTextReq
-			Params   = struct{}
-			Response = ModerateAdTextRes
+			Request  = struct{}
+			Params   = SearchAdvertiserCampaignsParams
+			Response = SearchAdvertiserCampaignsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1175,14 +3481,14 @@ func (s *Server) handleModerateAdTextRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackSearchAdvertiserCampaignsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ModerateAdText(ctx, request)
+				response, err = s.h.SearchAdvertiserCampaigns(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ModerateAdText(ctx, request)
+		response, err = s.h.SearchAdvertiserCampaigns(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1190,7 +3496,7 @@ func (s *Server) handleModerateAdTextRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeModerateAdTextResponse(response, w); err != nil {
+	if err := encodeSearchAdvertiserCampaignsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1199,13 +3505,15 @@ func (s *Server) handleModerateAdTextRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleRecordAdClickRequest handles recordAdClick operation.
+// handleSearchCampaignsRequest handles searchCampaigns operation.
 //
-// Фиксирует клик (переход) клиента по рекламному
-// объявлению.
+// Ищет кампании всех рекламодателей по словам в
+// названии и тексте объявления с учётом морфологии
+// русского и английского языков. Совпадения в названии
+// важнее совпадений в тексте.
 //
-// POST /ads/{adId}/click
-func (s *Server) handleRecordAdClickRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /admin/campaigns/search
+func (s *Server) handleSearchCampaignsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1213,11 +3521,11 @@ func (s *Server) handleRecordAdClickRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RecordAdClickOperation,
-			ID:   "recordAdClick",
+			Name: SearchCampaignsOperation,
+			ID:   "searchCampaigns",
 		}
 	)
-	params, err := decodeRecordAdClickParams(args, argsEscaped, r)
+	params, err := decodeSearchCampaignsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1227,43 +3535,36 @@ func (s *Server) handleRecordAdClickRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeRecordAdClickRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response RecordAdClickRes
+	var response SearchCampaignsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RecordAdClickOperation,
-			OperationSummary: "Фиксация перехода по рекламному объявлению",
-			OperationID:      "recordAdClick",
-			Body:             request,
+			OperationName:    SearchCampaignsOperation,
+			OperationSummary: "Полнотекстовый поиск по всем рекламным кампаниям",
+			OperationID:      "searchCampaigns",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "adId",
-					In:   "path",
-				}: params.AdId,
+					Name: "q",
+					In:   "query",
+				}: params.Q,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RecordAdClickReq
-			Params   = RecordAdClickParams
-			Response = RecordAdClickRes
+			Request  = struct{}
+			Params   = SearchCampaignsParams
+			Response = SearchCampaignsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1272,14 +3573,14 @@ func (s *Server) handleRecordAdClickRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackRecordAdClickParams,
+			unpackSearchCampaignsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RecordAdClick(ctx, request, params)
+				response, err = s.h.SearchCampaigns(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RecordAdClick(ctx, request, params)
+		response, err = s.h.SearchCampaigns(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1287,7 +3588,7 @@ func (s *Server) handleRecordAdClickRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeRecordAdClickResponse(response, w); err != nil {
+	if err := encodeSearchCampaignsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1357,6 +3658,10 @@ func (s *Server) handleUpdateCampaignRequest(args [2]string, argsEscaped bool, w
 					Name: "campaignId",
 					In:   "path",
 				}: params.CampaignId,
+				{
+					Name: "If-Match",
+					In:   "header",
+				}: params.IfMatch,
 			},
 			Raw: r,
 		}
@@ -1501,7 +3806,9 @@ func (s *Server) handleUploadCampaignImageRequest(args [2]string, argsEscaped bo
 // handleUpsertAdvertisersRequest handles upsertAdvertisers operation.
 //
 // Создаёт новых или обновляет существующих
-// рекламодателей.
+// рекламодателей. Не переданные бюджеты существующего
+// рекламодателя не меняются, `null` снимает ограничение.
+// Удалённых рекламодателей восстановить нельзя.
 //
 // POST /advertisers/bulk
 func (s *Server) handleUpsertAdvertisersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -1661,6 +3968,89 @@ func (s *Server) handleUpsertClientsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
+// handleUpsertLocationsRequest handles upsertLocations operation.
+//
+// Создаёт новые или обновляет существующие локации
+// справочника. Родительская локация должна
+// существовать или передаваться в том же запросе.
+//
+// POST /locations/bulk
+func (s *Server) handleUpsertLocationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpsertLocationsOperation,
+			ID:   "upsertLocations",
+		}
+	)
+	request, close, err := s.decodeUpsertLocationsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response UpsertLocationsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    UpsertLocationsOperation,
+			OperationSummary: "Массовое создание/обновление локаций",
+			OperationID:      "upsertLocations",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = []Location
+			Params   = struct{}
+			Response = UpsertLocationsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.UpsertLocations(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.UpsertLocations(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeUpsertLocationsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleUpsertMLScoreRequest handles upsertMLScore operation.
 //
 // Добавляет или обновляет ML скор для указанной пары
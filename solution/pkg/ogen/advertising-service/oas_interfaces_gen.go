@@ -5,14 +5,50 @@ type AdvanceDayRes interface {
 	advanceDayRes()
 }
 
+type ArchiveCampaignRes interface {
+	archiveCampaignRes()
+}
+
+type CloneCampaignRes interface {
+	cloneCampaignRes()
+}
+
 type CreateCampaignRes interface {
 	createCampaignRes()
 }
 
+type CreateCampaignTemplateRes interface {
+	createCampaignTemplateRes()
+}
+
+type DeleteAdvertiserRes interface {
+	deleteAdvertiserRes()
+}
+
 type DeleteCampaignRes interface {
 	deleteCampaignRes()
 }
 
+type DeleteCampaignTemplateRes interface {
+	deleteCampaignTemplateRes()
+}
+
+type EraseClientRes interface {
+	eraseClientRes()
+}
+
+type ExplainAdForClientRes interface {
+	explainAdForClientRes()
+}
+
+type ExportCampaignsRes interface {
+	exportCampaignsRes()
+}
+
+type ExportClientDataRes interface {
+	exportClientDataRes()
+}
+
 type GenerateAdTextRes interface {
 	generateAdTextRes()
 }
@@ -45,22 +81,90 @@ type GetCampaignStatsRes interface {
 	getCampaignStatsRes()
 }
 
+type GetCampaignTemplateRes interface {
+	getCampaignTemplateRes()
+}
+
 type GetClientByIdRes interface {
 	getClientByIdRes()
 }
 
+type ImportCampaignsReq interface {
+	importCampaignsReq()
+}
+
+type ImportCampaignsRes interface {
+	importCampaignsRes()
+}
+
+type InstantiateCampaignTemplateRes interface {
+	instantiateCampaignTemplateRes()
+}
+
+type ListAdvertisersRes interface {
+	listAdvertisersRes()
+}
+
+type ListCampaignHistoryRes interface {
+	listCampaignHistoryRes()
+}
+
+type ListCampaignTemplatesRes interface {
+	listCampaignTemplatesRes()
+}
+
 type ListCampaignsRes interface {
 	listCampaignsRes()
 }
 
+type LoadMLScoresReq interface {
+	loadMLScoresReq()
+}
+
+type LoadMLScoresRes interface {
+	loadMLScoresRes()
+}
+
 type ModerateAdTextRes interface {
 	moderateAdTextRes()
 }
 
+type OverrideCampaignModerationRes interface {
+	overrideCampaignModerationRes()
+}
+
+type PatchCampaignRes interface {
+	patchCampaignRes()
+}
+
+type PauseCampaignRes interface {
+	pauseCampaignRes()
+}
+
+type PurgeCampaignRes interface {
+	purgeCampaignRes()
+}
+
 type RecordAdClickRes interface {
 	recordAdClickRes()
 }
 
+type RestoreCampaignVersionRes interface {
+	restoreCampaignVersionRes()
+}
+
+type ResumeCampaignRes interface {
+	resumeCampaignRes()
+}
+
+type SearchAdvertiserCampaignsRes interface {
+	searchAdvertiserCampaignsRes()
+}
+
+type SearchCampaignsRes interface {
+	searchCampaignsRes()
+}
+
 type UpdateCampaignRes interface {
 	updateCampaignRes()
 }
@@ -81,6 +185,10 @@ type UpsertClientsRes interface {
 	upsertClientsRes()
 }
 
+type UpsertLocationsRes interface {
+	upsertLocationsRes()
+}
+
 type UpsertMLScoreRes interface {
 	upsertMLScoreRes()
 }
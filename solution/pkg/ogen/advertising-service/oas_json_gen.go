@@ -177,6 +177,144 @@ func (s *Ad) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *AdExplanation) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *AdExplanation) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("client_id")
+		json.EncodeUUID(e, s.ClientID)
+	}
+	{
+		e.FieldStart("current_date")
+		s.CurrentDate.Encode(e)
+	}
+	{
+		e.FieldStart("campaigns")
+		e.ArrStart()
+		for _, elem := range s.Campaigns {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfAdExplanation = [3]string{
+	0: "client_id",
+	1: "current_date",
+	2: "campaigns",
+}
+
+// Decode decodes AdExplanation from json.
+func (s *AdExplanation) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AdExplanation to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "client_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ClientID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_id\"")
+			}
+		case "current_date":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.CurrentDate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"current_date\"")
+			}
+		case "campaigns":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Campaigns = make([]CampaignExplanation, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CampaignExplanation
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Campaigns = append(s.Campaigns, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"campaigns\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AdExplanation")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfAdExplanation) {
+					name = jsonFieldsNameOfAdExplanation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AdExplanation) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AdExplanation) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *AdvanceDayOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -320,11 +458,25 @@ func (s *Advertiser) encodeFields(e *jx.Encoder) {
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
+	{
+		if s.DailyBudget.Set {
+			e.FieldStart("daily_budget")
+			s.DailyBudget.Encode(e)
+		}
+	}
+	{
+		if s.TotalBudget.Set {
+			e.FieldStart("total_budget")
+			s.TotalBudget.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfAdvertiser = [2]string{
+var jsonFieldsNameOfAdvertiser = [4]string{
 	0: "advertiser_id",
 	1: "name",
+	2: "daily_budget",
+	3: "total_budget",
 }
 
 // Decode decodes Advertiser from json.
@@ -360,6 +512,26 @@ func (s *Advertiser) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
+		case "daily_budget":
+			if err := func() error {
+				s.DailyBudget.Reset()
+				if err := s.DailyBudget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daily_budget\"")
+			}
+		case "total_budget":
+			if err := func() error {
+				s.TotalBudget.Reset()
+				if err := s.TotalBudget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total_budget\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -433,11 +605,25 @@ func (s *AdvertiserUpsert) encodeFields(e *jx.Encoder) {
 		e.FieldStart("name")
 		e.Str(s.Name)
 	}
+	{
+		if s.DailyBudget.Set {
+			e.FieldStart("daily_budget")
+			s.DailyBudget.Encode(e)
+		}
+	}
+	{
+		if s.TotalBudget.Set {
+			e.FieldStart("total_budget")
+			s.TotalBudget.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfAdvertiserUpsert = [2]string{
+var jsonFieldsNameOfAdvertiserUpsert = [4]string{
 	0: "advertiser_id",
 	1: "name",
+	2: "daily_budget",
+	3: "total_budget",
 }
 
 // Decode decodes AdvertiserUpsert from json.
@@ -473,6 +659,26 @@ func (s *AdvertiserUpsert) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"name\"")
 			}
+		case "daily_budget":
+			if err := func() error {
+				s.DailyBudget.Reset()
+				if err := s.DailyBudget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daily_budget\"")
+			}
+		case "total_budget":
+			if err := func() error {
+				s.TotalBudget.Reset()
+				if err := s.TotalBudget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total_budget\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -588,9 +794,35 @@ func (s *Campaign) encodeFields(e *jx.Encoder) {
 		e.FieldStart("targeting")
 		s.Targeting.Encode(e)
 	}
+	{
+		if s.FrequencyCap.Set {
+			e.FieldStart("frequency_cap")
+			s.FrequencyCap.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("pacing")
+		s.Pacing.Encode(e)
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		e.FieldStart("moderation_status")
+		s.ModerationStatus.Encode(e)
+	}
+	{
+		e.FieldStart("flagged_phrases")
+		e.ArrStart()
+		for _, elem := range s.FlaggedPhrases {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
 }
 
-var jsonFieldsNameOfCampaign = [12]string{
+var jsonFieldsNameOfCampaign = [17]string{
 	0:  "campaign_id",
 	1:  "advertiser_id",
 	2:  "impressions_limit",
@@ -603,6 +835,11 @@ var jsonFieldsNameOfCampaign = [12]string{
 	9:  "start_date",
 	10: "end_date",
 	11: "targeting",
+	12: "frequency_cap",
+	13: "pacing",
+	14: "status",
+	15: "moderation_status",
+	16: "flagged_phrases",
 }
 
 // Decode decodes Campaign from json.
@@ -610,7 +847,8 @@ func (s *Campaign) Decode(d *jx.Decoder) error {
 	if s == nil {
 		return errors.New("invalid: unable to decode Campaign to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [3]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -750,6 +988,66 @@ func (s *Campaign) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"targeting\"")
 			}
+		case "frequency_cap":
+			if err := func() error {
+				s.FrequencyCap.Reset()
+				if err := s.FrequencyCap.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"frequency_cap\"")
+			}
+		case "pacing":
+			requiredBitSet[1] |= 1 << 5
+			if err := func() error {
+				if err := s.Pacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pacing\"")
+			}
+		case "status":
+			requiredBitSet[1] |= 1 << 6
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "moderation_status":
+			requiredBitSet[1] |= 1 << 7
+			if err := func() error {
+				if err := s.ModerationStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moderation_status\"")
+			}
+		case "flagged_phrases":
+			requiredBitSet[2] |= 1 << 0
+			if err := func() error {
+				s.FlaggedPhrases = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.FlaggedPhrases = append(s.FlaggedPhrases, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"flagged_phrases\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -759,9 +1057,10 @@ func (s *Campaign) Decode(d *jx.Decoder) error {
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
+	for i, mask := range [3]uint8{
 		0b11111111,
-		0b00001110,
+		0b11101110,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -807,190 +1106,145 @@ func (s *Campaign) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes CampaignAction as json.
+func (s CampaignAction) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes CampaignAction from json.
+func (s *CampaignAction) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CampaignAction to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch CampaignAction(v) {
+	case CampaignActionCREATE:
+		*s = CampaignActionCREATE
+	case CampaignActionUPDATE:
+		*s = CampaignActionUPDATE
+	case CampaignActionIMAGE:
+		*s = CampaignActionIMAGE
+	case CampaignActionSTATUS:
+		*s = CampaignActionSTATUS
+	case CampaignActionDELETE:
+		*s = CampaignActionDELETE
+	case CampaignActionRESTORE:
+		*s = CampaignActionRESTORE
+	case CampaignActionMODERATION:
+		*s = CampaignActionMODERATION
+	default:
+		*s = CampaignAction(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CampaignAction) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CampaignAction) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
-func (s *CampaignCreate) Encode(e *jx.Encoder) {
+func (s *CampaignChange) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CampaignCreate) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("impressions_limit")
-		e.Int(s.ImpressionsLimit)
-	}
+func (s *CampaignChange) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("clicks_limit")
-		e.Int(s.ClicksLimit)
+		e.FieldStart("field")
+		e.Str(s.Field)
 	}
 	{
-		e.FieldStart("cost_per_impression")
-		e.Float32(s.CostPerImpression)
+		if len(s.Old) != 0 {
+			e.FieldStart("old")
+			e.Raw(s.Old)
+		}
 	}
 	{
-		e.FieldStart("cost_per_click")
-		e.Float32(s.CostPerClick)
+		if len(s.New) != 0 {
+			e.FieldStart("new")
+			e.Raw(s.New)
+		}
 	}
-	{
-		e.FieldStart("ad_title")
-		e.Str(s.AdTitle)
+}
+
+var jsonFieldsNameOfCampaignChange = [3]string{
+	0: "field",
+	1: "old",
+	2: "new",
+}
+
+// Decode decodes CampaignChange from json.
+func (s *CampaignChange) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CampaignChange to nil")
 	}
-	{
-		e.FieldStart("ad_text")
-		e.Str(s.AdText)
-	}
-	{
-		e.FieldStart("start_date")
-		s.StartDate.Encode(e)
-	}
-	{
-		e.FieldStart("end_date")
-		s.EndDate.Encode(e)
-	}
-	{
-		if s.Targeting.Set {
-			e.FieldStart("targeting")
-			s.Targeting.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfCampaignCreate = [9]string{
-	0: "impressions_limit",
-	1: "clicks_limit",
-	2: "cost_per_impression",
-	3: "cost_per_click",
-	4: "ad_title",
-	5: "ad_text",
-	6: "start_date",
-	7: "end_date",
-	8: "targeting",
-}
-
-// Decode decodes CampaignCreate from json.
-func (s *CampaignCreate) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CampaignCreate to nil")
-	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "impressions_limit":
+		case "field":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.ImpressionsLimit = int(v)
+				v, err := d.Str()
+				s.Field = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"impressions_limit\"")
+				return errors.Wrap(err, "decode field \"field\"")
 			}
-		case "clicks_limit":
+		case "old":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Int()
-				s.ClicksLimit = int(v)
+				v, err := d.RawAppend(nil)
+				s.Old = jx.Raw(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"clicks_limit\"")
+				return errors.Wrap(err, "decode field \"old\"")
 			}
-		case "cost_per_impression":
+		case "new":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Float32()
-				s.CostPerImpression = float32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cost_per_impression\"")
-			}
-		case "cost_per_click":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Float32()
-				s.CostPerClick = float32(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cost_per_click\"")
-			}
-		case "ad_title":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Str()
-				s.AdTitle = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ad_title\"")
-			}
-		case "ad_text":
-			requiredBitSet[0] |= 1 << 5
-			if err := func() error {
-				v, err := d.Str()
-				s.AdText = string(v)
+				v, err := d.RawAppend(nil)
+				s.New = jx.Raw(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ad_text\"")
-			}
-		case "start_date":
-			requiredBitSet[0] |= 1 << 6
-			if err := func() error {
-				if err := s.StartDate.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_date\"")
-			}
-		case "end_date":
-			requiredBitSet[0] |= 1 << 7
-			if err := func() error {
-				if err := s.EndDate.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_date\"")
-			}
-		case "targeting":
-			if err := func() error {
-				s.Targeting.Reset()
-				if err := s.Targeting.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"targeting\"")
+				return errors.Wrap(err, "decode field \"new\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CampaignCreate")
+		return errors.Wrap(err, "decode CampaignChange")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b11111111,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1002,8 +1256,8 @@ func (s *CampaignCreate) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCampaignCreate) {
-					name = jsonFieldsNameOfCampaignCreate[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignChange) {
+					name = jsonFieldsNameOfCampaignChange[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1024,27 +1278,27 @@ func (s *CampaignCreate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CampaignCreate) MarshalJSON() ([]byte, error) {
+func (s *CampaignChange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CampaignCreate) UnmarshalJSON(data []byte) error {
+func (s *CampaignChange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CampaignUpdate) Encode(e *jx.Encoder) {
+func (s *CampaignCreate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CampaignUpdate) encodeFields(e *jx.Encoder) {
+func (s *CampaignCreate) encodeFields(e *jx.Encoder) {
 	{
 		e.FieldStart("impressions_limit")
 		e.Int(s.ImpressionsLimit)
@@ -1083,26 +1337,48 @@ func (s *CampaignUpdate) encodeFields(e *jx.Encoder) {
 			s.Targeting.Encode(e)
 		}
 	}
+	{
+		if s.FrequencyCap.Set {
+			e.FieldStart("frequency_cap")
+			s.FrequencyCap.Encode(e)
+		}
+	}
+	{
+		if s.Pacing.Set {
+			e.FieldStart("pacing")
+			s.Pacing.Encode(e)
+		}
+	}
+	{
+		if s.Draft.Set {
+			e.FieldStart("draft")
+			s.Draft.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCampaignUpdate = [9]string{
-	0: "impressions_limit",
-	1: "clicks_limit",
-	2: "cost_per_impression",
-	3: "cost_per_click",
-	4: "ad_title",
-	5: "ad_text",
-	6: "start_date",
-	7: "end_date",
-	8: "targeting",
+var jsonFieldsNameOfCampaignCreate = [12]string{
+	0:  "impressions_limit",
+	1:  "clicks_limit",
+	2:  "cost_per_impression",
+	3:  "cost_per_click",
+	4:  "ad_title",
+	5:  "ad_text",
+	6:  "start_date",
+	7:  "end_date",
+	8:  "targeting",
+	9:  "frequency_cap",
+	10: "pacing",
+	11: "draft",
 }
 
-// Decode decodes CampaignUpdate from json.
-func (s *CampaignUpdate) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignCreate from json.
+func (s *CampaignCreate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CampaignUpdate to nil")
+		return errors.New("invalid: unable to decode CampaignCreate to nil")
 	}
 	var requiredBitSet [2]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -1208,12 +1484,42 @@ func (s *CampaignUpdate) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"targeting\"")
 			}
+		case "frequency_cap":
+			if err := func() error {
+				s.FrequencyCap.Reset()
+				if err := s.FrequencyCap.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"frequency_cap\"")
+			}
+		case "pacing":
+			if err := func() error {
+				s.Pacing.Reset()
+				if err := s.Pacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pacing\"")
+			}
+		case "draft":
+			if err := func() error {
+				s.Draft.Reset()
+				if err := s.Draft.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"draft\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CampaignUpdate")
+		return errors.Wrap(err, "decode CampaignCreate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1231,8 +1537,8 @@ func (s *CampaignUpdate) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCampaignUpdate) {
-					name = jsonFieldsNameOfCampaignUpdate[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignCreate) {
+					name = jsonFieldsNameOfCampaignCreate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1253,135 +1559,219 @@ func (s *CampaignUpdate) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CampaignUpdate) MarshalJSON() ([]byte, error) {
+func (s *CampaignCreate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CampaignUpdate) UnmarshalJSON(data []byte) error {
+func (s *CampaignCreate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ClientModel) Encode(e *jx.Encoder) {
+func (s *CampaignDailyStats) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ClientModel) encodeFields(e *jx.Encoder) {
+func (s *CampaignDailyStats) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("client_id")
-		json.EncodeUUID(e, s.ClientID)
+		e.FieldStart("impressions_count")
+		e.Int(s.ImpressionsCount)
 	}
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
+		e.FieldStart("clicks_count")
+		e.Int(s.ClicksCount)
 	}
 	{
-		e.FieldStart("age")
-		e.Int(s.Age)
+		e.FieldStart("conversion")
+		e.Float64(s.Conversion)
 	}
 	{
-		e.FieldStart("location")
-		e.Str(s.Location)
+		e.FieldStart("spent_impressions")
+		e.Float64(s.SpentImpressions)
 	}
 	{
-		e.FieldStart("gender")
-		s.Gender.Encode(e)
+		e.FieldStart("spent_clicks")
+		e.Float64(s.SpentClicks)
+	}
+	{
+		e.FieldStart("spent_total")
+		e.Float64(s.SpentTotal)
+	}
+	{
+		e.FieldStart("spent_first_price")
+		e.Float64(s.SpentFirstPrice)
+	}
+	{
+		e.FieldStart("spent_second_price")
+		e.Float64(s.SpentSecondPrice)
+	}
+	{
+		e.FieldStart("date")
+		s.Date.Encode(e)
+	}
+	{
+		e.FieldStart("impressions_target")
+		s.ImpressionsTarget.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfClientModel = [5]string{
-	0: "client_id",
-	1: "login",
-	2: "age",
-	3: "location",
-	4: "gender",
+var jsonFieldsNameOfCampaignDailyStats = [10]string{
+	0: "impressions_count",
+	1: "clicks_count",
+	2: "conversion",
+	3: "spent_impressions",
+	4: "spent_clicks",
+	5: "spent_total",
+	6: "spent_first_price",
+	7: "spent_second_price",
+	8: "date",
+	9: "impressions_target",
 }
 
-// Decode decodes ClientModel from json.
-func (s *ClientModel) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignDailyStats from json.
+func (s *CampaignDailyStats) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ClientModel to nil")
+		return errors.New("invalid: unable to decode CampaignDailyStats to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "client_id":
+		case "impressions_count":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := json.DecodeUUID(d)
-				s.ClientID = v
+				v, err := d.Int()
+				s.ImpressionsCount = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
+				return errors.Wrap(err, "decode field \"impressions_count\"")
 			}
-		case "login":
+		case "clicks_count":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
+				v, err := d.Int()
+				s.ClicksCount = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
+				return errors.Wrap(err, "decode field \"clicks_count\"")
 			}
-		case "age":
+		case "conversion":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int()
-				s.Age = int(v)
+				v, err := d.Float64()
+				s.Conversion = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"age\"")
+				return errors.Wrap(err, "decode field \"conversion\"")
 			}
-		case "location":
+		case "spent_impressions":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Str()
-				s.Location = string(v)
+				v, err := d.Float64()
+				s.SpentImpressions = float64(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"spent_impressions\"")
 			}
-		case "gender":
+		case "spent_clicks":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				if err := s.Gender.Decode(d); err != nil {
+				v, err := d.Float64()
+				s.SpentClicks = float64(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"gender\"")
+				return errors.Wrap(err, "decode field \"spent_clicks\"")
+			}
+		case "spent_total":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentTotal = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_total\"")
+			}
+		case "spent_first_price":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentFirstPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_first_price\"")
+			}
+		case "spent_second_price":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentSecondPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_second_price\"")
+			}
+		case "date":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				if err := s.Date.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"date\"")
+			}
+		case "impressions_target":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				if err := s.ImpressionsTarget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions_target\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ClientModel")
+		return errors.Wrap(err, "decode CampaignDailyStats")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00011111,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1393,8 +1783,8 @@ func (s *ClientModel) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfClientModel) {
-					name = jsonFieldsNameOfClientModel[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignDailyStats) {
+					name = jsonFieldsNameOfCampaignDailyStats[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1415,170 +1805,159 @@ func (s *ClientModel) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ClientModel) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientModel) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ClientModelGender as json.
-func (s ClientModelGender) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes ClientModelGender from json.
-func (s *ClientModelGender) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ClientModelGender to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch ClientModelGender(v) {
-	case ClientModelGenderMALE:
-		*s = ClientModelGenderMALE
-	case ClientModelGenderFEMALE:
-		*s = ClientModelGenderFEMALE
-	default:
-		*s = ClientModelGender(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ClientModelGender) MarshalJSON() ([]byte, error) {
+func (s *CampaignDailyStats) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientModelGender) UnmarshalJSON(data []byte) error {
+func (s *CampaignDailyStats) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ClientUpsert) Encode(e *jx.Encoder) {
+func (s *CampaignExplanation) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ClientUpsert) encodeFields(e *jx.Encoder) {
+func (s *CampaignExplanation) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("client_id")
-		json.EncodeUUID(e, s.ClientID)
+		e.FieldStart("campaign_id")
+		json.EncodeUUID(e, s.CampaignID)
 	}
 	{
-		e.FieldStart("login")
-		e.Str(s.Login)
+		e.FieldStart("advertiser_id")
+		json.EncodeUUID(e, s.AdvertiserID)
 	}
 	{
-		e.FieldStart("age")
-		e.Int(s.Age)
+		e.FieldStart("ad_title")
+		e.Str(s.AdTitle)
 	}
 	{
-		e.FieldStart("location")
-		e.Str(s.Location)
+		e.FieldStart("eligible")
+		e.Bool(s.Eligible)
 	}
 	{
-		e.FieldStart("gender")
-		s.Gender.Encode(e)
+		e.FieldStart("exclusion_reasons")
+		e.ArrStart()
+		for _, elem := range s.ExclusionReasons {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.Score.Set {
+			e.FieldStart("score")
+			s.Score.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfClientUpsert = [5]string{
-	0: "client_id",
-	1: "login",
-	2: "age",
-	3: "location",
-	4: "gender",
+var jsonFieldsNameOfCampaignExplanation = [6]string{
+	0: "campaign_id",
+	1: "advertiser_id",
+	2: "ad_title",
+	3: "eligible",
+	4: "exclusion_reasons",
+	5: "score",
 }
 
-// Decode decodes ClientUpsert from json.
-func (s *ClientUpsert) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignExplanation from json.
+func (s *CampaignExplanation) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ClientUpsert to nil")
+		return errors.New("invalid: unable to decode CampaignExplanation to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "client_id":
+		case "campaign_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := json.DecodeUUID(d)
-				s.ClientID = v
+				s.CampaignID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
+				return errors.Wrap(err, "decode field \"campaign_id\"")
 			}
-		case "login":
+		case "advertiser_id":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Login = string(v)
+				v, err := json.DecodeUUID(d)
+				s.AdvertiserID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"login\"")
+				return errors.Wrap(err, "decode field \"advertiser_id\"")
 			}
-		case "age":
+		case "ad_title":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int()
-				s.Age = int(v)
+				v, err := d.Str()
+				s.AdTitle = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"age\"")
+				return errors.Wrap(err, "decode field \"ad_title\"")
 			}
-		case "location":
+		case "eligible":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				v, err := d.Str()
-				s.Location = string(v)
+				v, err := d.Bool()
+				s.Eligible = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"eligible\"")
 			}
-		case "gender":
+		case "exclusion_reasons":
 			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				if err := s.Gender.Decode(d); err != nil {
+				s.ExclusionReasons = make([]ExclusionReason, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ExclusionReason
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ExclusionReasons = append(s.ExclusionReasons, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"gender\"")
+				return errors.Wrap(err, "decode field \"exclusion_reasons\"")
+			}
+		case "score":
+			if err := func() error {
+				s.Score.Reset()
+				if err := s.Score.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ClientUpsert")
+		return errors.Wrap(err, "decode CampaignExplanation")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -1595,8 +1974,8 @@ func (s *ClientUpsert) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfClientUpsert) {
-					name = jsonFieldsNameOfClientUpsert[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignExplanation) {
+					name = jsonFieldsNameOfCampaignExplanation[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1617,209 +1996,103 @@ func (s *ClientUpsert) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ClientUpsert) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientUpsert) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ClientUpsertGender as json.
-func (s ClientUpsertGender) Encode(e *jx.Encoder) {
-	e.Str(string(s))
-}
-
-// Decode decodes ClientUpsertGender from json.
-func (s *ClientUpsertGender) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ClientUpsertGender to nil")
-	}
-	v, err := d.StrBytes()
-	if err != nil {
-		return err
-	}
-	// Try to use constant string.
-	switch ClientUpsertGender(v) {
-	case ClientUpsertGenderMALE:
-		*s = ClientUpsertGenderMALE
-	case ClientUpsertGenderFEMALE:
-		*s = ClientUpsertGenderFEMALE
-	default:
-		*s = ClientUpsertGender(v)
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s ClientUpsertGender) MarshalJSON() ([]byte, error) {
+func (s *CampaignExplanation) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ClientUpsertGender) UnmarshalJSON(data []byte) error {
+func (s *CampaignExplanation) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DailyStats) Encode(e *jx.Encoder) {
+func (s *CampaignImportRow) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DailyStats) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("impressions_count")
-		e.Int(s.ImpressionsCount)
-	}
+func (s *CampaignImportRow) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("clicks_count")
-		e.Int(s.ClicksCount)
-	}
-	{
-		e.FieldStart("conversion")
-		e.Float64(s.Conversion)
-	}
-	{
-		e.FieldStart("spent_impressions")
-		e.Float64(s.SpentImpressions)
-	}
-	{
-		e.FieldStart("spent_clicks")
-		e.Float64(s.SpentClicks)
+		e.FieldStart("row")
+		e.Int(s.Row)
 	}
 	{
-		e.FieldStart("spent_total")
-		e.Float64(s.SpentTotal)
+		if s.CampaignID.Set {
+			e.FieldStart("campaign_id")
+			s.CampaignID.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("date")
-		s.Date.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfDailyStats = [7]string{
-	0: "impressions_count",
-	1: "clicks_count",
-	2: "conversion",
-	3: "spent_impressions",
-	4: "spent_clicks",
-	5: "spent_total",
-	6: "date",
+var jsonFieldsNameOfCampaignImportRow = [3]string{
+	0: "row",
+	1: "campaign_id",
+	2: "error",
 }
 
-// Decode decodes DailyStats from json.
-func (s *DailyStats) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignImportRow from json.
+func (s *CampaignImportRow) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DailyStats to nil")
+		return errors.New("invalid: unable to decode CampaignImportRow to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "impressions_count":
+		case "row":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int()
-				s.ImpressionsCount = int(v)
+				s.Row = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"impressions_count\"")
+				return errors.Wrap(err, "decode field \"row\"")
 			}
-		case "clicks_count":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Int()
-				s.ClicksCount = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"clicks_count\"")
-			}
-		case "conversion":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Float64()
-				s.Conversion = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"conversion\"")
-			}
-		case "spent_impressions":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Float64()
-				s.SpentImpressions = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"spent_impressions\"")
-			}
-		case "spent_clicks":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				v, err := d.Float64()
-				s.SpentClicks = float64(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"spent_clicks\"")
-			}
-		case "spent_total":
-			requiredBitSet[0] |= 1 << 5
+		case "campaign_id":
 			if err := func() error {
-				v, err := d.Float64()
-				s.SpentTotal = float64(v)
-				if err != nil {
+				s.CampaignID.Reset()
+				if err := s.CampaignID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"spent_total\"")
+				return errors.Wrap(err, "decode field \"campaign_id\"")
 			}
-		case "date":
-			requiredBitSet[0] |= 1 << 6
+		case "error":
 			if err := func() error {
-				if err := s.Date.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"date\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DailyStats")
+		return errors.Wrap(err, "decode CampaignImportRow")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b01111111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1831,8 +2104,8 @@ func (s *DailyStats) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDailyStats) {
-					name = jsonFieldsNameOfDailyStats[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignImportRow) {
+					name = jsonFieldsNameOfCampaignImportRow[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1853,109 +2126,250 @@ func (s *DailyStats) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DailyStats) MarshalJSON() ([]byte, error) {
+func (s *CampaignImportRow) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DailyStats) UnmarshalJSON(data []byte) error {
+func (s *CampaignImportRow) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Date as json.
-func (s Date) Encode(e *jx.Encoder) {
-	unwrapped := int32(s)
+// Encode implements json.Marshaler.
+func (s *CampaignOverrides) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
 
-	e.Int32(unwrapped)
+// encodeFields encodes fields.
+func (s *CampaignOverrides) encodeFields(e *jx.Encoder) {
+	{
+		if s.StartDate.Set {
+			e.FieldStart("start_date")
+			s.StartDate.Encode(e)
+		}
+	}
+	{
+		if s.EndDate.Set {
+			e.FieldStart("end_date")
+			s.EndDate.Encode(e)
+		}
+	}
+	{
+		if s.ImpressionsLimit.Set {
+			e.FieldStart("impressions_limit")
+			s.ImpressionsLimit.Encode(e)
+		}
+	}
+	{
+		if s.ClicksLimit.Set {
+			e.FieldStart("clicks_limit")
+			s.ClicksLimit.Encode(e)
+		}
+	}
+	{
+		if s.Draft.Set {
+			e.FieldStart("draft")
+			s.Draft.Encode(e)
+		}
+	}
 }
 
-// Decode decodes Date from json.
-func (s *Date) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfCampaignOverrides = [5]string{
+	0: "start_date",
+	1: "end_date",
+	2: "impressions_limit",
+	3: "clicks_limit",
+	4: "draft",
+}
+
+// Decode decodes CampaignOverrides from json.
+func (s *CampaignOverrides) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Date to nil")
+		return errors.New("invalid: unable to decode CampaignOverrides to nil")
 	}
-	var unwrapped int32
-	if err := func() error {
-		v, err := d.Int32()
-		unwrapped = int32(v)
-		if err != nil {
-			return err
+	s.setDefaults()
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "start_date":
+			if err := func() error {
+				s.StartDate.Reset()
+				if err := s.StartDate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_date\"")
+			}
+		case "end_date":
+			if err := func() error {
+				s.EndDate.Reset()
+				if err := s.EndDate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_date\"")
+			}
+		case "impressions_limit":
+			if err := func() error {
+				s.ImpressionsLimit.Reset()
+				if err := s.ImpressionsLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions_limit\"")
+			}
+		case "clicks_limit":
+			if err := func() error {
+				s.ClicksLimit.Reset()
+				if err := s.ClicksLimit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_limit\"")
+			}
+		case "draft":
+			if err := func() error {
+				s.Draft.Reset()
+				if err := s.Draft.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"draft\"")
+			}
+		default:
+			return d.Skip()
 		}
 		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	}); err != nil {
+		return errors.Wrap(err, "decode CampaignOverrides")
 	}
-	*s = Date(unwrapped)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s Date) MarshalJSON() ([]byte, error) {
+func (s *CampaignOverrides) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Date) UnmarshalJSON(data []byte) error {
+func (s *CampaignOverrides) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GenerateAdTextOK) Encode(e *jx.Encoder) {
+func (s *CampaignSearchResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GenerateAdTextOK) encodeFields(e *jx.Encoder) {
+func (s *CampaignSearchResult) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("ad_text")
-		e.Str(s.AdText)
+		e.FieldStart("campaign")
+		s.Campaign.Encode(e)
+	}
+	{
+		e.FieldStart("rank")
+		e.Float64(s.Rank)
+	}
+	{
+		e.FieldStart("title_highlight")
+		e.Str(s.TitleHighlight)
+	}
+	{
+		e.FieldStart("text_highlight")
+		e.Str(s.TextHighlight)
 	}
 }
 
-var jsonFieldsNameOfGenerateAdTextOK = [1]string{
-	0: "ad_text",
+var jsonFieldsNameOfCampaignSearchResult = [4]string{
+	0: "campaign",
+	1: "rank",
+	2: "title_highlight",
+	3: "text_highlight",
 }
 
-// Decode decodes GenerateAdTextOK from json.
-func (s *GenerateAdTextOK) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignSearchResult from json.
+func (s *CampaignSearchResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GenerateAdTextOK to nil")
+		return errors.New("invalid: unable to decode CampaignSearchResult to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ad_text":
+		case "campaign":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Campaign.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"campaign\"")
+			}
+		case "rank":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Rank = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rank\"")
+			}
+		case "title_highlight":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
-				s.AdText = string(v)
+				s.TitleHighlight = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ad_text\"")
+				return errors.Wrap(err, "decode field \"title_highlight\"")
+			}
+		case "text_highlight":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.TextHighlight = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"text_highlight\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GenerateAdTextOK")
+		return errors.Wrap(err, "decode CampaignSearchResult")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1967,8 +2381,8 @@ func (s *GenerateAdTextOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfGenerateAdTextOK) {
-					name = jsonFieldsNameOfGenerateAdTextOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignSearchResult) {
+					name = jsonFieldsNameOfCampaignSearchResult[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1989,68 +2403,205 @@ func (s *GenerateAdTextOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GenerateAdTextOK) MarshalJSON() ([]byte, error) {
+func (s *CampaignSearchResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GenerateAdTextOK) UnmarshalJSON(data []byte) error {
+func (s *CampaignSearchResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GenerateAdTextReq) Encode(e *jx.Encoder) {
+func (s *CampaignStats) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GenerateAdTextReq) encodeFields(e *jx.Encoder) {
+func (s *CampaignStats) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("ad_title")
-		e.Str(s.AdTitle)
+		e.FieldStart("impressions_count")
+		e.Int(s.ImpressionsCount)
+	}
+	{
+		e.FieldStart("clicks_count")
+		e.Int(s.ClicksCount)
+	}
+	{
+		e.FieldStart("conversion")
+		e.Float64(s.Conversion)
+	}
+	{
+		e.FieldStart("spent_impressions")
+		e.Float64(s.SpentImpressions)
+	}
+	{
+		e.FieldStart("spent_clicks")
+		e.Float64(s.SpentClicks)
+	}
+	{
+		e.FieldStart("spent_total")
+		e.Float64(s.SpentTotal)
+	}
+	{
+		e.FieldStart("spent_first_price")
+		e.Float64(s.SpentFirstPrice)
+	}
+	{
+		e.FieldStart("spent_second_price")
+		e.Float64(s.SpentSecondPrice)
+	}
+	{
+		e.FieldStart("clicks_limit_reached")
+		e.Bool(s.ClicksLimitReached)
 	}
 }
 
-var jsonFieldsNameOfGenerateAdTextReq = [1]string{
-	0: "ad_title",
+var jsonFieldsNameOfCampaignStats = [9]string{
+	0: "impressions_count",
+	1: "clicks_count",
+	2: "conversion",
+	3: "spent_impressions",
+	4: "spent_clicks",
+	5: "spent_total",
+	6: "spent_first_price",
+	7: "spent_second_price",
+	8: "clicks_limit_reached",
 }
 
-// Decode decodes GenerateAdTextReq from json.
-func (s *GenerateAdTextReq) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignStats from json.
+func (s *CampaignStats) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GenerateAdTextReq to nil")
+		return errors.New("invalid: unable to decode CampaignStats to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ad_title":
+		case "impressions_count":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.AdTitle = string(v)
+				v, err := d.Int()
+				s.ImpressionsCount = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ad_title\"")
+				return errors.Wrap(err, "decode field \"impressions_count\"")
+			}
+		case "clicks_count":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.ClicksCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_count\"")
+			}
+		case "conversion":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Conversion = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conversion\"")
+			}
+		case "spent_impressions":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentImpressions = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_impressions\"")
+			}
+		case "spent_clicks":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentClicks = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_clicks\"")
+			}
+		case "spent_total":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentTotal = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_total\"")
+			}
+		case "spent_first_price":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentFirstPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_first_price\"")
+			}
+		case "spent_second_price":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentSecondPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_second_price\"")
+			}
+		case "clicks_limit_reached":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.ClicksLimitReached = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_limit_reached\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GenerateAdTextReq")
+		return errors.Wrap(err, "decode CampaignStats")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
+	for i, mask := range [2]uint8{
+		0b11111111,
 		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -2063,8 +2614,8 @@ func (s *GenerateAdTextReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfGenerateAdTextReq) {
-					name = jsonFieldsNameOfGenerateAdTextReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignStats) {
+					name = jsonFieldsNameOfCampaignStats[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2085,217 +2636,123 @@ func (s *GenerateAdTextReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GenerateAdTextReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GenerateAdTextReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes GetAdvertiserDailyStatsOKApplicationJSON as json.
-func (s GetAdvertiserDailyStatsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []DailyStats(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes GetAdvertiserDailyStatsOKApplicationJSON from json.
-func (s *GetAdvertiserDailyStatsOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetAdvertiserDailyStatsOKApplicationJSON to nil")
-	}
-	var unwrapped []DailyStats
-	if err := func() error {
-		unwrapped = make([]DailyStats, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem DailyStats
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = GetAdvertiserDailyStatsOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s GetAdvertiserDailyStatsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s *CampaignStats) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetAdvertiserDailyStatsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *CampaignStats) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes GetCampaignDailyStatsOKApplicationJSON as json.
-func (s GetCampaignDailyStatsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []DailyStats(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
+// Encode encodes CampaignStatus as json.
+func (s CampaignStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes GetCampaignDailyStatsOKApplicationJSON from json.
-func (s *GetCampaignDailyStatsOKApplicationJSON) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignStatus from json.
+func (s *CampaignStatus) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetCampaignDailyStatsOKApplicationJSON to nil")
+		return errors.New("invalid: unable to decode CampaignStatus to nil")
 	}
-	var unwrapped []DailyStats
-	if err := func() error {
-		unwrapped = make([]DailyStats, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem DailyStats
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
 	}
-	*s = GetCampaignDailyStatsOKApplicationJSON(unwrapped)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s GetCampaignDailyStatsOKApplicationJSON) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetCampaignDailyStatsOKApplicationJSON) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes ListCampaignsOKApplicationJSON as json.
-func (s ListCampaignsOKApplicationJSON) Encode(e *jx.Encoder) {
-	unwrapped := []Campaign(s)
-
-	e.ArrStart()
-	for _, elem := range unwrapped {
-		elem.Encode(e)
+	// Try to use constant string.
+	switch CampaignStatus(v) {
+	case CampaignStatusDRAFT:
+		*s = CampaignStatusDRAFT
+	case CampaignStatusACTIVE:
+		*s = CampaignStatusACTIVE
+	case CampaignStatusPAUSED:
+		*s = CampaignStatusPAUSED
+	case CampaignStatusCOMPLETED:
+		*s = CampaignStatusCOMPLETED
+	case CampaignStatusARCHIVED:
+		*s = CampaignStatusARCHIVED
+	default:
+		*s = CampaignStatus(v)
 	}
-	e.ArrEnd()
-}
 
-// Decode decodes ListCampaignsOKApplicationJSON from json.
-func (s *ListCampaignsOKApplicationJSON) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode ListCampaignsOKApplicationJSON to nil")
-	}
-	var unwrapped []Campaign
-	if err := func() error {
-		unwrapped = make([]Campaign, 0)
-		if err := d.Arr(func(d *jx.Decoder) error {
-			var elem Campaign
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			unwrapped = append(unwrapped, elem)
-			return nil
-		}); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return errors.Wrap(err, "alias")
-	}
-	*s = ListCampaignsOKApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s ListCampaignsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+func (s CampaignStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ListCampaignsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+func (s *CampaignStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *MLScore) Encode(e *jx.Encoder) {
+func (s *CampaignTemplate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *MLScore) encodeFields(e *jx.Encoder) {
+func (s *CampaignTemplate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("client_id")
-		json.EncodeUUID(e, s.ClientID)
+		e.FieldStart("template_id")
+		json.EncodeUUID(e, s.TemplateID)
 	}
 	{
 		e.FieldStart("advertiser_id")
 		json.EncodeUUID(e, s.AdvertiserID)
 	}
 	{
-		e.FieldStart("score")
-		e.Int(s.Score)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("campaign")
+		s.Campaign.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfMLScore = [3]string{
-	0: "client_id",
+var jsonFieldsNameOfCampaignTemplate = [5]string{
+	0: "template_id",
 	1: "advertiser_id",
-	2: "score",
+	2: "name",
+	3: "created_at",
+	4: "campaign",
 }
 
-// Decode decodes MLScore from json.
-func (s *MLScore) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignTemplate from json.
+func (s *CampaignTemplate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode MLScore to nil")
+		return errors.New("invalid: unable to decode CampaignTemplate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "client_id":
+		case "template_id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := json.DecodeUUID(d)
-				s.ClientID = v
+				s.TemplateID = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"client_id\"")
+				return errors.Wrap(err, "decode field \"template_id\"")
 			}
 		case "advertiser_id":
 			requiredBitSet[0] |= 1 << 1
@@ -2309,29 +2766,51 @@ func (s *MLScore) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"advertiser_id\"")
 			}
-		case "score":
+		case "name":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Int()
-				s.Score = int(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"score\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode MLScore")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
+		case "created_at":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "campaign":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Campaign.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"campaign\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CampaignTemplate")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2343,8 +2822,8 @@ func (s *MLScore) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfMLScore) {
-					name = jsonFieldsNameOfMLScore[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignTemplate) {
+					name = jsonFieldsNameOfCampaignTemplate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2365,93 +2844,79 @@ func (s *MLScore) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *MLScore) MarshalJSON() ([]byte, error) {
+func (s *CampaignTemplate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *MLScore) UnmarshalJSON(data []byte) error {
+func (s *CampaignTemplate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ModerateAdTextOK) Encode(e *jx.Encoder) {
+func (s *CampaignTemplateCreate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ModerateAdTextOK) encodeFields(e *jx.Encoder) {
+func (s *CampaignTemplateCreate) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("ok")
-		e.Bool(s.Ok)
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		e.FieldStart("illegal_phrases")
-		e.ArrStart()
-		for _, elem := range s.IllegalPhrases {
-			e.Str(elem)
-		}
-		e.ArrEnd()
+		e.FieldStart("campaign")
+		s.Campaign.Encode(e)
 	}
 }
 
-var jsonFieldsNameOfModerateAdTextOK = [2]string{
-	0: "ok",
-	1: "illegal_phrases",
+var jsonFieldsNameOfCampaignTemplateCreate = [2]string{
+	0: "name",
+	1: "campaign",
 }
 
-// Decode decodes ModerateAdTextOK from json.
-func (s *ModerateAdTextOK) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignTemplateCreate from json.
+func (s *CampaignTemplateCreate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ModerateAdTextOK to nil")
+		return errors.New("invalid: unable to decode CampaignTemplateCreate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ok":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Ok = bool(v)
+				v, err := d.Str()
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ok\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "illegal_phrases":
+		case "campaign":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.IllegalPhrases = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.IllegalPhrases = append(s.IllegalPhrases, elem)
-					return nil
-				}); err != nil {
+				if err := s.Campaign.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"illegal_phrases\"")
+				return errors.Wrap(err, "decode field \"campaign\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ModerateAdTextOK")
+		return errors.Wrap(err, "decode CampaignTemplateCreate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2468,8 +2933,8 @@ func (s *ModerateAdTextOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfModerateAdTextOK) {
-					name = jsonFieldsNameOfModerateAdTextOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignTemplateCreate) {
+					name = jsonFieldsNameOfCampaignTemplateCreate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2490,48 +2955,165 @@ func (s *ModerateAdTextOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ModerateAdTextOK) MarshalJSON() ([]byte, error) {
+func (s *CampaignTemplateCreate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ModerateAdTextOK) UnmarshalJSON(data []byte) error {
+func (s *CampaignTemplateCreate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *ModerateAdTextReq) Encode(e *jx.Encoder) {
+func (s *CampaignUpdate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *ModerateAdTextReq) encodeFields(e *jx.Encoder) {
+func (s *CampaignUpdate) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("impressions_limit")
+		e.Int(s.ImpressionsLimit)
+	}
+	{
+		e.FieldStart("clicks_limit")
+		e.Int(s.ClicksLimit)
+	}
+	{
+		e.FieldStart("cost_per_impression")
+		e.Float32(s.CostPerImpression)
+	}
+	{
+		e.FieldStart("cost_per_click")
+		e.Float32(s.CostPerClick)
+	}
+	{
+		e.FieldStart("ad_title")
+		e.Str(s.AdTitle)
+	}
 	{
 		e.FieldStart("ad_text")
 		e.Str(s.AdText)
 	}
+	{
+		e.FieldStart("start_date")
+		s.StartDate.Encode(e)
+	}
+	{
+		e.FieldStart("end_date")
+		s.EndDate.Encode(e)
+	}
+	{
+		if s.Targeting.Set {
+			e.FieldStart("targeting")
+			s.Targeting.Encode(e)
+		}
+	}
+	{
+		if s.FrequencyCap.Set {
+			e.FieldStart("frequency_cap")
+			s.FrequencyCap.Encode(e)
+		}
+	}
+	{
+		if s.Pacing.Set {
+			e.FieldStart("pacing")
+			s.Pacing.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfModerateAdTextReq = [1]string{
-	0: "ad_text",
+var jsonFieldsNameOfCampaignUpdate = [11]string{
+	0:  "impressions_limit",
+	1:  "clicks_limit",
+	2:  "cost_per_impression",
+	3:  "cost_per_click",
+	4:  "ad_title",
+	5:  "ad_text",
+	6:  "start_date",
+	7:  "end_date",
+	8:  "targeting",
+	9:  "frequency_cap",
+	10: "pacing",
 }
 
-// Decode decodes ModerateAdTextReq from json.
-func (s *ModerateAdTextReq) Decode(d *jx.Decoder) error {
+// Decode decodes CampaignUpdate from json.
+func (s *CampaignUpdate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ModerateAdTextReq to nil")
+		return errors.New("invalid: unable to decode CampaignUpdate to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ad_text":
+		case "impressions_limit":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ImpressionsLimit = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions_limit\"")
+			}
+		case "clicks_limit":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.ClicksLimit = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_limit\"")
+			}
+		case "cost_per_impression":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float32()
+				s.CostPerImpression = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cost_per_impression\"")
+			}
+		case "cost_per_click":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float32()
+				s.CostPerClick = float32(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cost_per_click\"")
+			}
+		case "ad_title":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.AdTitle = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ad_title\"")
+			}
+		case "ad_text":
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := d.Str()
 				s.AdText = string(v)
@@ -2542,17 +3124,68 @@ func (s *ModerateAdTextReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"ad_text\"")
 			}
+		case "start_date":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				if err := s.StartDate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_date\"")
+			}
+		case "end_date":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				if err := s.EndDate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_date\"")
+			}
+		case "targeting":
+			if err := func() error {
+				s.Targeting.Reset()
+				if err := s.Targeting.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"targeting\"")
+			}
+		case "frequency_cap":
+			if err := func() error {
+				s.FrequencyCap.Reset()
+				if err := s.FrequencyCap.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"frequency_cap\"")
+			}
+		case "pacing":
+			if err := func() error {
+				s.Pacing.Reset()
+				if err := s.Pacing.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pacing\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode ModerateAdTextReq")
+		return errors.Wrap(err, "decode CampaignUpdate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2564,8 +3197,8 @@ func (s *ModerateAdTextReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfModerateAdTextReq) {
-					name = jsonFieldsNameOfModerateAdTextReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCampaignUpdate) {
+					name = jsonFieldsNameOfCampaignUpdate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2586,43 +3219,3921 @@ func (s *ModerateAdTextReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ModerateAdTextReq) MarshalJSON() ([]byte, error) {
+func (s *CampaignUpdate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ModerateAdTextReq) UnmarshalJSON(data []byte) error {
+func (s *CampaignUpdate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o NilString) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Str(string(o.Value))
-}
-
+// Encode implements json.Marshaler.
+func (s *CampaignVersion) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CampaignVersion) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("version")
+		e.Int(s.Version)
+	}
+	{
+		e.FieldStart("action")
+		s.Action.Encode(e)
+	}
+	{
+		e.FieldStart("day")
+		e.Int(s.Day)
+	}
+	{
+		e.FieldStart("created_at")
+		json.EncodeDateTime(e, s.CreatedAt)
+	}
+	{
+		e.FieldStart("campaign")
+		s.Campaign.Encode(e)
+	}
+	{
+		e.FieldStart("changes")
+		e.ArrStart()
+		for _, elem := range s.Changes {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfCampaignVersion = [6]string{
+	0: "version",
+	1: "action",
+	2: "day",
+	3: "created_at",
+	4: "campaign",
+	5: "changes",
+}
+
+// Decode decodes CampaignVersion from json.
+func (s *CampaignVersion) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CampaignVersion to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "version":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Version = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		case "action":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Action.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"action\"")
+			}
+		case "day":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Day = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"day\"")
+			}
+		case "created_at":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.CreatedAt = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "campaign":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Campaign.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"campaign\"")
+			}
+		case "changes":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				s.Changes = make([]CampaignChange, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CampaignChange
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Changes = append(s.Changes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"changes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CampaignVersion")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCampaignVersion) {
+					name = jsonFieldsNameOfCampaignVersion[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CampaignVersion) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CampaignVersion) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CampaignsImportReport) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CampaignsImportReport) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("created")
+		e.Int(s.Created)
+	}
+	{
+		e.FieldStart("failed")
+		e.Int(s.Failed)
+	}
+	{
+		e.FieldStart("rows")
+		e.ArrStart()
+		for _, elem := range s.Rows {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfCampaignsImportReport = [3]string{
+	0: "created",
+	1: "failed",
+	2: "rows",
+}
+
+// Decode decodes CampaignsImportReport from json.
+func (s *CampaignsImportReport) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CampaignsImportReport to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "created":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Created = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created\"")
+			}
+		case "failed":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Failed = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"failed\"")
+			}
+		case "rows":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Rows = make([]CampaignImportRow, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CampaignImportRow
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Rows = append(s.Rows, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rows\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CampaignsImportReport")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCampaignsImportReport) {
+					name = jsonFieldsNameOfCampaignsImportReport[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CampaignsImportReport) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CampaignsImportReport) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s ClientAttributes) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s ClientAttributes) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes ClientAttributes from json.
+func (s *ClientAttributes) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientAttributes to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientAttributes")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ClientAttributes) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientAttributes) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ClientClick) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ClientClick) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("impression_id")
+		json.EncodeUUID(e, s.ImpressionID)
+	}
+	{
+		e.FieldStart("campaign_id")
+		json.EncodeUUID(e, s.CampaignID)
+	}
+	{
+		e.FieldStart("date")
+		e.Int(s.Date)
+	}
+	{
+		e.FieldStart("price")
+		e.Float64(s.Price)
+	}
+}
+
+var jsonFieldsNameOfClientClick = [4]string{
+	0: "impression_id",
+	1: "campaign_id",
+	2: "date",
+	3: "price",
+}
+
+// Decode decodes ClientClick from json.
+func (s *ClientClick) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientClick to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "impression_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ImpressionID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impression_id\"")
+			}
+		case "campaign_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.CampaignID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"campaign_id\"")
+			}
+		case "date":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Date = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"date\"")
+			}
+		case "price":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.Price = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"price\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientClick")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfClientClick) {
+					name = jsonFieldsNameOfClientClick[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ClientClick) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientClick) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ClientDataExport) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ClientDataExport) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("client")
+		s.Client.Encode(e)
+	}
+	{
+		e.FieldStart("ml_scores")
+		e.ArrStart()
+		for _, elem := range s.MlScores {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("impressions")
+		e.ArrStart()
+		for _, elem := range s.Impressions {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("clicks")
+		e.ArrStart()
+		for _, elem := range s.Clicks {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfClientDataExport = [4]string{
+	0: "client",
+	1: "ml_scores",
+	2: "impressions",
+	3: "clicks",
+}
+
+// Decode decodes ClientDataExport from json.
+func (s *ClientDataExport) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientDataExport to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "client":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Client.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client\"")
+			}
+		case "ml_scores":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.MlScores = make([]MLScore, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem MLScore
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MlScores = append(s.MlScores, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ml_scores\"")
+			}
+		case "impressions":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Impressions = make([]ClientImpression, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ClientImpression
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Impressions = append(s.Impressions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions\"")
+			}
+		case "clicks":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Clicks = make([]ClientClick, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem ClientClick
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Clicks = append(s.Clicks, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientDataExport")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfClientDataExport) {
+					name = jsonFieldsNameOfClientDataExport[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ClientDataExport) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientDataExport) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ClientImpression) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ClientImpression) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("impression_id")
+		json.EncodeUUID(e, s.ImpressionID)
+	}
+	{
+		e.FieldStart("campaign_id")
+		json.EncodeUUID(e, s.CampaignID)
+	}
+	{
+		e.FieldStart("date")
+		e.Int(s.Date)
+	}
+	{
+		e.FieldStart("price")
+		e.Float64(s.Price)
+	}
+	{
+		e.FieldStart("click_price")
+		e.Float64(s.ClickPrice)
+	}
+	{
+		e.FieldStart("explored")
+		e.Bool(s.Explored)
+	}
+}
+
+var jsonFieldsNameOfClientImpression = [6]string{
+	0: "impression_id",
+	1: "campaign_id",
+	2: "date",
+	3: "price",
+	4: "click_price",
+	5: "explored",
+}
+
+// Decode decodes ClientImpression from json.
+func (s *ClientImpression) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientImpression to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "impression_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ImpressionID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impression_id\"")
+			}
+		case "campaign_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.CampaignID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"campaign_id\"")
+			}
+		case "date":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Date = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"date\"")
+			}
+		case "price":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.Price = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"price\"")
+			}
+		case "click_price":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.ClickPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"click_price\"")
+			}
+		case "explored":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Bool()
+				s.Explored = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"explored\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientImpression")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00111111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfClientImpression) {
+					name = jsonFieldsNameOfClientImpression[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ClientImpression) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientImpression) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ClientModel) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ClientModel) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("client_id")
+		json.EncodeUUID(e, s.ClientID)
+	}
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		e.FieldStart("age")
+		e.Int(s.Age)
+	}
+	{
+		e.FieldStart("location")
+		e.Str(s.Location)
+	}
+	{
+		e.FieldStart("gender")
+		s.Gender.Encode(e)
+	}
+	{
+		if s.Attributes.Set {
+			e.FieldStart("attributes")
+			s.Attributes.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfClientModel = [6]string{
+	0: "client_id",
+	1: "login",
+	2: "age",
+	3: "location",
+	4: "gender",
+	5: "attributes",
+}
+
+// Decode decodes ClientModel from json.
+func (s *ClientModel) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientModel to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "client_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ClientID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_id\"")
+			}
+		case "login":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "age":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Age = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"age\"")
+			}
+		case "location":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Location = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "gender":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Gender.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gender\"")
+			}
+		case "attributes":
+			if err := func() error {
+				s.Attributes.Reset()
+				if err := s.Attributes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attributes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientModel")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfClientModel) {
+					name = jsonFieldsNameOfClientModel[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ClientModel) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientModel) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ClientModelGender as json.
+func (s ClientModelGender) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ClientModelGender from json.
+func (s *ClientModelGender) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientModelGender to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ClientModelGender(v) {
+	case ClientModelGenderMALE:
+		*s = ClientModelGenderMALE
+	case ClientModelGenderFEMALE:
+		*s = ClientModelGenderFEMALE
+	default:
+		*s = ClientModelGender(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ClientModelGender) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientModelGender) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ClientUpsert) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ClientUpsert) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("client_id")
+		json.EncodeUUID(e, s.ClientID)
+	}
+	{
+		e.FieldStart("login")
+		e.Str(s.Login)
+	}
+	{
+		e.FieldStart("age")
+		e.Int(s.Age)
+	}
+	{
+		e.FieldStart("location")
+		e.Str(s.Location)
+	}
+	{
+		e.FieldStart("gender")
+		s.Gender.Encode(e)
+	}
+	{
+		if s.Attributes.Set {
+			e.FieldStart("attributes")
+			s.Attributes.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfClientUpsert = [6]string{
+	0: "client_id",
+	1: "login",
+	2: "age",
+	3: "location",
+	4: "gender",
+	5: "attributes",
+}
+
+// Decode decodes ClientUpsert from json.
+func (s *ClientUpsert) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientUpsert to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "client_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ClientID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_id\"")
+			}
+		case "login":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Login = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"login\"")
+			}
+		case "age":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Age = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"age\"")
+			}
+		case "location":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Location = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "gender":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Gender.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gender\"")
+			}
+		case "attributes":
+			if err := func() error {
+				s.Attributes.Reset()
+				if err := s.Attributes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"attributes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ClientUpsert")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00011111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfClientUpsert) {
+					name = jsonFieldsNameOfClientUpsert[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ClientUpsert) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientUpsert) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ClientUpsertGender as json.
+func (s ClientUpsertGender) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ClientUpsertGender from json.
+func (s *ClientUpsertGender) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ClientUpsertGender to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ClientUpsertGender(v) {
+	case ClientUpsertGenderMALE:
+		*s = ClientUpsertGenderMALE
+	case ClientUpsertGenderFEMALE:
+		*s = ClientUpsertGenderFEMALE
+	default:
+		*s = ClientUpsertGender(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ClientUpsertGender) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ClientUpsertGender) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateCampaignTemplateConflict) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateCampaignTemplateConflict) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreateCampaignTemplateConflict = [1]string{
+	0: "message",
+}
+
+// Decode decodes CreateCampaignTemplateConflict from json.
+func (s *CreateCampaignTemplateConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateCampaignTemplateConflict to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateCampaignTemplateConflict")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateCampaignTemplateConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateCampaignTemplateConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DailyStats) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DailyStats) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("impressions_count")
+		e.Int(s.ImpressionsCount)
+	}
+	{
+		e.FieldStart("clicks_count")
+		e.Int(s.ClicksCount)
+	}
+	{
+		e.FieldStart("conversion")
+		e.Float64(s.Conversion)
+	}
+	{
+		e.FieldStart("spent_impressions")
+		e.Float64(s.SpentImpressions)
+	}
+	{
+		e.FieldStart("spent_clicks")
+		e.Float64(s.SpentClicks)
+	}
+	{
+		e.FieldStart("spent_total")
+		e.Float64(s.SpentTotal)
+	}
+	{
+		e.FieldStart("spent_first_price")
+		e.Float64(s.SpentFirstPrice)
+	}
+	{
+		e.FieldStart("spent_second_price")
+		e.Float64(s.SpentSecondPrice)
+	}
+	{
+		e.FieldStart("date")
+		s.Date.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfDailyStats = [9]string{
+	0: "impressions_count",
+	1: "clicks_count",
+	2: "conversion",
+	3: "spent_impressions",
+	4: "spent_clicks",
+	5: "spent_total",
+	6: "spent_first_price",
+	7: "spent_second_price",
+	8: "date",
+}
+
+// Decode decodes DailyStats from json.
+func (s *DailyStats) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DailyStats to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "impressions_count":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ImpressionsCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions_count\"")
+			}
+		case "clicks_count":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.ClicksCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_count\"")
+			}
+		case "conversion":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.Conversion = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"conversion\"")
+			}
+		case "spent_impressions":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentImpressions = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_impressions\"")
+			}
+		case "spent_clicks":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentClicks = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_clicks\"")
+			}
+		case "spent_total":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentTotal = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_total\"")
+			}
+		case "spent_first_price":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentFirstPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_first_price\"")
+			}
+		case "spent_second_price":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentSecondPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_second_price\"")
+			}
+		case "date":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				if err := s.Date.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"date\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DailyStats")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDailyStats) {
+					name = jsonFieldsNameOfDailyStats[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DailyStats) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DailyStats) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Date as json.
+func (s Date) Encode(e *jx.Encoder) {
+	unwrapped := int32(s)
+
+	e.Int32(unwrapped)
+}
+
+// Decode decodes Date from json.
+func (s *Date) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Date to nil")
+	}
+	var unwrapped int32
+	if err := func() error {
+		v, err := d.Int32()
+		unwrapped = int32(v)
+		if err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = Date(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s Date) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Date) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DeleteAdvertiserConflict) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DeleteAdvertiserConflict) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDeleteAdvertiserConflict = [1]string{
+	0: "message",
+}
+
+// Decode decodes DeleteAdvertiserConflict from json.
+func (s *DeleteAdvertiserConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeleteAdvertiserConflict to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DeleteAdvertiserConflict")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeleteAdvertiserConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeleteAdvertiserConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ExclusionReason as json.
+func (s ExclusionReason) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ExclusionReason from json.
+func (s *ExclusionReason) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ExclusionReason to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ExclusionReason(v) {
+	case ExclusionReasonCAMPAIGNNOTACTIVE:
+		*s = ExclusionReasonCAMPAIGNNOTACTIVE
+	case ExclusionReasonNOTMODERATED:
+		*s = ExclusionReasonNOTMODERATED
+	case ExclusionReasonDATEWINDOW:
+		*s = ExclusionReasonDATEWINDOW
+	case ExclusionReasonTARGETINGGENDER:
+		*s = ExclusionReasonTARGETINGGENDER
+	case ExclusionReasonTARGETINGLOCATION:
+		*s = ExclusionReasonTARGETINGLOCATION
+	case ExclusionReasonTARGETINGAGE:
+		*s = ExclusionReasonTARGETINGAGE
+	case ExclusionReasonTARGETINGRULES:
+		*s = ExclusionReasonTARGETINGRULES
+	case ExclusionReasonALREADYIMPRESSED:
+		*s = ExclusionReasonALREADYIMPRESSED
+	case ExclusionReasonIMPRESSIONSLIMITREACHED:
+		*s = ExclusionReasonIMPRESSIONSLIMITREACHED
+	case ExclusionReasonDAILYTARGETREACHED:
+		*s = ExclusionReasonDAILYTARGETREACHED
+	case ExclusionReasonCLICKSLIMITREACHED:
+		*s = ExclusionReasonCLICKSLIMITREACHED
+	case ExclusionReasonADVERTISERBUDGETREACHED:
+		*s = ExclusionReasonADVERTISERBUDGETREACHED
+	default:
+		*s = ExclusionReason(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ExclusionReason) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ExclusionReason) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *FrequencyCap) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *FrequencyCap) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("impressions")
+		e.Int(s.Impressions)
+	}
+	{
+		if s.Days.Set {
+			e.FieldStart("days")
+			s.Days.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfFrequencyCap = [2]string{
+	0: "impressions",
+	1: "days",
+}
+
+// Decode decodes FrequencyCap from json.
+func (s *FrequencyCap) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode FrequencyCap to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "impressions":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Impressions = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions\"")
+			}
+		case "days":
+			if err := func() error {
+				s.Days.Reset()
+				if err := s.Days.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"days\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode FrequencyCap")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfFrequencyCap) {
+					name = jsonFieldsNameOfFrequencyCap[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *FrequencyCap) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *FrequencyCap) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GenerateAdTextOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GenerateAdTextOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ad_text")
+		e.Str(s.AdText)
+	}
+}
+
+var jsonFieldsNameOfGenerateAdTextOK = [1]string{
+	0: "ad_text",
+}
+
+// Decode decodes GenerateAdTextOK from json.
+func (s *GenerateAdTextOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GenerateAdTextOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ad_text":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AdText = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ad_text\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GenerateAdTextOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGenerateAdTextOK) {
+					name = jsonFieldsNameOfGenerateAdTextOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GenerateAdTextOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GenerateAdTextOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GenerateAdTextReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GenerateAdTextReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ad_title")
+		e.Str(s.AdTitle)
+	}
+}
+
+var jsonFieldsNameOfGenerateAdTextReq = [1]string{
+	0: "ad_title",
+}
+
+// Decode decodes GenerateAdTextReq from json.
+func (s *GenerateAdTextReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GenerateAdTextReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ad_title":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AdTitle = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ad_title\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GenerateAdTextReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGenerateAdTextReq) {
+					name = jsonFieldsNameOfGenerateAdTextReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GenerateAdTextReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GenerateAdTextReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetAdForClientOK as json.
+func (s GetAdForClientOK) Encode(e *jx.Encoder) {
+	switch s.Type {
+	case AdGetAdForClientOK:
+		s.Ad.Encode(e)
+	case AdArrayGetAdForClientOK:
+		e.ArrStart()
+		for _, elem := range s.AdArray {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+// Decode decodes GetAdForClientOK from json.
+func (s *GetAdForClientOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetAdForClientOK to nil")
+	}
+	// Sum type type_discriminator.
+	switch t := d.Next(); t {
+	case jx.Array:
+		s.AdArray = make([]Ad, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Ad
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			s.AdArray = append(s.AdArray, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		s.Type = AdArrayGetAdForClientOK
+	case jx.Object:
+		if err := s.Ad.Decode(d); err != nil {
+			return err
+		}
+		s.Type = AdGetAdForClientOK
+	default:
+		return errors.Errorf("unexpected json type %q", t)
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetAdForClientOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetAdForClientOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetAdvertiserDailyStatsOKApplicationJSON as json.
+func (s GetAdvertiserDailyStatsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []DailyStats(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetAdvertiserDailyStatsOKApplicationJSON from json.
+func (s *GetAdvertiserDailyStatsOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetAdvertiserDailyStatsOKApplicationJSON to nil")
+	}
+	var unwrapped []DailyStats
+	if err := func() error {
+		unwrapped = make([]DailyStats, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem DailyStats
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetAdvertiserDailyStatsOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetAdvertiserDailyStatsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetAdvertiserDailyStatsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetCampaignDailyStatsOKApplicationJSON as json.
+func (s GetCampaignDailyStatsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []CampaignDailyStats(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetCampaignDailyStatsOKApplicationJSON from json.
+func (s *GetCampaignDailyStatsOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetCampaignDailyStatsOKApplicationJSON to nil")
+	}
+	var unwrapped []CampaignDailyStats
+	if err := func() error {
+		unwrapped = make([]CampaignDailyStats, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem CampaignDailyStats
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetCampaignDailyStatsOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetCampaignDailyStatsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetCampaignDailyStatsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListCampaignHistoryOKApplicationJSON as json.
+func (s ListCampaignHistoryOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []CampaignVersion(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListCampaignHistoryOKApplicationJSON from json.
+func (s *ListCampaignHistoryOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListCampaignHistoryOKApplicationJSON to nil")
+	}
+	var unwrapped []CampaignVersion
+	if err := func() error {
+		unwrapped = make([]CampaignVersion, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem CampaignVersion
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListCampaignHistoryOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListCampaignHistoryOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListCampaignHistoryOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ListCampaignTemplatesOKApplicationJSON as json.
+func (s ListCampaignTemplatesOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []CampaignTemplate(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes ListCampaignTemplatesOKApplicationJSON from json.
+func (s *ListCampaignTemplatesOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ListCampaignTemplatesOKApplicationJSON to nil")
+	}
+	var unwrapped []CampaignTemplate
+	if err := func() error {
+		unwrapped = make([]CampaignTemplate, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem CampaignTemplate
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = ListCampaignTemplatesOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ListCampaignTemplatesOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ListCampaignTemplatesOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Location) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Location) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		if s.Parent.Set {
+			e.FieldStart("parent")
+			s.Parent.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfLocation = [3]string{
+	0: "name",
+	1: "type",
+	2: "parent",
+}
+
+// Decode decodes Location from json.
+func (s *Location) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Location to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "parent":
+			if err := func() error {
+				s.Parent.Reset()
+				if err := s.Parent.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"parent\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Location")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLocation) {
+					name = jsonFieldsNameOfLocation[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Location) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Location) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LocationType as json.
+func (s LocationType) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes LocationType from json.
+func (s *LocationType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LocationType to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch LocationType(v) {
+	case LocationTypeCITY:
+		*s = LocationTypeCITY
+	case LocationTypeREGION:
+		*s = LocationTypeREGION
+	case LocationTypeCOUNTRY:
+		*s = LocationTypeCOUNTRY
+	default:
+		*s = LocationType(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s LocationType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LocationType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MLScore) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MLScore) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("client_id")
+		json.EncodeUUID(e, s.ClientID)
+	}
+	{
+		e.FieldStart("advertiser_id")
+		json.EncodeUUID(e, s.AdvertiserID)
+	}
+	{
+		e.FieldStart("score")
+		e.Int(s.Score)
+	}
+}
+
+var jsonFieldsNameOfMLScore = [3]string{
+	0: "client_id",
+	1: "advertiser_id",
+	2: "score",
+}
+
+// Decode decodes MLScore from json.
+func (s *MLScore) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MLScore to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "client_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.ClientID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"client_id\"")
+			}
+		case "advertiser_id":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.AdvertiserID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"advertiser_id\"")
+			}
+		case "score":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.Score = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MLScore")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMLScore) {
+					name = jsonFieldsNameOfMLScore[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MLScore) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MLScore) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MLScoreLoadError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MLScoreLoadError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("row")
+		e.Int(s.Row)
+	}
+	{
+		e.FieldStart("error")
+		e.Str(s.Error)
+	}
+}
+
+var jsonFieldsNameOfMLScoreLoadError = [2]string{
+	0: "row",
+	1: "error",
+}
+
+// Decode decodes MLScoreLoadError from json.
+func (s *MLScoreLoadError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MLScoreLoadError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "row":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Row = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"row\"")
+			}
+		case "error":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Error = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MLScoreLoadError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMLScoreLoadError) {
+					name = jsonFieldsNameOfMLScoreLoadError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MLScoreLoadError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MLScoreLoadError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *MLScoresLoadReport) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *MLScoresLoadReport) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("loaded")
+		e.Int(s.Loaded)
+	}
+	{
+		e.FieldStart("failed")
+		e.Int(s.Failed)
+	}
+	{
+		e.FieldStart("errors")
+		e.ArrStart()
+		for _, elem := range s.Errors {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfMLScoresLoadReport = [3]string{
+	0: "loaded",
+	1: "failed",
+	2: "errors",
+}
+
+// Decode decodes MLScoresLoadReport from json.
+func (s *MLScoresLoadReport) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode MLScoresLoadReport to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "loaded":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Loaded = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"loaded\"")
+			}
+		case "failed":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Failed = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"failed\"")
+			}
+		case "errors":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Errors = make([]MLScoreLoadError, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem MLScoreLoadError
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Errors = append(s.Errors, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"errors\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode MLScoresLoadReport")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfMLScoresLoadReport) {
+					name = jsonFieldsNameOfMLScoresLoadReport[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *MLScoresLoadReport) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *MLScoresLoadReport) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ModerateAdTextOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ModerateAdTextOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ok")
+		e.Bool(s.Ok)
+	}
+	{
+		e.FieldStart("illegal_phrases")
+		e.ArrStart()
+		for _, elem := range s.IllegalPhrases {
+			e.Str(elem)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfModerateAdTextOK = [2]string{
+	0: "ok",
+	1: "illegal_phrases",
+}
+
+// Decode decodes ModerateAdTextOK from json.
+func (s *ModerateAdTextOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ModerateAdTextOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ok":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Ok = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ok\"")
+			}
+		case "illegal_phrases":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				s.IllegalPhrases = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.IllegalPhrases = append(s.IllegalPhrases, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"illegal_phrases\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ModerateAdTextOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfModerateAdTextOK) {
+					name = jsonFieldsNameOfModerateAdTextOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ModerateAdTextOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ModerateAdTextOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ModerateAdTextReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ModerateAdTextReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("ad_text")
+		e.Str(s.AdText)
+	}
+}
+
+var jsonFieldsNameOfModerateAdTextReq = [1]string{
+	0: "ad_text",
+}
+
+// Decode decodes ModerateAdTextReq from json.
+func (s *ModerateAdTextReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ModerateAdTextReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ad_text":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.AdText = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ad_text\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ModerateAdTextReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfModerateAdTextReq) {
+					name = jsonFieldsNameOfModerateAdTextReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ModerateAdTextReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ModerateAdTextReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ModerationStatus as json.
+func (s ModerationStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes ModerationStatus from json.
+func (s *ModerationStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ModerationStatus to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch ModerationStatus(v) {
+	case ModerationStatusAPPROVED:
+		*s = ModerationStatusAPPROVED
+	case ModerationStatusPENDINGREVIEW:
+		*s = ModerationStatusPENDINGREVIEW
+	case ModerationStatusREJECTED:
+		*s = ModerationStatusREJECTED
+	case ModerationStatusMODERATIONFAILED:
+		*s = ModerationStatusMODERATIONFAILED
+	default:
+		*s = ModerationStatus(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ModerationStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ModerationStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o NilInt) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *NilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilInt to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o NilString) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *NilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilString to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v string
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AdvanceDayReq as json.
+func (o OptAdvanceDayReq) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes AdvanceDayReq from json.
+func (o *OptAdvanceDayReq) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAdvanceDayReq to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAdvanceDayReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAdvanceDayReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CampaignOverrides as json.
+func (o OptCampaignOverrides) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CampaignOverrides from json.
+func (o *OptCampaignOverrides) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCampaignOverrides to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCampaignOverrides) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCampaignOverrides) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CampaignStatus as json.
+func (o OptCampaignStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes CampaignStatus from json.
+func (o *OptCampaignStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCampaignStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCampaignStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCampaignStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ClientAttributes as json.
+func (o OptClientAttributes) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ClientAttributes from json.
+func (o *OptClientAttributes) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptClientAttributes to nil")
+	}
+	o.Set = true
+	o.Value = make(ClientAttributes)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptClientAttributes) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptClientAttributes) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Date as json.
+func (o OptDate) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Date from json.
+func (o *OptDate) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDate to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes FrequencyCap as json.
+func (o OptFrequencyCap) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes FrequencyCap from json.
+func (o *OptFrequencyCap) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFrequencyCap to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFrequencyCap) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFrequencyCap) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ModerationStatus as json.
+func (o OptModerationStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes ModerationStatus from json.
+func (o *OptModerationStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptModerationStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptModerationStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptModerationStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptNilFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptNilFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilFloat64 to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v float64
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Float64()
+	if err != nil {
+		return err
+	}
+	o.Value = float64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptNilInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptNilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v int
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptNilString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
 // Decode decodes string from json.
-func (o *NilString) Decode(d *jx.Decoder) error {
+func (o *OptNilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilString to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v string
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []string as json.
+func (o OptNilStringArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		e.Str(elem)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes []string from json.
+func (o *OptNilStringArray) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode NilString to nil")
+		return errors.New("invalid: unable to decode OptNilStringArray to nil")
 	}
 	if d.Next() == jx.Null {
 		if err := d.Null(); err != nil {
 			return err
 		}
 
-		var v string
+		var v []string
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	o.Value = make([]string, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem string
+		v, err := d.Str()
+		elem = string(v)
+		if err != nil {
+			return err
+		}
+		o.Value = append(o.Value, elem)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilStringArray) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilStringArray) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TargetingGender as json.
+func (o OptNilTargetingGender) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes TargetingGender from json.
+func (o *OptNilTargetingGender) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilTargetingGender to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v TargetingGender
 		o.Value = v
+		o.Set = true
 		o.Null = true
 		return nil
 	}
+	o.Set = true
 	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilTargetingGender) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilTargetingGender) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Pacing as json.
+func (o OptPacing) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes Pacing from json.
+func (o *OptPacing) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPacing to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPacing) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPacing) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ScoreBreakdown as json.
+func (o OptScoreBreakdown) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes ScoreBreakdown from json.
+func (o *OptScoreBreakdown) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptScoreBreakdown to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptScoreBreakdown) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptScoreBreakdown) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
 	v, err := d.Str()
 	if err != nil {
 		return err
@@ -2632,30 +7143,30 @@ func (o *NilString) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NilString) MarshalJSON() ([]byte, error) {
+func (s OptString) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilString) UnmarshalJSON(data []byte) error {
+func (s *OptString) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes AdvanceDayReq as json.
-func (o OptAdvanceDayReq) Encode(e *jx.Encoder) {
+// Encode encodes Targeting as json.
+func (o OptTargeting) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes AdvanceDayReq from json.
-func (o *OptAdvanceDayReq) Decode(d *jx.Decoder) error {
+// Decode decodes Targeting from json.
+func (o *OptTargeting) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptAdvanceDayReq to nil")
+		return errors.New("invalid: unable to decode OptTargeting to nil")
 	}
 	o.Set = true
 	if err := o.Value.Decode(d); err != nil {
@@ -2665,266 +7176,475 @@ func (o *OptAdvanceDayReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptAdvanceDayReq) MarshalJSON() ([]byte, error) {
+func (s OptTargeting) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptAdvanceDayReq) UnmarshalJSON(data []byte) error {
+func (s *OptTargeting) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Date as json.
-func (o OptDate) Encode(e *jx.Encoder) {
+// Encode encodes TargetingRule as json.
+func (o OptTargetingRule) Encode(e *jx.Encoder) {
 	if !o.Set {
 		return
 	}
 	o.Value.Encode(e)
 }
 
-// Decode decodes Date from json.
-func (o *OptDate) Decode(d *jx.Decoder) error {
+// Decode decodes TargetingRule from json.
+func (o *OptTargetingRule) Decode(d *jx.Decoder) error {
 	if o == nil {
-		return errors.New("invalid: unable to decode OptDate to nil")
+		return errors.New("invalid: unable to decode OptTargetingRule to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTargetingRule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTargetingRule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TargetingRuleOp as json.
+func (o OptTargetingRuleOp) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes TargetingRuleOp from json.
+func (o *OptTargetingRuleOp) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptTargetingRuleOp to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptTargetingRuleOp) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptTargetingRuleOp) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes uuid.UUID as json.
+func (o OptUUID) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	json.EncodeUUID(e, o.Value)
+}
+
+// Decode decodes uuid.UUID from json.
+func (o *OptUUID) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUUID to nil")
+	}
+	o.Set = true
+	v, err := json.DecodeUUID(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUUID) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUUID) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *OverrideCampaignModerationConflict) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OverrideCampaignModerationConflict) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfOverrideCampaignModerationConflict = [1]string{
+	0: "message",
+}
+
+// Decode decodes OverrideCampaignModerationConflict from json.
+func (s *OverrideCampaignModerationConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OverrideCampaignModerationConflict to nil")
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode OverrideCampaignModerationConflict")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptDate) MarshalJSON() ([]byte, error) {
+func (s *OverrideCampaignModerationConflict) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDate) UnmarshalJSON(data []byte) error {
+func (s *OverrideCampaignModerationConflict) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptNilInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Encode implements json.Marshaler.
+func (s *OverrideCampaignModerationReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *OverrideCampaignModerationReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("moderation_status")
+		s.ModerationStatus.Encode(e)
 	}
-	e.Int(int(o.Value))
 }
 
-// Decode decodes int from json.
-func (o *OptNilInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilInt to nil")
+var jsonFieldsNameOfOverrideCampaignModerationReq = [1]string{
+	0: "moderation_status",
+}
+
+// Decode decodes OverrideCampaignModerationReq from json.
+func (s *OverrideCampaignModerationReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OverrideCampaignModerationReq to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
+	var requiredBitSet [1]uint8
 
-		var v int
-		o.Value = v
-		o.Set = true
-		o.Null = true
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "moderation_status":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.ModerationStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"moderation_status\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode OverrideCampaignModerationReq")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Int()
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfOverrideCampaignModerationReq) {
+					name = jsonFieldsNameOfOverrideCampaignModerationReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Value = int(v)
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilInt) MarshalJSON() ([]byte, error) {
+func (s *OverrideCampaignModerationReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+func (s *OverrideCampaignModerationReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptNilString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes OverrideCampaignModerationReqModerationStatus as json.
+func (s OverrideCampaignModerationReqModerationStatus) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes string from json.
-func (o *OptNilString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilString to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
-
-		var v string
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
+// Decode decodes OverrideCampaignModerationReqModerationStatus from json.
+func (s *OverrideCampaignModerationReqModerationStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode OverrideCampaignModerationReqModerationStatus to nil")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Str()
+	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
-	o.Value = string(v)
+	// Try to use constant string.
+	switch OverrideCampaignModerationReqModerationStatus(v) {
+	case OverrideCampaignModerationReqModerationStatusAPPROVED:
+		*s = OverrideCampaignModerationReqModerationStatusAPPROVED
+	case OverrideCampaignModerationReqModerationStatusREJECTED:
+		*s = OverrideCampaignModerationReqModerationStatusREJECTED
+	default:
+		*s = OverrideCampaignModerationReqModerationStatus(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilString) MarshalJSON() ([]byte, error) {
+func (s OverrideCampaignModerationReqModerationStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilString) UnmarshalJSON(data []byte) error {
+func (s *OverrideCampaignModerationReqModerationStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TargetingGender as json.
-func (o OptNilTargetingGender) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Str(string(o.Value))
+// Encode encodes Pacing as json.
+func (s Pacing) Encode(e *jx.Encoder) {
+	e.Str(string(s))
 }
 
-// Decode decodes TargetingGender from json.
-func (o *OptNilTargetingGender) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilTargetingGender to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
-
-		var v TargetingGender
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
+// Decode decodes Pacing from json.
+func (s *Pacing) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Pacing to nil")
 	}
-	o.Set = true
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
+	v, err := d.StrBytes()
+	if err != nil {
 		return err
 	}
+	// Try to use constant string.
+	switch Pacing(v) {
+	case PacingNONE:
+		*s = PacingNONE
+	case PacingEVEN:
+		*s = PacingEVEN
+	case PacingFRONTLOADED:
+		*s = PacingFRONTLOADED
+	default:
+		*s = Pacing(v)
+	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilTargetingGender) MarshalJSON() ([]byte, error) {
+func (s Pacing) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilTargetingGender) UnmarshalJSON(data []byte) error {
+func (s *Pacing) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *PatchCampaignPreconditionFailed) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchCampaignPreconditionFailed) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
 	}
-	e.Str(string(o.Value))
 }
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
+var jsonFieldsNameOfPatchCampaignPreconditionFailed = [1]string{
+	0: "message",
+}
+
+// Decode decodes PatchCampaignPreconditionFailed from json.
+func (s *PatchCampaignPreconditionFailed) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchCampaignPreconditionFailed to nil")
 	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchCampaignPreconditionFailed")
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s *PatchCampaignPreconditionFailed) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *PatchCampaignPreconditionFailed) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes Targeting as json.
-func (o OptTargeting) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s PatchCampaignReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes Targeting from json.
-func (o *OptTargeting) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptTargeting to nil")
+// encodeFields implements json.Marshaler.
+func (s PatchCampaignReq) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+}
+
+// Decode decodes PatchCampaignReq from json.
+func (s *PatchCampaignReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchCampaignReq to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchCampaignReq")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptTargeting) MarshalJSON() ([]byte, error) {
+func (s PatchCampaignReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptTargeting) UnmarshalJSON(data []byte) error {
+func (s *PatchCampaignReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -3047,6 +7767,10 @@ func (s *ResourceEnum) Decode(d *jx.Decoder) error {
 		*s = ResourceEnumClient
 	case ResourceEnumCampaign:
 		*s = ResourceEnumCampaign
+	case ResourceEnumCampaignVersion:
+		*s = ResourceEnumCampaignVersion
+	case ResourceEnumCampaignTemplate:
+		*s = ResourceEnumCampaignTemplate
 	case ResourceEnumAd:
 		*s = ResourceEnumAd
 	default:
@@ -3163,24 +7887,388 @@ func (s *Response404) Decode(d *jx.Decoder) error {
 		case "resource":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Resource.Decode(d); err != nil {
+				if err := s.Resource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"resource\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Response404")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfResponse404) {
+					name = jsonFieldsNameOfResponse404[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Response404) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Response404) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Response409) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Response409) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfResponse409 = [1]string{
+	0: "message",
+}
+
+// Decode decodes Response409 from json.
+func (s *Response409) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Response409 to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Response409")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Response409) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Response409) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ScoreBreakdown) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ScoreBreakdown) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("position")
+		e.Int(s.Position)
+	}
+	{
+		e.FieldStart("rank")
+		e.Float64(s.Rank)
+	}
+	{
+		e.FieldStart("expected_profit")
+		e.Float64(s.ExpectedProfit)
+	}
+	{
+		e.FieldStart("cost_per_impression")
+		e.Float64(s.CostPerImpression)
+	}
+	{
+		e.FieldStart("cost_per_click")
+		e.Float64(s.CostPerClick)
+	}
+	{
+		e.FieldStart("ml_score")
+		e.Int(s.MlScore)
+	}
+	{
+		e.FieldStart("max_ml_score")
+		e.Int(s.MaxMlScore)
+	}
+	{
+		e.FieldStart("impressions_count")
+		e.Int(s.ImpressionsCount)
+	}
+	{
+		e.FieldStart("impressions_limit")
+		e.Int(s.ImpressionsLimit)
+	}
+	{
+		e.FieldStart("clicks_count")
+		e.Int(s.ClicksCount)
+	}
+	{
+		e.FieldStart("clicks_limit")
+		e.Int(s.ClicksLimit)
+	}
+	{
+		e.FieldStart("impressions_today")
+		e.Int(s.ImpressionsToday)
+	}
+	{
+		if s.DailyImpressionsTarget.Set {
+			e.FieldStart("daily_impressions_target")
+			s.DailyImpressionsTarget.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfScoreBreakdown = [13]string{
+	0:  "position",
+	1:  "rank",
+	2:  "expected_profit",
+	3:  "cost_per_impression",
+	4:  "cost_per_click",
+	5:  "ml_score",
+	6:  "max_ml_score",
+	7:  "impressions_count",
+	8:  "impressions_limit",
+	9:  "clicks_count",
+	10: "clicks_limit",
+	11: "impressions_today",
+	12: "daily_impressions_target",
+}
+
+// Decode decodes ScoreBreakdown from json.
+func (s *ScoreBreakdown) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ScoreBreakdown to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "position":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.Position = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"position\"")
+			}
+		case "rank":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Float64()
+				s.Rank = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rank\"")
+			}
+		case "expected_profit":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.ExpectedProfit = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"expected_profit\"")
+			}
+		case "cost_per_impression":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Float64()
+				s.CostPerImpression = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cost_per_impression\"")
+			}
+		case "cost_per_click":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Float64()
+				s.CostPerClick = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cost_per_click\"")
+			}
+		case "ml_score":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Int()
+				s.MlScore = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ml_score\"")
+			}
+		case "max_ml_score":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Int()
+				s.MaxMlScore = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_ml_score\"")
+			}
+		case "impressions_count":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Int()
+				s.ImpressionsCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions_count\"")
+			}
+		case "impressions_limit":
+			requiredBitSet[1] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ImpressionsLimit = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"resource\"")
+				return errors.Wrap(err, "decode field \"impressions_limit\"")
+			}
+		case "clicks_count":
+			requiredBitSet[1] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.ClicksCount = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_count\"")
+			}
+		case "clicks_limit":
+			requiredBitSet[1] |= 1 << 2
+			if err := func() error {
+				v, err := d.Int()
+				s.ClicksLimit = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"clicks_limit\"")
+			}
+		case "impressions_today":
+			requiredBitSet[1] |= 1 << 3
+			if err := func() error {
+				v, err := d.Int()
+				s.ImpressionsToday = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"impressions_today\"")
+			}
+		case "daily_impressions_target":
+			if err := func() error {
+				s.DailyImpressionsTarget.Reset()
+				if err := s.DailyImpressionsTarget.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"daily_impressions_target\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Response404")
+		return errors.Wrap(err, "decode ScoreBreakdown")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
+	for i, mask := range [2]uint8{
+		0b11111111,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3192,8 +8280,8 @@ func (s *Response404) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfResponse404) {
-					name = jsonFieldsNameOfResponse404[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfScoreBreakdown) {
+					name = jsonFieldsNameOfScoreBreakdown[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3214,14 +8302,14 @@ func (s *Response404) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Response404) MarshalJSON() ([]byte, error) {
+func (s *ScoreBreakdown) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Response404) UnmarshalJSON(data []byte) error {
+func (s *ScoreBreakdown) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -3259,15 +8347,25 @@ func (s *Stats) encodeFields(e *jx.Encoder) {
 		e.FieldStart("spent_total")
 		e.Float64(s.SpentTotal)
 	}
+	{
+		e.FieldStart("spent_first_price")
+		e.Float64(s.SpentFirstPrice)
+	}
+	{
+		e.FieldStart("spent_second_price")
+		e.Float64(s.SpentSecondPrice)
+	}
 }
 
-var jsonFieldsNameOfStats = [6]string{
+var jsonFieldsNameOfStats = [8]string{
 	0: "impressions_count",
 	1: "clicks_count",
 	2: "conversion",
 	3: "spent_impressions",
 	4: "spent_clicks",
 	5: "spent_total",
+	6: "spent_first_price",
+	7: "spent_second_price",
 }
 
 // Decode decodes Stats from json.
@@ -3351,6 +8449,30 @@ func (s *Stats) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"spent_total\"")
 			}
+		case "spent_first_price":
+			requiredBitSet[0] |= 1 << 6
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentFirstPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_first_price\"")
+			}
+		case "spent_second_price":
+			requiredBitSet[0] |= 1 << 7
+			if err := func() error {
+				v, err := d.Float64()
+				s.SpentSecondPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"spent_second_price\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -3361,7 +8483,7 @@ func (s *Stats) Decode(d *jx.Decoder) error {
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00111111,
+		0b11111111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3395,170 +8517,523 @@ func (s *Stats) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Stats) MarshalJSON() ([]byte, error) {
+func (s *Stats) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Stats) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Targeting) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Targeting) encodeFields(e *jx.Encoder) {
+	{
+		if s.Gender.Set {
+			e.FieldStart("gender")
+			s.Gender.Encode(e)
+		}
+	}
+	{
+		if s.AgeFrom.Set {
+			e.FieldStart("age_from")
+			s.AgeFrom.Encode(e)
+		}
+	}
+	{
+		if s.AgeTo.Set {
+			e.FieldStart("age_to")
+			s.AgeTo.Encode(e)
+		}
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
+	}
+	{
+		if s.Locations.Set {
+			e.FieldStart("locations")
+			s.Locations.Encode(e)
+		}
+	}
+	{
+		if s.Rules.Set {
+			e.FieldStart("rules")
+			s.Rules.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTargeting = [6]string{
+	0: "gender",
+	1: "age_from",
+	2: "age_to",
+	3: "location",
+	4: "locations",
+	5: "rules",
+}
+
+// Decode decodes Targeting from json.
+func (s *Targeting) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Targeting to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "gender":
+			if err := func() error {
+				s.Gender.Reset()
+				if err := s.Gender.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"gender\"")
+			}
+		case "age_from":
+			if err := func() error {
+				s.AgeFrom.Reset()
+				if err := s.AgeFrom.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"age_from\"")
+			}
+		case "age_to":
+			if err := func() error {
+				s.AgeTo.Reset()
+				if err := s.AgeTo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"age_to\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		case "locations":
+			if err := func() error {
+				s.Locations.Reset()
+				if err := s.Locations.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"locations\"")
+			}
+		case "rules":
+			if err := func() error {
+				s.Rules.Reset()
+				if err := s.Rules.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rules\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Targeting")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Targeting) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Targeting) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TargetingGender as json.
+func (s TargetingGender) Encode(e *jx.Encoder) {
+	e.Str(string(s))
+}
+
+// Decode decodes TargetingGender from json.
+func (s *TargetingGender) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TargetingGender to nil")
+	}
+	v, err := d.StrBytes()
+	if err != nil {
+		return err
+	}
+	// Try to use constant string.
+	switch TargetingGender(v) {
+	case TargetingGenderMALE:
+		*s = TargetingGenderMALE
+	case TargetingGenderFEMALE:
+		*s = TargetingGenderFEMALE
+	case TargetingGenderALL:
+		*s = TargetingGenderALL
+	default:
+		*s = TargetingGender(v)
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s TargetingGender) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Stats) UnmarshalJSON(data []byte) error {
+func (s *TargetingGender) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Targeting) Encode(e *jx.Encoder) {
+func (s *TargetingRule) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Targeting) encodeFields(e *jx.Encoder) {
+func (s *TargetingRule) encodeFields(e *jx.Encoder) {
 	{
-		if s.Gender.Set {
-			e.FieldStart("gender")
-			s.Gender.Encode(e)
+		if s.And != nil {
+			e.FieldStart("and")
+			e.ArrStart()
+			for _, elem := range s.And {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.AgeFrom.Set {
-			e.FieldStart("age_from")
-			s.AgeFrom.Encode(e)
+		if s.Or != nil {
+			e.FieldStart("or")
+			e.ArrStart()
+			for _, elem := range s.Or {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.AgeTo.Set {
-			e.FieldStart("age_to")
-			s.AgeTo.Encode(e)
+		if s.Negate.Set {
+			e.FieldStart("negate")
+			s.Negate.Encode(e)
 		}
 	}
 	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
+		if s.Attribute.Set {
+			e.FieldStart("attribute")
+			s.Attribute.Encode(e)
+		}
+	}
+	{
+		if s.Op.Set {
+			e.FieldStart("op")
+			s.Op.Encode(e)
+		}
+	}
+	{
+		if len(s.Value) != 0 {
+			e.FieldStart("value")
+			e.Raw(s.Value)
+		}
+	}
+	{
+		if s.Values != nil {
+			e.FieldStart("values")
+			e.ArrStart()
+			for _, elem := range s.Values {
+				if len(elem) != 0 {
+					e.Raw(elem)
+				}
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfTargeting = [4]string{
-	0: "gender",
-	1: "age_from",
-	2: "age_to",
-	3: "location",
+var jsonFieldsNameOfTargetingRule = [7]string{
+	0: "and",
+	1: "or",
+	2: "negate",
+	3: "attribute",
+	4: "op",
+	5: "value",
+	6: "values",
 }
 
-// Decode decodes Targeting from json.
-func (s *Targeting) Decode(d *jx.Decoder) error {
+// Decode decodes TargetingRule from json.
+func (s *TargetingRule) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Targeting to nil")
+		return errors.New("invalid: unable to decode TargetingRule to nil")
 	}
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "gender":
+		case "and":
 			if err := func() error {
-				s.Gender.Reset()
-				if err := s.Gender.Decode(d); err != nil {
+				s.And = make([]TargetingRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TargetingRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.And = append(s.And, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"gender\"")
+				return errors.Wrap(err, "decode field \"and\"")
 			}
-		case "age_from":
+		case "or":
 			if err := func() error {
-				s.AgeFrom.Reset()
-				if err := s.AgeFrom.Decode(d); err != nil {
+				s.Or = make([]TargetingRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem TargetingRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Or = append(s.Or, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"age_from\"")
+				return errors.Wrap(err, "decode field \"or\"")
 			}
-		case "age_to":
+		case "negate":
 			if err := func() error {
-				s.AgeTo.Reset()
-				if err := s.AgeTo.Decode(d); err != nil {
+				s.Negate.Reset()
+				if err := s.Negate.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"age_to\"")
+				return errors.Wrap(err, "decode field \"negate\"")
 			}
-		case "location":
+		case "attribute":
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				s.Attribute.Reset()
+				if err := s.Attribute.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return errors.Wrap(err, "decode field \"attribute\"")
+			}
+		case "op":
+			if err := func() error {
+				s.Op.Reset()
+				if err := s.Op.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"op\"")
+			}
+		case "value":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Value = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"value\"")
+			}
+		case "values":
+			if err := func() error {
+				s.Values = make([]jx.Raw, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem jx.Raw
+					v, err := d.RawAppend(nil)
+					elem = jx.Raw(v)
+					if err != nil {
+						return err
+					}
+					s.Values = append(s.Values, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"values\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Targeting")
+		return errors.Wrap(err, "decode TargetingRule")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Targeting) MarshalJSON() ([]byte, error) {
+func (s *TargetingRule) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Targeting) UnmarshalJSON(data []byte) error {
+func (s *TargetingRule) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes TargetingGender as json.
-func (s TargetingGender) Encode(e *jx.Encoder) {
+// Encode encodes TargetingRuleOp as json.
+func (s TargetingRuleOp) Encode(e *jx.Encoder) {
 	e.Str(string(s))
 }
 
-// Decode decodes TargetingGender from json.
-func (s *TargetingGender) Decode(d *jx.Decoder) error {
+// Decode decodes TargetingRuleOp from json.
+func (s *TargetingRuleOp) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode TargetingGender to nil")
+		return errors.New("invalid: unable to decode TargetingRuleOp to nil")
 	}
 	v, err := d.StrBytes()
 	if err != nil {
 		return err
 	}
 	// Try to use constant string.
-	switch TargetingGender(v) {
-	case TargetingGenderMALE:
-		*s = TargetingGenderMALE
-	case TargetingGenderFEMALE:
-		*s = TargetingGenderFEMALE
-	case TargetingGenderALL:
-		*s = TargetingGenderALL
+	switch TargetingRuleOp(v) {
+	case TargetingRuleOpEq:
+		*s = TargetingRuleOpEq
+	case TargetingRuleOpNe:
+		*s = TargetingRuleOpNe
+	case TargetingRuleOpGt:
+		*s = TargetingRuleOpGt
+	case TargetingRuleOpGte:
+		*s = TargetingRuleOpGte
+	case TargetingRuleOpLt:
+		*s = TargetingRuleOpLt
+	case TargetingRuleOpLte:
+		*s = TargetingRuleOpLte
+	case TargetingRuleOpIn:
+		*s = TargetingRuleOpIn
+	case TargetingRuleOpExists:
+		*s = TargetingRuleOpExists
 	default:
-		*s = TargetingGender(v)
+		*s = TargetingRuleOp(v)
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s TargetingGender) MarshalJSON() ([]byte, error) {
+func (s TargetingRuleOp) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *TargetingGender) UnmarshalJSON(data []byte) error {
+func (s *TargetingRuleOp) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UpdateCampaignPreconditionFailed) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UpdateCampaignPreconditionFailed) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUpdateCampaignPreconditionFailed = [1]string{
+	0: "message",
+}
+
+// Decode decodes UpdateCampaignPreconditionFailed from json.
+func (s *UpdateCampaignPreconditionFailed) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateCampaignPreconditionFailed to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateCampaignPreconditionFailed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateCampaignPreconditionFailed) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateCampaignPreconditionFailed) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -3657,6 +9132,69 @@ func (s *UploadCampaignImageOK) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *UpsertAdvertisersConflict) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UpsertAdvertisersConflict) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUpsertAdvertisersConflict = [1]string{
+	0: "message",
+}
+
+// Decode decodes UpsertAdvertisersConflict from json.
+func (s *UpsertAdvertisersConflict) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpsertAdvertisersConflict to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpsertAdvertisersConflict")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpsertAdvertisersConflict) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpsertAdvertisersConflict) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes UpsertAdvertisersCreatedApplicationJSON as json.
 func (s UpsertAdvertisersCreatedApplicationJSON) Encode(e *jx.Encoder) {
 	unwrapped := []Advertiser(s)
@@ -3756,3 +9294,53 @@ func (s *UpsertClientsCreatedApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
+
+// Encode encodes UpsertLocationsCreatedApplicationJSON as json.
+func (s UpsertLocationsCreatedApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []Location(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes UpsertLocationsCreatedApplicationJSON from json.
+func (s *UpsertLocationsCreatedApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpsertLocationsCreatedApplicationJSON to nil")
+	}
+	var unwrapped []Location
+	if err := func() error {
+		unwrapped = make([]Location, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem Location
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = UpsertLocationsCreatedApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UpsertLocationsCreatedApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpsertLocationsCreatedApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
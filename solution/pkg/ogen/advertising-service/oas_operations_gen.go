@@ -7,8 +7,17 @@ type OperationName = string
 
 const (
 	AdvanceDayOperation                  OperationName = "AdvanceDay"
+	ArchiveCampaignOperation             OperationName = "ArchiveCampaign"
+	CloneCampaignOperation               OperationName = "CloneCampaign"
 	CreateCampaignOperation              OperationName = "CreateCampaign"
+	CreateCampaignTemplateOperation      OperationName = "CreateCampaignTemplate"
+	DeleteAdvertiserOperation            OperationName = "DeleteAdvertiser"
 	DeleteCampaignOperation              OperationName = "DeleteCampaign"
+	DeleteCampaignTemplateOperation      OperationName = "DeleteCampaignTemplate"
+	EraseClientOperation                 OperationName = "EraseClient"
+	ExplainAdForClientOperation          OperationName = "ExplainAdForClient"
+	ExportCampaignsOperation             OperationName = "ExportCampaigns"
+	ExportClientDataOperation            OperationName = "ExportClientData"
 	GenerateAdTextOperation              OperationName = "GenerateAdText"
 	GetAdForClientOperation              OperationName = "GetAdForClient"
 	GetAdvertiserByIdOperation           OperationName = "GetAdvertiserById"
@@ -17,13 +26,30 @@ const (
 	GetCampaignOperation                 OperationName = "GetCampaign"
 	GetCampaignDailyStatsOperation       OperationName = "GetCampaignDailyStats"
 	GetCampaignStatsOperation            OperationName = "GetCampaignStats"
+	GetCampaignTemplateOperation         OperationName = "GetCampaignTemplate"
 	GetClientByIdOperation               OperationName = "GetClientById"
+	ImportCampaignsOperation             OperationName = "ImportCampaigns"
+	InstantiateCampaignTemplateOperation OperationName = "InstantiateCampaignTemplate"
+	ListAdvertisersOperation             OperationName = "ListAdvertisers"
+	ListCampaignHistoryOperation         OperationName = "ListCampaignHistory"
+	ListCampaignTemplatesOperation       OperationName = "ListCampaignTemplates"
 	ListCampaignsOperation               OperationName = "ListCampaigns"
+	ListLocationsOperation               OperationName = "ListLocations"
+	LoadMLScoresOperation                OperationName = "LoadMLScores"
 	ModerateAdTextOperation              OperationName = "ModerateAdText"
+	OverrideCampaignModerationOperation  OperationName = "OverrideCampaignModeration"
+	PatchCampaignOperation               OperationName = "PatchCampaign"
+	PauseCampaignOperation               OperationName = "PauseCampaign"
+	PurgeCampaignOperation               OperationName = "PurgeCampaign"
 	RecordAdClickOperation               OperationName = "RecordAdClick"
+	RestoreCampaignVersionOperation      OperationName = "RestoreCampaignVersion"
+	ResumeCampaignOperation              OperationName = "ResumeCampaign"
+	SearchAdvertiserCampaignsOperation   OperationName = "SearchAdvertiserCampaigns"
+	SearchCampaignsOperation             OperationName = "SearchCampaigns"
 	UpdateCampaignOperation              OperationName = "UpdateCampaign"
 	UploadCampaignImageOperation         OperationName = "UploadCampaignImage"
 	UpsertAdvertisersOperation           OperationName = "UpsertAdvertisers"
 	UpsertClientsOperation               OperationName = "UpsertClients"
+	UpsertLocationsOperation             OperationName = "UpsertLocations"
 	UpsertMLScoreOperation               OperationName = "UpsertMLScore"
 )
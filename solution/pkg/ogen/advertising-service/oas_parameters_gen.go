@@ -16,13 +16,15 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// CreateCampaignParams is parameters of createCampaign operation.
-type CreateCampaignParams struct {
-	// UUID рекламодателя, для которого создаётся кампания.
+// ArchiveCampaignParams is parameters of archiveCampaign operation.
+type ArchiveCampaignParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
 	AdvertiserId uuid.UUID
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
 }
 
-func unpackCreateCampaignParams(packed middleware.Parameters) (params CreateCampaignParams) {
+func unpackArchiveCampaignParams(packed middleware.Parameters) (params ArchiveCampaignParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "advertiserId",
@@ -30,10 +32,17 @@ func unpackCreateCampaignParams(packed middleware.Parameters) (params CreateCamp
 		}
 		params.AdvertiserId = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
 	return params
 }
 
-func decodeCreateCampaignParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCampaignParams, _ error) {
+func decodeArchiveCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params ArchiveCampaignParams, _ error) {
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -79,18 +88,63 @@ func decodeCreateCampaignParams(args [1]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// DeleteCampaignParams is parameters of deleteCampaign operation.
-type DeleteCampaignParams struct {
+// CloneCampaignParams is parameters of cloneCampaign operation.
+type CloneCampaignParams struct {
 	// UUID рекламодателя, которому принадлежит кампания.
 	AdvertiserId uuid.UUID
-	// UUID рекламной кампании, которую необходимо удалить.
+	// UUID копируемой рекламной кампании.
 	CampaignId uuid.UUID
 }
 
-func unpackDeleteCampaignParams(packed middleware.Parameters) (params DeleteCampaignParams) {
+func unpackCloneCampaignParams(packed middleware.Parameters) (params CloneCampaignParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "advertiserId",
@@ -108,7 +162,7 @@ func unpackDeleteCampaignParams(packed middleware.Parameters) (params DeleteCamp
 	return params
 }
 
-func decodeDeleteCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteCampaignParams, _ error) {
+func decodeCloneCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params CloneCampaignParams, _ error) {
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -202,71 +256,13 @@ func decodeDeleteCampaignParams(args [2]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetAdForClientParams is parameters of getAdForClient operation.
-type GetAdForClientParams struct {
-	// UUID клиента, запрашивающего показ объявления.
-	ClientID uuid.UUID
-}
-
-func unpackGetAdForClientParams(packed middleware.Parameters) (params GetAdForClientParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "client_id",
-			In:   "query",
-		}
-		params.ClientID = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetAdForClientParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAdForClientParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: client_id.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "client_id",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToUUID(val)
-				if err != nil {
-					return err
-				}
-
-				params.ClientID = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "client_id",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetAdvertiserByIdParams is parameters of getAdvertiserById operation.
-type GetAdvertiserByIdParams struct {
-	// UUID рекламодателя.
+// CreateCampaignParams is parameters of createCampaign operation.
+type CreateCampaignParams struct {
+	// UUID рекламодателя, для которого создаётся кампания.
 	AdvertiserId uuid.UUID
 }
 
-func unpackGetAdvertiserByIdParams(packed middleware.Parameters) (params GetAdvertiserByIdParams) {
+func unpackCreateCampaignParams(packed middleware.Parameters) (params CreateCampaignParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "advertiserId",
@@ -277,7 +273,7 @@ func unpackGetAdvertiserByIdParams(packed middleware.Parameters) (params GetAdve
 	return params
 }
 
-func decodeGetAdvertiserByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAdvertiserByIdParams, _ error) {
+func decodeCreateCampaignParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCampaignParams, _ error) {
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -326,14 +322,13 @@ func decodeGetAdvertiserByIdParams(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetAdvertiserCampaignsStatsParams is parameters of getAdvertiserCampaignsStats operation.
-type GetAdvertiserCampaignsStatsParams struct {
-	// UUID рекламодателя, для которого запрашивается
-	// статистика.
+// CreateCampaignTemplateParams is parameters of createCampaignTemplate operation.
+type CreateCampaignTemplateParams struct {
+	// UUID рекламодателя, для которого создаётся шаблон.
 	AdvertiserId uuid.UUID
 }
 
-func unpackGetAdvertiserCampaignsStatsParams(packed middleware.Parameters) (params GetAdvertiserCampaignsStatsParams) {
+func unpackCreateCampaignTemplateParams(packed middleware.Parameters) (params CreateCampaignTemplateParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "advertiserId",
@@ -344,7 +339,7 @@ func unpackGetAdvertiserCampaignsStatsParams(packed middleware.Parameters) (para
 	return params
 }
 
-func decodeGetAdvertiserCampaignsStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAdvertiserCampaignsStatsParams, _ error) {
+func decodeCreateCampaignTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateCampaignTemplateParams, _ error) {
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -393,14 +388,13 @@ func decodeGetAdvertiserCampaignsStatsParams(args [1]string, argsEscaped bool, r
 	return params, nil
 }
 
-// GetAdvertiserDailyStatsParams is parameters of getAdvertiserDailyStats operation.
-type GetAdvertiserDailyStatsParams struct {
-	// UUID рекламодателя, для которого запрашивается
-	// ежедневная статистика по кампаниям.
+// DeleteAdvertiserParams is parameters of deleteAdvertiser operation.
+type DeleteAdvertiserParams struct {
+	// UUID рекламодателя.
 	AdvertiserId uuid.UUID
 }
 
-func unpackGetAdvertiserDailyStatsParams(packed middleware.Parameters) (params GetAdvertiserDailyStatsParams) {
+func unpackDeleteAdvertiserParams(packed middleware.Parameters) (params DeleteAdvertiserParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "advertiserId",
@@ -411,7 +405,7 @@ func unpackGetAdvertiserDailyStatsParams(packed middleware.Parameters) (params G
 	return params
 }
 
-func decodeGetAdvertiserDailyStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAdvertiserDailyStatsParams, _ error) {
+func decodeDeleteAdvertiserParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteAdvertiserParams, _ error) {
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -460,15 +454,15 @@ func decodeGetAdvertiserDailyStatsParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetCampaignParams is parameters of getCampaign operation.
-type GetCampaignParams struct {
+// DeleteCampaignParams is parameters of deleteCampaign operation.
+type DeleteCampaignParams struct {
 	// UUID рекламодателя, которому принадлежит кампания.
 	AdvertiserId uuid.UUID
-	// UUID рекламной кампании, которую необходимо получить.
+	// UUID рекламной кампании, которую необходимо удалить.
 	CampaignId uuid.UUID
 }
 
-func unpackGetCampaignParams(packed middleware.Parameters) (params GetCampaignParams) {
+func unpackDeleteCampaignParams(packed middleware.Parameters) (params DeleteCampaignParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "advertiserId",
@@ -486,7 +480,7 @@ func unpackGetCampaignParams(packed middleware.Parameters) (params GetCampaignPa
 	return params
 }
 
-func decodeGetCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params GetCampaignParams, _ error) {
+func decodeDeleteCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteCampaignParams, _ error) {
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -580,26 +574,34 @@ func decodeGetCampaignParams(args [2]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetCampaignDailyStatsParams is parameters of getCampaignDailyStats operation.
-type GetCampaignDailyStatsParams struct {
-	// UUID рекламной кампании, для которой запрашивается
-	// ежедневная статистика.
-	CampaignId uuid.UUID
+// DeleteCampaignTemplateParams is parameters of deleteCampaignTemplate operation.
+type DeleteCampaignTemplateParams struct {
+	// UUID рекламодателя, которому принадлежит шаблон.
+	AdvertiserId uuid.UUID
+	// UUID шаблона рекламной кампании.
+	TemplateId uuid.UUID
 }
 
-func unpackGetCampaignDailyStatsParams(packed middleware.Parameters) (params GetCampaignDailyStatsParams) {
+func unpackDeleteCampaignTemplateParams(packed middleware.Parameters) (params DeleteCampaignTemplateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "campaignId",
+			Name: "advertiserId",
 			In:   "path",
 		}
-		params.CampaignId = packed[key].(uuid.UUID)
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "templateId",
+			In:   "path",
+		}
+		params.TemplateId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeGetCampaignDailyStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCampaignDailyStatsParams, _ error) {
-	// Decode path: campaignId.
+func decodeDeleteCampaignTemplateParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteCampaignTemplateParams, _ error) {
+	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -611,7 +613,7 @@ func decodeGetCampaignDailyStatsParams(args [1]string, argsEscaped bool, r *http
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "campaignId",
+				Param:   "advertiserId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -628,7 +630,7 @@ func decodeGetCampaignDailyStatsParams(args [1]string, argsEscaped bool, r *http
 					return err
 				}
 
-				params.CampaignId = c
+				params.AdvertiserId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -639,38 +641,16 @@ func decodeGetCampaignDailyStatsParams(args [1]string, argsEscaped bool, r *http
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "campaignId",
+			Name: "advertiserId",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetCampaignStatsParams is parameters of getCampaignStats operation.
-type GetCampaignStatsParams struct {
-	// UUID рекламной кампании, для которой запрашивается
-	// статистика.
-	CampaignId uuid.UUID
-}
-
-func unpackGetCampaignStatsParams(packed middleware.Parameters) (params GetCampaignStatsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "campaignId",
-			In:   "path",
-		}
-		params.CampaignId = packed[key].(uuid.UUID)
-	}
-	return params
-}
-
-func decodeGetCampaignStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCampaignStatsParams, _ error) {
-	// Decode path: campaignId.
+	// Decode path: templateId.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -678,7 +658,7 @@ func decodeGetCampaignStatsParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "campaignId",
+				Param:   "templateId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -695,7 +675,7 @@ func decodeGetCampaignStatsParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.CampaignId = c
+				params.TemplateId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -706,7 +686,7 @@ func decodeGetCampaignStatsParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "campaignId",
+			Name: "templateId",
 			In:   "path",
 			Err:  err,
 		}
@@ -714,13 +694,13 @@ func decodeGetCampaignStatsParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetClientByIdParams is parameters of getClientById operation.
-type GetClientByIdParams struct {
+// EraseClientParams is parameters of eraseClient operation.
+type EraseClientParams struct {
 	// UUID клиента.
 	ClientId uuid.UUID
 }
 
-func unpackGetClientByIdParams(packed middleware.Parameters) (params GetClientByIdParams) {
+func unpackEraseClientParams(packed middleware.Parameters) (params EraseClientParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "clientId",
@@ -731,7 +711,7 @@ func unpackGetClientByIdParams(packed middleware.Parameters) (params GetClientBy
 	return params
 }
 
-func decodeGetClientByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetClientByIdParams, _ error) {
+func decodeEraseClientParams(args [1]string, argsEscaped bool, r *http.Request) (params EraseClientParams, _ error) {
 	// Decode path: clientId.
 	if err := func() error {
 		param := args[0]
@@ -780,59 +760,3525 @@ func decodeGetClientByIdParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// ListCampaignsParams is parameters of listCampaigns operation.
-type ListCampaignsParams struct {
-	// UUID рекламодателя, для которого запрашиваются
-	// кампании.
-	AdvertiserId uuid.UUID
-	// Количество элементов на странице.
-	Size OptInt
-	// Номер страницы.
-	Page OptInt
+// ExplainAdForClientParams is parameters of explainAdForClient operation.
+type ExplainAdForClientParams struct {
+	// UUID клиента.
+	ClientID uuid.UUID
+	// UUID рекламной кампании. Если задан, возвращается
+	// объяснение только для неё.
+	CampaignID OptUUID
 }
 
-func unpackListCampaignsParams(packed middleware.Parameters) (params ListCampaignsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "advertiserId",
-			In:   "path",
-		}
-		params.AdvertiserId = packed[key].(uuid.UUID)
-	}
+func unpackExplainAdForClientParams(packed middleware.Parameters) (params ExplainAdForClientParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "size",
+			Name: "client_id",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Size = v.(OptInt)
-		}
+		params.ClientID = packed[key].(uuid.UUID)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "page",
+			Name: "campaign_id",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Page = v.(OptInt)
+			params.CampaignID = v.(OptUUID)
 		}
 	}
 	return params
 }
 
-func decodeListCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListCampaignsParams, _ error) {
+func decodeExplainAdForClientParams(args [0]string, argsEscaped bool, r *http.Request) (params ExplainAdForClientParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: advertiserId.
+	// Decode query: client_id.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "client_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ClientID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "client_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: campaign_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "campaign_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCampaignIDVal uuid.UUID
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToUUID(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCampaignIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.CampaignID.SetTo(paramsDotCampaignIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaign_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ExportCampaignsParams is parameters of exportCampaigns operation.
+type ExportCampaignsParams struct {
+	// UUID рекламодателя.
+	AdvertiserId uuid.UUID
+	// Формат файла.
+	Format OptExportCampaignsFormat
+}
+
+func unpackExportCampaignsParams(packed middleware.Parameters) (params ExportCampaignsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptExportCampaignsFormat)
+		}
+	}
+	return params
+}
+
+func decodeExportCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params ExportCampaignsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: format.
+	{
+		val := ExportCampaignsFormat("csv")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal ExportCampaignsFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = ExportCampaignsFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ExportClientDataParams is parameters of exportClientData operation.
+type ExportClientDataParams struct {
+	// UUID клиента.
+	ClientId uuid.UUID
+}
+
+func unpackExportClientDataParams(packed middleware.Parameters) (params ExportClientDataParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "clientId",
+			In:   "path",
+		}
+		params.ClientId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeExportClientDataParams(args [1]string, argsEscaped bool, r *http.Request) (params ExportClientDataParams, _ error) {
+	// Decode path: clientId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "clientId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ClientId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "clientId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAdForClientParams is parameters of getAdForClient operation.
+type GetAdForClientParams struct {
+	// UUID клиента, запрашивающего показ объявления.
+	ClientID uuid.UUID
+	// Количество рекламных слотов. Если задано,
+	// возвращается список объявлений разных
+	// рекламодателей.
+	Slots OptInt
+}
+
+func unpackGetAdForClientParams(packed middleware.Parameters) (params GetAdForClientParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "client_id",
+			In:   "query",
+		}
+		params.ClientID = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "slots",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Slots = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetAdForClientParams(args [0]string, argsEscaped bool, r *http.Request) (params GetAdForClientParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: client_id.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "client_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ClientID = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "client_id",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: slots.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "slots",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSlotsVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSlotsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Slots.SetTo(paramsDotSlotsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Slots.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           10,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slots",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAdvertiserByIdParams is parameters of getAdvertiserById operation.
+type GetAdvertiserByIdParams struct {
+	// UUID рекламодателя.
+	AdvertiserId uuid.UUID
+}
+
+func unpackGetAdvertiserByIdParams(packed middleware.Parameters) (params GetAdvertiserByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetAdvertiserByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAdvertiserByIdParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAdvertiserCampaignsStatsParams is parameters of getAdvertiserCampaignsStats operation.
+type GetAdvertiserCampaignsStatsParams struct {
+	// UUID рекламодателя, для которого запрашивается
+	// статистика.
+	AdvertiserId uuid.UUID
+}
+
+func unpackGetAdvertiserCampaignsStatsParams(packed middleware.Parameters) (params GetAdvertiserCampaignsStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetAdvertiserCampaignsStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAdvertiserCampaignsStatsParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAdvertiserDailyStatsParams is parameters of getAdvertiserDailyStats operation.
+type GetAdvertiserDailyStatsParams struct {
+	// UUID рекламодателя, для которого запрашивается
+	// ежедневная статистика по кампаниям.
+	AdvertiserId uuid.UUID
+}
+
+func unpackGetAdvertiserDailyStatsParams(packed middleware.Parameters) (params GetAdvertiserDailyStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetAdvertiserDailyStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAdvertiserDailyStatsParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCampaignParams is parameters of getCampaign operation.
+type GetCampaignParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
+	AdvertiserId uuid.UUID
+	// UUID рекламной кампании, которую необходимо получить.
+	CampaignId uuid.UUID
+}
+
+func unpackGetCampaignParams(packed middleware.Parameters) (params GetCampaignParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params GetCampaignParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCampaignDailyStatsParams is parameters of getCampaignDailyStats operation.
+type GetCampaignDailyStatsParams struct {
+	// UUID рекламной кампании, для которой запрашивается
+	// ежедневная статистика.
+	CampaignId uuid.UUID
+}
+
+func unpackGetCampaignDailyStatsParams(packed middleware.Parameters) (params GetCampaignDailyStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCampaignDailyStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCampaignDailyStatsParams, _ error) {
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCampaignStatsParams is parameters of getCampaignStats operation.
+type GetCampaignStatsParams struct {
+	// UUID рекламной кампании, для которой запрашивается
+	// статистика.
+	CampaignId uuid.UUID
+}
+
+func unpackGetCampaignStatsParams(packed middleware.Parameters) (params GetCampaignStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCampaignStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCampaignStatsParams, _ error) {
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCampaignTemplateParams is parameters of getCampaignTemplate operation.
+type GetCampaignTemplateParams struct {
+	// UUID рекламодателя, которому принадлежит шаблон.
+	AdvertiserId uuid.UUID
+	// UUID шаблона рекламной кампании.
+	TemplateId uuid.UUID
+}
+
+func unpackGetCampaignTemplateParams(packed middleware.Parameters) (params GetCampaignTemplateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "templateId",
+			In:   "path",
+		}
+		params.TemplateId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetCampaignTemplateParams(args [2]string, argsEscaped bool, r *http.Request) (params GetCampaignTemplateParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: templateId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "templateId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "templateId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetClientByIdParams is parameters of getClientById operation.
+type GetClientByIdParams struct {
+	// UUID клиента.
+	ClientId uuid.UUID
+}
+
+func unpackGetClientByIdParams(packed middleware.Parameters) (params GetClientByIdParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "clientId",
+			In:   "path",
+		}
+		params.ClientId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeGetClientByIdParams(args [1]string, argsEscaped bool, r *http.Request) (params GetClientByIdParams, _ error) {
+	// Decode path: clientId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "clientId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.ClientId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "clientId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ImportCampaignsParams is parameters of importCampaigns operation.
+type ImportCampaignsParams struct {
+	// UUID рекламодателя, для которого создаются кампании.
+	AdvertiserId uuid.UUID
+	// Создать кампании в одной транзакции. Если хотя бы
+	// одна строка некорректна, ни одна кампания не
+	// создаётся.
+	Atomic OptBool
+}
+
+func unpackImportCampaignsParams(packed middleware.Parameters) (params ImportCampaignsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "atomic",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Atomic = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeImportCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params ImportCampaignsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: atomic.
+	{
+		val := bool(false)
+		params.Atomic.SetTo(val)
+	}
+	// Decode query: atomic.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "atomic",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotAtomicVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotAtomicVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Atomic.SetTo(paramsDotAtomicVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "atomic",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// InstantiateCampaignTemplateParams is parameters of instantiateCampaignTemplate operation.
+type InstantiateCampaignTemplateParams struct {
+	// UUID рекламодателя, которому принадлежит шаблон.
+	AdvertiserId uuid.UUID
+	// UUID шаблона рекламной кампании.
+	TemplateId uuid.UUID
+}
+
+func unpackInstantiateCampaignTemplateParams(packed middleware.Parameters) (params InstantiateCampaignTemplateParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "templateId",
+			In:   "path",
+		}
+		params.TemplateId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeInstantiateCampaignTemplateParams(args [2]string, argsEscaped bool, r *http.Request) (params InstantiateCampaignTemplateParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: templateId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "templateId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.TemplateId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "templateId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListAdvertisersParams is parameters of listAdvertisers operation.
+type ListAdvertisersParams struct {
+	// Часть имени рекламодателя.
+	Name OptString
+	// Количество элементов на странице.
+	Size OptInt
+	// Номер страницы.
+	Page OptInt
+}
+
+func unpackListAdvertisersParams(packed middleware.Parameters) (params ListAdvertisersParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Name = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListAdvertisersParams(args [0]string, argsEscaped bool, r *http.Request) (params ListAdvertisersParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Name.SetTo(paramsDotNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Name.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    1,
+							MinLengthSet: true,
+							MaxLength:    0,
+							MaxLengthSet: false,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Size.SetTo(paramsDotSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Size.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListCampaignHistoryParams is parameters of listCampaignHistory operation.
+type ListCampaignHistoryParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
+	AdvertiserId uuid.UUID
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
+	// Количество элементов на странице.
+	Size OptInt
+	// Номер страницы.
+	Page OptInt
+}
+
+func unpackListCampaignHistoryParams(packed middleware.Parameters) (params ListCampaignHistoryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeListCampaignHistoryParams(args [2]string, argsEscaped bool, r *http.Request) (params ListCampaignHistoryParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Size.SetTo(paramsDotSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListCampaignTemplatesParams is parameters of listCampaignTemplates operation.
+type ListCampaignTemplatesParams struct {
+	// UUID рекламодателя.
+	AdvertiserId uuid.UUID
+}
+
+func unpackListCampaignTemplatesParams(packed middleware.Parameters) (params ListCampaignTemplatesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeListCampaignTemplatesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListCampaignTemplatesParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ListCampaignsParams is parameters of listCampaigns operation.
+type ListCampaignsParams struct {
+	// UUID рекламодателя, для которого запрашиваются
+	// кампании.
+	AdvertiserId uuid.UUID
+	// Количество элементов на странице.
+	Size OptInt
+	// Номер страницы. Не учитывается, если передан cursor.
+	Page OptInt
+	// Курсор следующей страницы из заголовка X-Next-Cursor
+	// предыдущего ответа. Передаётся с теми же фильтрами и
+	// сортировкой.
+	Cursor OptString
+	// Только кампании с указанным статусом.
+	Status OptCampaignStatus
+	// Только кампании с указанным решением модерации.
+	ModerationStatus OptModerationStatus
+	// Только кампании, период показа которых включает
+	// указанный день.
+	ActiveOn OptDate
+	// Только кампании, таргетированные на указанную
+	// локацию.
+	Location OptString
+	// Только кампании с указанным таргетингом по полу.
+	Gender OptListCampaignsGender
+	// Только кампании, название которых содержит указанную
+	// строку (без учёта регистра).
+	Title OptString
+	// Поле сортировки.
+	Sort OptListCampaignsSort
+	// Направление сортировки.
+	Order OptListCampaignsOrder
+}
+
+func unpackListCampaignsParams(packed middleware.Parameters) (params ListCampaignsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "cursor",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Cursor = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptCampaignStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "moderation_status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ModerationStatus = v.(OptModerationStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "active_on",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ActiveOn = v.(OptDate)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "location",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Location = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "gender",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Gender = v.(OptListCampaignsGender)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "title",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Title = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptListCampaignsSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptListCampaignsOrder)
+		}
+	}
+	return params
+}
+
+func decodeListCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListCampaignsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Size.SetTo(paramsDotSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Size.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: cursor.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "cursor",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotCursorVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCursorVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Cursor.SetTo(paramsDotCursorVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "cursor",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal CampaignStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = CampaignStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: moderation_status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "moderation_status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotModerationStatusVal ModerationStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotModerationStatusVal = ModerationStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ModerationStatus.SetTo(paramsDotModerationStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.ModerationStatus.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "moderation_status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: active_on.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "active_on",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotActiveOnVal Date
+				if err := func() error {
+					var paramsDotActiveOnValVal int32
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToInt32(val)
+						if err != nil {
+							return err
+						}
+
+						paramsDotActiveOnValVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					paramsDotActiveOnVal = Date(paramsDotActiveOnValVal)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ActiveOn.SetTo(paramsDotActiveOnVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.ActiveOn.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "active_on",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: location.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "location",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLocationVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLocationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Location.SetTo(paramsDotLocationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "location",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: gender.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "gender",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotGenderVal ListCampaignsGender
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotGenderVal = ListCampaignsGender(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Gender.SetTo(paramsDotGenderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Gender.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "gender",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: title.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "title",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTitleVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTitleVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Title.SetTo(paramsDotTitleVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "title",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: sort.
+	{
+		val := ListCampaignsSort("created_at")
+		params.Sort.SetTo(val)
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal ListCampaignsSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = ListCampaignsSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: order.
+	{
+		val := ListCampaignsOrder("desc")
+		params.Order.SetTo(val)
+	}
+	// Decode query: order.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "order",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrderVal ListCampaignsOrder
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrderVal = ListCampaignsOrder(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Order.SetTo(paramsDotOrderVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Order.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "order",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// OverrideCampaignModerationParams is parameters of overrideCampaignModeration operation.
+type OverrideCampaignModerationParams struct {
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
+}
+
+func unpackOverrideCampaignModerationParams(packed middleware.Parameters) (params OverrideCampaignModerationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeOverrideCampaignModerationParams(args [1]string, argsEscaped bool, r *http.Request) (params OverrideCampaignModerationParams, _ error) {
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PatchCampaignParams is parameters of patchCampaign operation.
+type PatchCampaignParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
+	AdvertiserId uuid.UUID
+	// UUID рекламной кампании, которую необходимо обновить.
+	CampaignId uuid.UUID
+	// ETag кампании, полученный при чтении, или `*`. Если
+	// кампания с тех пор изменилась, обновление
+	// отклоняется с кодом 412, некорректное значение
+	// заголовка - с кодом 400.
+	IfMatch OptString
+}
+
+func unpackPatchCampaignParams(packed middleware.Parameters) (params PatchCampaignParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodePatchCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params PatchCampaignParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PauseCampaignParams is parameters of pauseCampaign operation.
+type PauseCampaignParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
+	AdvertiserId uuid.UUID
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
+}
+
+func unpackPauseCampaignParams(packed middleware.Parameters) (params PauseCampaignParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePauseCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params PauseCampaignParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// PurgeCampaignParams is parameters of purgeCampaign operation.
+type PurgeCampaignParams struct {
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
+}
+
+func unpackPurgeCampaignParams(packed middleware.Parameters) (params PurgeCampaignParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodePurgeCampaignParams(args [1]string, argsEscaped bool, r *http.Request) (params PurgeCampaignParams, _ error) {
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RecordAdClickParams is parameters of recordAdClick operation.
+type RecordAdClickParams struct {
+	// UUID рекламного объявления (идентификатор кампании), по
+	// которому совершен клик.
+	AdId uuid.UUID
+}
+
+func unpackRecordAdClickParams(packed middleware.Parameters) (params RecordAdClickParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "adId",
+			In:   "path",
+		}
+		params.AdId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeRecordAdClickParams(args [1]string, argsEscaped bool, r *http.Request) (params RecordAdClickParams, _ error) {
+	// Decode path: adId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "adId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "adId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RestoreCampaignVersionParams is parameters of restoreCampaignVersion operation.
+type RestoreCampaignVersionParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
+	AdvertiserId uuid.UUID
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
+	// Номер версии рекламной кампании.
+	Version int
+}
+
+func unpackRestoreCampaignVersionParams(packed middleware.Parameters) (params RestoreCampaignVersionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "version",
+			In:   "path",
+		}
+		params.Version = packed[key].(int)
+	}
+	return params
+}
+
+func decodeRestoreCampaignVersionParams(args [3]string, argsEscaped bool, r *http.Request) (params RestoreCampaignVersionParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: version.
+	if err := func() error {
+		param := args[2]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[2])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "version",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.Version = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "version",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ResumeCampaignParams is parameters of resumeCampaign operation.
+type ResumeCampaignParams struct {
+	// UUID рекламодателя, которому принадлежит кампания.
+	AdvertiserId uuid.UUID
+	// UUID рекламной кампании.
+	CampaignId uuid.UUID
+}
+
+func unpackResumeCampaignParams(packed middleware.Parameters) (params ResumeCampaignParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "campaignId",
+			In:   "path",
+		}
+		params.CampaignId = packed[key].(uuid.UUID)
+	}
+	return params
+}
+
+func decodeResumeCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params ResumeCampaignParams, _ error) {
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "advertiserId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.AdvertiserId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "advertiserId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: campaignId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "campaignId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToUUID(val)
+				if err != nil {
+					return err
+				}
+
+				params.CampaignId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "campaignId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SearchAdvertiserCampaignsParams is parameters of searchAdvertiserCampaigns operation.
+type SearchAdvertiserCampaignsParams struct {
+	// UUID рекламодателя, среди кампаний которого
+	// выполняется поиск.
+	AdvertiserId uuid.UUID
+	// Поисковый запрос в синтаксисе websearch_to_tsquery - слова,
+	// "точные фразы", OR и -исключённые слова.
+	Q string
+	// Количество элементов на странице.
+	Size OptInt
+	// Номер страницы.
+	Page OptInt
+}
+
+func unpackSearchAdvertiserCampaignsParams(packed middleware.Parameters) (params SearchAdvertiserCampaignsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "advertiserId",
+			In:   "path",
+		}
+		params.AdvertiserId = packed[key].(uuid.UUID)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeSearchAdvertiserCampaignsParams(args [1]string, argsEscaped bool, r *http.Request) (params SearchAdvertiserCampaignsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: advertiserId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
 				Param:   "advertiserId",
 				Value:   param,
@@ -867,6 +4313,58 @@ func decodeListCampaignsParams(args [1]string, argsEscaped bool, r *http.Request
 			Err:  err,
 		}
 	}
+	// Decode query: q.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    1,
+					MinLengthSet: true,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	// Decode query: size.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -899,6 +4397,30 @@ func decodeListCampaignsParams(args [1]string, argsEscaped bool, r *http.Request
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Size.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -940,6 +4462,30 @@ func decodeListCampaignsParams(args [1]string, argsEscaped bool, r *http.Request
 			}); err != nil {
 				return err
 			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
 		}
 		return nil
 	}(); err != nil {
@@ -952,55 +4498,85 @@ func decodeListCampaignsParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// RecordAdClickParams is parameters of recordAdClick operation.
-type RecordAdClickParams struct {
-	// UUID рекламного объявления (идентификатор кампании), по
-	// которому совершен клик.
-	AdId uuid.UUID
+// SearchCampaignsParams is parameters of searchCampaigns operation.
+type SearchCampaignsParams struct {
+	// Поисковый запрос в синтаксисе websearch_to_tsquery - слова,
+	// "точные фразы", OR и -исключённые слова.
+	Q string
+	// Количество элементов на странице.
+	Size OptInt
+	// Номер страницы.
+	Page OptInt
 }
 
-func unpackRecordAdClickParams(packed middleware.Parameters) (params RecordAdClickParams) {
+func unpackSearchCampaignsParams(packed middleware.Parameters) (params SearchCampaignsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "adId",
-			In:   "path",
+			Name: "q",
+			In:   "query",
+		}
+		params.Q = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Size = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "page",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Page = v.(OptInt)
 		}
-		params.AdId = packed[key].(uuid.UUID)
 	}
 	return params
 }
 
-func decodeRecordAdClickParams(args [1]string, argsEscaped bool, r *http.Request) (params RecordAdClickParams, _ error) {
-	// Decode path: adId.
+func decodeSearchCampaignsParams(args [0]string, argsEscaped bool, r *http.Request) (params SearchCampaignsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: q.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "q",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "adId",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToUUID(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.AdId = c
+				params.Q = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    1,
+					MinLengthSet: true,
+					MaxLength:    0,
+					MaxLengthSet: false,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Q)); err != nil {
+					return errors.Wrap(err, "string")
+				}
 				return nil
 			}(); err != nil {
 				return err
@@ -1011,8 +4587,138 @@ func decodeRecordAdClickParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "adId",
-			In:   "path",
+			Name: "q",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: size.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "size",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSizeVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSizeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Size.SetTo(paramsDotSizeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Size.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "size",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: page.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "page",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotPageVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotPageVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Page.SetTo(paramsDotPageVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Page.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "page",
+			In:   "query",
 			Err:  err,
 		}
 	}
@@ -1025,6 +4731,11 @@ type UpdateCampaignParams struct {
 	AdvertiserId uuid.UUID
 	// UUID рекламной кампании, которую необходимо обновить.
 	CampaignId uuid.UUID
+	// ETag кампании, полученный при чтении, или `*`. Если
+	// кампания с тех пор изменилась, обновление
+	// отклоняется с кодом 412, некорректное значение
+	// заголовка - с кодом 400.
+	IfMatch OptString
 }
 
 func unpackUpdateCampaignParams(packed middleware.Parameters) (params UpdateCampaignParams) {
@@ -1042,10 +4753,20 @@ func unpackUpdateCampaignParams(packed middleware.Parameters) (params UpdateCamp
 		}
 		params.CampaignId = packed[key].(uuid.UUID)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "If-Match",
+			In:   "header",
+		}
+		if v, ok := packed[key]; ok {
+			params.IfMatch = v.(OptString)
+		}
+	}
 	return params
 }
 
 func decodeUpdateCampaignParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateCampaignParams, _ error) {
+	h := uri.NewHeaderDecoder(r.Header)
 	// Decode path: advertiserId.
 	if err := func() error {
 		param := args[0]
@@ -1136,6 +4857,45 @@ func decodeUpdateCampaignParams(args [2]string, argsEscaped bool, r *http.Reques
 			Err:  err,
 		}
 	}
+	// Decode header: If-Match.
+	if err := func() error {
+		cfg := uri.HeaderParameterDecodingConfig{
+			Name:    "If-Match",
+			Explode: false,
+		}
+		if err := h.HasParam(cfg); err == nil {
+			if err := h.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIfMatchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIfMatchVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IfMatch.SetTo(paramsDotIfMatchVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "If-Match",
+			In:   "header",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
@@ -32,6 +32,26 @@ func encodeAdvanceDayRequest(
 	return nil
 }
 
+func encodeCloneCampaignRequest(
+	req OptCampaignOverrides,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateCampaignRequest(
 	req *CampaignCreate,
 	r *http.Request,
@@ -46,6 +66,20 @@ func encodeCreateCampaignRequest(
 	return nil
 }
 
+func encodeCreateCampaignTemplateRequest(
+	req *CampaignTemplateCreate,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeGenerateAdTextRequest(
 	req *GenerateAdTextReq,
 	r *http.Request,
@@ -60,6 +94,66 @@ func encodeGenerateAdTextRequest(
 	return nil
 }
 
+func encodeImportCampaignsRequest(
+	req ImportCampaignsReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *ImportCampaignsReqApplicationXNdjson:
+		const contentType = "application/x-ndjson"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *ImportCampaignsReqTextCsv:
+		const contentType = "text/csv"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
+func encodeInstantiateCampaignTemplateRequest(
+	req OptCampaignOverrides,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	if !req.Set {
+		// Keep request with empty body if value is not set.
+		return nil
+	}
+	e := new(jx.Encoder)
+	{
+		if req.Set {
+			req.Encode(e)
+		}
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeLoadMLScoresRequest(
+	req LoadMLScoresReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *LoadMLScoresReqApplicationXNdjson:
+		const contentType = "application/x-ndjson"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *LoadMLScoresReqTextCsv:
+		const contentType = "text/csv"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
 func encodeModerateAdTextRequest(
 	req *ModerateAdTextReq,
 	r *http.Request,
@@ -74,6 +168,34 @@ func encodeModerateAdTextRequest(
 	return nil
 }
 
+func encodeOverrideCampaignModerationRequest(
+	req *OverrideCampaignModerationReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePatchCampaignRequest(
+	req PatchCampaignReq,
+	r *http.Request,
+) error {
+	const contentType = "application/merge-patch+json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeRecordAdClickRequest(
 	req *RecordAdClickReq,
 	r *http.Request,
@@ -158,6 +280,24 @@ func encodeUpsertClientsRequest(
 	return nil
 }
 
+func encodeUpsertLocationsRequest(
+	req []Location,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		e.ArrStart()
+		for _, elem := range req {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpsertMLScoreRequest(
 	req *MLScore,
 	r *http.Request,
@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [2]string{}
+	args := [3]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -85,34 +85,74 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 's': // Prefix: "s"
+					case 'm': // Prefix: "min/"
 						origElem := elem
-						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+						if l := len("min/"); len(elem) >= l && elem[0:l] == "min/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleGetAdForClientRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'a': // Prefix: "ads/explain"
 							origElem := elem
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("ads/explain"); len(elem) >= l && elem[0:l] == "ads/explain" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
-							// Param: "adId"
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleExplainAdForClientRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'c': // Prefix: "campaigns/"
+							origElem := elem
+							if l := len("campaigns/"); len(elem) >= l && elem[0:l] == "campaigns/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "search"
+								origElem := elem
+								if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleSearchCampaignsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "campaignId"
 							// Match until "/"
 							idx := strings.IndexByte(elem, '/')
 							if idx < 0 {
@@ -122,12 +162,21 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							elem = elem[idx:]
 
 							if len(elem) == 0 {
-								break
+								switch r.Method {
+								case "DELETE":
+									s.handlePurgeCampaignRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/click"
+							case '/': // Prefix: "/moderation"
 								origElem := elem
-								if l := len("/click"); len(elem) >= l && elem[0:l] == "/click" {
+								if l := len("/moderation"); len(elem) >= l && elem[0:l] == "/moderation" {
 									elem = elem[l:]
 								} else {
 									break
@@ -137,7 +186,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									// Leaf node.
 									switch r.Method {
 									case "POST":
-										s.handleRecordAdClickRequest([1]string{
+										s.handleOverrideCampaignModerationRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
@@ -154,55 +203,87 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						}
 
 						elem = origElem
-					case 'v': // Prefix: "vertisers/"
+					case 's': // Prefix: "s"
 						origElem := elem
-						if l := len("vertisers/"); len(elem) >= l && elem[0:l] == "vertisers/" {
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							break
+							switch r.Method {
+							case "GET":
+								s.handleGetAdForClientRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
 						}
 						switch elem[0] {
-						case 'b': // Prefix: "bulk"
+						case '/': // Prefix: "/"
 							origElem := elem
-							if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "adId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "POST":
-									s.handleUpsertAdvertisersRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "POST")
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/click"
+								origElem := elem
+								if l := len("/click"); len(elem) >= l && elem[0:l] == "/click" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRecordAdClickRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
 							}
 
 							elem = origElem
 						}
-						// Param: "advertiserId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+
+						elem = origElem
+					case 'v': // Prefix: "vertisers"
+						origElem := elem
+						if l := len("vertisers"); len(elem) >= l && elem[0:l] == "vertisers" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
 
 						if len(elem) == 0 {
 							switch r.Method {
 							case "GET":
-								s.handleGetAdvertiserByIdRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
+								s.handleListAdvertisersRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "GET")
 							}
@@ -210,94 +291,527 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/campaigns"
+						case '/': // Prefix: "/"
 							origElem := elem
-							if l := len("/campaigns"); len(elem) >= l && elem[0:l] == "/campaigns" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'b': // Prefix: "bulk"
+								origElem := elem
+								if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleUpsertAdvertisersRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+							// Param: "advertiserId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
 								switch r.Method {
-								case "GET":
-									s.handleListCampaignsRequest([1]string{
+								case "DELETE":
+									s.handleDeleteAdvertiserRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleCreateCampaignRequest([1]string{
+								case "GET":
+									s.handleGetAdvertiserByIdRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET,POST")
+									s.notAllowed(w, r, "DELETE,GET")
 								}
 
 								return
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case '/': // Prefix: "/campaign"
 								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("/campaign"); len(elem) >= l && elem[0:l] == "/campaign" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "campaignId"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
+								if len(elem) == 0 {
+									break
 								}
-								args[1] = elem[:idx]
-								elem = elem[idx:]
+								switch elem[0] {
+								case '-': // Prefix: "-templates"
+									origElem := elem
+									if l := len("-templates"); len(elem) >= l && elem[0:l] == "-templates" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if len(elem) == 0 {
-									switch r.Method {
-									case "DELETE":
-										s.handleDeleteCampaignRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
-									case "GET":
-										s.handleGetCampaignRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
-									case "PUT":
-										s.handleUpdateCampaignRequest([2]string{
-											args[0],
-											args[1],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "DELETE,GET,PUT")
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListCampaignTemplatesRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateCampaignTemplateRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+										origElem := elem
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									return
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/image"
+										// Param: "templateId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteCampaignTemplateRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											case "GET":
+												s.handleGetCampaignTemplateRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE,GET")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/campaigns"
+											origElem := elem
+											if l := len("/campaigns"); len(elem) >= l && elem[0:l] == "/campaigns" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleInstantiateCampaignTemplateRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 's': // Prefix: "s"
 									origElem := elem
-									if l := len("/image"); len(elem) >= l && elem[0:l] == "/image" {
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "PUT":
-											s.handleUploadCampaignImageRequest([2]string{
+										case "GET":
+											s.handleListCampaignsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateCampaignRequest([1]string{
 												args[0],
-												args[1],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "PUT")
+											s.notAllowed(w, r, "GET,POST")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+										origElem := elem
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "export"
+											origElem := elem
+											if l := len("export"); len(elem) >= l && elem[0:l] == "export" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleExportCampaignsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+											elem = origElem
+										case 'i': // Prefix: "import"
+											origElem := elem
+											if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleImportCampaignsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+											elem = origElem
+										case 's': // Prefix: "search"
+											origElem := elem
+											if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleSearchAdvertiserCampaignsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
+
+												return
+											}
+
+											elem = origElem
+										}
+										// Param: "campaignId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteCampaignRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											case "GET":
+												s.handleGetCampaignRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											case "PATCH":
+												s.handlePatchCampaignRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											case "PUT":
+												s.handleUpdateCampaignRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'a': // Prefix: "archive"
+												origElem := elem
+												if l := len("archive"); len(elem) >= l && elem[0:l] == "archive" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleArchiveCampaignRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+												elem = origElem
+											case 'c': // Prefix: "clone"
+												origElem := elem
+												if l := len("clone"); len(elem) >= l && elem[0:l] == "clone" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleCloneCampaignRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+												elem = origElem
+											case 'h': // Prefix: "history"
+												origElem := elem
+												if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch r.Method {
+													case "GET":
+														s.handleListCampaignHistoryRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+													origElem := elem
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "version"
+													// Match until "/"
+													idx := strings.IndexByte(elem, '/')
+													if idx < 0 {
+														idx = len(elem)
+													}
+													args[2] = elem[:idx]
+													elem = elem[idx:]
+
+													if len(elem) == 0 {
+														break
+													}
+													switch elem[0] {
+													case '/': // Prefix: "/restore"
+														origElem := elem
+														if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch r.Method {
+															case "POST":
+																s.handleRestoreCampaignVersionRequest([3]string{
+																	args[0],
+																	args[1],
+																	args[2],
+																}, elemIsEscaped, w, r)
+															default:
+																s.notAllowed(w, r, "POST")
+															}
+
+															return
+														}
+
+														elem = origElem
+													}
+
+													elem = origElem
+												}
+
+												elem = origElem
+											case 'i': // Prefix: "image"
+												origElem := elem
+												if l := len("image"); len(elem) >= l && elem[0:l] == "image" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "PUT":
+														s.handleUploadCampaignImageRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "PUT")
+													}
+
+													return
+												}
+
+												elem = origElem
+											case 'p': // Prefix: "pause"
+												origElem := elem
+												if l := len("pause"); len(elem) >= l && elem[0:l] == "pause" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handlePauseCampaignRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+												elem = origElem
+											case 'r': // Prefix: "resume"
+												origElem := elem
+												if l := len("resume"); len(elem) >= l && elem[0:l] == "resume" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleResumeCampaignRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									}
 
 									elem = origElem
 								}
@@ -407,23 +921,98 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					elem = origElem
 				}
 				// Param: "clientId"
-				// Leaf parameter
-				args[0] = elem
-				elem = ""
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
+					case "DELETE":
+						s.handleEraseClientRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
 					case "GET":
 						s.handleGetClientByIdRequest([1]string{
 							args[0],
 						}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "DELETE,GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/export"
+					origElem := elem
+					if l := len("/export"); len(elem) >= l && elem[0:l] == "/export" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleExportClientDataRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'l': // Prefix: "locations"
+				origElem := elem
+				if l := len("locations"); len(elem) >= l && elem[0:l] == "locations" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleListLocationsRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET")
 					}
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/bulk"
+					origElem := elem
+					if l := len("/bulk"); len(elem) >= l && elem[0:l] == "/bulk" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleUpsertLocationsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 'm': // Prefix: "ml-scores"
@@ -435,7 +1024,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "POST":
 						s.handleUpsertMLScoreRequest([0]string{}, elemIsEscaped, w, r)
@@ -445,6 +1033,29 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/bulk"
+					origElem := elem
+					if l := len("/bulk"); len(elem) >= l && elem[0:l] == "/bulk" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleLoadMLScoresRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 's': // Prefix: "stats/"
@@ -625,7 +1236,7 @@ type Route struct {
 	operationID string
 	pathPattern string
 	count       int
-	args        [2]string
+	args        [3]string
 }
 
 // Name returns ogen operation name.
@@ -686,49 +1297,179 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 		return r, false
 	}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
-			origElem := elem
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+			origElem := elem
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+				origElem := elem
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "d"
+					origElem := elem
+					if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'm': // Prefix: "min/"
+						origElem := elem
+						if l := len("min/"); len(elem) >= l && elem[0:l] == "min/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'a': // Prefix: "ads/explain"
+							origElem := elem
+							if l := len("ads/explain"); len(elem) >= l && elem[0:l] == "ads/explain" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ExplainAdForClientOperation
+									r.summary = "Объяснение подбора рекламного объявления для клиента"
+									r.operationID = "explainAdForClient"
+									r.pathPattern = "/admin/ads/explain"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'c': // Prefix: "campaigns/"
+							origElem := elem
+							if l := len("campaigns/"); len(elem) >= l && elem[0:l] == "campaigns/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "search"
+								origElem := elem
+								if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = SearchCampaignsOperation
+										r.summary = "Полнотекстовый поиск по всем рекламным кампаниям"
+										r.operationID = "searchCampaigns"
+										r.pathPattern = "/admin/campaigns/search"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "campaignId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = PurgeCampaignOperation
+									r.summary = "Окончательное удаление рекламной кампании"
+									r.operationID = "purgeCampaign"
+									r.pathPattern = "/admin/campaigns/{campaignId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/moderation"
+								origElem := elem
+								if l := len("/moderation"); len(elem) >= l && elem[0:l] == "/moderation" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = OverrideCampaignModerationOperation
+										r.summary = "Изменение решения модерации рекламной кампании"
+										r.operationID = "overrideCampaignModeration"
+										r.pathPattern = "/admin/campaigns/{campaignId}/moderation"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "a"
-				origElem := elem
-				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								elem = origElem
+							}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'd': // Prefix: "d"
-					origElem := elem
-					if l := len("d"); len(elem) >= l && elem[0:l] == "d" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+							elem = origElem
+						}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
+						elem = origElem
 					case 's': // Prefix: "s"
 						origElem := elem
 						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
@@ -804,91 +1545,91 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						}
 
 						elem = origElem
-					case 'v': // Prefix: "vertisers/"
+					case 'v': // Prefix: "vertisers"
 						origElem := elem
-						if l := len("vertisers/"); len(elem) >= l && elem[0:l] == "vertisers/" {
+						if l := len("vertisers"); len(elem) >= l && elem[0:l] == "vertisers" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'b': // Prefix: "bulk"
-							origElem := elem
-							if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "POST":
-									r.name = UpsertAdvertisersOperation
-									r.summary = "Массовое создание/обновление рекламодателей"
-									r.operationID = "upsertAdvertisers"
-									r.pathPattern = "/advertisers/bulk"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
-
-							elem = origElem
-						}
-						// Param: "advertiserId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							switch method {
 							case "GET":
-								r.name = GetAdvertiserByIdOperation
-								r.summary = "Получение рекламодателя по ID"
-								r.operationID = "getAdvertiserById"
-								r.pathPattern = "/advertisers/{advertiserId}"
+								r.name = ListAdvertisersOperation
+								r.summary = "Получение списка рекламодателей"
+								r.operationID = "listAdvertisers"
+								r.pathPattern = "/advertisers"
 								r.args = args
-								r.count = 1
+								r.count = 0
 								return r, true
 							default:
 								return
 							}
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/campaigns"
+						case '/': // Prefix: "/"
 							origElem := elem
-							if l := len("/campaigns"); len(elem) >= l && elem[0:l] == "/campaigns" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'b': // Prefix: "bulk"
+								origElem := elem
+								if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = UpsertAdvertisersOperation
+										r.summary = "Массовое создание/обновление рекламодателей"
+										r.operationID = "upsertAdvertisers"
+										r.pathPattern = "/advertisers/bulk"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+							// Param: "advertiserId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
 								switch method {
-								case "GET":
-									r.name = ListCampaignsOperation
-									r.summary = "Получение рекламных кампаний рекламодателя c пагинацией"
-									r.operationID = "listCampaigns"
-									r.pathPattern = "/advertisers/{advertiserId}/campaigns"
+								case "DELETE":
+									r.name = DeleteAdvertiserOperation
+									r.summary = "Удаление рекламодателя"
+									r.operationID = "deleteAdvertiser"
+									r.pathPattern = "/advertisers/{advertiserId}"
 									r.args = args
 									r.count = 1
 									return r, true
-								case "POST":
-									r.name = CreateCampaignOperation
-									r.summary = "Создание рекламной кампании"
-									r.operationID = "createCampaign"
-									r.pathPattern = "/advertisers/{advertiserId}/campaigns"
+								case "GET":
+									r.name = GetAdvertiserByIdOperation
+									r.summary = "Получение рекламодателя по ID"
+									r.operationID = "getAdvertiserById"
+									r.pathPattern = "/advertisers/{advertiserId}"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -897,77 +1638,506 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 							switch elem[0] {
-							case '/': // Prefix: "/"
+							case '/': // Prefix: "/campaign"
 								origElem := elem
-								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								if l := len("/campaign"); len(elem) >= l && elem[0:l] == "/campaign" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
-								// Param: "campaignId"
-								// Match until "/"
-								idx := strings.IndexByte(elem, '/')
-								if idx < 0 {
-									idx = len(elem)
-								}
-								args[1] = elem[:idx]
-								elem = elem[idx:]
-
 								if len(elem) == 0 {
-									switch method {
-									case "DELETE":
-										r.name = DeleteCampaignOperation
-										r.summary = "Удаление рекламной кампании"
-										r.operationID = "deleteCampaign"
-										r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
-										r.args = args
-										r.count = 2
-										return r, true
-									case "GET":
-										r.name = GetCampaignOperation
-										r.summary = "Получение кампании по ID"
-										r.operationID = "getCampaign"
-										r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
-										r.args = args
-										r.count = 2
-										return r, true
-									case "PUT":
-										r.name = UpdateCampaignOperation
-										r.summary = "Обновление рекламной кампании"
-										r.operationID = "updateCampaign"
-										r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
-										r.args = args
-										r.count = 2
-										return r, true
-									default:
-										return
-									}
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/image"
+								case '-': // Prefix: "-templates"
+									origElem := elem
+									if l := len("-templates"); len(elem) >= l && elem[0:l] == "-templates" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListCampaignTemplatesOperation
+											r.summary = "Получение шаблонов рекламных кампаний"
+											r.operationID = "listCampaignTemplates"
+											r.pathPattern = "/advertisers/{advertiserId}/campaign-templates"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = CreateCampaignTemplateOperation
+											r.summary = "Создание шаблона рекламной кампании"
+											r.operationID = "createCampaignTemplate"
+											r.pathPattern = "/advertisers/{advertiserId}/campaign-templates"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+										origElem := elem
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "templateId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "DELETE":
+												r.name = DeleteCampaignTemplateOperation
+												r.summary = "Удаление шаблона рекламной кампании"
+												r.operationID = "deleteCampaignTemplate"
+												r.pathPattern = "/advertisers/{advertiserId}/campaign-templates/{templateId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											case "GET":
+												r.name = GetCampaignTemplateOperation
+												r.summary = "Получение шаблона рекламной кампании"
+												r.operationID = "getCampaignTemplate"
+												r.pathPattern = "/advertisers/{advertiserId}/campaign-templates/{templateId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/campaigns"
+											origElem := elem
+											if l := len("/campaigns"); len(elem) >= l && elem[0:l] == "/campaigns" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = InstantiateCampaignTemplateOperation
+													r.summary = "Создание рекламной кампании из шаблона"
+													r.operationID = "instantiateCampaignTemplate"
+													r.pathPattern = "/advertisers/{advertiserId}/campaign-templates/{templateId}/campaigns"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 's': // Prefix: "s"
 									origElem := elem
-									if l := len("/image"); len(elem) >= l && elem[0:l] == "/image" {
+									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch method {
-										case "PUT":
-											r.name = UploadCampaignImageOperation
-											r.summary = "загрузка изображения рекламного объявления"
-											r.operationID = "uploadCampaignImage"
-											r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/image"
+										case "GET":
+											r.name = ListCampaignsOperation
+											r.summary = "Получение рекламных кампаний рекламодателя c пагинацией"
+											r.operationID = "listCampaigns"
+											r.pathPattern = "/advertisers/{advertiserId}/campaigns"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											r.name = CreateCampaignOperation
+											r.summary = "Создание рекламной кампании"
+											r.operationID = "createCampaign"
+											r.pathPattern = "/advertisers/{advertiserId}/campaigns"
 											r.args = args
-											r.count = 2
+											r.count = 1
 											return r, true
 										default:
 											return
 										}
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+										origElem := elem
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "export"
+											origElem := elem
+											if l := len("export"); len(elem) >= l && elem[0:l] == "export" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = ExportCampaignsOperation
+													r.summary = "Экспорт рекламных кампаний"
+													r.operationID = "exportCampaigns"
+													r.pathPattern = "/advertisers/{advertiserId}/campaigns/export"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										case 'i': // Prefix: "import"
+											origElem := elem
+											if l := len("import"); len(elem) >= l && elem[0:l] == "import" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = ImportCampaignsOperation
+													r.summary = "Массовый импорт рекламных кампаний"
+													r.operationID = "importCampaigns"
+													r.pathPattern = "/advertisers/{advertiserId}/campaigns/import"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										case 's': // Prefix: "search"
+											origElem := elem
+											if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = SearchAdvertiserCampaignsOperation
+													r.summary = "Полнотекстовый поиск рекламных кампаний рекламодателя"
+													r.operationID = "searchAdvertiserCampaigns"
+													r.pathPattern = "/advertisers/{advertiserId}/campaigns/search"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+										// Param: "campaignId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "DELETE":
+												r.name = DeleteCampaignOperation
+												r.summary = "Удаление рекламной кампании"
+												r.operationID = "deleteCampaign"
+												r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											case "GET":
+												r.name = GetCampaignOperation
+												r.summary = "Получение кампании по ID"
+												r.operationID = "getCampaign"
+												r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											case "PATCH":
+												r.name = PatchCampaignOperation
+												r.summary = "Частичное обновление рекламной кампании"
+												r.operationID = "patchCampaign"
+												r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											case "PUT":
+												r.name = UpdateCampaignOperation
+												r.summary = "Обновление рекламной кампании"
+												r.operationID = "updateCampaign"
+												r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case 'a': // Prefix: "archive"
+												origElem := elem
+												if l := len("archive"); len(elem) >= l && elem[0:l] == "archive" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = ArchiveCampaignOperation
+														r.summary = "Архивирование рекламной кампании"
+														r.operationID = "archiveCampaign"
+														r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/archive"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											case 'c': // Prefix: "clone"
+												origElem := elem
+												if l := len("clone"); len(elem) >= l && elem[0:l] == "clone" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = CloneCampaignOperation
+														r.summary = "Копирование рекламной кампании"
+														r.operationID = "cloneCampaign"
+														r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/clone"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											case 'h': // Prefix: "history"
+												origElem := elem
+												if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch method {
+													case "GET":
+														r.name = ListCampaignHistoryOperation
+														r.summary = "История изменений рекламной кампании"
+														r.operationID = "listCampaignHistory"
+														r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/history"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+													origElem := elem
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "version"
+													// Match until "/"
+													idx := strings.IndexByte(elem, '/')
+													if idx < 0 {
+														idx = len(elem)
+													}
+													args[2] = elem[:idx]
+													elem = elem[idx:]
+
+													if len(elem) == 0 {
+														break
+													}
+													switch elem[0] {
+													case '/': // Prefix: "/restore"
+														origElem := elem
+														if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch method {
+															case "POST":
+																r.name = RestoreCampaignVersionOperation
+																r.summary = "Восстановление версии рекламной кампании"
+																r.operationID = "restoreCampaignVersion"
+																r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/history/{version}/restore"
+																r.args = args
+																r.count = 3
+																return r, true
+															default:
+																return
+															}
+														}
+
+														elem = origElem
+													}
+
+													elem = origElem
+												}
+
+												elem = origElem
+											case 'i': // Prefix: "image"
+												origElem := elem
+												if l := len("image"); len(elem) >= l && elem[0:l] == "image" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "PUT":
+														r.name = UploadCampaignImageOperation
+														r.summary = "загрузка изображения рекламного объявления"
+														r.operationID = "uploadCampaignImage"
+														r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/image"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											case 'p': // Prefix: "pause"
+												origElem := elem
+												if l := len("pause"); len(elem) >= l && elem[0:l] == "pause" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = PauseCampaignOperation
+														r.summary = "Приостановка рекламной кампании"
+														r.operationID = "pauseCampaign"
+														r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/pause"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											case 'r': // Prefix: "resume"
+												origElem := elem
+												if l := len("resume"); len(elem) >= l && elem[0:l] == "resume" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = ResumeCampaignOperation
+														r.summary = "Запуск рекламной кампании"
+														r.operationID = "resumeCampaign"
+														r.pathPattern = "/advertisers/{advertiserId}/campaigns/{campaignId}/resume"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									}
 
 									elem = origElem
 								}
@@ -1089,13 +2259,24 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					elem = origElem
 				}
 				// Param: "clientId"
-				// Leaf parameter
-				args[0] = elem
-				elem = ""
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
+					case "DELETE":
+						r.name = EraseClientOperation
+						r.summary = "Удаление данных клиента"
+						r.operationID = "eraseClient"
+						r.pathPattern = "/clients/{clientId}"
+						r.args = args
+						r.count = 1
+						return r, true
 					case "GET":
 						r.name = GetClientByIdOperation
 						r.summary = "Получение клиента по ID"
@@ -1108,6 +2289,84 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/export"
+					origElem := elem
+					if l := len("/export"); len(elem) >= l && elem[0:l] == "/export" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ExportClientDataOperation
+							r.summary = "Выгрузка данных клиента"
+							r.operationID = "exportClientData"
+							r.pathPattern = "/clients/{clientId}/export"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'l': // Prefix: "locations"
+				origElem := elem
+				if l := len("locations"); len(elem) >= l && elem[0:l] == "locations" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = ListLocationsOperation
+						r.summary = "Получение справочника локаций"
+						r.operationID = "listLocations"
+						r.pathPattern = "/locations"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/bulk"
+					origElem := elem
+					if l := len("/bulk"); len(elem) >= l && elem[0:l] == "/bulk" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = UpsertLocationsOperation
+							r.summary = "Массовое создание/обновление локаций"
+							r.operationID = "upsertLocations"
+							r.pathPattern = "/locations/bulk"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 'm': // Prefix: "ml-scores"
@@ -1119,7 +2378,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
 					case "POST":
 						r.name = UpsertMLScoreOperation
@@ -1133,6 +2391,33 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/bulk"
+					origElem := elem
+					if l := len("/bulk"); len(elem) >= l && elem[0:l] == "/bulk" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = LoadMLScoresOperation
+							r.summary = "Массовая загрузка ML скоров"
+							r.operationID = "loadMLScores"
+							r.pathPattern = "/ml-scores/bulk"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			case 's': // Prefix: "stats/"
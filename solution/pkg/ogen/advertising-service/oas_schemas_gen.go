@@ -4,8 +4,10 @@ package api
 
 import (
 	"io"
+	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
 )
 
@@ -76,7 +78,50 @@ func (s *Ad) SetAdvertiserID(val uuid.UUID) {
 	s.AdvertiserID = val
 }
 
-func (*Ad) getAdForClientRes() {}
+// Объяснение подбора рекламного объявления для
+// клиента.
+// Ref: #/components/schemas/AdExplanation
+type AdExplanation struct {
+	// UUID клиента.
+	ClientID uuid.UUID `json:"client_id"`
+	// Текущий день, на который выполнен подбор.
+	CurrentDate Date `json:"current_date"`
+	// Рекламные кампании. Сначала подходящие клиенту в
+	// порядке ранжирования, затем исключённые.
+	Campaigns []CampaignExplanation `json:"campaigns"`
+}
+
+// GetClientID returns the value of ClientID.
+func (s *AdExplanation) GetClientID() uuid.UUID {
+	return s.ClientID
+}
+
+// GetCurrentDate returns the value of CurrentDate.
+func (s *AdExplanation) GetCurrentDate() Date {
+	return s.CurrentDate
+}
+
+// GetCampaigns returns the value of Campaigns.
+func (s *AdExplanation) GetCampaigns() []CampaignExplanation {
+	return s.Campaigns
+}
+
+// SetClientID sets the value of ClientID.
+func (s *AdExplanation) SetClientID(val uuid.UUID) {
+	s.ClientID = val
+}
+
+// SetCurrentDate sets the value of CurrentDate.
+func (s *AdExplanation) SetCurrentDate(val Date) {
+	s.CurrentDate = val
+}
+
+// SetCampaigns sets the value of Campaigns.
+func (s *AdExplanation) SetCampaigns(val []CampaignExplanation) {
+	s.Campaigns = val
+}
+
+func (*AdExplanation) explainAdForClientRes() {}
 
 type AdvanceDayOK struct {
 	// Текущий день (целое число).
@@ -117,6 +162,14 @@ type Advertiser struct {
 	AdvertiserID uuid.UUID `json:"advertiser_id"`
 	// Название рекламодателя.
 	Name string `json:"name"`
+	// Максимальная сумма, которую рекламодатель тратит на
+	// показы и переходы по всем кампаниям за день. Если не
+	// задана, ограничения нет.
+	DailyBudget OptNilFloat64 `json:"daily_budget"`
+	// Максимальная сумма, которую рекламодатель тратит на
+	// показы и переходы по всем кампаниям за всё время. Если
+	// не задана, ограничения нет.
+	TotalBudget OptNilFloat64 `json:"total_budget"`
 }
 
 // GetAdvertiserID returns the value of AdvertiserID.
@@ -129,6 +182,16 @@ func (s *Advertiser) GetName() string {
 	return s.Name
 }
 
+// GetDailyBudget returns the value of DailyBudget.
+func (s *Advertiser) GetDailyBudget() OptNilFloat64 {
+	return s.DailyBudget
+}
+
+// GetTotalBudget returns the value of TotalBudget.
+func (s *Advertiser) GetTotalBudget() OptNilFloat64 {
+	return s.TotalBudget
+}
+
 // SetAdvertiserID sets the value of AdvertiserID.
 func (s *Advertiser) SetAdvertiserID(val uuid.UUID) {
 	s.AdvertiserID = val
@@ -139,6 +202,16 @@ func (s *Advertiser) SetName(val string) {
 	s.Name = val
 }
 
+// SetDailyBudget sets the value of DailyBudget.
+func (s *Advertiser) SetDailyBudget(val OptNilFloat64) {
+	s.DailyBudget = val
+}
+
+// SetTotalBudget sets the value of TotalBudget.
+func (s *Advertiser) SetTotalBudget(val OptNilFloat64) {
+	s.TotalBudget = val
+}
+
 func (*Advertiser) getAdvertiserByIdRes() {}
 
 // Ref: #/components/schemas/AdvertiserUpsert
@@ -146,6 +219,14 @@ type AdvertiserUpsert struct {
 	// UUID рекламодателя.
 	AdvertiserID uuid.UUID `json:"advertiser_id"`
 	Name         string    `json:"name"`
+	// Максимальная сумма, которую рекламодатель тратит на
+	// показы и переходы по всем кампаниям за день. Если не
+	// задана, ограничения нет.
+	DailyBudget OptNilFloat64 `json:"daily_budget"`
+	// Максимальная сумма, которую рекламодатель тратит на
+	// показы и переходы по всем кампаниям за всё время. Если
+	// не задана, ограничения нет.
+	TotalBudget OptNilFloat64 `json:"total_budget"`
 }
 
 // GetAdvertiserID returns the value of AdvertiserID.
@@ -158,6 +239,16 @@ func (s *AdvertiserUpsert) GetName() string {
 	return s.Name
 }
 
+// GetDailyBudget returns the value of DailyBudget.
+func (s *AdvertiserUpsert) GetDailyBudget() OptNilFloat64 {
+	return s.DailyBudget
+}
+
+// GetTotalBudget returns the value of TotalBudget.
+func (s *AdvertiserUpsert) GetTotalBudget() OptNilFloat64 {
+	return s.TotalBudget
+}
+
 // SetAdvertiserID sets the value of AdvertiserID.
 func (s *AdvertiserUpsert) SetAdvertiserID(val uuid.UUID) {
 	s.AdvertiserID = val
@@ -168,6 +259,16 @@ func (s *AdvertiserUpsert) SetName(val string) {
 	s.Name = val
 }
 
+// SetDailyBudget sets the value of DailyBudget.
+func (s *AdvertiserUpsert) SetDailyBudget(val OptNilFloat64) {
+	s.DailyBudget = val
+}
+
+// SetTotalBudget sets the value of TotalBudget.
+func (s *AdvertiserUpsert) SetTotalBudget(val OptNilFloat64) {
+	s.TotalBudget = val
+}
+
 // Объект, представляющий рекламную кампанию.
 // Ref: #/components/schemas/Campaign
 type Campaign struct {
@@ -197,8 +298,15 @@ type Campaign struct {
 	StartDate Date `json:"start_date"`
 	// День окончания показа рекламного объявления
 	// (включительно).
-	EndDate   Date      `json:"end_date"`
-	Targeting Targeting `json:"targeting"`
+	EndDate          Date             `json:"end_date"`
+	Targeting        Targeting        `json:"targeting"`
+	FrequencyCap     OptFrequencyCap  `json:"frequency_cap"`
+	Pacing           Pacing           `json:"pacing"`
+	Status           CampaignStatus   `json:"status"`
+	ModerationStatus ModerationStatus `json:"moderation_status"`
+	// Недопустимые фразы, найденные модерацией в названии
+	// и тексте объявления.
+	FlaggedPhrases []string `json:"flagged_phrases"`
 }
 
 // GetCampaignID returns the value of CampaignID.
@@ -261,6 +369,31 @@ func (s *Campaign) GetTargeting() Targeting {
 	return s.Targeting
 }
 
+// GetFrequencyCap returns the value of FrequencyCap.
+func (s *Campaign) GetFrequencyCap() OptFrequencyCap {
+	return s.FrequencyCap
+}
+
+// GetPacing returns the value of Pacing.
+func (s *Campaign) GetPacing() Pacing {
+	return s.Pacing
+}
+
+// GetStatus returns the value of Status.
+func (s *Campaign) GetStatus() CampaignStatus {
+	return s.Status
+}
+
+// GetModerationStatus returns the value of ModerationStatus.
+func (s *Campaign) GetModerationStatus() ModerationStatus {
+	return s.ModerationStatus
+}
+
+// GetFlaggedPhrases returns the value of FlaggedPhrases.
+func (s *Campaign) GetFlaggedPhrases() []string {
+	return s.FlaggedPhrases
+}
+
 // SetCampaignID sets the value of CampaignID.
 func (s *Campaign) SetCampaignID(val uuid.UUID) {
 	s.CampaignID = val
@@ -321,9 +454,163 @@ func (s *Campaign) SetTargeting(val Targeting) {
 	s.Targeting = val
 }
 
-func (*Campaign) createCampaignRes() {}
-func (*Campaign) getCampaignRes()    {}
-func (*Campaign) updateCampaignRes() {}
+// SetFrequencyCap sets the value of FrequencyCap.
+func (s *Campaign) SetFrequencyCap(val OptFrequencyCap) {
+	s.FrequencyCap = val
+}
+
+// SetPacing sets the value of Pacing.
+func (s *Campaign) SetPacing(val Pacing) {
+	s.Pacing = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Campaign) SetStatus(val CampaignStatus) {
+	s.Status = val
+}
+
+// SetModerationStatus sets the value of ModerationStatus.
+func (s *Campaign) SetModerationStatus(val ModerationStatus) {
+	s.ModerationStatus = val
+}
+
+// SetFlaggedPhrases sets the value of FlaggedPhrases.
+func (s *Campaign) SetFlaggedPhrases(val []string) {
+	s.FlaggedPhrases = val
+}
+
+func (*Campaign) archiveCampaignRes()             {}
+func (*Campaign) cloneCampaignRes()               {}
+func (*Campaign) createCampaignRes()              {}
+func (*Campaign) instantiateCampaignTemplateRes() {}
+func (*Campaign) overrideCampaignModerationRes()  {}
+func (*Campaign) pauseCampaignRes()               {}
+func (*Campaign) restoreCampaignVersionRes()      {}
+func (*Campaign) resumeCampaignRes()              {}
+
+// Действие, после которого сохранена версия: CREATE -
+// создание кампании, UPDATE - обновление,
+// IMAGE - загрузка или удаление изображения, STATUS -
+// изменение статуса, DELETE - удаление,
+// RESTORE - восстановление версии, MODERATION - решение
+// модерации изменено администратором.
+// Ref: #/components/schemas/CampaignAction
+type CampaignAction string
+
+const (
+	CampaignActionCREATE     CampaignAction = "CREATE"
+	CampaignActionUPDATE     CampaignAction = "UPDATE"
+	CampaignActionIMAGE      CampaignAction = "IMAGE"
+	CampaignActionSTATUS     CampaignAction = "STATUS"
+	CampaignActionDELETE     CampaignAction = "DELETE"
+	CampaignActionRESTORE    CampaignAction = "RESTORE"
+	CampaignActionMODERATION CampaignAction = "MODERATION"
+)
+
+// AllValues returns all CampaignAction values.
+func (CampaignAction) AllValues() []CampaignAction {
+	return []CampaignAction{
+		CampaignActionCREATE,
+		CampaignActionUPDATE,
+		CampaignActionIMAGE,
+		CampaignActionSTATUS,
+		CampaignActionDELETE,
+		CampaignActionRESTORE,
+		CampaignActionMODERATION,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CampaignAction) MarshalText() ([]byte, error) {
+	switch s {
+	case CampaignActionCREATE:
+		return []byte(s), nil
+	case CampaignActionUPDATE:
+		return []byte(s), nil
+	case CampaignActionIMAGE:
+		return []byte(s), nil
+	case CampaignActionSTATUS:
+		return []byte(s), nil
+	case CampaignActionDELETE:
+		return []byte(s), nil
+	case CampaignActionRESTORE:
+		return []byte(s), nil
+	case CampaignActionMODERATION:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CampaignAction) UnmarshalText(data []byte) error {
+	switch CampaignAction(data) {
+	case CampaignActionCREATE:
+		*s = CampaignActionCREATE
+		return nil
+	case CampaignActionUPDATE:
+		*s = CampaignActionUPDATE
+		return nil
+	case CampaignActionIMAGE:
+		*s = CampaignActionIMAGE
+		return nil
+	case CampaignActionSTATUS:
+		*s = CampaignActionSTATUS
+		return nil
+	case CampaignActionDELETE:
+		*s = CampaignActionDELETE
+		return nil
+	case CampaignActionRESTORE:
+		*s = CampaignActionRESTORE
+		return nil
+	case CampaignActionMODERATION:
+		*s = CampaignActionMODERATION
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Изменение поля рекламной кампании.
+// Ref: #/components/schemas/CampaignChange
+type CampaignChange struct {
+	// Название поля.
+	Field string `json:"field"`
+	// Значение поля до изменения.
+	Old jx.Raw `json:"old"`
+	// Значение поля после изменения.
+	New jx.Raw `json:"new"`
+}
+
+// GetField returns the value of Field.
+func (s *CampaignChange) GetField() string {
+	return s.Field
+}
+
+// GetOld returns the value of Old.
+func (s *CampaignChange) GetOld() jx.Raw {
+	return s.Old
+}
+
+// GetNew returns the value of New.
+func (s *CampaignChange) GetNew() jx.Raw {
+	return s.New
+}
+
+// SetField sets the value of Field.
+func (s *CampaignChange) SetField(val string) {
+	s.Field = val
+}
+
+// SetOld sets the value of Old.
+func (s *CampaignChange) SetOld(val jx.Raw) {
+	s.Old = val
+}
+
+// SetNew sets the value of New.
+func (s *CampaignChange) SetNew(val jx.Raw) {
+	s.New = val
+}
 
 // Объект для создания новой рекламной кампании.
 // Ref: #/components/schemas/CampaignCreate
@@ -345,8 +632,13 @@ type CampaignCreate struct {
 	StartDate Date `json:"start_date"`
 	// День окончания показа рекламного объявления
 	// (включительно).
-	EndDate   Date         `json:"end_date"`
-	Targeting OptTargeting `json:"targeting"`
+	EndDate      Date            `json:"end_date"`
+	Targeting    OptTargeting    `json:"targeting"`
+	FrequencyCap OptFrequencyCap `json:"frequency_cap"`
+	Pacing       OptPacing       `json:"pacing"`
+	// Создать кампанию в статусе DRAFT. Черновик не
+	// показывается клиентам, пока не будет запущен.
+	Draft OptBool `json:"draft"`
 }
 
 // GetImpressionsLimit returns the value of ImpressionsLimit.
@@ -394,6 +686,21 @@ func (s *CampaignCreate) GetTargeting() OptTargeting {
 	return s.Targeting
 }
 
+// GetFrequencyCap returns the value of FrequencyCap.
+func (s *CampaignCreate) GetFrequencyCap() OptFrequencyCap {
+	return s.FrequencyCap
+}
+
+// GetPacing returns the value of Pacing.
+func (s *CampaignCreate) GetPacing() OptPacing {
+	return s.Pacing
+}
+
+// GetDraft returns the value of Draft.
+func (s *CampaignCreate) GetDraft() OptBool {
+	return s.Draft
+}
+
 // SetImpressionsLimit sets the value of ImpressionsLimit.
 func (s *CampaignCreate) SetImpressionsLimit(val int) {
 	s.ImpressionsLimit = val
@@ -439,335 +746,435 @@ func (s *CampaignCreate) SetTargeting(val OptTargeting) {
 	s.Targeting = val
 }
 
-// Объект для обновления параметров кампании, которые
-// разрешено изменять до старта кампании.
-// Ref: #/components/schemas/CampaignUpdate
-type CampaignUpdate struct {
-	// Задаёт лимит показов для рекламного объявления.
-	ImpressionsLimit int `json:"impressions_limit"`
-	// Задаёт лимит переходов для рекламного объявления.
-	ClicksLimit int `json:"clicks_limit"`
-	// Новая стоимость одного показа объявления.
-	CostPerImpression float32 `json:"cost_per_impression"`
-	// Новая стоимость одного перехода (клика) по объявлению.
-	CostPerClick float32 `json:"cost_per_click"`
-	// Новое название рекламного объявления.
-	AdTitle string `json:"ad_title"`
-	// Новый текст рекламного объявления.
-	AdText string `json:"ad_text"`
-	// День начала показа рекламного объявления
-	// (включительно).
-	StartDate Date `json:"start_date"`
-	// День окончания показа рекламного объявления
-	// (включительно).
-	EndDate Date `json:"end_date"`
-	// Новые параметры таргетирования для рекламной
-	// кампании.
-	Targeting OptTargeting `json:"targeting"`
+// SetFrequencyCap sets the value of FrequencyCap.
+func (s *CampaignCreate) SetFrequencyCap(val OptFrequencyCap) {
+	s.FrequencyCap = val
 }
 
-// GetImpressionsLimit returns the value of ImpressionsLimit.
-func (s *CampaignUpdate) GetImpressionsLimit() int {
-	return s.ImpressionsLimit
+// SetPacing sets the value of Pacing.
+func (s *CampaignCreate) SetPacing(val OptPacing) {
+	s.Pacing = val
 }
 
-// GetClicksLimit returns the value of ClicksLimit.
-func (s *CampaignUpdate) GetClicksLimit() int {
-	return s.ClicksLimit
+// SetDraft sets the value of Draft.
+func (s *CampaignCreate) SetDraft(val OptBool) {
+	s.Draft = val
 }
 
-// GetCostPerImpression returns the value of CostPerImpression.
-func (s *CampaignUpdate) GetCostPerImpression() float32 {
-	return s.CostPerImpression
+// Merged schema.
+// Ref: #/components/schemas/CampaignDailyStats
+type CampaignDailyStats struct {
+	// Общее количество уникальных показов рекламного
+	// объявления.
+	ImpressionsCount int `json:"impressions_count"`
+	// Общее количество уникальных переходов (кликов) по
+	// рекламному объявлению.
+	ClicksCount int `json:"clicks_count"`
+	// Коэффициент конверсии, вычисляемый как (clicks_count /
+	// impressions_count * 100) в процентах.
+	Conversion float64 `json:"conversion"`
+	// Сумма денег, потраченная на показы рекламного
+	// объявления.
+	SpentImpressions float64 `json:"spent_impressions"`
+	// Сумма денег, потраченная на переходы (клики) по
+	// рекламному объявлению.
+	SpentClicks float64 `json:"spent_clicks"`
+	// Общая сумма денег, потраченная на кампанию (показы и
+	// клики).
+	SpentTotal float64 `json:"spent_total"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом первой цены.
+	SpentFirstPrice float64 `json:"spent_first_price"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом второй цены.
+	SpentSecondPrice float64 `json:"spent_second_price"`
+	// День, за который была собрана статистика.
+	Date Date `json:"date"`
+	// Цель по показам на день, рассчитанная из оставшегося
+	// лимита показов и оставшихся дней кампании. null, если
+	// день вне периода кампании или у кампании нет
+	// распределения показов (pacing NONE).
+	ImpressionsTarget NilInt `json:"impressions_target"`
 }
 
-// GetCostPerClick returns the value of CostPerClick.
-func (s *CampaignUpdate) GetCostPerClick() float32 {
-	return s.CostPerClick
+// GetImpressionsCount returns the value of ImpressionsCount.
+func (s *CampaignDailyStats) GetImpressionsCount() int {
+	return s.ImpressionsCount
 }
 
-// GetAdTitle returns the value of AdTitle.
-func (s *CampaignUpdate) GetAdTitle() string {
-	return s.AdTitle
+// GetClicksCount returns the value of ClicksCount.
+func (s *CampaignDailyStats) GetClicksCount() int {
+	return s.ClicksCount
 }
 
-// GetAdText returns the value of AdText.
-func (s *CampaignUpdate) GetAdText() string {
-	return s.AdText
+// GetConversion returns the value of Conversion.
+func (s *CampaignDailyStats) GetConversion() float64 {
+	return s.Conversion
 }
 
-// GetStartDate returns the value of StartDate.
-func (s *CampaignUpdate) GetStartDate() Date {
-	return s.StartDate
+// GetSpentImpressions returns the value of SpentImpressions.
+func (s *CampaignDailyStats) GetSpentImpressions() float64 {
+	return s.SpentImpressions
 }
 
-// GetEndDate returns the value of EndDate.
-func (s *CampaignUpdate) GetEndDate() Date {
-	return s.EndDate
+// GetSpentClicks returns the value of SpentClicks.
+func (s *CampaignDailyStats) GetSpentClicks() float64 {
+	return s.SpentClicks
 }
 
-// GetTargeting returns the value of Targeting.
-func (s *CampaignUpdate) GetTargeting() OptTargeting {
-	return s.Targeting
+// GetSpentTotal returns the value of SpentTotal.
+func (s *CampaignDailyStats) GetSpentTotal() float64 {
+	return s.SpentTotal
 }
 
-// SetImpressionsLimit sets the value of ImpressionsLimit.
-func (s *CampaignUpdate) SetImpressionsLimit(val int) {
-	s.ImpressionsLimit = val
+// GetSpentFirstPrice returns the value of SpentFirstPrice.
+func (s *CampaignDailyStats) GetSpentFirstPrice() float64 {
+	return s.SpentFirstPrice
 }
 
-// SetClicksLimit sets the value of ClicksLimit.
-func (s *CampaignUpdate) SetClicksLimit(val int) {
-	s.ClicksLimit = val
+// GetSpentSecondPrice returns the value of SpentSecondPrice.
+func (s *CampaignDailyStats) GetSpentSecondPrice() float64 {
+	return s.SpentSecondPrice
 }
 
-// SetCostPerImpression sets the value of CostPerImpression.
-func (s *CampaignUpdate) SetCostPerImpression(val float32) {
-	s.CostPerImpression = val
+// GetDate returns the value of Date.
+func (s *CampaignDailyStats) GetDate() Date {
+	return s.Date
 }
 
-// SetCostPerClick sets the value of CostPerClick.
-func (s *CampaignUpdate) SetCostPerClick(val float32) {
-	s.CostPerClick = val
+// GetImpressionsTarget returns the value of ImpressionsTarget.
+func (s *CampaignDailyStats) GetImpressionsTarget() NilInt {
+	return s.ImpressionsTarget
 }
 
-// SetAdTitle sets the value of AdTitle.
-func (s *CampaignUpdate) SetAdTitle(val string) {
-	s.AdTitle = val
+// SetImpressionsCount sets the value of ImpressionsCount.
+func (s *CampaignDailyStats) SetImpressionsCount(val int) {
+	s.ImpressionsCount = val
 }
 
-// SetAdText sets the value of AdText.
-func (s *CampaignUpdate) SetAdText(val string) {
-	s.AdText = val
+// SetClicksCount sets the value of ClicksCount.
+func (s *CampaignDailyStats) SetClicksCount(val int) {
+	s.ClicksCount = val
 }
 
-// SetStartDate sets the value of StartDate.
-func (s *CampaignUpdate) SetStartDate(val Date) {
-	s.StartDate = val
+// SetConversion sets the value of Conversion.
+func (s *CampaignDailyStats) SetConversion(val float64) {
+	s.Conversion = val
 }
 
-// SetEndDate sets the value of EndDate.
-func (s *CampaignUpdate) SetEndDate(val Date) {
-	s.EndDate = val
+// SetSpentImpressions sets the value of SpentImpressions.
+func (s *CampaignDailyStats) SetSpentImpressions(val float64) {
+	s.SpentImpressions = val
 }
 
-// SetTargeting sets the value of Targeting.
-func (s *CampaignUpdate) SetTargeting(val OptTargeting) {
-	s.Targeting = val
+// SetSpentClicks sets the value of SpentClicks.
+func (s *CampaignDailyStats) SetSpentClicks(val float64) {
+	s.SpentClicks = val
 }
 
-// Объект, представляющий клиента системы.
-// Ref: #/components/schemas/Client
-type ClientModel struct {
-	// Уникальный идентификатор клиента (UUID).
-	ClientID uuid.UUID `json:"client_id"`
-	// Логин клиента в системе.
-	Login string `json:"login"`
-	// Возраст клиента.
-	Age int `json:"age"`
-	// Локация клиента (город, регион или район).
-	Location string `json:"location"`
-	// Пол клиента (MALE или FEMALE).
-	Gender ClientModelGender `json:"gender"`
+// SetSpentTotal sets the value of SpentTotal.
+func (s *CampaignDailyStats) SetSpentTotal(val float64) {
+	s.SpentTotal = val
 }
 
-// GetClientID returns the value of ClientID.
-func (s *ClientModel) GetClientID() uuid.UUID {
-	return s.ClientID
+// SetSpentFirstPrice sets the value of SpentFirstPrice.
+func (s *CampaignDailyStats) SetSpentFirstPrice(val float64) {
+	s.SpentFirstPrice = val
 }
 
-// GetLogin returns the value of Login.
-func (s *ClientModel) GetLogin() string {
-	return s.Login
+// SetSpentSecondPrice sets the value of SpentSecondPrice.
+func (s *CampaignDailyStats) SetSpentSecondPrice(val float64) {
+	s.SpentSecondPrice = val
 }
 
-// GetAge returns the value of Age.
-func (s *ClientModel) GetAge() int {
-	return s.Age
+// SetDate sets the value of Date.
+func (s *CampaignDailyStats) SetDate(val Date) {
+	s.Date = val
 }
 
-// GetLocation returns the value of Location.
-func (s *ClientModel) GetLocation() string {
-	return s.Location
+// SetImpressionsTarget sets the value of ImpressionsTarget.
+func (s *CampaignDailyStats) SetImpressionsTarget(val NilInt) {
+	s.ImpressionsTarget = val
 }
 
-// GetGender returns the value of Gender.
-func (s *ClientModel) GetGender() ClientModelGender {
-	return s.Gender
+// Результат проверки рекламной кампании при подборе
+// объявления.
+// Ref: #/components/schemas/CampaignExplanation
+type CampaignExplanation struct {
+	// UUID рекламной кампании.
+	CampaignID uuid.UUID `json:"campaign_id"`
+	// UUID рекламодателя.
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	// Название рекламного объявления.
+	AdTitle string `json:"ad_title"`
+	// Подходит ли кампания клиенту.
+	Eligible bool `json:"eligible"`
+	// Причины, по которым кампания не подходит клиенту.
+	ExclusionReasons []ExclusionReason `json:"exclusion_reasons"`
+	Score            OptScoreBreakdown `json:"score"`
 }
 
-// SetClientID sets the value of ClientID.
-func (s *ClientModel) SetClientID(val uuid.UUID) {
-	s.ClientID = val
+// GetCampaignID returns the value of CampaignID.
+func (s *CampaignExplanation) GetCampaignID() uuid.UUID {
+	return s.CampaignID
 }
 
-// SetLogin sets the value of Login.
-func (s *ClientModel) SetLogin(val string) {
-	s.Login = val
+// GetAdvertiserID returns the value of AdvertiserID.
+func (s *CampaignExplanation) GetAdvertiserID() uuid.UUID {
+	return s.AdvertiserID
 }
 
-// SetAge sets the value of Age.
-func (s *ClientModel) SetAge(val int) {
-	s.Age = val
+// GetAdTitle returns the value of AdTitle.
+func (s *CampaignExplanation) GetAdTitle() string {
+	return s.AdTitle
 }
 
-// SetLocation sets the value of Location.
-func (s *ClientModel) SetLocation(val string) {
-	s.Location = val
+// GetEligible returns the value of Eligible.
+func (s *CampaignExplanation) GetEligible() bool {
+	return s.Eligible
 }
 
-// SetGender sets the value of Gender.
-func (s *ClientModel) SetGender(val ClientModelGender) {
-	s.Gender = val
+// GetExclusionReasons returns the value of ExclusionReasons.
+func (s *CampaignExplanation) GetExclusionReasons() []ExclusionReason {
+	return s.ExclusionReasons
 }
 
-func (*ClientModel) getClientByIdRes() {}
+// GetScore returns the value of Score.
+func (s *CampaignExplanation) GetScore() OptScoreBreakdown {
+	return s.Score
+}
 
-// Пол клиента (MALE или FEMALE).
-type ClientModelGender string
+// SetCampaignID sets the value of CampaignID.
+func (s *CampaignExplanation) SetCampaignID(val uuid.UUID) {
+	s.CampaignID = val
+}
 
-const (
-	ClientModelGenderMALE   ClientModelGender = "MALE"
-	ClientModelGenderFEMALE ClientModelGender = "FEMALE"
-)
+// SetAdvertiserID sets the value of AdvertiserID.
+func (s *CampaignExplanation) SetAdvertiserID(val uuid.UUID) {
+	s.AdvertiserID = val
+}
 
-// AllValues returns all ClientModelGender values.
-func (ClientModelGender) AllValues() []ClientModelGender {
-	return []ClientModelGender{
-		ClientModelGenderMALE,
-		ClientModelGenderFEMALE,
-	}
+// SetAdTitle sets the value of AdTitle.
+func (s *CampaignExplanation) SetAdTitle(val string) {
+	s.AdTitle = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ClientModelGender) MarshalText() ([]byte, error) {
-	switch s {
-	case ClientModelGenderMALE:
-		return []byte(s), nil
-	case ClientModelGenderFEMALE:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetEligible sets the value of Eligible.
+func (s *CampaignExplanation) SetEligible(val bool) {
+	s.Eligible = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ClientModelGender) UnmarshalText(data []byte) error {
-	switch ClientModelGender(data) {
-	case ClientModelGenderMALE:
-		*s = ClientModelGenderMALE
-		return nil
-	case ClientModelGenderFEMALE:
-		*s = ClientModelGenderFEMALE
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetExclusionReasons sets the value of ExclusionReasons.
+func (s *CampaignExplanation) SetExclusionReasons(val []ExclusionReason) {
+	s.ExclusionReasons = val
 }
 
-// Ref: #/components/schemas/ClientUpsert
-type ClientUpsert struct {
-	ClientID uuid.UUID          `json:"client_id"`
-	Login    string             `json:"login"`
-	Age      int                `json:"age"`
-	Location string             `json:"location"`
-	Gender   ClientUpsertGender `json:"gender"`
+// SetScore sets the value of Score.
+func (s *CampaignExplanation) SetScore(val OptScoreBreakdown) {
+	s.Score = val
 }
 
-// GetClientID returns the value of ClientID.
-func (s *ClientUpsert) GetClientID() uuid.UUID {
-	return s.ClientID
+// CampaignHeaders wraps Campaign with response headers.
+type CampaignHeaders struct {
+	ETag     OptString
+	Response Campaign
 }
 
-// GetLogin returns the value of Login.
-func (s *ClientUpsert) GetLogin() string {
-	return s.Login
+// GetETag returns the value of ETag.
+func (s *CampaignHeaders) GetETag() OptString {
+	return s.ETag
 }
 
-// GetAge returns the value of Age.
-func (s *ClientUpsert) GetAge() int {
-	return s.Age
+// GetResponse returns the value of Response.
+func (s *CampaignHeaders) GetResponse() Campaign {
+	return s.Response
 }
 
-// GetLocation returns the value of Location.
-func (s *ClientUpsert) GetLocation() string {
-	return s.Location
+// SetETag sets the value of ETag.
+func (s *CampaignHeaders) SetETag(val OptString) {
+	s.ETag = val
 }
 
-// GetGender returns the value of Gender.
-func (s *ClientUpsert) GetGender() ClientUpsertGender {
-	return s.Gender
+// SetResponse sets the value of Response.
+func (s *CampaignHeaders) SetResponse(val Campaign) {
+	s.Response = val
 }
 
-// SetClientID sets the value of ClientID.
-func (s *ClientUpsert) SetClientID(val uuid.UUID) {
-	s.ClientID = val
+func (*CampaignHeaders) getCampaignRes()    {}
+func (*CampaignHeaders) patchCampaignRes()  {}
+func (*CampaignHeaders) updateCampaignRes() {}
+
+// Результат импорта строки файла.
+// Ref: #/components/schemas/CampaignImportRow
+type CampaignImportRow struct {
+	// Номер строки с кампанией, начиная с 1. Заголовок CSV не
+	// учитывается.
+	Row int `json:"row"`
+	// UUID созданной кампании.
+	CampaignID OptUUID `json:"campaign_id"`
+	// Причина, по которой кампания не создана.
+	Error OptString `json:"error"`
 }
 
-// SetLogin sets the value of Login.
-func (s *ClientUpsert) SetLogin(val string) {
-	s.Login = val
+// GetRow returns the value of Row.
+func (s *CampaignImportRow) GetRow() int {
+	return s.Row
 }
 
-// SetAge sets the value of Age.
-func (s *ClientUpsert) SetAge(val int) {
-	s.Age = val
+// GetCampaignID returns the value of CampaignID.
+func (s *CampaignImportRow) GetCampaignID() OptUUID {
+	return s.CampaignID
 }
 
-// SetLocation sets the value of Location.
-func (s *ClientUpsert) SetLocation(val string) {
-	s.Location = val
+// GetError returns the value of Error.
+func (s *CampaignImportRow) GetError() OptString {
+	return s.Error
 }
 
-// SetGender sets the value of Gender.
-func (s *ClientUpsert) SetGender(val ClientUpsertGender) {
-	s.Gender = val
+// SetRow sets the value of Row.
+func (s *CampaignImportRow) SetRow(val int) {
+	s.Row = val
 }
 
-type ClientUpsertGender string
+// SetCampaignID sets the value of CampaignID.
+func (s *CampaignImportRow) SetCampaignID(val OptUUID) {
+	s.CampaignID = val
+}
 
-const (
-	ClientUpsertGenderMALE   ClientUpsertGender = "MALE"
-	ClientUpsertGenderFEMALE ClientUpsertGender = "FEMALE"
-)
+// SetError sets the value of Error.
+func (s *CampaignImportRow) SetError(val OptString) {
+	s.Error = val
+}
 
-// AllValues returns all ClientUpsertGender values.
-func (ClientUpsertGender) AllValues() []ClientUpsertGender {
-	return []ClientUpsertGender{
-		ClientUpsertGenderMALE,
-		ClientUpsertGenderFEMALE,
-	}
+// Параметры, заменяемые в копии кампании или в кампании,
+//
+//	созданной из шаблона. Незаданные параметры берутся
+//
+// из исходной кампании или шаблона.
+// Ref: #/components/schemas/CampaignOverrides
+type CampaignOverrides struct {
+	// День начала показа рекламного объявления
+	// (включительно).
+	StartDate OptDate `json:"start_date"`
+	// День окончания показа рекламного объявления
+	// (включительно).
+	EndDate OptDate `json:"end_date"`
+	// Лимит показов для рекламного объявления.
+	ImpressionsLimit OptInt `json:"impressions_limit"`
+	// Лимит переходов для рекламного объявления.
+	ClicksLimit OptInt `json:"clicks_limit"`
+	// Создать кампанию в статусе DRAFT.
+	Draft OptBool `json:"draft"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ClientUpsertGender) MarshalText() ([]byte, error) {
-	switch s {
-	case ClientUpsertGenderMALE:
-		return []byte(s), nil
-	case ClientUpsertGenderFEMALE:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetStartDate returns the value of StartDate.
+func (s *CampaignOverrides) GetStartDate() OptDate {
+	return s.StartDate
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ClientUpsertGender) UnmarshalText(data []byte) error {
-	switch ClientUpsertGender(data) {
-	case ClientUpsertGenderMALE:
-		*s = ClientUpsertGenderMALE
-		return nil
-	case ClientUpsertGenderFEMALE:
-		*s = ClientUpsertGenderFEMALE
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetEndDate returns the value of EndDate.
+func (s *CampaignOverrides) GetEndDate() OptDate {
+	return s.EndDate
+}
+
+// GetImpressionsLimit returns the value of ImpressionsLimit.
+func (s *CampaignOverrides) GetImpressionsLimit() OptInt {
+	return s.ImpressionsLimit
+}
+
+// GetClicksLimit returns the value of ClicksLimit.
+func (s *CampaignOverrides) GetClicksLimit() OptInt {
+	return s.ClicksLimit
+}
+
+// GetDraft returns the value of Draft.
+func (s *CampaignOverrides) GetDraft() OptBool {
+	return s.Draft
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *CampaignOverrides) SetStartDate(val OptDate) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *CampaignOverrides) SetEndDate(val OptDate) {
+	s.EndDate = val
+}
+
+// SetImpressionsLimit sets the value of ImpressionsLimit.
+func (s *CampaignOverrides) SetImpressionsLimit(val OptInt) {
+	s.ImpressionsLimit = val
+}
+
+// SetClicksLimit sets the value of ClicksLimit.
+func (s *CampaignOverrides) SetClicksLimit(val OptInt) {
+	s.ClicksLimit = val
+}
+
+// SetDraft sets the value of Draft.
+func (s *CampaignOverrides) SetDraft(val OptBool) {
+	s.Draft = val
+}
+
+// Рекламная кампания, найденная полнотекстовым
+// поиском.
+// Ref: #/components/schemas/CampaignSearchResult
+type CampaignSearchResult struct {
+	Campaign Campaign `json:"campaign"`
+	// Релевантность кампании запросу, больше - релевантнее.
+	Rank float64 `json:"rank"`
+	// Название объявления, в котором найденные слова
+	// выделены тегом <b>.
+	TitleHighlight string `json:"title_highlight"`
+	// Фрагменты текста объявления, в которых найденные
+	// слова выделены тегом <b>.
+	TextHighlight string `json:"text_highlight"`
+}
+
+// GetCampaign returns the value of Campaign.
+func (s *CampaignSearchResult) GetCampaign() Campaign {
+	return s.Campaign
+}
+
+// GetRank returns the value of Rank.
+func (s *CampaignSearchResult) GetRank() float64 {
+	return s.Rank
+}
+
+// GetTitleHighlight returns the value of TitleHighlight.
+func (s *CampaignSearchResult) GetTitleHighlight() string {
+	return s.TitleHighlight
+}
+
+// GetTextHighlight returns the value of TextHighlight.
+func (s *CampaignSearchResult) GetTextHighlight() string {
+	return s.TextHighlight
+}
+
+// SetCampaign sets the value of Campaign.
+func (s *CampaignSearchResult) SetCampaign(val Campaign) {
+	s.Campaign = val
+}
+
+// SetRank sets the value of Rank.
+func (s *CampaignSearchResult) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetTitleHighlight sets the value of TitleHighlight.
+func (s *CampaignSearchResult) SetTitleHighlight(val string) {
+	s.TitleHighlight = val
+}
+
+// SetTextHighlight sets the value of TextHighlight.
+func (s *CampaignSearchResult) SetTextHighlight(val string) {
+	s.TextHighlight = val
 }
 
 // Merged schema.
-// Ref: #/components/schemas/DailyStats
-type DailyStats struct {
+// Ref: #/components/schemas/CampaignStats
+type CampaignStats struct {
 	// Общее количество уникальных показов рекламного
 	// объявления.
 	ImpressionsCount int `json:"impressions_count"`
@@ -786,295 +1193,3114 @@ type DailyStats struct {
 	// Общая сумма денег, потраченная на кампанию (показы и
 	// клики).
 	SpentTotal float64 `json:"spent_total"`
-	// День, за который была собрана статистика.
-	Date Date `json:"date"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом первой цены.
+	SpentFirstPrice float64 `json:"spent_first_price"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом второй цены.
+	SpentSecondPrice float64 `json:"spent_second_price"`
+	// Достигнут ли лимит переходов. Такая кампания больше
+	// не показывается клиентам, а переходы сверх лимита не
+	// оплачиваются.
+	ClicksLimitReached bool `json:"clicks_limit_reached"`
 }
 
 // GetImpressionsCount returns the value of ImpressionsCount.
-func (s *DailyStats) GetImpressionsCount() int {
+func (s *CampaignStats) GetImpressionsCount() int {
 	return s.ImpressionsCount
 }
 
 // GetClicksCount returns the value of ClicksCount.
-func (s *DailyStats) GetClicksCount() int {
+func (s *CampaignStats) GetClicksCount() int {
 	return s.ClicksCount
 }
 
 // GetConversion returns the value of Conversion.
-func (s *DailyStats) GetConversion() float64 {
+func (s *CampaignStats) GetConversion() float64 {
 	return s.Conversion
 }
 
 // GetSpentImpressions returns the value of SpentImpressions.
-func (s *DailyStats) GetSpentImpressions() float64 {
+func (s *CampaignStats) GetSpentImpressions() float64 {
 	return s.SpentImpressions
 }
 
 // GetSpentClicks returns the value of SpentClicks.
-func (s *DailyStats) GetSpentClicks() float64 {
+func (s *CampaignStats) GetSpentClicks() float64 {
 	return s.SpentClicks
 }
 
 // GetSpentTotal returns the value of SpentTotal.
-func (s *DailyStats) GetSpentTotal() float64 {
+func (s *CampaignStats) GetSpentTotal() float64 {
 	return s.SpentTotal
 }
 
-// GetDate returns the value of Date.
-func (s *DailyStats) GetDate() Date {
-	return s.Date
+// GetSpentFirstPrice returns the value of SpentFirstPrice.
+func (s *CampaignStats) GetSpentFirstPrice() float64 {
+	return s.SpentFirstPrice
+}
+
+// GetSpentSecondPrice returns the value of SpentSecondPrice.
+func (s *CampaignStats) GetSpentSecondPrice() float64 {
+	return s.SpentSecondPrice
+}
+
+// GetClicksLimitReached returns the value of ClicksLimitReached.
+func (s *CampaignStats) GetClicksLimitReached() bool {
+	return s.ClicksLimitReached
 }
 
 // SetImpressionsCount sets the value of ImpressionsCount.
-func (s *DailyStats) SetImpressionsCount(val int) {
+func (s *CampaignStats) SetImpressionsCount(val int) {
 	s.ImpressionsCount = val
 }
 
 // SetClicksCount sets the value of ClicksCount.
-func (s *DailyStats) SetClicksCount(val int) {
+func (s *CampaignStats) SetClicksCount(val int) {
 	s.ClicksCount = val
 }
 
 // SetConversion sets the value of Conversion.
-func (s *DailyStats) SetConversion(val float64) {
+func (s *CampaignStats) SetConversion(val float64) {
 	s.Conversion = val
 }
 
 // SetSpentImpressions sets the value of SpentImpressions.
-func (s *DailyStats) SetSpentImpressions(val float64) {
+func (s *CampaignStats) SetSpentImpressions(val float64) {
 	s.SpentImpressions = val
 }
 
 // SetSpentClicks sets the value of SpentClicks.
-func (s *DailyStats) SetSpentClicks(val float64) {
+func (s *CampaignStats) SetSpentClicks(val float64) {
 	s.SpentClicks = val
 }
 
 // SetSpentTotal sets the value of SpentTotal.
-func (s *DailyStats) SetSpentTotal(val float64) {
+func (s *CampaignStats) SetSpentTotal(val float64) {
 	s.SpentTotal = val
 }
 
-// SetDate sets the value of Date.
-func (s *DailyStats) SetDate(val Date) {
-	s.Date = val
+// SetSpentFirstPrice sets the value of SpentFirstPrice.
+func (s *CampaignStats) SetSpentFirstPrice(val float64) {
+	s.SpentFirstPrice = val
 }
 
-type Date int32
+// SetSpentSecondPrice sets the value of SpentSecondPrice.
+func (s *CampaignStats) SetSpentSecondPrice(val float64) {
+	s.SpentSecondPrice = val
+}
 
-// DeleteCampaignNoContent is response for DeleteCampaign operation.
-type DeleteCampaignNoContent struct{}
+// SetClicksLimitReached sets the value of ClicksLimitReached.
+func (s *CampaignStats) SetClicksLimitReached(val bool) {
+	s.ClicksLimitReached = val
+}
 
-func (*DeleteCampaignNoContent) deleteCampaignRes() {}
+func (*CampaignStats) getCampaignStatsRes() {}
 
-type GenerateAdTextOK struct {
-	// Сгенерированный текст рекламного объявления.
-	AdText string `json:"ad_text"`
+// Статус рекламной кампании. Клиентам показываются
+// только кампании в статусе ACTIVE.
+// DRAFT - черновик, ACTIVE - кампания показывается в дни
+// проведения, PAUSED - показы приостановлены,
+// COMPLETED - кампания завершилась (закончился последний
+// день или достигнут лимит показов или переходов),
+// ARCHIVED - кампания в архиве, её статистика сохраняется.
+// Ref: #/components/schemas/CampaignStatus
+type CampaignStatus string
+
+const (
+	CampaignStatusDRAFT     CampaignStatus = "DRAFT"
+	CampaignStatusACTIVE    CampaignStatus = "ACTIVE"
+	CampaignStatusPAUSED    CampaignStatus = "PAUSED"
+	CampaignStatusCOMPLETED CampaignStatus = "COMPLETED"
+	CampaignStatusARCHIVED  CampaignStatus = "ARCHIVED"
+)
+
+// AllValues returns all CampaignStatus values.
+func (CampaignStatus) AllValues() []CampaignStatus {
+	return []CampaignStatus{
+		CampaignStatusDRAFT,
+		CampaignStatusACTIVE,
+		CampaignStatusPAUSED,
+		CampaignStatusCOMPLETED,
+		CampaignStatusARCHIVED,
+	}
 }
 
-// GetAdText returns the value of AdText.
-func (s *GenerateAdTextOK) GetAdText() string {
+// MarshalText implements encoding.TextMarshaler.
+func (s CampaignStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case CampaignStatusDRAFT:
+		return []byte(s), nil
+	case CampaignStatusACTIVE:
+		return []byte(s), nil
+	case CampaignStatusPAUSED:
+		return []byte(s), nil
+	case CampaignStatusCOMPLETED:
+		return []byte(s), nil
+	case CampaignStatusARCHIVED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CampaignStatus) UnmarshalText(data []byte) error {
+	switch CampaignStatus(data) {
+	case CampaignStatusDRAFT:
+		*s = CampaignStatusDRAFT
+		return nil
+	case CampaignStatusACTIVE:
+		*s = CampaignStatusACTIVE
+		return nil
+	case CampaignStatusPAUSED:
+		*s = CampaignStatusPAUSED
+		return nil
+	case CampaignStatusCOMPLETED:
+		*s = CampaignStatusCOMPLETED
+		return nil
+	case CampaignStatusARCHIVED:
+		*s = CampaignStatusARCHIVED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Именованный шаблон рекламной кампании.
+// Ref: #/components/schemas/CampaignTemplate
+type CampaignTemplate struct {
+	// Уникальный идентификатор шаблона (UUID).
+	TemplateID uuid.UUID `json:"template_id"`
+	// UUID рекламодателя, которому принадлежит шаблон.
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	// Название шаблона.
+	Name string `json:"name"`
+	// Время создания шаблона.
+	CreatedAt time.Time      `json:"created_at"`
+	Campaign  CampaignUpdate `json:"campaign"`
+}
+
+// GetTemplateID returns the value of TemplateID.
+func (s *CampaignTemplate) GetTemplateID() uuid.UUID {
+	return s.TemplateID
+}
+
+// GetAdvertiserID returns the value of AdvertiserID.
+func (s *CampaignTemplate) GetAdvertiserID() uuid.UUID {
+	return s.AdvertiserID
+}
+
+// GetName returns the value of Name.
+func (s *CampaignTemplate) GetName() string {
+	return s.Name
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CampaignTemplate) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetCampaign returns the value of Campaign.
+func (s *CampaignTemplate) GetCampaign() CampaignUpdate {
+	return s.Campaign
+}
+
+// SetTemplateID sets the value of TemplateID.
+func (s *CampaignTemplate) SetTemplateID(val uuid.UUID) {
+	s.TemplateID = val
+}
+
+// SetAdvertiserID sets the value of AdvertiserID.
+func (s *CampaignTemplate) SetAdvertiserID(val uuid.UUID) {
+	s.AdvertiserID = val
+}
+
+// SetName sets the value of Name.
+func (s *CampaignTemplate) SetName(val string) {
+	s.Name = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CampaignTemplate) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetCampaign sets the value of Campaign.
+func (s *CampaignTemplate) SetCampaign(val CampaignUpdate) {
+	s.Campaign = val
+}
+
+func (*CampaignTemplate) createCampaignTemplateRes() {}
+func (*CampaignTemplate) getCampaignTemplateRes()    {}
+
+// Объект для создания шаблона рекламной кампании.
+// Ref: #/components/schemas/CampaignTemplateCreate
+type CampaignTemplateCreate struct {
+	// Название шаблона, уникальное для рекламодателя.
+	Name     string         `json:"name"`
+	Campaign CampaignUpdate `json:"campaign"`
+}
+
+// GetName returns the value of Name.
+func (s *CampaignTemplateCreate) GetName() string {
+	return s.Name
+}
+
+// GetCampaign returns the value of Campaign.
+func (s *CampaignTemplateCreate) GetCampaign() CampaignUpdate {
+	return s.Campaign
+}
+
+// SetName sets the value of Name.
+func (s *CampaignTemplateCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetCampaign sets the value of Campaign.
+func (s *CampaignTemplateCreate) SetCampaign(val CampaignUpdate) {
+	s.Campaign = val
+}
+
+// Объект для обновления параметров кампании, которые
+// разрешено изменять до старта кампании.
+// Ref: #/components/schemas/CampaignUpdate
+type CampaignUpdate struct {
+	// Задаёт лимит показов для рекламного объявления.
+	ImpressionsLimit int `json:"impressions_limit"`
+	// Задаёт лимит переходов для рекламного объявления.
+	ClicksLimit int `json:"clicks_limit"`
+	// Новая стоимость одного показа объявления.
+	CostPerImpression float32 `json:"cost_per_impression"`
+	// Новая стоимость одного перехода (клика) по объявлению.
+	CostPerClick float32 `json:"cost_per_click"`
+	// Новое название рекламного объявления.
+	AdTitle string `json:"ad_title"`
+	// Новый текст рекламного объявления.
+	AdText string `json:"ad_text"`
+	// День начала показа рекламного объявления
+	// (включительно).
+	StartDate Date `json:"start_date"`
+	// День окончания показа рекламного объявления
+	// (включительно).
+	EndDate Date `json:"end_date"`
+	// Новые параметры таргетирования для рекламной
+	// кампании.
+	Targeting OptTargeting `json:"targeting"`
+	// Новое ограничение частоты показов.
+	FrequencyCap OptFrequencyCap `json:"frequency_cap"`
+	Pacing       OptPacing       `json:"pacing"`
+}
+
+// GetImpressionsLimit returns the value of ImpressionsLimit.
+func (s *CampaignUpdate) GetImpressionsLimit() int {
+	return s.ImpressionsLimit
+}
+
+// GetClicksLimit returns the value of ClicksLimit.
+func (s *CampaignUpdate) GetClicksLimit() int {
+	return s.ClicksLimit
+}
+
+// GetCostPerImpression returns the value of CostPerImpression.
+func (s *CampaignUpdate) GetCostPerImpression() float32 {
+	return s.CostPerImpression
+}
+
+// GetCostPerClick returns the value of CostPerClick.
+func (s *CampaignUpdate) GetCostPerClick() float32 {
+	return s.CostPerClick
+}
+
+// GetAdTitle returns the value of AdTitle.
+func (s *CampaignUpdate) GetAdTitle() string {
+	return s.AdTitle
+}
+
+// GetAdText returns the value of AdText.
+func (s *CampaignUpdate) GetAdText() string {
 	return s.AdText
 }
 
-// SetAdText sets the value of AdText.
-func (s *GenerateAdTextOK) SetAdText(val string) {
-	s.AdText = val
+// GetStartDate returns the value of StartDate.
+func (s *CampaignUpdate) GetStartDate() Date {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *CampaignUpdate) GetEndDate() Date {
+	return s.EndDate
+}
+
+// GetTargeting returns the value of Targeting.
+func (s *CampaignUpdate) GetTargeting() OptTargeting {
+	return s.Targeting
+}
+
+// GetFrequencyCap returns the value of FrequencyCap.
+func (s *CampaignUpdate) GetFrequencyCap() OptFrequencyCap {
+	return s.FrequencyCap
+}
+
+// GetPacing returns the value of Pacing.
+func (s *CampaignUpdate) GetPacing() OptPacing {
+	return s.Pacing
+}
+
+// SetImpressionsLimit sets the value of ImpressionsLimit.
+func (s *CampaignUpdate) SetImpressionsLimit(val int) {
+	s.ImpressionsLimit = val
+}
+
+// SetClicksLimit sets the value of ClicksLimit.
+func (s *CampaignUpdate) SetClicksLimit(val int) {
+	s.ClicksLimit = val
+}
+
+// SetCostPerImpression sets the value of CostPerImpression.
+func (s *CampaignUpdate) SetCostPerImpression(val float32) {
+	s.CostPerImpression = val
+}
+
+// SetCostPerClick sets the value of CostPerClick.
+func (s *CampaignUpdate) SetCostPerClick(val float32) {
+	s.CostPerClick = val
+}
+
+// SetAdTitle sets the value of AdTitle.
+func (s *CampaignUpdate) SetAdTitle(val string) {
+	s.AdTitle = val
+}
+
+// SetAdText sets the value of AdText.
+func (s *CampaignUpdate) SetAdText(val string) {
+	s.AdText = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *CampaignUpdate) SetStartDate(val Date) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *CampaignUpdate) SetEndDate(val Date) {
+	s.EndDate = val
+}
+
+// SetTargeting sets the value of Targeting.
+func (s *CampaignUpdate) SetTargeting(val OptTargeting) {
+	s.Targeting = val
+}
+
+// SetFrequencyCap sets the value of FrequencyCap.
+func (s *CampaignUpdate) SetFrequencyCap(val OptFrequencyCap) {
+	s.FrequencyCap = val
+}
+
+// SetPacing sets the value of Pacing.
+func (s *CampaignUpdate) SetPacing(val OptPacing) {
+	s.Pacing = val
+}
+
+// Версия рекламной кампании.
+// Ref: #/components/schemas/CampaignVersion
+type CampaignVersion struct {
+	// Номер версии, версии кампании нумеруются с 1.
+	Version int            `json:"version"`
+	Action  CampaignAction `json:"action"`
+	// Текущий день, в который сохранена версия.
+	Day int `json:"day"`
+	// Время сохранения версии.
+	CreatedAt time.Time `json:"created_at"`
+	Campaign  Campaign  `json:"campaign"`
+	// Поля, изменённые по сравнению с предыдущей версией.
+	Changes []CampaignChange `json:"changes"`
+}
+
+// GetVersion returns the value of Version.
+func (s *CampaignVersion) GetVersion() int {
+	return s.Version
+}
+
+// GetAction returns the value of Action.
+func (s *CampaignVersion) GetAction() CampaignAction {
+	return s.Action
+}
+
+// GetDay returns the value of Day.
+func (s *CampaignVersion) GetDay() int {
+	return s.Day
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CampaignVersion) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetCampaign returns the value of Campaign.
+func (s *CampaignVersion) GetCampaign() Campaign {
+	return s.Campaign
+}
+
+// GetChanges returns the value of Changes.
+func (s *CampaignVersion) GetChanges() []CampaignChange {
+	return s.Changes
+}
+
+// SetVersion sets the value of Version.
+func (s *CampaignVersion) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetAction sets the value of Action.
+func (s *CampaignVersion) SetAction(val CampaignAction) {
+	s.Action = val
+}
+
+// SetDay sets the value of Day.
+func (s *CampaignVersion) SetDay(val int) {
+	s.Day = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CampaignVersion) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetCampaign sets the value of Campaign.
+func (s *CampaignVersion) SetCampaign(val Campaign) {
+	s.Campaign = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *CampaignVersion) SetChanges(val []CampaignChange) {
+	s.Changes = val
+}
+
+// Результат импорта рекламных кампаний.
+// Ref: #/components/schemas/CampaignsImportReport
+type CampaignsImportReport struct {
+	// Количество созданных кампаний.
+	Created int `json:"created"`
+	// Количество строк с ошибками.
+	Failed int `json:"failed"`
+	// Результат по каждой строке файла.
+	Rows []CampaignImportRow `json:"rows"`
+}
+
+// GetCreated returns the value of Created.
+func (s *CampaignsImportReport) GetCreated() int {
+	return s.Created
+}
+
+// GetFailed returns the value of Failed.
+func (s *CampaignsImportReport) GetFailed() int {
+	return s.Failed
+}
+
+// GetRows returns the value of Rows.
+func (s *CampaignsImportReport) GetRows() []CampaignImportRow {
+	return s.Rows
+}
+
+// SetCreated sets the value of Created.
+func (s *CampaignsImportReport) SetCreated(val int) {
+	s.Created = val
+}
+
+// SetFailed sets the value of Failed.
+func (s *CampaignsImportReport) SetFailed(val int) {
+	s.Failed = val
+}
+
+// SetRows sets the value of Rows.
+func (s *CampaignsImportReport) SetRows(val []CampaignImportRow) {
+	s.Rows = val
+}
+
+func (*CampaignsImportReport) importCampaignsRes() {}
+
+// Произвольные атрибуты клиента (интересы, устройство,
+// уровень и т.д.). Значение атрибута - строка, число,
+// логическое значение или массив из них.
+// Ref: #/components/schemas/ClientAttributes
+type ClientAttributes map[string]jx.Raw
+
+func (s *ClientAttributes) init() ClientAttributes {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Переход клиента по рекламному объявлению.
+// Ref: #/components/schemas/ClientClick
+type ClientClick struct {
+	// UUID показа, по которому совершён переход.
+	ImpressionID uuid.UUID `json:"impression_id"`
+	// UUID рекламной кампании.
+	CampaignID uuid.UUID `json:"campaign_id"`
+	// День перехода.
+	Date int `json:"date"`
+	// Стоимость перехода для рекламодателя.
+	Price float64 `json:"price"`
+}
+
+// GetImpressionID returns the value of ImpressionID.
+func (s *ClientClick) GetImpressionID() uuid.UUID {
+	return s.ImpressionID
+}
+
+// GetCampaignID returns the value of CampaignID.
+func (s *ClientClick) GetCampaignID() uuid.UUID {
+	return s.CampaignID
+}
+
+// GetDate returns the value of Date.
+func (s *ClientClick) GetDate() int {
+	return s.Date
+}
+
+// GetPrice returns the value of Price.
+func (s *ClientClick) GetPrice() float64 {
+	return s.Price
+}
+
+// SetImpressionID sets the value of ImpressionID.
+func (s *ClientClick) SetImpressionID(val uuid.UUID) {
+	s.ImpressionID = val
+}
+
+// SetCampaignID sets the value of CampaignID.
+func (s *ClientClick) SetCampaignID(val uuid.UUID) {
+	s.CampaignID = val
+}
+
+// SetDate sets the value of Date.
+func (s *ClientClick) SetDate(val int) {
+	s.Date = val
+}
+
+// SetPrice sets the value of Price.
+func (s *ClientClick) SetPrice(val float64) {
+	s.Price = val
+}
+
+// Все хранящиеся данные о клиенте.
+// Ref: #/components/schemas/ClientDataExport
+type ClientDataExport struct {
+	Client ClientModel `json:"client"`
+	// ML скоры клиента для рекламодателей.
+	MlScores []MLScore `json:"ml_scores"`
+	// Показы рекламных объявлений клиенту, по возрастанию
+	// дня.
+	Impressions []ClientImpression `json:"impressions"`
+	// Переходы клиента по рекламным объявлениям, по
+	// возрастанию дня.
+	Clicks []ClientClick `json:"clicks"`
+}
+
+// GetClient returns the value of Client.
+func (s *ClientDataExport) GetClient() ClientModel {
+	return s.Client
+}
+
+// GetMlScores returns the value of MlScores.
+func (s *ClientDataExport) GetMlScores() []MLScore {
+	return s.MlScores
+}
+
+// GetImpressions returns the value of Impressions.
+func (s *ClientDataExport) GetImpressions() []ClientImpression {
+	return s.Impressions
+}
+
+// GetClicks returns the value of Clicks.
+func (s *ClientDataExport) GetClicks() []ClientClick {
+	return s.Clicks
+}
+
+// SetClient sets the value of Client.
+func (s *ClientDataExport) SetClient(val ClientModel) {
+	s.Client = val
+}
+
+// SetMlScores sets the value of MlScores.
+func (s *ClientDataExport) SetMlScores(val []MLScore) {
+	s.MlScores = val
+}
+
+// SetImpressions sets the value of Impressions.
+func (s *ClientDataExport) SetImpressions(val []ClientImpression) {
+	s.Impressions = val
+}
+
+// SetClicks sets the value of Clicks.
+func (s *ClientDataExport) SetClicks(val []ClientClick) {
+	s.Clicks = val
+}
+
+func (*ClientDataExport) exportClientDataRes() {}
+
+// Показ рекламного объявления клиенту.
+// Ref: #/components/schemas/ClientImpression
+type ClientImpression struct {
+	// UUID показа.
+	ImpressionID uuid.UUID `json:"impression_id"`
+	// UUID рекламной кампании.
+	CampaignID uuid.UUID `json:"campaign_id"`
+	// День показа.
+	Date int `json:"date"`
+	// Стоимость показа для рекламодателя.
+	Price float64 `json:"price"`
+	// Стоимость перехода по этому показу для рекламодателя.
+	ClickPrice float64 `json:"click_price"`
+	// Кампания была показана для исследования, а не как
+	// лучшая по ранжированию.
+	Explored bool `json:"explored"`
+}
+
+// GetImpressionID returns the value of ImpressionID.
+func (s *ClientImpression) GetImpressionID() uuid.UUID {
+	return s.ImpressionID
+}
+
+// GetCampaignID returns the value of CampaignID.
+func (s *ClientImpression) GetCampaignID() uuid.UUID {
+	return s.CampaignID
+}
+
+// GetDate returns the value of Date.
+func (s *ClientImpression) GetDate() int {
+	return s.Date
+}
+
+// GetPrice returns the value of Price.
+func (s *ClientImpression) GetPrice() float64 {
+	return s.Price
+}
+
+// GetClickPrice returns the value of ClickPrice.
+func (s *ClientImpression) GetClickPrice() float64 {
+	return s.ClickPrice
+}
+
+// GetExplored returns the value of Explored.
+func (s *ClientImpression) GetExplored() bool {
+	return s.Explored
+}
+
+// SetImpressionID sets the value of ImpressionID.
+func (s *ClientImpression) SetImpressionID(val uuid.UUID) {
+	s.ImpressionID = val
+}
+
+// SetCampaignID sets the value of CampaignID.
+func (s *ClientImpression) SetCampaignID(val uuid.UUID) {
+	s.CampaignID = val
+}
+
+// SetDate sets the value of Date.
+func (s *ClientImpression) SetDate(val int) {
+	s.Date = val
+}
+
+// SetPrice sets the value of Price.
+func (s *ClientImpression) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetClickPrice sets the value of ClickPrice.
+func (s *ClientImpression) SetClickPrice(val float64) {
+	s.ClickPrice = val
+}
+
+// SetExplored sets the value of Explored.
+func (s *ClientImpression) SetExplored(val bool) {
+	s.Explored = val
+}
+
+// Объект, представляющий клиента системы.
+// Ref: #/components/schemas/Client
+type ClientModel struct {
+	// Уникальный идентификатор клиента (UUID).
+	ClientID uuid.UUID `json:"client_id"`
+	// Логин клиента в системе.
+	Login string `json:"login"`
+	// Возраст клиента.
+	Age int `json:"age"`
+	// Локация клиента (город, регион или район).
+	Location string `json:"location"`
+	// Пол клиента (MALE или FEMALE).
+	Gender     ClientModelGender   `json:"gender"`
+	Attributes OptClientAttributes `json:"attributes"`
+}
+
+// GetClientID returns the value of ClientID.
+func (s *ClientModel) GetClientID() uuid.UUID {
+	return s.ClientID
+}
+
+// GetLogin returns the value of Login.
+func (s *ClientModel) GetLogin() string {
+	return s.Login
+}
+
+// GetAge returns the value of Age.
+func (s *ClientModel) GetAge() int {
+	return s.Age
+}
+
+// GetLocation returns the value of Location.
+func (s *ClientModel) GetLocation() string {
+	return s.Location
+}
+
+// GetGender returns the value of Gender.
+func (s *ClientModel) GetGender() ClientModelGender {
+	return s.Gender
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *ClientModel) GetAttributes() OptClientAttributes {
+	return s.Attributes
+}
+
+// SetClientID sets the value of ClientID.
+func (s *ClientModel) SetClientID(val uuid.UUID) {
+	s.ClientID = val
+}
+
+// SetLogin sets the value of Login.
+func (s *ClientModel) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetAge sets the value of Age.
+func (s *ClientModel) SetAge(val int) {
+	s.Age = val
+}
+
+// SetLocation sets the value of Location.
+func (s *ClientModel) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetGender sets the value of Gender.
+func (s *ClientModel) SetGender(val ClientModelGender) {
+	s.Gender = val
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *ClientModel) SetAttributes(val OptClientAttributes) {
+	s.Attributes = val
+}
+
+func (*ClientModel) getClientByIdRes() {}
+
+// Пол клиента (MALE или FEMALE).
+type ClientModelGender string
+
+const (
+	ClientModelGenderMALE   ClientModelGender = "MALE"
+	ClientModelGenderFEMALE ClientModelGender = "FEMALE"
+)
+
+// AllValues returns all ClientModelGender values.
+func (ClientModelGender) AllValues() []ClientModelGender {
+	return []ClientModelGender{
+		ClientModelGenderMALE,
+		ClientModelGenderFEMALE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ClientModelGender) MarshalText() ([]byte, error) {
+	switch s {
+	case ClientModelGenderMALE:
+		return []byte(s), nil
+	case ClientModelGenderFEMALE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ClientModelGender) UnmarshalText(data []byte) error {
+	switch ClientModelGender(data) {
+	case ClientModelGenderMALE:
+		*s = ClientModelGenderMALE
+		return nil
+	case ClientModelGenderFEMALE:
+		*s = ClientModelGenderFEMALE
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ClientUpsert
+type ClientUpsert struct {
+	ClientID   uuid.UUID           `json:"client_id"`
+	Login      string              `json:"login"`
+	Age        int                 `json:"age"`
+	Location   string              `json:"location"`
+	Gender     ClientUpsertGender  `json:"gender"`
+	Attributes OptClientAttributes `json:"attributes"`
+}
+
+// GetClientID returns the value of ClientID.
+func (s *ClientUpsert) GetClientID() uuid.UUID {
+	return s.ClientID
+}
+
+// GetLogin returns the value of Login.
+func (s *ClientUpsert) GetLogin() string {
+	return s.Login
+}
+
+// GetAge returns the value of Age.
+func (s *ClientUpsert) GetAge() int {
+	return s.Age
+}
+
+// GetLocation returns the value of Location.
+func (s *ClientUpsert) GetLocation() string {
+	return s.Location
+}
+
+// GetGender returns the value of Gender.
+func (s *ClientUpsert) GetGender() ClientUpsertGender {
+	return s.Gender
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *ClientUpsert) GetAttributes() OptClientAttributes {
+	return s.Attributes
+}
+
+// SetClientID sets the value of ClientID.
+func (s *ClientUpsert) SetClientID(val uuid.UUID) {
+	s.ClientID = val
+}
+
+// SetLogin sets the value of Login.
+func (s *ClientUpsert) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetAge sets the value of Age.
+func (s *ClientUpsert) SetAge(val int) {
+	s.Age = val
+}
+
+// SetLocation sets the value of Location.
+func (s *ClientUpsert) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetGender sets the value of Gender.
+func (s *ClientUpsert) SetGender(val ClientUpsertGender) {
+	s.Gender = val
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *ClientUpsert) SetAttributes(val OptClientAttributes) {
+	s.Attributes = val
+}
+
+type ClientUpsertGender string
+
+const (
+	ClientUpsertGenderMALE   ClientUpsertGender = "MALE"
+	ClientUpsertGenderFEMALE ClientUpsertGender = "FEMALE"
+)
+
+// AllValues returns all ClientUpsertGender values.
+func (ClientUpsertGender) AllValues() []ClientUpsertGender {
+	return []ClientUpsertGender{
+		ClientUpsertGenderMALE,
+		ClientUpsertGenderFEMALE,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ClientUpsertGender) MarshalText() ([]byte, error) {
+	switch s {
+	case ClientUpsertGenderMALE:
+		return []byte(s), nil
+	case ClientUpsertGenderFEMALE:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ClientUpsertGender) UnmarshalText(data []byte) error {
+	switch ClientUpsertGender(data) {
+	case ClientUpsertGenderMALE:
+		*s = ClientUpsertGenderMALE
+		return nil
+	case ClientUpsertGenderFEMALE:
+		*s = ClientUpsertGenderFEMALE
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreateCampaignTemplateConflict struct {
+	// Описание ошибки.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *CreateCampaignTemplateConflict) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *CreateCampaignTemplateConflict) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*CreateCampaignTemplateConflict) createCampaignTemplateRes() {}
+
+// Merged schema.
+// Ref: #/components/schemas/DailyStats
+type DailyStats struct {
+	// Общее количество уникальных показов рекламного
+	// объявления.
+	ImpressionsCount int `json:"impressions_count"`
+	// Общее количество уникальных переходов (кликов) по
+	// рекламному объявлению.
+	ClicksCount int `json:"clicks_count"`
+	// Коэффициент конверсии, вычисляемый как (clicks_count /
+	// impressions_count * 100) в процентах.
+	Conversion float64 `json:"conversion"`
+	// Сумма денег, потраченная на показы рекламного
+	// объявления.
+	SpentImpressions float64 `json:"spent_impressions"`
+	// Сумма денег, потраченная на переходы (клики) по
+	// рекламному объявлению.
+	SpentClicks float64 `json:"spent_clicks"`
+	// Общая сумма денег, потраченная на кампанию (показы и
+	// клики).
+	SpentTotal float64 `json:"spent_total"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом первой цены.
+	SpentFirstPrice float64 `json:"spent_first_price"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом второй цены.
+	SpentSecondPrice float64 `json:"spent_second_price"`
+	// День, за который была собрана статистика.
+	Date Date `json:"date"`
+}
+
+// GetImpressionsCount returns the value of ImpressionsCount.
+func (s *DailyStats) GetImpressionsCount() int {
+	return s.ImpressionsCount
+}
+
+// GetClicksCount returns the value of ClicksCount.
+func (s *DailyStats) GetClicksCount() int {
+	return s.ClicksCount
+}
+
+// GetConversion returns the value of Conversion.
+func (s *DailyStats) GetConversion() float64 {
+	return s.Conversion
+}
+
+// GetSpentImpressions returns the value of SpentImpressions.
+func (s *DailyStats) GetSpentImpressions() float64 {
+	return s.SpentImpressions
+}
+
+// GetSpentClicks returns the value of SpentClicks.
+func (s *DailyStats) GetSpentClicks() float64 {
+	return s.SpentClicks
+}
+
+// GetSpentTotal returns the value of SpentTotal.
+func (s *DailyStats) GetSpentTotal() float64 {
+	return s.SpentTotal
+}
+
+// GetSpentFirstPrice returns the value of SpentFirstPrice.
+func (s *DailyStats) GetSpentFirstPrice() float64 {
+	return s.SpentFirstPrice
+}
+
+// GetSpentSecondPrice returns the value of SpentSecondPrice.
+func (s *DailyStats) GetSpentSecondPrice() float64 {
+	return s.SpentSecondPrice
+}
+
+// GetDate returns the value of Date.
+func (s *DailyStats) GetDate() Date {
+	return s.Date
+}
+
+// SetImpressionsCount sets the value of ImpressionsCount.
+func (s *DailyStats) SetImpressionsCount(val int) {
+	s.ImpressionsCount = val
+}
+
+// SetClicksCount sets the value of ClicksCount.
+func (s *DailyStats) SetClicksCount(val int) {
+	s.ClicksCount = val
+}
+
+// SetConversion sets the value of Conversion.
+func (s *DailyStats) SetConversion(val float64) {
+	s.Conversion = val
+}
+
+// SetSpentImpressions sets the value of SpentImpressions.
+func (s *DailyStats) SetSpentImpressions(val float64) {
+	s.SpentImpressions = val
+}
+
+// SetSpentClicks sets the value of SpentClicks.
+func (s *DailyStats) SetSpentClicks(val float64) {
+	s.SpentClicks = val
+}
+
+// SetSpentTotal sets the value of SpentTotal.
+func (s *DailyStats) SetSpentTotal(val float64) {
+	s.SpentTotal = val
+}
+
+// SetSpentFirstPrice sets the value of SpentFirstPrice.
+func (s *DailyStats) SetSpentFirstPrice(val float64) {
+	s.SpentFirstPrice = val
+}
+
+// SetSpentSecondPrice sets the value of SpentSecondPrice.
+func (s *DailyStats) SetSpentSecondPrice(val float64) {
+	s.SpentSecondPrice = val
+}
+
+// SetDate sets the value of Date.
+func (s *DailyStats) SetDate(val Date) {
+	s.Date = val
+}
+
+type Date int32
+
+type DeleteAdvertiserConflict struct {
+	// Описание ошибки.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *DeleteAdvertiserConflict) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *DeleteAdvertiserConflict) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*DeleteAdvertiserConflict) deleteAdvertiserRes() {}
+
+// DeleteAdvertiserNoContent is response for DeleteAdvertiser operation.
+type DeleteAdvertiserNoContent struct{}
+
+func (*DeleteAdvertiserNoContent) deleteAdvertiserRes() {}
+
+// DeleteCampaignNoContent is response for DeleteCampaign operation.
+type DeleteCampaignNoContent struct{}
+
+func (*DeleteCampaignNoContent) deleteCampaignRes() {}
+
+// DeleteCampaignTemplateNoContent is response for DeleteCampaignTemplate operation.
+type DeleteCampaignTemplateNoContent struct{}
+
+func (*DeleteCampaignTemplateNoContent) deleteCampaignTemplateRes() {}
+
+// EraseClientNoContent is response for EraseClient operation.
+type EraseClientNoContent struct{}
+
+func (*EraseClientNoContent) eraseClientRes() {}
+
+// Причина исключения кампании: CAMPAIGN_NOT_ACTIVE - кампания не
+// в статусе ACTIVE, NOT_MODERATED - объявление не одобрено
+// модерацией, DATE_WINDOW - текущий день вне дат кампании,
+// TARGETING_GENDER, TARGETING_LOCATION, TARGETING_AGE - клиент не подходит под
+// таргетинг, TARGETING_RULES - атрибуты клиента не подходят под
+// правила таргетирования, ALREADY_IMPRESSED - достигнуто
+// ограничение частоты показов клиенту, IMPRESSIONS_LIMIT_REACHED -
+// достигнут лимит показов, DAILY_TARGET_REACHED - кампания
+// получила цель по показам на текущий день, CLICKS_LIMIT_REACHED -
+// достигнут лимит переходов, ADVERTISER_BUDGET_REACHED -
+// рекламодатель исчерпал дневной или общий бюджет.
+// Ref: #/components/schemas/ExclusionReason
+type ExclusionReason string
+
+const (
+	ExclusionReasonCAMPAIGNNOTACTIVE       ExclusionReason = "CAMPAIGN_NOT_ACTIVE"
+	ExclusionReasonNOTMODERATED            ExclusionReason = "NOT_MODERATED"
+	ExclusionReasonDATEWINDOW              ExclusionReason = "DATE_WINDOW"
+	ExclusionReasonTARGETINGGENDER         ExclusionReason = "TARGETING_GENDER"
+	ExclusionReasonTARGETINGLOCATION       ExclusionReason = "TARGETING_LOCATION"
+	ExclusionReasonTARGETINGAGE            ExclusionReason = "TARGETING_AGE"
+	ExclusionReasonTARGETINGRULES          ExclusionReason = "TARGETING_RULES"
+	ExclusionReasonALREADYIMPRESSED        ExclusionReason = "ALREADY_IMPRESSED"
+	ExclusionReasonIMPRESSIONSLIMITREACHED ExclusionReason = "IMPRESSIONS_LIMIT_REACHED"
+	ExclusionReasonDAILYTARGETREACHED      ExclusionReason = "DAILY_TARGET_REACHED"
+	ExclusionReasonCLICKSLIMITREACHED      ExclusionReason = "CLICKS_LIMIT_REACHED"
+	ExclusionReasonADVERTISERBUDGETREACHED ExclusionReason = "ADVERTISER_BUDGET_REACHED"
+)
+
+// AllValues returns all ExclusionReason values.
+func (ExclusionReason) AllValues() []ExclusionReason {
+	return []ExclusionReason{
+		ExclusionReasonCAMPAIGNNOTACTIVE,
+		ExclusionReasonNOTMODERATED,
+		ExclusionReasonDATEWINDOW,
+		ExclusionReasonTARGETINGGENDER,
+		ExclusionReasonTARGETINGLOCATION,
+		ExclusionReasonTARGETINGAGE,
+		ExclusionReasonTARGETINGRULES,
+		ExclusionReasonALREADYIMPRESSED,
+		ExclusionReasonIMPRESSIONSLIMITREACHED,
+		ExclusionReasonDAILYTARGETREACHED,
+		ExclusionReasonCLICKSLIMITREACHED,
+		ExclusionReasonADVERTISERBUDGETREACHED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExclusionReason) MarshalText() ([]byte, error) {
+	switch s {
+	case ExclusionReasonCAMPAIGNNOTACTIVE:
+		return []byte(s), nil
+	case ExclusionReasonNOTMODERATED:
+		return []byte(s), nil
+	case ExclusionReasonDATEWINDOW:
+		return []byte(s), nil
+	case ExclusionReasonTARGETINGGENDER:
+		return []byte(s), nil
+	case ExclusionReasonTARGETINGLOCATION:
+		return []byte(s), nil
+	case ExclusionReasonTARGETINGAGE:
+		return []byte(s), nil
+	case ExclusionReasonTARGETINGRULES:
+		return []byte(s), nil
+	case ExclusionReasonALREADYIMPRESSED:
+		return []byte(s), nil
+	case ExclusionReasonIMPRESSIONSLIMITREACHED:
+		return []byte(s), nil
+	case ExclusionReasonDAILYTARGETREACHED:
+		return []byte(s), nil
+	case ExclusionReasonCLICKSLIMITREACHED:
+		return []byte(s), nil
+	case ExclusionReasonADVERTISERBUDGETREACHED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExclusionReason) UnmarshalText(data []byte) error {
+	switch ExclusionReason(data) {
+	case ExclusionReasonCAMPAIGNNOTACTIVE:
+		*s = ExclusionReasonCAMPAIGNNOTACTIVE
+		return nil
+	case ExclusionReasonNOTMODERATED:
+		*s = ExclusionReasonNOTMODERATED
+		return nil
+	case ExclusionReasonDATEWINDOW:
+		*s = ExclusionReasonDATEWINDOW
+		return nil
+	case ExclusionReasonTARGETINGGENDER:
+		*s = ExclusionReasonTARGETINGGENDER
+		return nil
+	case ExclusionReasonTARGETINGLOCATION:
+		*s = ExclusionReasonTARGETINGLOCATION
+		return nil
+	case ExclusionReasonTARGETINGAGE:
+		*s = ExclusionReasonTARGETINGAGE
+		return nil
+	case ExclusionReasonTARGETINGRULES:
+		*s = ExclusionReasonTARGETINGRULES
+		return nil
+	case ExclusionReasonALREADYIMPRESSED:
+		*s = ExclusionReasonALREADYIMPRESSED
+		return nil
+	case ExclusionReasonIMPRESSIONSLIMITREACHED:
+		*s = ExclusionReasonIMPRESSIONSLIMITREACHED
+		return nil
+	case ExclusionReasonDAILYTARGETREACHED:
+		*s = ExclusionReasonDAILYTARGETREACHED
+		return nil
+	case ExclusionReasonCLICKSLIMITREACHED:
+		*s = ExclusionReasonCLICKSLIMITREACHED
+		return nil
+	case ExclusionReasonADVERTISERBUDGETREACHED:
+		*s = ExclusionReasonADVERTISERBUDGETREACHED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ExportCampaignsFormat string
+
+const (
+	ExportCampaignsFormatCsv    ExportCampaignsFormat = "csv"
+	ExportCampaignsFormatNdjson ExportCampaignsFormat = "ndjson"
+)
+
+// AllValues returns all ExportCampaignsFormat values.
+func (ExportCampaignsFormat) AllValues() []ExportCampaignsFormat {
+	return []ExportCampaignsFormat{
+		ExportCampaignsFormatCsv,
+		ExportCampaignsFormatNdjson,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExportCampaignsFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ExportCampaignsFormatCsv:
+		return []byte(s), nil
+	case ExportCampaignsFormatNdjson:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExportCampaignsFormat) UnmarshalText(data []byte) error {
+	switch ExportCampaignsFormat(data) {
+	case ExportCampaignsFormatCsv:
+		*s = ExportCampaignsFormatCsv
+		return nil
+	case ExportCampaignsFormatNdjson:
+		*s = ExportCampaignsFormatNdjson
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ExportCampaignsOKApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportCampaignsOKApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ExportCampaignsOKApplicationXNdjson) exportCampaignsRes() {}
+
+type ExportCampaignsOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ExportCampaignsOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ExportCampaignsOKTextCsv) exportCampaignsRes() {}
+
+// Ограничение частоты показов объявления одному
+// клиенту. Если не задано, клиент видит объявление
+// только один раз.
+// Ref: #/components/schemas/FrequencyCap
+type FrequencyCap struct {
+	// Максимальное количество показов объявления одному
+	// клиенту за период.
+	Impressions int `json:"impressions"`
+	// Длина периода в днях, включая текущий. Если не задан,
+	// ограничение действует за всё время кампании.
+	Days OptNilInt `json:"days"`
+}
+
+// GetImpressions returns the value of Impressions.
+func (s *FrequencyCap) GetImpressions() int {
+	return s.Impressions
+}
+
+// GetDays returns the value of Days.
+func (s *FrequencyCap) GetDays() OptNilInt {
+	return s.Days
+}
+
+// SetImpressions sets the value of Impressions.
+func (s *FrequencyCap) SetImpressions(val int) {
+	s.Impressions = val
+}
+
+// SetDays sets the value of Days.
+func (s *FrequencyCap) SetDays(val OptNilInt) {
+	s.Days = val
+}
+
+type GenerateAdTextOK struct {
+	// Сгенерированный текст рекламного объявления.
+	AdText string `json:"ad_text"`
+}
+
+// GetAdText returns the value of AdText.
+func (s *GenerateAdTextOK) GetAdText() string {
+	return s.AdText
+}
+
+// SetAdText sets the value of AdText.
+func (s *GenerateAdTextOK) SetAdText(val string) {
+	s.AdText = val
+}
+
+func (*GenerateAdTextOK) generateAdTextRes() {}
+
+type GenerateAdTextReq struct {
+	// Заголовок рекламного объявления.
+	AdTitle string `json:"ad_title"`
+}
+
+// GetAdTitle returns the value of AdTitle.
+func (s *GenerateAdTextReq) GetAdTitle() string {
+	return s.AdTitle
+}
+
+// SetAdTitle sets the value of AdTitle.
+func (s *GenerateAdTextReq) SetAdTitle(val string) {
+	s.AdTitle = val
+}
+
+// GetAdForClientOK represents sum type.
+type GetAdForClientOK struct {
+	Type    GetAdForClientOKType // switch on this field
+	Ad      Ad
+	AdArray []Ad
+}
+
+// GetAdForClientOKType is oneOf type of GetAdForClientOK.
+type GetAdForClientOKType string
+
+// Possible values for GetAdForClientOKType.
+const (
+	AdGetAdForClientOK      GetAdForClientOKType = "Ad"
+	AdArrayGetAdForClientOK GetAdForClientOKType = "[]Ad"
+)
+
+// IsAd reports whether GetAdForClientOK is Ad.
+func (s GetAdForClientOK) IsAd() bool { return s.Type == AdGetAdForClientOK }
+
+// IsAdArray reports whether GetAdForClientOK is []Ad.
+func (s GetAdForClientOK) IsAdArray() bool { return s.Type == AdArrayGetAdForClientOK }
+
+// SetAd sets GetAdForClientOK to Ad.
+func (s *GetAdForClientOK) SetAd(v Ad) {
+	s.Type = AdGetAdForClientOK
+	s.Ad = v
+}
+
+// GetAd returns Ad and true boolean if GetAdForClientOK is Ad.
+func (s GetAdForClientOK) GetAd() (v Ad, ok bool) {
+	if !s.IsAd() {
+		return v, false
+	}
+	return s.Ad, true
+}
+
+// NewAdGetAdForClientOK returns new GetAdForClientOK from Ad.
+func NewAdGetAdForClientOK(v Ad) GetAdForClientOK {
+	var s GetAdForClientOK
+	s.SetAd(v)
+	return s
+}
+
+// SetAdArray sets GetAdForClientOK to []Ad.
+func (s *GetAdForClientOK) SetAdArray(v []Ad) {
+	s.Type = AdArrayGetAdForClientOK
+	s.AdArray = v
+}
+
+// GetAdArray returns []Ad and true boolean if GetAdForClientOK is []Ad.
+func (s GetAdForClientOK) GetAdArray() (v []Ad, ok bool) {
+	if !s.IsAdArray() {
+		return v, false
+	}
+	return s.AdArray, true
+}
+
+// NewAdArrayGetAdForClientOK returns new GetAdForClientOK from []Ad.
+func NewAdArrayGetAdForClientOK(v []Ad) GetAdForClientOK {
+	var s GetAdForClientOK
+	s.SetAdArray(v)
+	return s
+}
+
+func (*GetAdForClientOK) getAdForClientRes() {}
+
+type GetAdvertiserDailyStatsOKApplicationJSON []DailyStats
+
+func (*GetAdvertiserDailyStatsOKApplicationJSON) getAdvertiserDailyStatsRes() {}
+
+type GetCampaignDailyStatsOKApplicationJSON []CampaignDailyStats
+
+func (*GetCampaignDailyStatsOKApplicationJSON) getCampaignDailyStatsRes() {}
+
+type ImportCampaignsReqApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportCampaignsReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportCampaignsReqApplicationXNdjson) importCampaignsReq() {}
+
+type ImportCampaignsReqTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ImportCampaignsReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ImportCampaignsReqTextCsv) importCampaignsReq() {}
+
+// ListAdvertisersOKHeaders wraps []Advertiser with response headers.
+type ListAdvertisersOKHeaders struct {
+	XTotalCount OptInt
+	Response    []Advertiser
+}
+
+// GetXTotalCount returns the value of XTotalCount.
+func (s *ListAdvertisersOKHeaders) GetXTotalCount() OptInt {
+	return s.XTotalCount
+}
+
+// GetResponse returns the value of Response.
+func (s *ListAdvertisersOKHeaders) GetResponse() []Advertiser {
+	return s.Response
+}
+
+// SetXTotalCount sets the value of XTotalCount.
+func (s *ListAdvertisersOKHeaders) SetXTotalCount(val OptInt) {
+	s.XTotalCount = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListAdvertisersOKHeaders) SetResponse(val []Advertiser) {
+	s.Response = val
+}
+
+func (*ListAdvertisersOKHeaders) listAdvertisersRes() {}
+
+type ListCampaignHistoryOKApplicationJSON []CampaignVersion
+
+func (*ListCampaignHistoryOKApplicationJSON) listCampaignHistoryRes() {}
+
+type ListCampaignTemplatesOKApplicationJSON []CampaignTemplate
+
+func (*ListCampaignTemplatesOKApplicationJSON) listCampaignTemplatesRes() {}
+
+type ListCampaignsGender string
+
+const (
+	ListCampaignsGenderMALE   ListCampaignsGender = "MALE"
+	ListCampaignsGenderFEMALE ListCampaignsGender = "FEMALE"
+	ListCampaignsGenderALL    ListCampaignsGender = "ALL"
+)
+
+// AllValues returns all ListCampaignsGender values.
+func (ListCampaignsGender) AllValues() []ListCampaignsGender {
+	return []ListCampaignsGender{
+		ListCampaignsGenderMALE,
+		ListCampaignsGenderFEMALE,
+		ListCampaignsGenderALL,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListCampaignsGender) MarshalText() ([]byte, error) {
+	switch s {
+	case ListCampaignsGenderMALE:
+		return []byte(s), nil
+	case ListCampaignsGenderFEMALE:
+		return []byte(s), nil
+	case ListCampaignsGenderALL:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListCampaignsGender) UnmarshalText(data []byte) error {
+	switch ListCampaignsGender(data) {
+	case ListCampaignsGenderMALE:
+		*s = ListCampaignsGenderMALE
+		return nil
+	case ListCampaignsGenderFEMALE:
+		*s = ListCampaignsGenderFEMALE
+		return nil
+	case ListCampaignsGenderALL:
+		*s = ListCampaignsGenderALL
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ListCampaignsOKHeaders wraps []Campaign with response headers.
+type ListCampaignsOKHeaders struct {
+	XNextCursor OptString
+	XTotalCount OptInt
+	Response    []Campaign
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *ListCampaignsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetXTotalCount returns the value of XTotalCount.
+func (s *ListCampaignsOKHeaders) GetXTotalCount() OptInt {
+	return s.XTotalCount
+}
+
+// GetResponse returns the value of Response.
+func (s *ListCampaignsOKHeaders) GetResponse() []Campaign {
+	return s.Response
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *ListCampaignsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetXTotalCount sets the value of XTotalCount.
+func (s *ListCampaignsOKHeaders) SetXTotalCount(val OptInt) {
+	s.XTotalCount = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListCampaignsOKHeaders) SetResponse(val []Campaign) {
+	s.Response = val
+}
+
+func (*ListCampaignsOKHeaders) listCampaignsRes() {}
+
+type ListCampaignsOrder string
+
+const (
+	ListCampaignsOrderAsc  ListCampaignsOrder = "asc"
+	ListCampaignsOrderDesc ListCampaignsOrder = "desc"
+)
+
+// AllValues returns all ListCampaignsOrder values.
+func (ListCampaignsOrder) AllValues() []ListCampaignsOrder {
+	return []ListCampaignsOrder{
+		ListCampaignsOrderAsc,
+		ListCampaignsOrderDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListCampaignsOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case ListCampaignsOrderAsc:
+		return []byte(s), nil
+	case ListCampaignsOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListCampaignsOrder) UnmarshalText(data []byte) error {
+	switch ListCampaignsOrder(data) {
+	case ListCampaignsOrderAsc:
+		*s = ListCampaignsOrderAsc
+		return nil
+	case ListCampaignsOrderDesc:
+		*s = ListCampaignsOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListCampaignsSort string
+
+const (
+	ListCampaignsSortCreatedAt         ListCampaignsSort = "created_at"
+	ListCampaignsSortStartDate         ListCampaignsSort = "start_date"
+	ListCampaignsSortCostPerImpression ListCampaignsSort = "cost_per_impression"
+	ListCampaignsSortCostPerClick      ListCampaignsSort = "cost_per_click"
+)
+
+// AllValues returns all ListCampaignsSort values.
+func (ListCampaignsSort) AllValues() []ListCampaignsSort {
+	return []ListCampaignsSort{
+		ListCampaignsSortCreatedAt,
+		ListCampaignsSortStartDate,
+		ListCampaignsSortCostPerImpression,
+		ListCampaignsSortCostPerClick,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListCampaignsSort) MarshalText() ([]byte, error) {
+	switch s {
+	case ListCampaignsSortCreatedAt:
+		return []byte(s), nil
+	case ListCampaignsSortStartDate:
+		return []byte(s), nil
+	case ListCampaignsSortCostPerImpression:
+		return []byte(s), nil
+	case ListCampaignsSortCostPerClick:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListCampaignsSort) UnmarshalText(data []byte) error {
+	switch ListCampaignsSort(data) {
+	case ListCampaignsSortCreatedAt:
+		*s = ListCampaignsSortCreatedAt
+		return nil
+	case ListCampaignsSortStartDate:
+		*s = ListCampaignsSortStartDate
+		return nil
+	case ListCampaignsSortCostPerImpression:
+		*s = ListCampaignsSortCostPerImpression
+		return nil
+	case ListCampaignsSortCostPerClick:
+		*s = ListCampaignsSortCostPerClick
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type LoadMLScoresReqApplicationXNdjson struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s LoadMLScoresReqApplicationXNdjson) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*LoadMLScoresReqApplicationXNdjson) loadMLScoresReq() {}
+
+type LoadMLScoresReqTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s LoadMLScoresReqTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*LoadMLScoresReqTextCsv) loadMLScoresReq() {}
+
+// Локация справочника. Локации образуют иерархию город
+// → регион → страна.
+// Ref: #/components/schemas/Location
+type Location struct {
+	// Название локации, совпадающее с location клиентов и
+	// кампаний.
+	Name string       `json:"name"`
+	Type LocationType `json:"type"`
+	// Название родительской локации. У страны родителя нет.
+	Parent OptNilString `json:"parent"`
+}
+
+// GetName returns the value of Name.
+func (s *Location) GetName() string {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *Location) GetType() LocationType {
+	return s.Type
+}
+
+// GetParent returns the value of Parent.
+func (s *Location) GetParent() OptNilString {
+	return s.Parent
+}
+
+// SetName sets the value of Name.
+func (s *Location) SetName(val string) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *Location) SetType(val LocationType) {
+	s.Type = val
+}
+
+// SetParent sets the value of Parent.
+func (s *Location) SetParent(val OptNilString) {
+	s.Parent = val
+}
+
+// Тип локации.
+// Ref: #/components/schemas/LocationType
+type LocationType string
+
+const (
+	LocationTypeCITY    LocationType = "CITY"
+	LocationTypeREGION  LocationType = "REGION"
+	LocationTypeCOUNTRY LocationType = "COUNTRY"
+)
+
+// AllValues returns all LocationType values.
+func (LocationType) AllValues() []LocationType {
+	return []LocationType{
+		LocationTypeCITY,
+		LocationTypeREGION,
+		LocationTypeCOUNTRY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LocationType) MarshalText() ([]byte, error) {
+	switch s {
+	case LocationTypeCITY:
+		return []byte(s), nil
+	case LocationTypeREGION:
+		return []byte(s), nil
+	case LocationTypeCOUNTRY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LocationType) UnmarshalText(data []byte) error {
+	switch LocationType(data) {
+	case LocationTypeCITY:
+		*s = LocationTypeCITY
+		return nil
+	case LocationTypeREGION:
+		*s = LocationTypeREGION
+		return nil
+	case LocationTypeCOUNTRY:
+		*s = LocationTypeCOUNTRY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Объект, представляющий ML скор для пары
+// клиент-рекламодатель.
+// Ref: #/components/schemas/MLScore
+type MLScore struct {
+	// UUID клиента для которого рассчитывается ML скор.
+	ClientID uuid.UUID `json:"client_id"`
+	// UUID рекламодателя для которого рассчитывается ML скор.
+	AdvertiserID uuid.UUID `json:"advertiser_id"`
+	// Целочисленное значение ML скора; чем больше – тем выше
+	// релевантность.
+	Score int `json:"score"`
+}
+
+// GetClientID returns the value of ClientID.
+func (s *MLScore) GetClientID() uuid.UUID {
+	return s.ClientID
+}
+
+// GetAdvertiserID returns the value of AdvertiserID.
+func (s *MLScore) GetAdvertiserID() uuid.UUID {
+	return s.AdvertiserID
+}
+
+// GetScore returns the value of Score.
+func (s *MLScore) GetScore() int {
+	return s.Score
+}
+
+// SetClientID sets the value of ClientID.
+func (s *MLScore) SetClientID(val uuid.UUID) {
+	s.ClientID = val
+}
+
+// SetAdvertiserID sets the value of AdvertiserID.
+func (s *MLScore) SetAdvertiserID(val uuid.UUID) {
+	s.AdvertiserID = val
+}
+
+// SetScore sets the value of Score.
+func (s *MLScore) SetScore(val int) {
+	s.Score = val
+}
+
+// Строка файла, которая не загружена.
+// Ref: #/components/schemas/MLScoreLoadError
+type MLScoreLoadError struct {
+	// Номер строки с ML скором, начиная с 1. Заголовок CSV не
+	// учитывается.
+	Row int `json:"row"`
+	// Причина, по которой строка не загружена.
+	Error string `json:"error"`
+}
+
+// GetRow returns the value of Row.
+func (s *MLScoreLoadError) GetRow() int {
+	return s.Row
+}
+
+// GetError returns the value of Error.
+func (s *MLScoreLoadError) GetError() string {
+	return s.Error
+}
+
+// SetRow sets the value of Row.
+func (s *MLScoreLoadError) SetRow(val int) {
+	s.Row = val
+}
+
+// SetError sets the value of Error.
+func (s *MLScoreLoadError) SetError(val string) {
+	s.Error = val
+}
+
+// Результат загрузки ML скоров.
+// Ref: #/components/schemas/MLScoresLoadReport
+type MLScoresLoadReport struct {
+	// Количество загруженных строк.
+	Loaded int `json:"loaded"`
+	// Количество строк с ошибками.
+	Failed int `json:"failed"`
+	// Первые 1000 строк с ошибками в порядке следования в
+	// файле.
+	Errors []MLScoreLoadError `json:"errors"`
+}
+
+// GetLoaded returns the value of Loaded.
+func (s *MLScoresLoadReport) GetLoaded() int {
+	return s.Loaded
+}
+
+// GetFailed returns the value of Failed.
+func (s *MLScoresLoadReport) GetFailed() int {
+	return s.Failed
+}
+
+// GetErrors returns the value of Errors.
+func (s *MLScoresLoadReport) GetErrors() []MLScoreLoadError {
+	return s.Errors
+}
+
+// SetLoaded sets the value of Loaded.
+func (s *MLScoresLoadReport) SetLoaded(val int) {
+	s.Loaded = val
+}
+
+// SetFailed sets the value of Failed.
+func (s *MLScoresLoadReport) SetFailed(val int) {
+	s.Failed = val
+}
+
+// SetErrors sets the value of Errors.
+func (s *MLScoresLoadReport) SetErrors(val []MLScoreLoadError) {
+	s.Errors = val
+}
+
+func (*MLScoresLoadReport) loadMLScoresRes() {}
+
+type ModerateAdTextOK struct {
+	// Прошёл ли текст модерацию.
+	Ok bool `json:"ok"`
+	// Фразы, которые не прошли модерацию.
+	IllegalPhrases []string `json:"illegal_phrases"`
+}
+
+// GetOk returns the value of Ok.
+func (s *ModerateAdTextOK) GetOk() bool {
+	return s.Ok
+}
+
+// GetIllegalPhrases returns the value of IllegalPhrases.
+func (s *ModerateAdTextOK) GetIllegalPhrases() []string {
+	return s.IllegalPhrases
+}
+
+// SetOk sets the value of Ok.
+func (s *ModerateAdTextOK) SetOk(val bool) {
+	s.Ok = val
+}
+
+// SetIllegalPhrases sets the value of IllegalPhrases.
+func (s *ModerateAdTextOK) SetIllegalPhrases(val []string) {
+	s.IllegalPhrases = val
+}
+
+func (*ModerateAdTextOK) moderateAdTextRes() {}
+
+type ModerateAdTextReq struct {
+	// Текст рекламного объявления.
+	AdText string `json:"ad_text"`
+}
+
+// GetAdText returns the value of AdText.
+func (s *ModerateAdTextReq) GetAdText() string {
+	return s.AdText
+}
+
+// SetAdText sets the value of AdText.
+func (s *ModerateAdTextReq) SetAdText(val string) {
+	s.AdText = val
+}
+
+// Решение модерации названия и текста объявления.
+// Клиентам показываются только одобренные кампании.
+// APPROVED - объявление одобрено, PENDING_REVIEW - модерация
+// усомнилась в объявлении, не назвав фраз, и кампания
+// ждёт проверки администратором,
+// REJECTED - в объявлении найдены недопустимые фразы,
+// MODERATION_FAILED - модерация не ответила или не уложилась в
+// отведённое время, кампания ждёт проверки
+// администратором.
+// Ref: #/components/schemas/ModerationStatus
+type ModerationStatus string
+
+const (
+	ModerationStatusAPPROVED         ModerationStatus = "APPROVED"
+	ModerationStatusPENDINGREVIEW    ModerationStatus = "PENDING_REVIEW"
+	ModerationStatusREJECTED         ModerationStatus = "REJECTED"
+	ModerationStatusMODERATIONFAILED ModerationStatus = "MODERATION_FAILED"
+)
+
+// AllValues returns all ModerationStatus values.
+func (ModerationStatus) AllValues() []ModerationStatus {
+	return []ModerationStatus{
+		ModerationStatusAPPROVED,
+		ModerationStatusPENDINGREVIEW,
+		ModerationStatusREJECTED,
+		ModerationStatusMODERATIONFAILED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ModerationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ModerationStatusAPPROVED:
+		return []byte(s), nil
+	case ModerationStatusPENDINGREVIEW:
+		return []byte(s), nil
+	case ModerationStatusREJECTED:
+		return []byte(s), nil
+	case ModerationStatusMODERATIONFAILED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ModerationStatus) UnmarshalText(data []byte) error {
+	switch ModerationStatus(data) {
+	case ModerationStatusAPPROVED:
+		*s = ModerationStatusAPPROVED
+		return nil
+	case ModerationStatusPENDINGREVIEW:
+		*s = ModerationStatusPENDINGREVIEW
+		return nil
+	case ModerationStatusREJECTED:
+		*s = ModerationStatusREJECTED
+		return nil
+	case ModerationStatusMODERATIONFAILED:
+		*s = ModerationStatusMODERATIONFAILED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAdvanceDayReq returns new OptAdvanceDayReq with value set to v.
+func NewOptAdvanceDayReq(v AdvanceDayReq) OptAdvanceDayReq {
+	return OptAdvanceDayReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAdvanceDayReq is optional AdvanceDayReq.
+type OptAdvanceDayReq struct {
+	Value AdvanceDayReq
+	Set   bool
+}
+
+// IsSet returns true if OptAdvanceDayReq was set.
+func (o OptAdvanceDayReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAdvanceDayReq) Reset() {
+	var v AdvanceDayReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAdvanceDayReq) SetTo(v AdvanceDayReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAdvanceDayReq) Get() (v AdvanceDayReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAdvanceDayReq) Or(d AdvanceDayReq) AdvanceDayReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCampaignOverrides returns new OptCampaignOverrides with value set to v.
+func NewOptCampaignOverrides(v CampaignOverrides) OptCampaignOverrides {
+	return OptCampaignOverrides{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCampaignOverrides is optional CampaignOverrides.
+type OptCampaignOverrides struct {
+	Value CampaignOverrides
+	Set   bool
+}
+
+// IsSet returns true if OptCampaignOverrides was set.
+func (o OptCampaignOverrides) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCampaignOverrides) Reset() {
+	var v CampaignOverrides
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCampaignOverrides) SetTo(v CampaignOverrides) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCampaignOverrides) Get() (v CampaignOverrides, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCampaignOverrides) Or(d CampaignOverrides) CampaignOverrides {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCampaignStatus returns new OptCampaignStatus with value set to v.
+func NewOptCampaignStatus(v CampaignStatus) OptCampaignStatus {
+	return OptCampaignStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCampaignStatus is optional CampaignStatus.
+type OptCampaignStatus struct {
+	Value CampaignStatus
+	Set   bool
+}
+
+// IsSet returns true if OptCampaignStatus was set.
+func (o OptCampaignStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCampaignStatus) Reset() {
+	var v CampaignStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCampaignStatus) SetTo(v CampaignStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCampaignStatus) Get() (v CampaignStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCampaignStatus) Or(d CampaignStatus) CampaignStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptClientAttributes returns new OptClientAttributes with value set to v.
+func NewOptClientAttributes(v ClientAttributes) OptClientAttributes {
+	return OptClientAttributes{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptClientAttributes is optional ClientAttributes.
+type OptClientAttributes struct {
+	Value ClientAttributes
+	Set   bool
+}
+
+// IsSet returns true if OptClientAttributes was set.
+func (o OptClientAttributes) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptClientAttributes) Reset() {
+	var v ClientAttributes
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptClientAttributes) SetTo(v ClientAttributes) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptClientAttributes) Get() (v ClientAttributes, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptClientAttributes) Or(d ClientAttributes) ClientAttributes {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v Date) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional Date.
+type OptDate struct {
+	Value Date
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v Date
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v Date) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v Date, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d Date) Date {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExportCampaignsFormat returns new OptExportCampaignsFormat with value set to v.
+func NewOptExportCampaignsFormat(v ExportCampaignsFormat) OptExportCampaignsFormat {
+	return OptExportCampaignsFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExportCampaignsFormat is optional ExportCampaignsFormat.
+type OptExportCampaignsFormat struct {
+	Value ExportCampaignsFormat
+	Set   bool
+}
+
+// IsSet returns true if OptExportCampaignsFormat was set.
+func (o OptExportCampaignsFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExportCampaignsFormat) Reset() {
+	var v ExportCampaignsFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExportCampaignsFormat) SetTo(v ExportCampaignsFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExportCampaignsFormat) Get() (v ExportCampaignsFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExportCampaignsFormat) Or(d ExportCampaignsFormat) ExportCampaignsFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFrequencyCap returns new OptFrequencyCap with value set to v.
+func NewOptFrequencyCap(v FrequencyCap) OptFrequencyCap {
+	return OptFrequencyCap{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFrequencyCap is optional FrequencyCap.
+type OptFrequencyCap struct {
+	Value FrequencyCap
+	Set   bool
+}
+
+// IsSet returns true if OptFrequencyCap was set.
+func (o OptFrequencyCap) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFrequencyCap) Reset() {
+	var v FrequencyCap
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFrequencyCap) SetTo(v FrequencyCap) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFrequencyCap) Get() (v FrequencyCap, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFrequencyCap) Or(d FrequencyCap) FrequencyCap {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListCampaignsGender returns new OptListCampaignsGender with value set to v.
+func NewOptListCampaignsGender(v ListCampaignsGender) OptListCampaignsGender {
+	return OptListCampaignsGender{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListCampaignsGender is optional ListCampaignsGender.
+type OptListCampaignsGender struct {
+	Value ListCampaignsGender
+	Set   bool
+}
+
+// IsSet returns true if OptListCampaignsGender was set.
+func (o OptListCampaignsGender) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListCampaignsGender) Reset() {
+	var v ListCampaignsGender
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListCampaignsGender) SetTo(v ListCampaignsGender) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListCampaignsGender) Get() (v ListCampaignsGender, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListCampaignsGender) Or(d ListCampaignsGender) ListCampaignsGender {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListCampaignsOrder returns new OptListCampaignsOrder with value set to v.
+func NewOptListCampaignsOrder(v ListCampaignsOrder) OptListCampaignsOrder {
+	return OptListCampaignsOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListCampaignsOrder is optional ListCampaignsOrder.
+type OptListCampaignsOrder struct {
+	Value ListCampaignsOrder
+	Set   bool
+}
+
+// IsSet returns true if OptListCampaignsOrder was set.
+func (o OptListCampaignsOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListCampaignsOrder) Reset() {
+	var v ListCampaignsOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListCampaignsOrder) SetTo(v ListCampaignsOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListCampaignsOrder) Get() (v ListCampaignsOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListCampaignsOrder) Or(d ListCampaignsOrder) ListCampaignsOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListCampaignsSort returns new OptListCampaignsSort with value set to v.
+func NewOptListCampaignsSort(v ListCampaignsSort) OptListCampaignsSort {
+	return OptListCampaignsSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListCampaignsSort is optional ListCampaignsSort.
+type OptListCampaignsSort struct {
+	Value ListCampaignsSort
+	Set   bool
+}
+
+// IsSet returns true if OptListCampaignsSort was set.
+func (o OptListCampaignsSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListCampaignsSort) Reset() {
+	var v ListCampaignsSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListCampaignsSort) SetTo(v ListCampaignsSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListCampaignsSort) Get() (v ListCampaignsSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListCampaignsSort) Or(d ListCampaignsSort) ListCampaignsSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptModerationStatus returns new OptModerationStatus with value set to v.
+func NewOptModerationStatus(v ModerationStatus) OptModerationStatus {
+	return OptModerationStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptModerationStatus is optional ModerationStatus.
+type OptModerationStatus struct {
+	Value ModerationStatus
+	Set   bool
+}
+
+// IsSet returns true if OptModerationStatus was set.
+func (o OptModerationStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptModerationStatus) Reset() {
+	var v ModerationStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptModerationStatus) SetTo(v ModerationStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptModerationStatus) Get() (v ModerationStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptModerationStatus) Or(d ModerationStatus) ModerationStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
 }
 
-func (*GenerateAdTextOK) generateAdTextRes() {}
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-type GenerateAdTextReq struct {
-	// Заголовок рекламного объявления.
-	AdTitle string `json:"ad_title"`
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetAdTitle returns the value of AdTitle.
-func (s *GenerateAdTextReq) GetAdTitle() string {
-	return s.AdTitle
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetAdTitle sets the value of AdTitle.
-func (s *GenerateAdTextReq) SetAdTitle(val string) {
-	s.AdTitle = val
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
 }
 
-type GetAdvertiserDailyStatsOKApplicationJSON []DailyStats
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
 
-func (*GetAdvertiserDailyStatsOKApplicationJSON) getAdvertiserDailyStatsRes() {}
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
 
-type GetCampaignDailyStatsOKApplicationJSON []DailyStats
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
 
-func (*GetCampaignDailyStatsOKApplicationJSON) getCampaignDailyStatsRes() {}
+// IsSet returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
 
-type ListCampaignsOKApplicationJSON []Campaign
+// SetNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
 
-func (*ListCampaignsOKApplicationJSON) listCampaignsRes() {}
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
 
-// Объект, представляющий ML скор для пары
-// клиент-рекламодатель.
-// Ref: #/components/schemas/MLScore
-type MLScore struct {
-	// UUID клиента для которого рассчитывается ML скор.
-	ClientID uuid.UUID `json:"client_id"`
-	// UUID рекламодателя для которого рассчитывается ML скор.
-	AdvertiserID uuid.UUID `json:"advertiser_id"`
-	// Целочисленное значение ML скора; чем больше – тем выше
-	// релевантность.
-	Score int `json:"score"`
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetClientID returns the value of ClientID.
-func (s *MLScore) GetClientID() uuid.UUID {
-	return s.ClientID
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetAdvertiserID returns the value of AdvertiserID.
-func (s *MLScore) GetAdvertiserID() uuid.UUID {
-	return s.AdvertiserID
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
 }
 
-// GetScore returns the value of Score.
-func (s *MLScore) GetScore() int {
-	return s.Score
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
 }
 
-// SetClientID sets the value of ClientID.
-func (s *MLScore) SetClientID(val uuid.UUID) {
-	s.ClientID = val
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
 }
 
-// SetAdvertiserID sets the value of AdvertiserID.
-func (s *MLScore) SetAdvertiserID(val uuid.UUID) {
-	s.AdvertiserID = val
+// IsSet returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
 }
 
-// SetScore sets the value of Score.
-func (s *MLScore) SetScore(val int) {
-	s.Score = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-type ModerateAdTextOK struct {
-	// Прошёл ли текст модерацию.
-	Ok bool `json:"ok"`
-	// Фразы, которые не прошли модерацию.
-	IllegalPhrases []string `json:"illegal_phrases"`
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetOk returns the value of Ok.
-func (s *ModerateAdTextOK) GetOk() bool {
-	return s.Ok
+// NewOptNilStringArray returns new OptNilStringArray with value set to v.
+func NewOptNilStringArray(v []string) OptNilStringArray {
+	return OptNilStringArray{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetIllegalPhrases returns the value of IllegalPhrases.
-func (s *ModerateAdTextOK) GetIllegalPhrases() []string {
-	return s.IllegalPhrases
+// OptNilStringArray is optional nullable []string.
+type OptNilStringArray struct {
+	Value []string
+	Set   bool
+	Null  bool
 }
 
-// SetOk sets the value of Ok.
-func (s *ModerateAdTextOK) SetOk(val bool) {
-	s.Ok = val
+// IsSet returns true if OptNilStringArray was set.
+func (o OptNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilStringArray) Reset() {
+	var v []string
+	o.Value = v
+	o.Set = false
+	o.Null = false
 }
 
-// SetIllegalPhrases sets the value of IllegalPhrases.
-func (s *ModerateAdTextOK) SetIllegalPhrases(val []string) {
-	s.IllegalPhrases = val
+// SetTo sets value to v.
+func (o *OptNilStringArray) SetTo(v []string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
 }
 
-func (*ModerateAdTextOK) moderateAdTextRes() {}
+// IsSet returns true if value is Null.
+func (o OptNilStringArray) IsNull() bool { return o.Null }
 
-type ModerateAdTextReq struct {
-	// Текст рекламного объявления.
-	AdText string `json:"ad_text"`
+// SetNull sets value to null.
+func (o *OptNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []string
+	o.Value = v
 }
 
-// GetAdText returns the value of AdText.
-func (s *ModerateAdTextReq) GetAdText() string {
-	return s.AdText
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilStringArray) Get() (v []string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetAdText sets the value of AdText.
-func (s *ModerateAdTextReq) SetAdText(val string) {
-	s.AdText = val
+// Or returns value if set, or given parameter if does not.
+func (o OptNilStringArray) Or(d []string) []string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
+// NewOptNilTargetingGender returns new OptNilTargetingGender with value set to v.
+func NewOptNilTargetingGender(v TargetingGender) OptNilTargetingGender {
+	return OptNilTargetingGender{
 		Value: v,
+		Set:   true,
 	}
 }
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
+// OptNilTargetingGender is optional nullable TargetingGender.
+type OptNilTargetingGender struct {
+	Value TargetingGender
+	Set   bool
 	Null  bool
 }
 
+// IsSet returns true if OptNilTargetingGender was set.
+func (o OptNilTargetingGender) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilTargetingGender) Reset() {
+	var v TargetingGender
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
 // SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
+func (o *OptNilTargetingGender) SetTo(v TargetingGender) {
+	o.Set = true
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+func (o OptNilTargetingGender) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *NilString) SetToNull() {
+func (o *OptNilTargetingGender) SetToNull() {
+	o.Set = true
 	o.Null = true
-	var v string
+	var v TargetingGender
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
+func (o OptNilTargetingGender) Get() (v TargetingGender, ok bool) {
 	if o.Null {
 		return v, false
 	}
+	if !o.Set {
+		return v, false
+	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
+func (o OptNilTargetingGender) Or(d TargetingGender) TargetingGender {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptAdvanceDayReq returns new OptAdvanceDayReq with value set to v.
-func NewOptAdvanceDayReq(v AdvanceDayReq) OptAdvanceDayReq {
-	return OptAdvanceDayReq{
+// NewOptPacing returns new OptPacing with value set to v.
+func NewOptPacing(v Pacing) OptPacing {
+	return OptPacing{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptAdvanceDayReq is optional AdvanceDayReq.
-type OptAdvanceDayReq struct {
-	Value AdvanceDayReq
+// OptPacing is optional Pacing.
+type OptPacing struct {
+	Value Pacing
 	Set   bool
 }
 
-// IsSet returns true if OptAdvanceDayReq was set.
-func (o OptAdvanceDayReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptPacing was set.
+func (o OptPacing) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptAdvanceDayReq) Reset() {
-	var v AdvanceDayReq
+func (o *OptPacing) Reset() {
+	var v Pacing
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptAdvanceDayReq) SetTo(v AdvanceDayReq) {
+func (o *OptPacing) SetTo(v Pacing) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptAdvanceDayReq) Get() (v AdvanceDayReq, ok bool) {
+func (o OptPacing) Get() (v Pacing, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1082,45 +4308,45 @@ func (o OptAdvanceDayReq) Get() (v AdvanceDayReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptAdvanceDayReq) Or(d AdvanceDayReq) AdvanceDayReq {
+func (o OptPacing) Or(d Pacing) Pacing {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v Date) OptDate {
-	return OptDate{
+// NewOptScoreBreakdown returns new OptScoreBreakdown with value set to v.
+func NewOptScoreBreakdown(v ScoreBreakdown) OptScoreBreakdown {
+	return OptScoreBreakdown{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDate is optional Date.
-type OptDate struct {
-	Value Date
+// OptScoreBreakdown is optional ScoreBreakdown.
+type OptScoreBreakdown struct {
+	Value ScoreBreakdown
 	Set   bool
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// IsSet returns true if OptScoreBreakdown was set.
+func (o OptScoreBreakdown) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDate) Reset() {
-	var v Date
+func (o *OptScoreBreakdown) Reset() {
+	var v ScoreBreakdown
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptDate) SetTo(v Date) {
+func (o *OptScoreBreakdown) SetTo(v ScoreBreakdown) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptDate) Get() (v Date, ok bool) {
+func (o OptScoreBreakdown) Get() (v ScoreBreakdown, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1128,45 +4354,45 @@ func (o OptDate) Get() (v Date, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptDate) Or(d Date) Date {
+func (o OptScoreBreakdown) Or(d ScoreBreakdown) ScoreBreakdown {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
+// OptString is optional string.
+type OptString struct {
+	Value string
 	Set   bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+func (o *OptString) Reset() {
+	var v string
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1174,62 +4400,45 @@ func (o OptInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilInt returns new OptNilInt with value set to v.
-func NewOptNilInt(v int) OptNilInt {
-	return OptNilInt{
+// NewOptTargeting returns new OptTargeting with value set to v.
+func NewOptTargeting(v Targeting) OptTargeting {
+	return OptTargeting{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilInt is optional nullable int.
-type OptNilInt struct {
-	Value int
+// OptTargeting is optional Targeting.
+type OptTargeting struct {
+	Value Targeting
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilInt was set.
-func (o OptNilInt) IsSet() bool { return o.Set }
+// IsSet returns true if OptTargeting was set.
+func (o OptTargeting) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilInt) Reset() {
-	var v int
+func (o *OptTargeting) Reset() {
+	var v Targeting
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilInt) SetTo(v int) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsSet returns true if value is Null.
-func (o OptNilInt) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *OptNilInt) SetToNull() {
+func (o *OptTargeting) SetTo(v Targeting) {
 	o.Set = true
-	o.Null = true
-	var v int
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt) Get() (v int, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptTargeting) Get() (v Targeting, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1237,62 +4446,45 @@ func (o OptNilInt) Get() (v int, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilInt) Or(d int) int {
+func (o OptTargeting) Or(d Targeting) Targeting {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
+// NewOptTargetingRule returns new OptTargetingRule with value set to v.
+func NewOptTargetingRule(v TargetingRule) OptTargetingRule {
+	return OptTargetingRule{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
+// OptTargetingRule is optional TargetingRule.
+type OptTargetingRule struct {
+	Value TargetingRule
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// IsSet returns true if OptTargetingRule was set.
+func (o OptTargetingRule) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
+func (o *OptTargetingRule) Reset() {
+	var v TargetingRule
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsSet returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *OptNilString) SetToNull() {
+func (o *OptTargetingRule) SetTo(v TargetingRule) {
 	o.Set = true
-	o.Null = true
-	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptTargetingRule) Get() (v TargetingRule, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1300,62 +4492,45 @@ func (o OptNilString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
+func (o OptTargetingRule) Or(d TargetingRule) TargetingRule {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilTargetingGender returns new OptNilTargetingGender with value set to v.
-func NewOptNilTargetingGender(v TargetingGender) OptNilTargetingGender {
-	return OptNilTargetingGender{
+// NewOptTargetingRuleOp returns new OptTargetingRuleOp with value set to v.
+func NewOptTargetingRuleOp(v TargetingRuleOp) OptTargetingRuleOp {
+	return OptTargetingRuleOp{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilTargetingGender is optional nullable TargetingGender.
-type OptNilTargetingGender struct {
-	Value TargetingGender
+// OptTargetingRuleOp is optional TargetingRuleOp.
+type OptTargetingRuleOp struct {
+	Value TargetingRuleOp
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilTargetingGender was set.
-func (o OptNilTargetingGender) IsSet() bool { return o.Set }
+// IsSet returns true if OptTargetingRuleOp was set.
+func (o OptTargetingRuleOp) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilTargetingGender) Reset() {
-	var v TargetingGender
+func (o *OptTargetingRuleOp) Reset() {
+	var v TargetingRuleOp
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilTargetingGender) SetTo(v TargetingGender) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsSet returns true if value is Null.
-func (o OptNilTargetingGender) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *OptNilTargetingGender) SetToNull() {
+func (o *OptTargetingRuleOp) SetTo(v TargetingRuleOp) {
 	o.Set = true
-	o.Null = true
-	var v TargetingGender
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilTargetingGender) Get() (v TargetingGender, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptTargetingRuleOp) Get() (v TargetingRuleOp, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1363,45 +4538,45 @@ func (o OptNilTargetingGender) Get() (v TargetingGender, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilTargetingGender) Or(d TargetingGender) TargetingGender {
+func (o OptTargetingRuleOp) Or(d TargetingRuleOp) TargetingRuleOp {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptUUID) SetTo(v uuid.UUID) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1409,59 +4584,180 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptTargeting returns new OptTargeting with value set to v.
-func NewOptTargeting(v Targeting) OptTargeting {
-	return OptTargeting{
-		Value: v,
-		Set:   true,
+type OverrideCampaignModerationConflict struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *OverrideCampaignModerationConflict) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *OverrideCampaignModerationConflict) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*OverrideCampaignModerationConflict) overrideCampaignModerationRes() {}
+
+type OverrideCampaignModerationReq struct {
+	// Новое решение модерации.
+	ModerationStatus OverrideCampaignModerationReqModerationStatus `json:"moderation_status"`
+}
+
+// GetModerationStatus returns the value of ModerationStatus.
+func (s *OverrideCampaignModerationReq) GetModerationStatus() OverrideCampaignModerationReqModerationStatus {
+	return s.ModerationStatus
+}
+
+// SetModerationStatus sets the value of ModerationStatus.
+func (s *OverrideCampaignModerationReq) SetModerationStatus(val OverrideCampaignModerationReqModerationStatus) {
+	s.ModerationStatus = val
+}
+
+// Новое решение модерации.
+type OverrideCampaignModerationReqModerationStatus string
+
+const (
+	OverrideCampaignModerationReqModerationStatusAPPROVED OverrideCampaignModerationReqModerationStatus = "APPROVED"
+	OverrideCampaignModerationReqModerationStatusREJECTED OverrideCampaignModerationReqModerationStatus = "REJECTED"
+)
+
+// AllValues returns all OverrideCampaignModerationReqModerationStatus values.
+func (OverrideCampaignModerationReqModerationStatus) AllValues() []OverrideCampaignModerationReqModerationStatus {
+	return []OverrideCampaignModerationReqModerationStatus{
+		OverrideCampaignModerationReqModerationStatusAPPROVED,
+		OverrideCampaignModerationReqModerationStatusREJECTED,
 	}
 }
 
-// OptTargeting is optional Targeting.
-type OptTargeting struct {
-	Value Targeting
-	Set   bool
+// MarshalText implements encoding.TextMarshaler.
+func (s OverrideCampaignModerationReqModerationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OverrideCampaignModerationReqModerationStatusAPPROVED:
+		return []byte(s), nil
+	case OverrideCampaignModerationReqModerationStatusREJECTED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// IsSet returns true if OptTargeting was set.
-func (o OptTargeting) IsSet() bool { return o.Set }
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OverrideCampaignModerationReqModerationStatus) UnmarshalText(data []byte) error {
+	switch OverrideCampaignModerationReqModerationStatus(data) {
+	case OverrideCampaignModerationReqModerationStatusAPPROVED:
+		*s = OverrideCampaignModerationReqModerationStatusAPPROVED
+		return nil
+	case OverrideCampaignModerationReqModerationStatusREJECTED:
+		*s = OverrideCampaignModerationReqModerationStatusREJECTED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Распределение показов по дням кампании. Для EVEN и
+// FRONT_LOADED каждый день кампании задаётся цель по показам
+// из оставшегося лимита показов и оставшихся дней.
+// NONE - цели на день нет, кампания показывается до
+// исчерпания лимита показов, EVEN - оставшиеся показы
+// распределяются по оставшимся дням поровну,
+// FRONT_LOADED - в первые дни показов больше, чем в последние.
+// Ref: #/components/schemas/Pacing
+type Pacing string
+
+const (
+	PacingNONE        Pacing = "NONE"
+	PacingEVEN        Pacing = "EVEN"
+	PacingFRONTLOADED Pacing = "FRONT_LOADED"
+)
+
+// AllValues returns all Pacing values.
+func (Pacing) AllValues() []Pacing {
+	return []Pacing{
+		PacingNONE,
+		PacingEVEN,
+		PacingFRONTLOADED,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pacing) MarshalText() ([]byte, error) {
+	switch s {
+	case PacingNONE:
+		return []byte(s), nil
+	case PacingEVEN:
+		return []byte(s), nil
+	case PacingFRONTLOADED:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pacing) UnmarshalText(data []byte) error {
+	switch Pacing(data) {
+	case PacingNONE:
+		*s = PacingNONE
+		return nil
+	case PacingEVEN:
+		*s = PacingEVEN
+		return nil
+	case PacingFRONTLOADED:
+		*s = PacingFRONTLOADED
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// PatchCampaignForbidden is response for PatchCampaign operation.
+type PatchCampaignForbidden struct{}
+
+func (*PatchCampaignForbidden) patchCampaignRes() {}
 
-// Reset unsets value.
-func (o *OptTargeting) Reset() {
-	var v Targeting
-	o.Value = v
-	o.Set = false
+type PatchCampaignPreconditionFailed struct {
+	// Описание ошибки.
+	Message OptString `json:"message"`
 }
 
-// SetTo sets value to v.
-func (o *OptTargeting) SetTo(v Targeting) {
-	o.Set = true
-	o.Value = v
+// GetMessage returns the value of Message.
+func (s *PatchCampaignPreconditionFailed) GetMessage() OptString {
+	return s.Message
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptTargeting) Get() (v Targeting, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetMessage sets the value of Message.
+func (s *PatchCampaignPreconditionFailed) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptTargeting) Or(d Targeting) Targeting {
-	if v, ok := o.Get(); ok {
-		return v
+func (*PatchCampaignPreconditionFailed) patchCampaignRes() {}
+
+type PatchCampaignReq map[string]jx.Raw
+
+func (s *PatchCampaignReq) init() PatchCampaignReq {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return d
+	return m
 }
 
+// PurgeCampaignNoContent is response for PurgeCampaign operation.
+type PurgeCampaignNoContent struct{}
+
+func (*PurgeCampaignNoContent) purgeCampaignRes() {}
+
 // RecordAdClickNoContent is response for RecordAdClick operation.
 type RecordAdClickNoContent struct{}
 
@@ -1486,10 +4782,12 @@ func (s *RecordAdClickReq) SetClientID(val uuid.UUID) {
 type ResourceEnum string
 
 const (
-	ResourceEnumAdvertiser ResourceEnum = "Advertiser"
-	ResourceEnumClient     ResourceEnum = "Client"
-	ResourceEnumCampaign   ResourceEnum = "Campaign"
-	ResourceEnumAd         ResourceEnum = "Ad"
+	ResourceEnumAdvertiser       ResourceEnum = "Advertiser"
+	ResourceEnumClient           ResourceEnum = "Client"
+	ResourceEnumCampaign         ResourceEnum = "Campaign"
+	ResourceEnumCampaignVersion  ResourceEnum = "CampaignVersion"
+	ResourceEnumCampaignTemplate ResourceEnum = "CampaignTemplate"
+	ResourceEnumAd               ResourceEnum = "Ad"
 )
 
 // AllValues returns all ResourceEnum values.
@@ -1498,6 +4796,8 @@ func (ResourceEnum) AllValues() []ResourceEnum {
 		ResourceEnumAdvertiser,
 		ResourceEnumClient,
 		ResourceEnumCampaign,
+		ResourceEnumCampaignVersion,
+		ResourceEnumCampaignTemplate,
 		ResourceEnumAd,
 	}
 }
@@ -1511,6 +4811,10 @@ func (s ResourceEnum) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case ResourceEnumCampaign:
 		return []byte(s), nil
+	case ResourceEnumCampaignVersion:
+		return []byte(s), nil
+	case ResourceEnumCampaignTemplate:
+		return []byte(s), nil
 	case ResourceEnumAd:
 		return []byte(s), nil
 	default:
@@ -1530,6 +4834,12 @@ func (s *ResourceEnum) UnmarshalText(data []byte) error {
 	case ResourceEnumCampaign:
 		*s = ResourceEnumCampaign
 		return nil
+	case ResourceEnumCampaignVersion:
+		*s = ResourceEnumCampaignVersion
+		return nil
+	case ResourceEnumCampaignTemplate:
+		*s = ResourceEnumCampaignTemplate
+		return nil
 	case ResourceEnumAd:
 		*s = ResourceEnumAd
 		return nil
@@ -1553,8 +4863,16 @@ func (s *Response400) SetMessage(val OptString) {
 }
 
 func (*Response400) advanceDayRes()                  {}
+func (*Response400) archiveCampaignRes()             {}
+func (*Response400) cloneCampaignRes()               {}
 func (*Response400) createCampaignRes()              {}
+func (*Response400) createCampaignTemplateRes()      {}
+func (*Response400) deleteAdvertiserRes()            {}
 func (*Response400) deleteCampaignRes()              {}
+func (*Response400) eraseClientRes()                 {}
+func (*Response400) explainAdForClientRes()          {}
+func (*Response400) exportCampaignsRes()             {}
+func (*Response400) exportClientDataRes()            {}
 func (*Response400) generateAdTextRes()              {}
 func (*Response400) getAdForClientRes()              {}
 func (*Response400) getAdvertiserByIdRes()           {}
@@ -1564,13 +4882,27 @@ func (*Response400) getCampaignDailyStatsRes()       {}
 func (*Response400) getCampaignRes()                 {}
 func (*Response400) getCampaignStatsRes()            {}
 func (*Response400) getClientByIdRes()               {}
+func (*Response400) importCampaignsRes()             {}
+func (*Response400) instantiateCampaignTemplateRes() {}
+func (*Response400) listAdvertisersRes()             {}
+func (*Response400) listCampaignHistoryRes()         {}
 func (*Response400) listCampaignsRes()               {}
+func (*Response400) loadMLScoresRes()                {}
 func (*Response400) moderateAdTextRes()              {}
+func (*Response400) overrideCampaignModerationRes()  {}
+func (*Response400) patchCampaignRes()               {}
+func (*Response400) pauseCampaignRes()               {}
+func (*Response400) purgeCampaignRes()               {}
 func (*Response400) recordAdClickRes()               {}
+func (*Response400) restoreCampaignVersionRes()      {}
+func (*Response400) resumeCampaignRes()              {}
+func (*Response400) searchAdvertiserCampaignsRes()   {}
+func (*Response400) searchCampaignsRes()             {}
 func (*Response400) updateCampaignRes()              {}
 func (*Response400) uploadCampaignImageRes()         {}
 func (*Response400) upsertAdvertisersRes()           {}
 func (*Response400) upsertClientsRes()               {}
+func (*Response400) upsertLocationsRes()             {}
 func (*Response400) upsertMLScoreRes()               {}
 
 type Response404 struct {
@@ -1587,8 +4919,17 @@ func (s *Response404) SetResource(val ResourceEnum) {
 	s.Resource = val
 }
 
+func (*Response404) archiveCampaignRes()             {}
+func (*Response404) cloneCampaignRes()               {}
 func (*Response404) createCampaignRes()              {}
+func (*Response404) createCampaignTemplateRes()      {}
+func (*Response404) deleteAdvertiserRes()            {}
 func (*Response404) deleteCampaignRes()              {}
+func (*Response404) deleteCampaignTemplateRes()      {}
+func (*Response404) eraseClientRes()                 {}
+func (*Response404) explainAdForClientRes()          {}
+func (*Response404) exportCampaignsRes()             {}
+func (*Response404) exportClientDataRes()            {}
 func (*Response404) getAdForClientRes()              {}
 func (*Response404) getAdvertiserByIdRes()           {}
 func (*Response404) getAdvertiserCampaignsStatsRes() {}
@@ -1596,13 +4937,271 @@ func (*Response404) getAdvertiserDailyStatsRes()     {}
 func (*Response404) getCampaignDailyStatsRes()       {}
 func (*Response404) getCampaignRes()                 {}
 func (*Response404) getCampaignStatsRes()            {}
+func (*Response404) getCampaignTemplateRes()         {}
 func (*Response404) getClientByIdRes()               {}
+func (*Response404) importCampaignsRes()             {}
+func (*Response404) instantiateCampaignTemplateRes() {}
+func (*Response404) listCampaignHistoryRes()         {}
+func (*Response404) listCampaignTemplatesRes()       {}
 func (*Response404) listCampaignsRes()               {}
+func (*Response404) overrideCampaignModerationRes()  {}
+func (*Response404) patchCampaignRes()               {}
+func (*Response404) pauseCampaignRes()               {}
+func (*Response404) purgeCampaignRes()               {}
 func (*Response404) recordAdClickRes()               {}
+func (*Response404) restoreCampaignVersionRes()      {}
+func (*Response404) resumeCampaignRes()              {}
+func (*Response404) searchAdvertiserCampaignsRes()   {}
 func (*Response404) updateCampaignRes()              {}
 func (*Response404) uploadCampaignImageRes()         {}
 func (*Response404) upsertMLScoreRes()               {}
 
+type Response409 struct {
+	// Описание ошибки.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Response409) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Response409) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*Response409) archiveCampaignRes() {}
+func (*Response409) pauseCampaignRes()   {}
+func (*Response409) resumeCampaignRes()  {}
+
+// RestoreCampaignVersionForbidden is response for RestoreCampaignVersion operation.
+type RestoreCampaignVersionForbidden struct{}
+
+func (*RestoreCampaignVersionForbidden) restoreCampaignVersionRes() {}
+
+// Признаки и итоговый ранг подходящей кампании.
+// Ref: #/components/schemas/ScoreBreakdown
+type ScoreBreakdown struct {
+	// Позиция кампании среди подходящих (1 - объявление,
+	// которое будет показано).
+	Position int `json:"position"`
+	// Итоговый ранг кампании в текущей стратегии
+	// ранжирования.
+	Rank float64 `json:"rank"`
+	// Ожидаемая прибыль от показа.
+	ExpectedProfit float64 `json:"expected_profit"`
+	// Стоимость показа.
+	CostPerImpression float64 `json:"cost_per_impression"`
+	// Стоимость перехода.
+	CostPerClick float64 `json:"cost_per_click"`
+	// ML скор клиента и рекламодателя.
+	MlScore int `json:"ml_score"`
+	// Максимальный ML скор среди всех пар
+	// клиент-рекламодатель.
+	MaxMlScore int `json:"max_ml_score"`
+	// Количество показов кампании.
+	ImpressionsCount int `json:"impressions_count"`
+	// Лимит показов кампании.
+	ImpressionsLimit int `json:"impressions_limit"`
+	// Количество переходов по кампании.
+	ClicksCount int `json:"clicks_count"`
+	// Лимит переходов кампании.
+	ClicksLimit int `json:"clicks_limit"`
+	// Количество показов кампании за текущий день.
+	ImpressionsToday int `json:"impressions_today"`
+	// Цель по показам кампании на текущий день. Не
+	// передаётся для кампаний без распределения показов
+	// (pacing NONE).
+	DailyImpressionsTarget OptInt `json:"daily_impressions_target"`
+}
+
+// GetPosition returns the value of Position.
+func (s *ScoreBreakdown) GetPosition() int {
+	return s.Position
+}
+
+// GetRank returns the value of Rank.
+func (s *ScoreBreakdown) GetRank() float64 {
+	return s.Rank
+}
+
+// GetExpectedProfit returns the value of ExpectedProfit.
+func (s *ScoreBreakdown) GetExpectedProfit() float64 {
+	return s.ExpectedProfit
+}
+
+// GetCostPerImpression returns the value of CostPerImpression.
+func (s *ScoreBreakdown) GetCostPerImpression() float64 {
+	return s.CostPerImpression
+}
+
+// GetCostPerClick returns the value of CostPerClick.
+func (s *ScoreBreakdown) GetCostPerClick() float64 {
+	return s.CostPerClick
+}
+
+// GetMlScore returns the value of MlScore.
+func (s *ScoreBreakdown) GetMlScore() int {
+	return s.MlScore
+}
+
+// GetMaxMlScore returns the value of MaxMlScore.
+func (s *ScoreBreakdown) GetMaxMlScore() int {
+	return s.MaxMlScore
+}
+
+// GetImpressionsCount returns the value of ImpressionsCount.
+func (s *ScoreBreakdown) GetImpressionsCount() int {
+	return s.ImpressionsCount
+}
+
+// GetImpressionsLimit returns the value of ImpressionsLimit.
+func (s *ScoreBreakdown) GetImpressionsLimit() int {
+	return s.ImpressionsLimit
+}
+
+// GetClicksCount returns the value of ClicksCount.
+func (s *ScoreBreakdown) GetClicksCount() int {
+	return s.ClicksCount
+}
+
+// GetClicksLimit returns the value of ClicksLimit.
+func (s *ScoreBreakdown) GetClicksLimit() int {
+	return s.ClicksLimit
+}
+
+// GetImpressionsToday returns the value of ImpressionsToday.
+func (s *ScoreBreakdown) GetImpressionsToday() int {
+	return s.ImpressionsToday
+}
+
+// GetDailyImpressionsTarget returns the value of DailyImpressionsTarget.
+func (s *ScoreBreakdown) GetDailyImpressionsTarget() OptInt {
+	return s.DailyImpressionsTarget
+}
+
+// SetPosition sets the value of Position.
+func (s *ScoreBreakdown) SetPosition(val int) {
+	s.Position = val
+}
+
+// SetRank sets the value of Rank.
+func (s *ScoreBreakdown) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetExpectedProfit sets the value of ExpectedProfit.
+func (s *ScoreBreakdown) SetExpectedProfit(val float64) {
+	s.ExpectedProfit = val
+}
+
+// SetCostPerImpression sets the value of CostPerImpression.
+func (s *ScoreBreakdown) SetCostPerImpression(val float64) {
+	s.CostPerImpression = val
+}
+
+// SetCostPerClick sets the value of CostPerClick.
+func (s *ScoreBreakdown) SetCostPerClick(val float64) {
+	s.CostPerClick = val
+}
+
+// SetMlScore sets the value of MlScore.
+func (s *ScoreBreakdown) SetMlScore(val int) {
+	s.MlScore = val
+}
+
+// SetMaxMlScore sets the value of MaxMlScore.
+func (s *ScoreBreakdown) SetMaxMlScore(val int) {
+	s.MaxMlScore = val
+}
+
+// SetImpressionsCount sets the value of ImpressionsCount.
+func (s *ScoreBreakdown) SetImpressionsCount(val int) {
+	s.ImpressionsCount = val
+}
+
+// SetImpressionsLimit sets the value of ImpressionsLimit.
+func (s *ScoreBreakdown) SetImpressionsLimit(val int) {
+	s.ImpressionsLimit = val
+}
+
+// SetClicksCount sets the value of ClicksCount.
+func (s *ScoreBreakdown) SetClicksCount(val int) {
+	s.ClicksCount = val
+}
+
+// SetClicksLimit sets the value of ClicksLimit.
+func (s *ScoreBreakdown) SetClicksLimit(val int) {
+	s.ClicksLimit = val
+}
+
+// SetImpressionsToday sets the value of ImpressionsToday.
+func (s *ScoreBreakdown) SetImpressionsToday(val int) {
+	s.ImpressionsToday = val
+}
+
+// SetDailyImpressionsTarget sets the value of DailyImpressionsTarget.
+func (s *ScoreBreakdown) SetDailyImpressionsTarget(val OptInt) {
+	s.DailyImpressionsTarget = val
+}
+
+// SearchAdvertiserCampaignsOKHeaders wraps []CampaignSearchResult with response headers.
+type SearchAdvertiserCampaignsOKHeaders struct {
+	XTotalCount OptInt
+	Response    []CampaignSearchResult
+}
+
+// GetXTotalCount returns the value of XTotalCount.
+func (s *SearchAdvertiserCampaignsOKHeaders) GetXTotalCount() OptInt {
+	return s.XTotalCount
+}
+
+// GetResponse returns the value of Response.
+func (s *SearchAdvertiserCampaignsOKHeaders) GetResponse() []CampaignSearchResult {
+	return s.Response
+}
+
+// SetXTotalCount sets the value of XTotalCount.
+func (s *SearchAdvertiserCampaignsOKHeaders) SetXTotalCount(val OptInt) {
+	s.XTotalCount = val
+}
+
+// SetResponse sets the value of Response.
+func (s *SearchAdvertiserCampaignsOKHeaders) SetResponse(val []CampaignSearchResult) {
+	s.Response = val
+}
+
+func (*SearchAdvertiserCampaignsOKHeaders) searchAdvertiserCampaignsRes() {}
+
+// SearchCampaignsOKHeaders wraps []CampaignSearchResult with response headers.
+type SearchCampaignsOKHeaders struct {
+	XTotalCount OptInt
+	Response    []CampaignSearchResult
+}
+
+// GetXTotalCount returns the value of XTotalCount.
+func (s *SearchCampaignsOKHeaders) GetXTotalCount() OptInt {
+	return s.XTotalCount
+}
+
+// GetResponse returns the value of Response.
+func (s *SearchCampaignsOKHeaders) GetResponse() []CampaignSearchResult {
+	return s.Response
+}
+
+// SetXTotalCount sets the value of XTotalCount.
+func (s *SearchCampaignsOKHeaders) SetXTotalCount(val OptInt) {
+	s.XTotalCount = val
+}
+
+// SetResponse sets the value of Response.
+func (s *SearchCampaignsOKHeaders) SetResponse(val []CampaignSearchResult) {
+	s.Response = val
+}
+
+func (*SearchCampaignsOKHeaders) searchCampaignsRes() {}
+
 // Объект, содержащий агрегированную статистику для
 // рекламной кампании или рекламодателя.
 // Ref: #/components/schemas/Stats
@@ -1625,6 +5224,12 @@ type Stats struct {
 	// Общая сумма денег, потраченная на кампанию (показы и
 	// клики).
 	SpentTotal float64 `json:"spent_total"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом первой цены.
+	SpentFirstPrice float64 `json:"spent_first_price"`
+	// Сумма денег, потраченная на показы и переходы, цены
+	// которых назначены аукционом второй цены.
+	SpentSecondPrice float64 `json:"spent_second_price"`
 }
 
 // GetImpressionsCount returns the value of ImpressionsCount.
@@ -1657,6 +5262,16 @@ func (s *Stats) GetSpentTotal() float64 {
 	return s.SpentTotal
 }
 
+// GetSpentFirstPrice returns the value of SpentFirstPrice.
+func (s *Stats) GetSpentFirstPrice() float64 {
+	return s.SpentFirstPrice
+}
+
+// GetSpentSecondPrice returns the value of SpentSecondPrice.
+func (s *Stats) GetSpentSecondPrice() float64 {
+	return s.SpentSecondPrice
+}
+
 // SetImpressionsCount sets the value of ImpressionsCount.
 func (s *Stats) SetImpressionsCount(val int) {
 	s.ImpressionsCount = val
@@ -1687,8 +5302,17 @@ func (s *Stats) SetSpentTotal(val float64) {
 	s.SpentTotal = val
 }
 
+// SetSpentFirstPrice sets the value of SpentFirstPrice.
+func (s *Stats) SetSpentFirstPrice(val float64) {
+	s.SpentFirstPrice = val
+}
+
+// SetSpentSecondPrice sets the value of SpentSecondPrice.
+func (s *Stats) SetSpentSecondPrice(val float64) {
+	s.SpentSecondPrice = val
+}
+
 func (*Stats) getAdvertiserCampaignsStatsRes() {}
-func (*Stats) getCampaignStatsRes()            {}
 
 // Объект, описывающий настройки таргетирования для
 // рекламной кампании.
@@ -1703,8 +5327,14 @@ type Targeting struct {
 	// показа объявления.
 	AgeTo OptNilInt `json:"age_to"`
 	// Локация аудитории, для которой будет показано
-	// объявление.
+	// объявление. Объявление показывается клиентам из этой
+	// локации и из всех вложенных в неё локаций справочника.
 	Location OptNilString `json:"location"`
+	// Список локаций аудитории. Объявление показывается
+	// клиентам из любой локации списка, поля location и
+	// вложенных в них локаций справочника.
+	Locations OptNilStringArray `json:"locations"`
+	Rules     OptTargetingRule  `json:"rules"`
 }
 
 // GetGender returns the value of Gender.
@@ -1727,6 +5357,16 @@ func (s *Targeting) GetLocation() OptNilString {
 	return s.Location
 }
 
+// GetLocations returns the value of Locations.
+func (s *Targeting) GetLocations() OptNilStringArray {
+	return s.Locations
+}
+
+// GetRules returns the value of Rules.
+func (s *Targeting) GetRules() OptTargetingRule {
+	return s.Rules
+}
+
 // SetGender sets the value of Gender.
 func (s *Targeting) SetGender(val OptNilTargetingGender) {
 	s.Gender = val
@@ -1747,6 +5387,16 @@ func (s *Targeting) SetLocation(val OptNilString) {
 	s.Location = val
 }
 
+// SetLocations sets the value of Locations.
+func (s *Targeting) SetLocations(val OptNilStringArray) {
+	s.Locations = val
+}
+
+// SetRules sets the value of Rules.
+func (s *Targeting) SetRules(val OptTargetingRule) {
+	s.Rules = val
+}
+
 // Пол аудитории для показа объявления (MALE, FEMALE или ALL).
 type TargetingGender string
 
@@ -1796,11 +5446,208 @@ func (s *TargetingGender) UnmarshalText(data []byte) error {
 	}
 }
 
+// Правило таргетирования по атрибутам клиента. Правило
+// задаётся ровно одним из способов: and - все вложенные
+// правила выполнены, or - выполнено хотя бы одно
+// вложенное правило, attribute с op - сравнение атрибута
+// клиента. Флаг negate инвертирует результат правила.
+// Ref: #/components/schemas/TargetingRule
+type TargetingRule struct {
+	And []TargetingRule `json:"and"`
+	Or  []TargetingRule `json:"or"`
+	// Инвертировать результат правила (NOT).
+	Negate OptBool `json:"negate"`
+	// Название атрибута клиента.
+	Attribute OptString          `json:"attribute"`
+	Op        OptTargetingRuleOp `json:"op"`
+	// Значение для сравнения операторами eq, ne, gt, gte, lt, lte. Для
+	// gt, gte, lt, lte - число.
+	Value jx.Raw `json:"value"`
+	// Множество значений для оператора in.
+	Values []jx.Raw `json:"values"`
+}
+
+// GetAnd returns the value of And.
+func (s *TargetingRule) GetAnd() []TargetingRule {
+	return s.And
+}
+
+// GetOr returns the value of Or.
+func (s *TargetingRule) GetOr() []TargetingRule {
+	return s.Or
+}
+
+// GetNegate returns the value of Negate.
+func (s *TargetingRule) GetNegate() OptBool {
+	return s.Negate
+}
+
+// GetAttribute returns the value of Attribute.
+func (s *TargetingRule) GetAttribute() OptString {
+	return s.Attribute
+}
+
+// GetOp returns the value of Op.
+func (s *TargetingRule) GetOp() OptTargetingRuleOp {
+	return s.Op
+}
+
+// GetValue returns the value of Value.
+func (s *TargetingRule) GetValue() jx.Raw {
+	return s.Value
+}
+
+// GetValues returns the value of Values.
+func (s *TargetingRule) GetValues() []jx.Raw {
+	return s.Values
+}
+
+// SetAnd sets the value of And.
+func (s *TargetingRule) SetAnd(val []TargetingRule) {
+	s.And = val
+}
+
+// SetOr sets the value of Or.
+func (s *TargetingRule) SetOr(val []TargetingRule) {
+	s.Or = val
+}
+
+// SetNegate sets the value of Negate.
+func (s *TargetingRule) SetNegate(val OptBool) {
+	s.Negate = val
+}
+
+// SetAttribute sets the value of Attribute.
+func (s *TargetingRule) SetAttribute(val OptString) {
+	s.Attribute = val
+}
+
+// SetOp sets the value of Op.
+func (s *TargetingRule) SetOp(val OptTargetingRuleOp) {
+	s.Op = val
+}
+
+// SetValue sets the value of Value.
+func (s *TargetingRule) SetValue(val jx.Raw) {
+	s.Value = val
+}
+
+// SetValues sets the value of Values.
+func (s *TargetingRule) SetValues(val []jx.Raw) {
+	s.Values = val
+}
+
+// Оператор сравнения атрибута клиента. Если атрибут
+// клиента - массив, правило выполнено, когда ему
+// удовлетворяет хотя бы один элемент массива (для ne - ни
+// один элемент не равен значению). exists - атрибут задан у
+// клиента.
+// Ref: #/components/schemas/TargetingRuleOp
+type TargetingRuleOp string
+
+const (
+	TargetingRuleOpEq     TargetingRuleOp = "eq"
+	TargetingRuleOpNe     TargetingRuleOp = "ne"
+	TargetingRuleOpGt     TargetingRuleOp = "gt"
+	TargetingRuleOpGte    TargetingRuleOp = "gte"
+	TargetingRuleOpLt     TargetingRuleOp = "lt"
+	TargetingRuleOpLte    TargetingRuleOp = "lte"
+	TargetingRuleOpIn     TargetingRuleOp = "in"
+	TargetingRuleOpExists TargetingRuleOp = "exists"
+)
+
+// AllValues returns all TargetingRuleOp values.
+func (TargetingRuleOp) AllValues() []TargetingRuleOp {
+	return []TargetingRuleOp{
+		TargetingRuleOpEq,
+		TargetingRuleOpNe,
+		TargetingRuleOpGt,
+		TargetingRuleOpGte,
+		TargetingRuleOpLt,
+		TargetingRuleOpLte,
+		TargetingRuleOpIn,
+		TargetingRuleOpExists,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TargetingRuleOp) MarshalText() ([]byte, error) {
+	switch s {
+	case TargetingRuleOpEq:
+		return []byte(s), nil
+	case TargetingRuleOpNe:
+		return []byte(s), nil
+	case TargetingRuleOpGt:
+		return []byte(s), nil
+	case TargetingRuleOpGte:
+		return []byte(s), nil
+	case TargetingRuleOpLt:
+		return []byte(s), nil
+	case TargetingRuleOpLte:
+		return []byte(s), nil
+	case TargetingRuleOpIn:
+		return []byte(s), nil
+	case TargetingRuleOpExists:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TargetingRuleOp) UnmarshalText(data []byte) error {
+	switch TargetingRuleOp(data) {
+	case TargetingRuleOpEq:
+		*s = TargetingRuleOpEq
+		return nil
+	case TargetingRuleOpNe:
+		*s = TargetingRuleOpNe
+		return nil
+	case TargetingRuleOpGt:
+		*s = TargetingRuleOpGt
+		return nil
+	case TargetingRuleOpGte:
+		*s = TargetingRuleOpGte
+		return nil
+	case TargetingRuleOpLt:
+		*s = TargetingRuleOpLt
+		return nil
+	case TargetingRuleOpLte:
+		*s = TargetingRuleOpLte
+		return nil
+	case TargetingRuleOpIn:
+		*s = TargetingRuleOpIn
+		return nil
+	case TargetingRuleOpExists:
+		*s = TargetingRuleOpExists
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // UpdateCampaignForbidden is response for UpdateCampaign operation.
 type UpdateCampaignForbidden struct{}
 
 func (*UpdateCampaignForbidden) updateCampaignRes() {}
 
+type UpdateCampaignPreconditionFailed struct {
+	// Описание ошибки.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UpdateCampaignPreconditionFailed) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UpdateCampaignPreconditionFailed) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*UpdateCampaignPreconditionFailed) updateCampaignRes() {}
+
 type UploadCampaignImageOK struct {
 	// Ссылка на изображение рекламного объявления.
 	AdImageURL NilString `json:"ad_image_url"`
@@ -1850,6 +5697,24 @@ func (s UploadCampaignImageReqImagePNG) Read(p []byte) (n int, err error) {
 
 func (*UploadCampaignImageReqImagePNG) uploadCampaignImageReq() {}
 
+type UpsertAdvertisersConflict struct {
+	// Описание ошибки со списком id удалённых
+	// рекламодателей.
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *UpsertAdvertisersConflict) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *UpsertAdvertisersConflict) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*UpsertAdvertisersConflict) upsertAdvertisersRes() {}
+
 type UpsertAdvertisersCreatedApplicationJSON []Advertiser
 
 func (*UpsertAdvertisersCreatedApplicationJSON) upsertAdvertisersRes() {}
@@ -1858,6 +5723,10 @@ type UpsertClientsCreatedApplicationJSON []ClientModel
 
 func (*UpsertClientsCreatedApplicationJSON) upsertClientsRes() {}
 
+type UpsertLocationsCreatedApplicationJSON []Location
+
+func (*UpsertLocationsCreatedApplicationJSON) upsertLocationsRes() {}
+
 // UpsertMLScoreOK is response for UpsertMLScore operation.
 type UpsertMLScoreOK struct{}
 
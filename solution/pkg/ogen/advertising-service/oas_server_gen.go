@@ -13,6 +13,7 @@ type Handler interface {
 	AdvertisersHandler
 	CampaignsHandler
 	ClientsHandler
+	LocationsHandler
 	StatisticsHandler
 	TimeHandler
 }
@@ -39,6 +40,15 @@ type AIHandler interface {
 //
 // x-ogen-operation-group: Ads
 type AdsHandler interface {
+	// ExplainAdForClient implements explainAdForClient operation.
+	//
+	// Возвращает все рекламные кампании с причинами, по
+	// которым они не подходят клиенту, и разбор
+	// ранжирования подходящих кампаний. Показ не
+	// записывается.
+	//
+	// GET /admin/ads/explain
+	ExplainAdForClient(ctx context.Context, params ExplainAdForClientParams) (ExplainAdForClientRes, error)
 	// GetAdForClient implements getAdForClient operation.
 	//
 	// Возвращает рекламное объявление, подходящее для
@@ -59,16 +69,48 @@ type AdsHandler interface {
 //
 // x-ogen-operation-group: Advertisers
 type AdvertisersHandler interface {
+	// DeleteAdvertiser implements deleteAdvertiser operation.
+	//
+	// Удаляет рекламодателя и архивирует все его рекламные
+	// кампании. Рекламодателя с активными кампаниями
+	// удалить нельзя, их нужно сначала приостановить или
+	// архивировать. Показы и переходы кампаний сохраняются,
+	//  повторное создание рекламодателя с тем же ID
+	// восстанавливает его без восстановления кампаний.
+	//
+	// DELETE /advertisers/{advertiserId}
+	DeleteAdvertiser(ctx context.Context, params DeleteAdvertiserParams) (DeleteAdvertiserRes, error)
 	// GetAdvertiserById implements getAdvertiserById operation.
 	//
 	// Возвращает информацию о рекламодателе по его ID.
 	//
 	// GET /advertisers/{advertiserId}
 	GetAdvertiserById(ctx context.Context, params GetAdvertiserByIdParams) (GetAdvertiserByIdRes, error)
+	// ListAdvertisers implements listAdvertisers operation.
+	//
+	// Возвращает рекламодателей, отсортированных по имени,
+	// с пагинацией и поиском по части имени без учёта
+	// регистра. Удалённые рекламодатели не возвращаются.
+	//
+	// GET /advertisers
+	ListAdvertisers(ctx context.Context, params ListAdvertisersParams) (ListAdvertisersRes, error)
+	// LoadMLScores implements loadMLScores operation.
+	//
+	// Добавляет или обновляет ML скоры из файла CSV или NDJSON,
+	// файл может быть сжат gzip. Файл читается потоково и
+	// загружается в базу через COPY. Строки с ошибками не
+	// мешают загрузке остальных, при повторе пары
+	// клиент-рекламодатель сохраняется скор из последней
+	// строки.
+	//
+	// POST /ml-scores/bulk
+	LoadMLScores(ctx context.Context, req LoadMLScoresReq) (LoadMLScoresRes, error)
 	// UpsertAdvertisers implements upsertAdvertisers operation.
 	//
 	// Создаёт новых или обновляет существующих
-	// рекламодателей.
+	// рекламодателей. Не переданные бюджеты существующего
+	// рекламодателя не меняются, `null` снимает ограничение.
+	// Удалённых рекламодателей восстановить нельзя.
 	//
 	// POST /advertisers/bulk
 	UpsertAdvertisers(ctx context.Context, req []AdvertiserUpsert) (UpsertAdvertisersRes, error)
@@ -85,6 +127,23 @@ type AdvertisersHandler interface {
 //
 // x-ogen-operation-group: Campaigns
 type CampaignsHandler interface {
+	// ArchiveCampaign implements archiveCampaign operation.
+	//
+	// Переводит кампанию в статус ARCHIVED. Архивная кампания
+	// не показывается и не изменяется, но её статистика
+	// сохраняется.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/archive
+	ArchiveCampaign(ctx context.Context, params ArchiveCampaignParams) (ArchiveCampaignRes, error)
+	// CloneCampaign implements cloneCampaign operation.
+	//
+	// Создаёт новую рекламную кампанию с таргетированием,
+	// текстом и изображением указанной кампании. Даты и
+	// лимиты можно переопределить, копия проверяется так
+	// же, как при создании кампании.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/clone
+	CloneCampaign(ctx context.Context, req OptCampaignOverrides, params CloneCampaignParams) (CloneCampaignRes, error)
 	// CreateCampaign implements createCampaign operation.
 	//
 	// Создаёт новую рекламную кампанию для указанного
@@ -92,26 +151,161 @@ type CampaignsHandler interface {
 	//
 	// POST /advertisers/{advertiserId}/campaigns
 	CreateCampaign(ctx context.Context, req *CampaignCreate, params CreateCampaignParams) (CreateCampaignRes, error)
+	// CreateCampaignTemplate implements createCampaignTemplate operation.
+	//
+	// Сохраняет параметры рекламной кампании под
+	// названием, уникальным для рекламодателя. Из шаблона
+	// можно создавать кампании.
+	//
+	// POST /advertisers/{advertiserId}/campaign-templates
+	CreateCampaignTemplate(ctx context.Context, req *CampaignTemplateCreate, params CreateCampaignTemplateParams) (CreateCampaignTemplateRes, error)
 	// DeleteCampaign implements deleteCampaign operation.
 	//
 	// Удаляет рекламную кампанию рекламодателя по
-	// заданному campaignId.
+	// заданному campaignId. Кампания перестаёт показываться и
+	// возвращаться, изображение объявления удаляется, но
+	// показы и переходы кампании сохраняются в статистике
+	// рекламодателя.
 	//
 	// DELETE /advertisers/{advertiserId}/campaigns/{campaignId}
 	DeleteCampaign(ctx context.Context, params DeleteCampaignParams) (DeleteCampaignRes, error)
+	// DeleteCampaignTemplate implements deleteCampaignTemplate operation.
+	//
+	// Удаляет шаблон. Кампании, созданные из шаблона, не
+	// изменяются.
+	//
+	// DELETE /advertisers/{advertiserId}/campaign-templates/{templateId}
+	DeleteCampaignTemplate(ctx context.Context, params DeleteCampaignTemplateParams) (DeleteCampaignTemplateRes, error)
+	// ExportCampaigns implements exportCampaigns operation.
+	//
+	// Возвращает все рекламные кампании рекламодателя в
+	// порядке создания в формате CSV или NDJSON. Файл в том же
+	// формате можно передать в импорт.
+	//
+	// GET /advertisers/{advertiserId}/campaigns/export
+	ExportCampaigns(ctx context.Context, params ExportCampaignsParams) (ExportCampaignsRes, error)
 	// GetCampaign implements getCampaign operation.
 	//
 	// Получение кампании по ID.
 	//
 	// GET /advertisers/{advertiserId}/campaigns/{campaignId}
 	GetCampaign(ctx context.Context, params GetCampaignParams) (GetCampaignRes, error)
+	// GetCampaignTemplate implements getCampaignTemplate operation.
+	//
+	// Получение шаблона рекламной кампании.
+	//
+	// GET /advertisers/{advertiserId}/campaign-templates/{templateId}
+	GetCampaignTemplate(ctx context.Context, params GetCampaignTemplateParams) (GetCampaignTemplateRes, error)
+	// ImportCampaigns implements importCampaigns operation.
+	//
+	// Создаёт рекламные кампании из файла CSV или NDJSON. Каждая
+	// строка проверяется так же, как запрос на создание
+	// кампании, результат возвращается по каждой строке.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/import
+	ImportCampaigns(ctx context.Context, req ImportCampaignsReq, params ImportCampaignsParams) (ImportCampaignsRes, error)
+	// InstantiateCampaignTemplate implements instantiateCampaignTemplate operation.
+	//
+	// Создаёт рекламную кампанию с параметрами шаблона.
+	// Даты и лимиты можно переопределить, кампания
+	// проверяется так же, как при обычном создании.
+	//
+	// POST /advertisers/{advertiserId}/campaign-templates/{templateId}/campaigns
+	InstantiateCampaignTemplate(ctx context.Context, req OptCampaignOverrides, params InstantiateCampaignTemplateParams) (InstantiateCampaignTemplateRes, error)
+	// ListCampaignHistory implements listCampaignHistory operation.
+	//
+	// Возвращает версии рекламной кампании, начиная с
+	// последней. Версия сохраняется при создании,
+	// обновлении, изменении изображения и статуса,
+	// удалении и восстановлении кампании.
+	//
+	// GET /advertisers/{advertiserId}/campaigns/{campaignId}/history
+	ListCampaignHistory(ctx context.Context, params ListCampaignHistoryParams) (ListCampaignHistoryRes, error)
+	// ListCampaignTemplates implements listCampaignTemplates operation.
+	//
+	// Возвращает шаблоны рекламных кампаний рекламодателя,
+	//  упорядоченные по названию.
+	//
+	// GET /advertisers/{advertiserId}/campaign-templates
+	ListCampaignTemplates(ctx context.Context, params ListCampaignTemplatesParams) (ListCampaignTemplatesRes, error)
 	// ListCampaigns implements listCampaigns operation.
 	//
 	// Возвращает список рекламных кампаний для указанного
-	// рекламодателя с пагинацией.
+	// рекламодателя с фильтрами, сортировкой и пагинацией.
+	// Для постраничного обхода большого списка
+	// используйте курсор из заголовка X-Next-Cursor, он устойчив
+	// к созданию и удалению кампаний между запросами.
 	//
 	// GET /advertisers/{advertiserId}/campaigns
 	ListCampaigns(ctx context.Context, params ListCampaignsParams) (ListCampaignsRes, error)
+	// OverrideCampaignModeration implements overrideCampaignModeration operation.
+	//
+	// Одобряет или отклоняет рекламную кампанию вместо
+	// автоматической модерации. Найденные модерацией
+	// фразы сохраняются, решение действует до следующего
+	// изменения названия или текста объявления.
+	//
+	// POST /admin/campaigns/{campaignId}/moderation
+	OverrideCampaignModeration(ctx context.Context, req *OverrideCampaignModerationReq, params OverrideCampaignModerationParams) (OverrideCampaignModerationRes, error)
+	// PatchCampaign implements patchCampaign operation.
+	//
+	// Изменяет только переданные параметры рекламной
+	// кампании по правилам JSON Merge Patch (RFC 7396). Значение null
+	// удаляет необязательное поле, например параметр
+	// таргетирования. К результату применяются те же
+	// проверки, что и при полном обновлении.
+	//
+	// PATCH /advertisers/{advertiserId}/campaigns/{campaignId}
+	PatchCampaign(ctx context.Context, req PatchCampaignReq, params PatchCampaignParams) (PatchCampaignRes, error)
+	// PauseCampaign implements pauseCampaign operation.
+	//
+	// Переводит активную кампанию в статус PAUSED, объявления
+	// кампании перестают показываться клиентам.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/pause
+	PauseCampaign(ctx context.Context, params PauseCampaignParams) (PauseCampaignRes, error)
+	// PurgeCampaign implements purgeCampaign operation.
+	//
+	// Удаляет рекламную кампанию, в том числе уже удалённую
+	// рекламодателем, вместе с её показами, переходами и
+	// изображением объявления.
+	//
+	// DELETE /admin/campaigns/{campaignId}
+	PurgeCampaign(ctx context.Context, params PurgeCampaignParams) (PurgeCampaignRes, error)
+	// RestoreCampaignVersion implements restoreCampaignVersion operation.
+	//
+	// Возвращает параметры рекламной кампании к
+	// сохранённой версии. Действуют те же ограничения, что
+	// и при обновлении кампании, статус и изображение не
+	// восстанавливаются.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/history/{version}/restore
+	RestoreCampaignVersion(ctx context.Context, params RestoreCampaignVersionParams) (RestoreCampaignVersionRes, error)
+	// ResumeCampaign implements resumeCampaign operation.
+	//
+	// Переводит черновик или приостановленную кампанию в
+	// статус ACTIVE.
+	//
+	// POST /advertisers/{advertiserId}/campaigns/{campaignId}/resume
+	ResumeCampaign(ctx context.Context, params ResumeCampaignParams) (ResumeCampaignRes, error)
+	// SearchAdvertiserCampaigns implements searchAdvertiserCampaigns operation.
+	//
+	// Ищет кампании рекламодателя по словам в названии и
+	// тексте объявления с учётом морфологии русского и
+	// английского языков. Совпадения в названии важнее
+	// совпадений в тексте.
+	//
+	// GET /advertisers/{advertiserId}/campaigns/search
+	SearchAdvertiserCampaigns(ctx context.Context, params SearchAdvertiserCampaignsParams) (SearchAdvertiserCampaignsRes, error)
+	// SearchCampaigns implements searchCampaigns operation.
+	//
+	// Ищет кампании всех рекламодателей по словам в
+	// названии и тексте объявления с учётом морфологии
+	// русского и английского языков. Совпадения в названии
+	// важнее совпадений в тексте.
+	//
+	// GET /admin/campaigns/search
+	SearchCampaigns(ctx context.Context, params SearchCampaignsParams) (SearchCampaignsRes, error)
 	// UpdateCampaign implements updateCampaign operation.
 	//
 	// Обновляет разрешённые параметры рекламной кампании
@@ -132,6 +326,22 @@ type CampaignsHandler interface {
 //
 // x-ogen-operation-group: Clients
 type ClientsHandler interface {
+	// EraseClient implements eraseClient operation.
+	//
+	// Удаляет клиента и его ML скоры в одной транзакции.
+	// Показы и переходы клиента обезличиваются -
+	// сохраняются без ссылки на клиента, поэтому
+	// статистика и расходы рекламодателей не меняются.
+	//
+	// DELETE /clients/{clientId}
+	EraseClient(ctx context.Context, params EraseClientParams) (EraseClientRes, error)
+	// ExportClientData implements exportClientData operation.
+	//
+	// Возвращает все хранящиеся данные о клиенте - профиль,
+	// ML скоры, показы и переходы.
+	//
+	// GET /clients/{clientId}/export
+	ExportClientData(ctx context.Context, params ExportClientDataParams) (ExportClientDataRes, error)
 	// GetClientById implements getClientById operation.
 	//
 	// Возвращает информацию о клиенте по его ID.
@@ -146,6 +356,26 @@ type ClientsHandler interface {
 	UpsertClients(ctx context.Context, req []ClientUpsert) (UpsertClientsRes, error)
 }
 
+// LocationsHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Locations
+type LocationsHandler interface {
+	// ListLocations implements listLocations operation.
+	//
+	// Возвращает все локации справочника.
+	//
+	// GET /locations
+	ListLocations(ctx context.Context) ([]Location, error)
+	// UpsertLocations implements upsertLocations operation.
+	//
+	// Создаёт новые или обновляет существующие локации
+	// справочника. Родительская локация должна
+	// существовать или передаваться в том же запросе.
+	//
+	// POST /locations/bulk
+	UpsertLocations(ctx context.Context, req []Location) (UpsertLocationsRes, error)
+}
+
 // StatisticsHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Statistics
@@ -167,7 +397,10 @@ type StatisticsHandler interface {
 	// GetCampaignDailyStats implements getCampaignDailyStats operation.
 	//
 	// Возвращает массив ежедневной статистики для
-	// указанной рекламной кампании.
+	// указанной рекламной кампании. Каждый день от даты
+	// начала до даты окончания кампании присутствует в
+	// массиве, дни без показов и переходов возвращаются с
+	// нулевой статистикой.
 	//
 	// GET /stats/campaigns/{campaignId}/daily
 	GetCampaignDailyStats(ctx context.Context, params GetCampaignDailyStatsParams) (GetCampaignDailyStatsRes, error)
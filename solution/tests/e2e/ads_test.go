@@ -3,12 +3,15 @@ package e2e
 import (
 	"advertising/tests/helpers"
 	"context"
+	"math"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/gavv/httpexpect/v2"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetAdForClient(t *testing.T) {
@@ -222,6 +225,211 @@ func TestGetAdForClient(t *testing.T) {
 
 	})
 
+	t.Run("check frequency cap", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// set day
+		advanceDaySuccess(e, pointer(0))
+
+		// create client
+		client := generateClient()
+		clientId := client["client_id"].(uuid.UUID)
+		upsertClientsSuccess(e, client)
+
+		// create campaign with 2 impressions per client within 2 days
+		campaign := generateCampaign(advertiserId, helpers.JSON{})
+		campaign["start_date"] = 0
+		campaign["end_date"] = 10
+		campaign["impressions_limit"] = 1000
+		campaign["clicks_limit"] = 900
+		campaign["frequency_cap"] = helpers.JSON{
+			"impressions": 2,
+			"days":        2,
+		}
+		campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+
+		// shoud get created campaign twice
+		for range 2 {
+			getAdForClientSuccess(e, clientId).JSON().
+				Object().
+				HasValue("ad_id", campaignId)
+		}
+
+		// shoud get 404
+		getAdForClient(e, clientId).
+			Expect().
+			Status(http.StatusNotFound)
+
+		// click is attributed to the latest impression
+		recordClickSuccess(e, campaignId, clientId)
+
+		// impressions of day 0 are out of the window on day 2
+		advanceDaySuccess(e, pointer(2))
+		getAdForClientSuccess(e, clientId).JSON().
+			Object().
+			HasValue("ad_id", campaignId)
+
+		// click on the new impression is recorded
+		recordClickSuccess(e, campaignId, clientId)
+
+		getCampaignStatsSuccess(e, campaignId).JSON().
+			Object().
+			HasValue("impressions_count", 3).
+			HasValue("clicks_count", 2)
+	})
+
+	t.Run("get ads for several slots", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// set day
+		advanceDaySuccess(e, pointer(0))
+
+		// create client
+		client := generateClient()
+		clientId := client["client_id"].(uuid.UUID)
+		upsertClientsSuccess(e, client)
+
+		// create another advertiser
+		anotherAdvertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, anotherAdvertiser)
+		anotherAdvertiserId := anotherAdvertiser["advertiser_id"].(uuid.UUID)
+
+		// create two campaigns of the same advertiser and one of another
+		for _, id := range []uuid.UUID{advertiserId, advertiserId, anotherAdvertiserId} {
+			campaign := generateCampaign(id, helpers.JSON{})
+			campaign["start_date"] = 0
+			campaign["end_date"] = 10
+			campaign["impressions_limit"] = 1000
+			campaign["clicks_limit"] = 900
+			campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+			campaignId := uuid.MustParse(campaignIdStr)
+			t.Cleanup(func() {
+				deleteCapaignSuccess(e, id, campaignId)
+			})
+		}
+
+		// shoud get one ad per advertiser
+		ads := getAdForClient(e, clientId).
+			WithQuery("slots", 3).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+		ads.Length().IsEqual(2)
+
+		advertisersIds := []any{
+			ads.Value(0).Object().Value("advertiser_id").String().Raw(),
+			ads.Value(1).Object().Value("advertiser_id").String().Raw(),
+		}
+		assert.ElementsMatch(t, []any{advertiserId.String(), anotherAdvertiserId.String()}, advertisersIds)
+
+		// impressions are recorded for each returned ad
+		for i := range 2 {
+			adId := uuid.MustParse(ads.Value(i).Object().Value("ad_id").String().Raw())
+			recordClickSuccess(e, adId, clientId)
+		}
+
+		// shoud get the rest campaign only
+		getAdForClient(e, clientId).
+			WithQuery("slots", 3).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array().
+			Length().IsEqual(1)
+
+		// invalid slots number
+		getAdForClient(e, clientId).
+			WithQuery("slots", 0).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("explain ad for client", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// set day
+		advanceDaySuccess(e, pointer(0))
+
+		// create client
+		client := generateClient()
+		client["age"] = 27
+		clientId := client["client_id"].(uuid.UUID)
+		upsertClientsSuccess(e, client)
+
+		// create campaign that matches client
+		campaign := generateCampaign(advertiserId, helpers.JSON{
+			"location": client["location"],
+		})
+		campaign["start_date"] = 0
+		campaign["impressions_limit"] = 1000
+		campaign["clicks_limit"] = 900
+		campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+
+		// create campaign that doesn`t match client
+		anotherCampaign := generateCampaign(advertiserId, helpers.JSON{
+			"age_from": 40,
+		})
+		anotherCampaign["start_date"] = 0
+		anotherCampaignIdStr := createCampaignSuccess(e, anotherCampaign).JSON().Object().Value("campaign_id").String().Raw()
+		anotherCampaignId := uuid.MustParse(anotherCampaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, anotherCampaignId)
+		})
+
+		// explain matched campaign
+		explanation := explainAdForClient(e, clientId).
+			WithQuery("campaign_id", campaignId).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+		explanation.HasValue("client_id", clientId).HasValue("current_date", 0)
+		explanation.Value("campaigns").Array().Length().IsEqual(1)
+		matched := explanation.Value("campaigns").Array().Value(0).Object()
+		matched.HasValue("campaign_id", campaignId).
+			HasValue("eligible", true).
+			HasValue("exclusion_reasons", []any{})
+		matched.Value("score").Object().HasValue("position", 1)
+
+		// explain not matched campaign
+		explainAdForClient(e, clientId).
+			WithQuery("campaign_id", anotherCampaignId).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("campaigns").Array().Value(0).Object().
+			HasValue("eligible", false).
+			HasValue("exclusion_reasons", []any{"TARGETING_AGE"}).
+			NotContainsKey("score")
+
+		// explanation doesn`t record impression
+		getAdForClientSuccess(e, clientId).
+			JSON().
+			Object().
+			HasValue("ad_id", campaignId)
+
+		// explain non-existent campaign
+		explainAdForClient(e, clientId).
+			WithQuery("campaign_id", uuid.New()).
+			Expect().
+			Status(http.StatusNotFound)
+
+		// explain for non-existent client
+		explainAdForClient(e, uuid.New()).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
 	t.Run("get ad for non-existent client", func(t *testing.T) {
 		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
@@ -382,6 +590,133 @@ func TestRecordClick(t *testing.T) {
 	})
 }
 
+func TestGetAdForClientConcurrent(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+	// set day
+	advanceDaySuccess(e, pointer(0))
+
+	// create advertiser
+	advertiser := generateAdvertiser()
+	upsertAdvertisersSuccess(e, advertiser)
+	advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+	// create campaign targeted to a unique location with a small limit
+	location := gofakeit.UUID()
+	impressionsLimit := 20
+	campaign := generateCampaign(advertiserId, helpers.JSON{
+		"location": location,
+	})
+	campaign["start_date"] = 0
+	campaign["impressions_limit"] = impressionsLimit
+	campaign["clicks_limit"] = impressionsLimit
+	campaign["cost_per_impression"] = 100000
+	campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+	campaignId := uuid.MustParse(campaignIdStr)
+	t.Cleanup(func() {
+		deleteCapaignSuccess(e, advertiserId, campaignId)
+	})
+
+	// create clients
+	clientsNumber := 200
+	clients := make([]helpers.JSON, 0, clientsNumber)
+	clientsIds := make([]uuid.UUID, 0, clientsNumber)
+	for range clientsNumber {
+		client := generateClient()
+		client["location"] = location
+		clients = append(clients, client)
+		clientsIds = append(clientsIds, client["client_id"].(uuid.UUID))
+	}
+	upsertClientsSuccess(e, clients...)
+
+	// request ads in parallel, require reporter can`t be used outside of test goroutine
+	asyncExpect := httpexpect.WithConfig(httpexpect.Config{
+		TestName: t.Name(),
+		BaseURL:  advertisingServerUrl,
+		Context:  ctx,
+		Reporter: httpexpect.NewAssertReporter(t),
+	})
+
+	var wg sync.WaitGroup
+	for _, clientId := range clientsIds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			status := getAdForClient(asyncExpect, clientId).Expect().Raw().StatusCode
+			assert.Contains(t, []int{http.StatusOK, http.StatusNotFound}, status)
+		}()
+	}
+	wg.Wait()
+
+	// check impressions count never goes past 5% tolerance
+	impressionsCount := getCampaignStatsSuccess(e, campaignId).
+		JSON().
+		Object().
+		Value("impressions_count").
+		Number().
+		Raw()
+	assert.LessOrEqual(t, impressionsCount, math.Round(float64(impressionsLimit)*1.05))
+}
+
+func TestGetAdForClientByTargetingRules(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+	// set day
+	advanceDaySuccess(e, pointer(0))
+
+	advertiser := generateAdvertiser()
+	upsertAdvertisersSuccess(e, advertiser)
+	advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+	// campaign targeted to premium clients by a unique attribute
+	attribute := gofakeit.UUID()
+	campaign := generateCampaign(advertiserId, helpers.JSON{
+		"rules": helpers.JSON{"attribute": attribute, "op": "gte", "value": 3},
+	})
+	campaign["start_date"] = 0
+	campaign["cost_per_impression"] = 100000
+	campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+	campaignId := uuid.MustParse(campaignIdStr)
+	t.Cleanup(func() {
+		deleteCapaignSuccess(e, advertiserId, campaignId)
+	})
+
+	premiumClient := generateClient()
+	premiumClient["attributes"] = helpers.JSON{attribute: 5}
+	basicClient := generateClient()
+	basicClient["attributes"] = helpers.JSON{attribute: 1}
+	upsertClientsSuccess(e, premiumClient, basicClient)
+
+	getAdForClientSuccess(e, premiumClient["client_id"].(uuid.UUID)).
+		JSON().
+		Object().
+		HasValue("ad_id", campaignId)
+
+	explainAdForClient(e, basicClient["client_id"].(uuid.UUID)).
+		WithQuery("campaign_id", campaignId).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		Value("campaigns").
+		Array().
+		Value(0).
+		Object().
+		HasValue("eligible", false).
+		Value("exclusion_reasons").
+		Array().
+		ContainsAll("TARGETING_RULES")
+}
+
 func getAdForClient(e *httpexpect.Expect, clientId uuid.UUID) *httpexpect.Request {
 	return e.GET("/ads").WithQuery("client_id", clientId)
 }
@@ -392,6 +727,10 @@ func getAdForClientSuccess(e *httpexpect.Expect, clientId uuid.UUID) *httpexpect
 		Status(http.StatusOK)
 }
 
+func explainAdForClient(e *httpexpect.Expect, clientId uuid.UUID) *httpexpect.Request {
+	return e.GET("/admin/ads/explain").WithQuery("client_id", clientId)
+}
+
 func recordClick(e *httpexpect.Expect, adId, clientId uuid.UUID) *httpexpect.Request {
 	return e.POST("/ads/{adId}/click", adId).
 		WithJSON(helpers.JSON{
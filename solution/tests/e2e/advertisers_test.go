@@ -4,6 +4,7 @@ import (
 	"advertising/tests/helpers"
 	"context"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -96,6 +97,44 @@ func TestAdvertisers(t *testing.T) {
 			IsEqual(advertiser2)
 	})
 
+	t.Run("present advertiser with budgets", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiser := generateAdvertiser()
+		advertiser["daily_budget"] = 100.5
+		advertiser["total_budget"] = 1000.0
+
+		upsertAdvertisersSuccess(e, advertiser).
+			JSON().
+			Array().
+			ContainsOnly(advertiser)
+
+		getAdvertiserSuccess(e, advertiser["advertiser_id"].(uuid.UUID)).
+			JSON().
+			IsEqual(advertiser)
+
+		// remove budgets
+		delete(advertiser, "daily_budget")
+		delete(advertiser, "total_budget")
+
+		upsertAdvertisersSuccess(e, advertiser).
+			JSON().
+			Array().
+			ContainsOnly(advertiser)
+	})
+
+	t.Run("present advertiser with negative budget", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiser := generateAdvertiser()
+		advertiser["daily_budget"] = -1
+
+		e.POST("/advertisers/bulk").
+			WithJSON([]helpers.JSON{advertiser}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
 	t.Run("get non-existent advertiser", func(t *testing.T) {
 		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
@@ -104,6 +143,94 @@ func TestAdvertisers(t *testing.T) {
 			Status(http.StatusNotFound)
 	})
 
+	t.Run("list advertisers with name search", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// unique part of names separates advertisers of this test
+		token := uuid.NewString()
+		advertisers := make([]helpers.JSON, 0, 3)
+		for _, name := range []string{"c", "A", "b"} {
+			advertiser := generateAdvertiser()
+			advertiser["name"] = name + " " + token
+			advertisers = append(advertisers, advertiser)
+		}
+		upsertAdvertisersSuccess(e, advertisers...)
+
+		response := listAdvertisersSuccess(e, strings.ToUpper(token), pointer(2), pointer(1))
+		response.Header("X-Total-Count").IsEqual("3")
+		response.JSON().Array().IsEqual([]helpers.JSON{advertisers[1], advertisers[2]})
+
+		response = listAdvertisersSuccess(e, token, pointer(2), pointer(2))
+		response.Header("X-Total-Count").IsEqual("3")
+		response.JSON().Array().IsEqual([]helpers.JSON{advertisers[0]})
+
+		listAdvertisers(e, token, pointer(0), nil).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("delete advertiser", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		campaignIdStr := createCampaignSuccess(e, generateCampaign(advertiserId, generatePartialTargeting())).
+			JSON().Object().Value("campaign_id").String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			purgeCampaignSuccess(e, campaignId)
+		})
+
+		// advertiser with active campaign can't be deleted
+		deleteAdvertiser(e, advertiserId).
+			Expect().
+			Status(http.StatusConflict)
+
+		getAdvertiserSuccess(e, advertiserId)
+
+		// campaigns are archived with advertiser
+		pauseCampaignSuccess(e, advertiserId, campaignId)
+
+		deleteAdvertiser(e, advertiserId).
+			Expect().
+			Status(http.StatusNoContent)
+
+		getAdvertiser(e, advertiserId).
+			Expect().
+			Status(http.StatusNotFound)
+
+		listAdvertisersSuccess(e, advertiser["name"].(string), nil, nil).
+			JSON().Array().NotContainsAll(advertiser)
+
+		createCampaign(e, generateCampaign(advertiserId, generatePartialTargeting())).
+			Expect().
+			Status(http.StatusNotFound)
+
+		deleteAdvertiser(e, advertiserId).
+			Expect().
+			Status(http.StatusNotFound)
+
+		// upsert does not restore deleted advertiser
+		e.POST("/advertisers/bulk").
+			WithJSON([]helpers.JSON{advertiser}).
+			Expect().
+			Status(http.StatusConflict).
+			JSON().Object().Value("message").String().Contains(advertiserId.String())
+
+		getAdvertiser(e, advertiserId).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("delete non-existent advertiser", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		deleteAdvertiser(e, uuid.New()).
+			Expect().
+			Status(http.StatusNotFound)
+	})
 }
 
 func generateAdvertiser() helpers.JSON {
@@ -129,3 +256,26 @@ func getAdvertiserSuccess(e *httpexpect.Expect, id uuid.UUID) *httpexpect.Respon
 		Expect().
 		Status(http.StatusOK)
 }
+
+func listAdvertisers(e *httpexpect.Expect, name string, size *int, page *int) *httpexpect.Request {
+	req := e.GET("/advertisers").WithQuery("name", name)
+
+	if size != nil {
+		req = req.WithQuery("size", *size)
+	}
+	if page != nil {
+		req = req.WithQuery("page", *page)
+	}
+
+	return req
+}
+
+func listAdvertisersSuccess(e *httpexpect.Expect, name string, size *int, page *int) *httpexpect.Response {
+	return listAdvertisers(e, name, size, page).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func deleteAdvertiser(e *httpexpect.Expect, id uuid.UUID) *httpexpect.Request {
+	return e.DELETE("/advertisers/{advertiser_id}", id)
+}
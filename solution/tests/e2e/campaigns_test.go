@@ -3,9 +3,11 @@ package e2e
 import (
 	"advertising/tests/helpers"
 	"context"
+	"encoding/json"
 	"maps"
 	"net/http"
 	"slices"
+	"strings"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v7"
@@ -74,6 +76,34 @@ func TestCampaignCreateAndGetById(t *testing.T) {
 			IsObject().
 			Object().
 			IsEqual(campaign2)
+
+		// create campaign with frequency cap
+		campaign3 := generateCampaign(advertiserId, generateFullTargeting())
+		campaign3["frequency_cap"] = helpers.JSON{
+			"impressions": 3,
+			"days":        7,
+		}
+		campaign3["pacing"] = "FRONT_LOADED"
+		campaign3IdStr := createCampaignSuccess(e, campaign3).
+			JSON().
+			IsObject().
+			Object().
+			ContainsSubset(campaign3).
+			Value("campaign_id").
+			String().Raw()
+
+		campaign3Id := uuid.MustParse(campaign3IdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaign3Id)
+		})
+
+		campaign3["campaign_id"] = campaign3Id
+
+		getCampaignSuccess(e, advertiserId, campaign3Id).
+			JSON().
+			IsObject().
+			Object().
+			IsEqual(campaign3)
 	})
 
 	t.Run("create invalid campaign", func(t *testing.T) {
@@ -147,6 +177,22 @@ func TestCampaignCreateAndGetById(t *testing.T) {
 			Expect().
 			Status(http.StatusBadRequest)
 
+		// create campaign with zero frequency cap
+		campaign = generateCampaign(advertiserId, generatePartialTargeting())
+		campaign["frequency_cap"] = helpers.JSON{
+			"impressions": 0,
+		}
+		createCampaign(e, campaign).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		// create campaign with invalid pacing
+		campaign = generateCampaign(advertiserId, generatePartialTargeting())
+		campaign["pacing"] = "invalid pacing"
+		createCampaign(e, campaign).
+			Expect().
+			Status(http.StatusBadRequest)
+
 		// create campaign clicks_limit > impressions_limit
 		campaign = generateCampaign(advertiserId, generatePartialTargeting())
 		campaign["impressions_limit"] = 100
@@ -184,6 +230,63 @@ func TestCampaignCreateAndGetById(t *testing.T) {
 
 	})
 
+	t.Run("create campaign with targeting rules", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// set day
+		advanceDaySuccess(e, pointer(0))
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		rules := helpers.JSON{
+			"and": []helpers.JSON{
+				{"attribute": "tier", "op": "gte", "value": 2},
+				{"or": []helpers.JSON{
+					{"attribute": "interests", "op": "in", "values": []string{"sport", "music"}},
+					{"attribute": "device", "op": "eq", "value": "android", "negate": true},
+				}},
+			},
+		}
+		targeting := generateFullTargeting()
+		targeting["rules"] = rules
+		campaign := generateCampaign(advertiserId, targeting)
+		campaign["start_date"] = 5
+		campaign["end_date"] = 10
+
+		campaignIdStr := createCampaignSuccess(e, campaign).
+			JSON().
+			Object().
+			Value("campaign_id").
+			String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+		campaign["campaign_id"] = campaignId
+
+		getCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
+
+		// invalid rules
+		for _, rules := range []helpers.JSON{
+			{},
+			{"attribute": "tier", "op": "gt", "value": "gold"},
+			{"attribute": "tier", "op": "in"},
+			{"and": []helpers.JSON{}, "attribute": "tier", "op": "exists"},
+		} {
+			campaign := generateCampaign(advertiserId, helpers.JSON{"rules": rules})
+			campaign["start_date"] = 5
+			campaign["end_date"] = 10
+
+			createCampaign(e, campaign).
+				Expect().
+				Status(http.StatusBadRequest)
+		}
+	})
+
 	t.Run("create campaign with non-existent advertiser", func(t *testing.T) {
 		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
@@ -273,6 +376,71 @@ func TestCampaignsList(t *testing.T) {
 			IsEqual(campaigns[(page-1)*size : page*size])
 	})
 
+	t.Run("with total count and cursor", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		size := gofakeit.IntRange(1, campaignsN-1)
+		response := listCampaignsSuccess(e, advertiserId, &size, nil)
+		response.Header("X-Total-Count").AsNumber().IsEqual(campaignsN)
+
+		got := []any{}
+		for {
+			got = append(got, response.JSON().Array().Raw()...)
+
+			cursor := response.Header("X-Next-Cursor").Raw()
+			if cursor == "" {
+				break
+			}
+			response = listCampaigns(e, advertiserId, &size, nil).
+				WithQuery("cursor", cursor).
+				Expect().
+				Status(http.StatusOK)
+		}
+
+		e.Value(got).IsEqual(campaigns)
+	})
+
+	t.Run("with filters and sort", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		campaign := campaigns[gofakeit.IntRange(0, campaignsN-1)]
+		response := listCampaigns(e, advertiserId, nil, nil).
+			WithQuery("title", campaign["ad_title"]).
+			WithQuery("active_on", campaign["start_date"]).
+			Expect().
+			Status(http.StatusOK)
+		response.JSON().Array().ContainsAll(campaign)
+
+		sorted := slices.Clone(campaigns)
+		slices.SortStableFunc(sorted, func(a, b helpers.JSON) int {
+			return a["start_date"].(int) - b["start_date"].(int)
+		})
+		startDates := []any{}
+		for _, campaign := range sorted {
+			startDates = append(startDates, campaign["start_date"])
+		}
+
+		items := listCampaigns(e, advertiserId, nil, nil).
+			WithQuery("sort", "start_date").
+			WithQuery("order", "asc").
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+		for i, startDate := range startDates {
+			items.Value(i).Object().HasValue("start_date", startDate)
+		}
+	})
+
+	t.Run("with invalid cursor", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		listCampaigns(e, advertiserId, nil, nil).
+			WithQuery("cursor", "invalid").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
 	t.Run("with non-existent advertiser", func(t *testing.T) {
 		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
@@ -625,6 +793,28 @@ func TestCampaignsDelete(t *testing.T) {
 			Status(http.StatusNotFound)
 	})
 
+	t.Run("purge deleted campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// create campaign
+		campaign := generateCampaign(advertiserId, generateFullTargeting())
+		campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+
+		deleteCapaignSuccess(e, advertiserId, campaignId)
+
+		// deleted campaign can't be deleted again, but can be purged
+		deleteCapaign(e, advertiserId, campaignId).
+			Expect().
+			Status(http.StatusNotFound)
+
+		purgeCampaignSuccess(e, campaignId)
+
+		purgeCampaign(e, campaignId).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
 	t.Run("delete with non-existent campaign", func(t *testing.T) {
 		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
@@ -650,129 +840,1071 @@ func TestCampaignsDelete(t *testing.T) {
 	})
 }
 
-func createCampaign(e *httpexpect.Expect, campaign helpers.JSON) *httpexpect.Request {
-	return e.POST("/advertisers/{advertiser_id}/campaigns", campaign["advertiser_id"]).
-		WithJSON(campaign)
-}
-
-func createCampaignSuccess(e *httpexpect.Expect, campaign helpers.JSON) *httpexpect.Response {
-	return createCampaign(e, campaign).
-		Expect().
-		Status(http.StatusCreated)
-}
+func TestCampaignStatus(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
 
-func getCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
-	return e.GET("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId)
-}
+	t.Run("pause, resume and archive campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
-func getCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
-	return getCampaign(e, advertiserId, campaignId).
-		Expect().
-		Status(http.StatusOK)
-}
+		advanceDaySuccess(e, pointer(0))
 
-func deleteCapaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
-	return e.DELETE("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId)
-}
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
 
-func deleteCapaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
-	return deleteCapaign(e, advertiserId, campaignId).
-		Expect().
-		Status(http.StatusNoContent)
-}
+		campaign["status"] = "PAUSED"
+		pauseCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
 
-func listCampaigns(e *httpexpect.Expect, advertiserId uuid.UUID, size *int, page *int) *httpexpect.Request {
-	req := e.GET("/advertisers/{advertiser_id}/campaigns", advertiserId)
+		// campaign is already paused
+		pauseCampaign(e, advertiserId, campaignId).
+			Expect().
+			Status(http.StatusConflict)
 
-	if size != nil {
-		req = req.WithQuery("size", *size)
-	}
-	if page != nil {
-		req = req.WithQuery("page", *page)
-	}
+		campaign["status"] = "ACTIVE"
+		resumeCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
 
-	return req
-}
+		campaign["status"] = "ARCHIVED"
+		archiveCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
 
-func listCampaignsSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, size *int, page *int) *httpexpect.Response {
-	return listCampaigns(e, advertiserId, size, page).
-		Expect().
-		Status(http.StatusOK)
-}
+		getCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
 
-func updateCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, updated helpers.JSON) *httpexpect.Request {
-	return e.PUT("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId).
-		WithJSON(updated)
-}
+		// archived campaign can't be resumed or updated
+		resumeCampaign(e, advertiserId, campaignId).
+			Expect().
+			Status(http.StatusConflict)
 
-func updateCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, updated helpers.JSON) *httpexpect.Response {
-	return updateCampaign(e, advertiserId, campaignId, updated).
-		Expect().
-		Status(http.StatusOK)
-}
+		updateCampaign(e, advertiserId, campaignId, campaign).
+			Expect().
+			Status(http.StatusForbidden)
+	})
 
-func generateCampaign(advertiserId uuid.UUID, targeting helpers.JSON) helpers.JSON {
-	impressionsLimit := gofakeit.IntRange(100, 2000)
-	clicksLimit := gofakeit.IntRange(20, impressionsLimit)
-	startDate := gofakeit.IntRange(0, 300)
-	endDate := gofakeit.IntRange(startDate, 999)
-	return helpers.JSON{
-		"advertiser_id":       advertiserId,
-		"impressions_limit":   impressionsLimit,
-		"clicks_limit":        clicksLimit,
-		"cost_per_impression": gofakeit.Float32Range(0, 10000),
-		"cost_per_click":      gofakeit.Float32Range(0, 100000),
-		"ad_title":            gofakeit.Phrase(),
-		"ad_text":             gofakeit.Sentence(gofakeit.IntRange(8, 20)),
-		"start_date":          startDate,
-		"end_date":            endDate,
-		"targeting":           targeting,
-	}
-}
+	t.Run("create draft campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
-func generateGender() string {
-	return gofakeit.RandomString([]string{"MALE", "FEMALE", "ALL"})
-}
+		advanceDaySuccess(e, pointer(0))
 
-func generateFullTargeting() helpers.JSON {
-	ageFrom := gofakeit.IntRange(0, 30)
-	ageTo := gofakeit.IntRange(ageFrom, 70)
-	return helpers.JSON{
-		"gender":   generateGender(),
-		"age_from": ageFrom,
-		"age_to":   ageTo,
-		"location": gofakeit.City(),
-	}
-}
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
 
-func generatePartialTargeting() helpers.JSON {
-	fullTargeting := generateFullTargeting()
-	fields := []string{"gender", "age_from", "age_to", "location"}
-	for _, field := range fields {
-		if gofakeit.Float32Range(0, 1) >= 0.5 {
-			fullTargeting[field] = nil
-		}
-	}
-	return fullTargeting
-}
+		campaign := generateCampaign(advertiserId, generateFullTargeting())
+		campaign["draft"] = true
+		campaignIdStr := createCampaignSuccess(e, campaign).
+			JSON().
+			Object().
+			HasValue("status", "DRAFT").
+			Value("campaign_id").
+			String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
 
-func setupCampaignHelper(t *testing.T, e *httpexpect.Expect) (uuid.UUID, uuid.UUID, helpers.JSON) {
-	advertiser := generateAdvertiser()
-	upsertAdvertisersSuccess(e, advertiser)
-	advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+		// draft campaign can't be paused
+		pauseCampaign(e, advertiserId, campaignId).
+			Expect().
+			Status(http.StatusConflict)
 
-	campaign := generateCampaign(advertiserId, generatePartialTargeting())
-	campaign["start_date"] = 5
-	campaign["end_date"] = 10
-	campaignIdStr := createCampaignSuccess(e, campaign).
-		JSON().
-		Object().
-		Value("campaign_id").
-		String().Raw()
-	campaignId := uuid.MustParse(campaignIdStr)
-	t.Cleanup(func() {
-		deleteCapaignSuccess(e, advertiserId, campaignId)
+		resumeCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			Object().
+			HasValue("status", "ACTIVE")
 	})
-	campaign["campaign_id"] = campaignId
-	return advertiserId, campaignId, campaign
+
+	t.Run("complete ended campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		campaign := generateCampaign(advertiserId, generateFullTargeting())
+		campaign["start_date"] = 0
+		campaign["end_date"] = 1
+		campaignIdStr := createCampaignSuccess(e, campaign).
+			JSON().
+			Object().
+			Value("campaign_id").
+			String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+
+		advanceDaySuccess(e, pointer(2))
+
+		getCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			Object().
+			HasValue("status", "COMPLETED")
+
+		resumeCampaign(e, advertiserId, campaignId).
+			Expect().
+			Status(http.StatusConflict)
+	})
+
+	t.Run("change status of non-existent campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiserId, campaignId, _ := setupCampaignHelper(t, e)
+
+		pauseCampaign(e, advertiserId, uuid.New()).
+			Expect().
+			Status(http.StatusNotFound)
+
+		archiveCampaign(e, uuid.New(), campaignId).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestCampaignHistory(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("list history and restore version", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		// update campaign
+		campaignNew := generateCampaign(advertiserId, generateFullTargeting())
+		campaignNew["start_date"] = campaign["start_date"]
+		campaignNew["end_date"] = campaign["end_date"]
+		campaignNew["campaign_id"] = campaignId
+		updateCampaignSuccess(e, advertiserId, campaignId, campaignNew)
+
+		history := listCampaignHistorySuccess(e, advertiserId, campaignId).
+			JSON().
+			Array()
+		history.Length().IsEqual(2)
+
+		updated := history.Value(0).Object()
+		updated.HasValue("version", 2).
+			HasValue("action", "UPDATE").
+			HasValue("day", 0).
+			HasValue("campaign", campaignNew)
+		updated.Value("changes").Array().NotEmpty()
+
+		history.Value(1).Object().
+			HasValue("version", 1).
+			HasValue("action", "CREATE").
+			HasValue("campaign", campaign).
+			HasValue("changes", []any{})
+
+		// restore created version
+		restoreCampaignVersionSuccess(e, advertiserId, campaignId, 1).
+			JSON().
+			IsEqual(campaign)
+
+		getCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
+
+		listCampaignHistorySuccess(e, advertiserId, campaignId).
+			JSON().
+			Array().
+			Value(0).
+			Object().
+			HasValue("version", 3).
+			HasValue("action", "RESTORE")
+
+		// restore non-existent version
+		restoreCampaignVersion(e, advertiserId, campaignId, 42).
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			HasValue("resource", "CampaignVersion")
+	})
+
+	t.Run("restore forbidden fields of started campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		campaignNew := campaign
+		campaignNew["impressions_limit"] = campaign["impressions_limit"].(int) + 1
+		updateCampaignSuccess(e, advertiserId, campaignId, campaignNew)
+
+		// campaign starts on day 5
+		advanceDaySuccess(e, pointer(5))
+
+		restoreCampaignVersion(e, advertiserId, campaignId, 1).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("history of non-existent campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiserId, _, _ := setupCampaignHelper(t, e)
+
+		listCampaignHistory(e, advertiserId, uuid.New()).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestCampaignOptimisticLocking(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("update with If-Match", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		etag := getCampaignSuccess(e, advertiserId, campaignId).
+			Header("ETag")
+		etag.IsEqual(`"1"`)
+
+		// update with actual version
+		campaign["ad_title"] = gofakeit.Sentence(8)
+		updateCampaign(e, advertiserId, campaignId, campaign).
+			WithHeader("If-Match", etag.Raw()).
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			IsEqual(`"2"`)
+
+		// update with stale version
+		campaign["ad_text"] = gofakeit.Sentence(20)
+		updateCampaign(e, advertiserId, campaignId, campaign).
+			WithHeader("If-Match", etag.Raw()).
+			Expect().
+			Status(http.StatusPreconditionFailed)
+
+		// update with any version
+		updateCampaign(e, advertiserId, campaignId, campaign).
+			WithHeader("If-Match", "*").
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			IsEqual(`"3"`)
+
+		// update with weak entity tag
+		updateCampaign(e, advertiserId, campaignId, campaign).
+			WithHeader("If-Match", `W/"3"`).
+			Expect().
+			Status(http.StatusPreconditionFailed)
+
+		// update with malformed If-Match
+		updateCampaign(e, advertiserId, campaignId, campaign).
+			WithHeader("If-Match", "3").
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+}
+
+func TestCampaignsPatch(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("patch supplied fields", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		// change title and clear targeting gender
+		title := gofakeit.Phrase()
+		patchCampaignSuccess(e, advertiserId, campaignId, helpers.JSON{
+			"ad_title": title,
+			"targeting": helpers.JSON{
+				"gender": nil,
+			},
+		})
+
+		campaign["ad_title"] = title
+		campaign["targeting"].(helpers.JSON)["gender"] = nil
+		getCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
+
+		// clear all targeting
+		response := patchCampaignSuccess(e, advertiserId, campaignId, helpers.JSON{
+			"targeting": nil,
+		}).
+			JSON().
+			Object()
+		response.Value("targeting").Object().
+			HasValue("gender", nil).
+			HasValue("age_from", nil).
+			HasValue("age_to", nil).
+			HasValue("location", nil)
+		response.HasValue("ad_title", title)
+	})
+
+	t.Run("patch invalid fields", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		// required field can not be removed
+		patchCampaign(e, advertiserId, campaignId, helpers.JSON{"ad_title": nil}).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		// merged campaign is validated
+		patchCampaign(e, advertiserId, campaignId, helpers.JSON{"clicks_limit": campaign["impressions_limit"].(int) + 1}).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		getCampaignSuccess(e, advertiserId, campaignId).
+			JSON().
+			IsEqual(campaign)
+	})
+
+	t.Run("patch started campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, _ := setupCampaignHelper(t, e)
+
+		// campaign starts on day 5
+		advanceDaySuccess(e, pointer(5))
+
+		patchCampaignSuccess(e, advertiserId, campaignId, helpers.JSON{"ad_text": gofakeit.Sentence(10)})
+
+		patchCampaign(e, advertiserId, campaignId, helpers.JSON{"end_date": 20}).
+			Expect().
+			Status(http.StatusForbidden)
+	})
+
+	t.Run("patch with stale If-Match", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, _ := setupCampaignHelper(t, e)
+
+		patchCampaign(e, advertiserId, campaignId, helpers.JSON{"ad_title": gofakeit.Phrase()}).
+			WithHeader("If-Match", `"1"`).
+			Expect().
+			Status(http.StatusOK).
+			Header("ETag").
+			IsEqual(`"2"`)
+
+		patchCampaign(e, advertiserId, campaignId, helpers.JSON{"ad_title": gofakeit.Phrase()}).
+			WithHeader("If-Match", `"1"`).
+			Expect().
+			Status(http.StatusPreconditionFailed)
+	})
+
+	t.Run("patch non-existent campaign", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiserId, _, _ := setupCampaignHelper(t, e)
+
+		patchCampaign(e, advertiserId, uuid.New(), helpers.JSON{"ad_title": gofakeit.Phrase()}).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestCampaignsSearch(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("search advertiser campaigns", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		word := strings.ToLower(gofakeit.LetterN(12))
+		campaign["ad_title"] = "Распродажа " + word
+		updateCampaignSuccess(e, advertiserId, campaignId, campaign)
+
+		response := searchAdvertiserCampaigns(e, advertiserId, word).
+			Expect().
+			Status(http.StatusOK)
+		response.Header("X-Total-Count").IsEqual("1")
+
+		result := response.JSON().Array().Value(0).Object()
+		result.Value("campaign").Object().HasValue("campaign_id", campaignId)
+		result.Value("title_highlight").String().Contains("<b>" + word + "</b>")
+		result.Value("rank").Number().Gt(0)
+
+		// campaigns of another advertiser are not found
+		anotherAdvertiserId, _, _ := setupCampaignHelper(t, e)
+		searchAdvertiserCampaigns(e, anotherAdvertiserId, word).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array().
+			IsEmpty()
+
+		// but found by global search
+		searchCampaigns(e, word).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array().
+			Length().
+			IsEqual(1)
+	})
+
+	t.Run("search with invalid params", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiserId, _, _ := setupCampaignHelper(t, e)
+
+		searchAdvertiserCampaigns(e, advertiserId, "").
+			Expect().
+			Status(http.StatusBadRequest)
+
+		searchAdvertiserCampaigns(e, uuid.New(), "sale").
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestCampaignsCloneAndTemplates(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("clone campaign with new dates", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiserId, campaignId, campaign := setupCampaignHelper(t, e)
+
+		clone := cloneCampaignSuccess(e, advertiserId, campaignId, helpers.JSON{
+			"start_date": 20,
+			"end_date":   30,
+			"draft":      true,
+		}).
+			JSON().
+			Object()
+		clone.Value("campaign_id").NotEqual(campaignId)
+		cloneId := uuid.MustParse(clone.Value("campaign_id").String().Raw())
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, cloneId)
+		})
+
+		expected := helpers.JSON{}
+		for field, value := range campaign {
+			expected[field] = value
+		}
+		expected["campaign_id"] = cloneId
+		expected["start_date"] = 20
+		expected["end_date"] = 30
+		expected["status"] = "DRAFT"
+		clone.IsEqual(expected)
+
+		// clone is validated as a new campaign
+		cloneCampaign(e, advertiserId, campaignId, helpers.JSON{"end_date": 1}).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		cloneCampaign(e, advertiserId, uuid.New(), helpers.JSON{}).
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			HasValue("resource", "Campaign")
+	})
+
+	t.Run("create campaigns from template", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		campaign := generateCampaign(advertiserId, generateFullTargeting())
+		template := createCampaignTemplateSuccess(e, advertiserId, helpers.JSON{
+			"name":     "weekly sale",
+			"campaign": campaign,
+		}).
+			JSON().
+			Object()
+		template.HasValue("name", "weekly sale").
+			HasValue("advertiser_id", advertiserId)
+		templateId := uuid.MustParse(template.Value("template_id").String().Raw())
+
+		// name is unique for advertiser
+		createCampaignTemplate(e, advertiserId, helpers.JSON{
+			"name":     "weekly sale",
+			"campaign": campaign,
+		}).
+			Expect().
+			Status(http.StatusConflict)
+
+		listCampaignTemplatesSuccess(e, advertiserId).
+			JSON().
+			Array().
+			Length().
+			IsEqual(1)
+
+		created := instantiateCampaignTemplateSuccess(e, advertiserId, templateId, helpers.JSON{
+			"start_date":        40,
+			"end_date":          50,
+			"impressions_limit": 5000,
+		}).
+			JSON().
+			Object()
+		createdId := uuid.MustParse(created.Value("campaign_id").String().Raw())
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, createdId)
+		})
+		created.HasValue("ad_title", campaign["ad_title"]).
+			HasValue("targeting", campaign["targeting"]).
+			HasValue("start_date", 40).
+			HasValue("end_date", 50).
+			HasValue("impressions_limit", 5000).
+			HasValue("status", "ACTIVE")
+
+		// campaign from template is validated as a new one
+		instantiateCampaignTemplate(e, advertiserId, templateId, helpers.JSON{
+			"start_date":   40,
+			"end_date":     50,
+			"clicks_limit": 10000,
+		}).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		deleteCampaignTemplate(e, advertiserId, templateId).
+			Expect().
+			Status(http.StatusNoContent)
+
+		getCampaignTemplate(e, advertiserId, templateId).
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			HasValue("resource", "CampaignTemplate")
+
+		// campaign created from template is kept
+		getCampaignSuccess(e, advertiserId, createdId)
+	})
+}
+
+func TestCampaignsImportExport(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("import campaigns from csv and export them", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		csv := "impressions_limit,clicks_limit,cost_per_impression,cost_per_click,ad_title,ad_text,start_date,end_date,gender,locations\n" +
+			"1000,100,1.5,10,first,first text,1,10,MALE,Moscow|Kazan\n" +
+			"1000,5000,1.5,10,invalid limits,text,1,10,,\n" +
+			"500,50,2,20,second,second text,5,20,,\n"
+
+		report := importCampaignsSuccess(e, advertiserId, "text/csv", csv, false).
+			JSON().
+			Object()
+		report.HasValue("created", 2).
+			HasValue("failed", 1)
+		rows := report.Value("rows").Array()
+		rows.Length().IsEqual(3)
+		rows.Value(1).Object().
+			HasValue("row", 2).
+			ContainsKey("error").
+			NotContainsKey("campaign_id")
+
+		listCampaignsSuccess(e, advertiserId, nil, nil).
+			JSON().
+			Array().
+			Length().
+			IsEqual(2)
+
+		exported := exportCampaignsSuccess(e, advertiserId, "csv").
+			Body().
+			Raw()
+		lines := strings.Split(strings.TrimSpace(exported), "\n")
+		if len(lines) != 3 {
+			t.Fatalf("expected header and 2 campaigns in export, got %q", exported)
+		}
+
+		// exported file can be imported again
+		importCampaignsSuccess(e, advertiserId, "text/csv", exported, true).
+			JSON().
+			Object().
+			HasValue("created", 2).
+			HasValue("failed", 0)
+	})
+
+	t.Run("atomic import of ndjson with invalid row", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		valid, _ := json.Marshal(generateCampaign(advertiserId, helpers.JSON{}))
+		invalid := generateCampaign(advertiserId, helpers.JSON{})
+		invalid["end_date"] = -1
+		invalidLine, _ := json.Marshal(invalid)
+
+		importCampaignsSuccess(e, advertiserId, "application/x-ndjson", string(valid)+"\n"+string(invalidLine)+"\n", true).
+			JSON().
+			Object().
+			HasValue("created", 0).
+			HasValue("failed", 1)
+
+		listCampaignsSuccess(e, advertiserId, nil, nil).
+			JSON().
+			Array().
+			IsEmpty()
+
+		exportCampaignsSuccess(e, advertiserId, "ndjson").
+			Body().
+			IsEmpty()
+	})
+
+	t.Run("import campaigns with unknown column", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		importCampaigns(e, advertiserId, "text/csv", "budget\n100\n", false).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		importCampaigns(e, uuid.New(), "text/csv", "impressions_limit\n", false).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
+func TestCampaignsModeration(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("override campaign moderation", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		advanceDaySuccess(e, pointer(0))
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		client := generateClient()
+		clientId := client["client_id"].(uuid.UUID)
+		upsertClientsSuccess(e, client)
+
+		campaign := generateCampaign(advertiserId, helpers.JSON{
+			"location": client["location"],
+		})
+		campaign["start_date"] = 0
+		campaignId := uuid.MustParse(createCampaignSuccess(e, campaign).
+			JSON().
+			Object().
+			HasValue("moderation_status", "APPROVED").
+			HasValue("flagged_phrases", []any{}).
+			Value("campaign_id").
+			String().Raw())
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+
+		overrideCampaignModerationSuccess(e, campaignId, "REJECTED").
+			JSON().
+			Object().
+			HasValue("moderation_status", "REJECTED")
+
+		// rejected campaign is not shown
+		explainAdForClient(e, clientId).
+			WithQuery("campaign_id", campaignId).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("campaigns").Array().Value(0).Object().
+			HasValue("eligible", false).
+			HasValue("exclusion_reasons", []any{"NOT_MODERATED"})
+
+		listCampaigns(e, advertiserId, nil, nil).
+			WithQuery("moderation_status", "REJECTED").
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array().
+			Length().
+			IsEqual(1)
+
+		// verdict is kept on update without title and text change
+		campaign["campaign_id"] = campaignId
+		campaign["cost_per_click"] = 10
+		campaign["moderation_status"] = "REJECTED"
+		updateCampaignSuccess(e, advertiserId, campaignId, campaign).
+			JSON().
+			IsEqual(campaign)
+
+		campaign["moderation_status"] = "APPROVED"
+		overrideCampaignModerationSuccess(e, campaignId, "APPROVED").
+			JSON().
+			IsEqual(campaign)
+
+		listCampaignHistorySuccess(e, advertiserId, campaignId).
+			JSON().
+			Array().
+			Value(0).Object().
+			HasValue("action", "MODERATION")
+
+		explainAdForClient(e, clientId).
+			WithQuery("campaign_id", campaignId).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			Value("campaigns").Array().Value(0).Object().
+			HasValue("eligible", true)
+
+		// verdict can be only approved or rejected
+		overrideCampaignModeration(e, campaignId, "PENDING_REVIEW").
+			Expect().
+			Status(http.StatusBadRequest)
+
+		overrideCampaignModeration(e, uuid.New(), "APPROVED").
+			Expect().
+			Status(http.StatusNotFound).
+			JSON().
+			Object().
+			HasValue("resource", "Campaign")
+	})
+}
+
+func createCampaign(e *httpexpect.Expect, campaign helpers.JSON) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns", campaign["advertiser_id"]).
+		WithJSON(campaign)
+}
+
+func createCampaignSuccess(e *httpexpect.Expect, campaign helpers.JSON) *httpexpect.Response {
+	return createCampaign(e, campaign).
+		Expect().
+		Status(http.StatusCreated)
+}
+
+func getCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
+	return e.GET("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId)
+}
+
+func getCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
+	return getCampaign(e, advertiserId, campaignId).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func deleteCapaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
+	return e.DELETE("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId)
+}
+
+func deleteCapaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
+	return deleteCapaign(e, advertiserId, campaignId).
+		Expect().
+		Status(http.StatusNoContent)
+}
+
+func listCampaigns(e *httpexpect.Expect, advertiserId uuid.UUID, size *int, page *int) *httpexpect.Request {
+	req := e.GET("/advertisers/{advertiser_id}/campaigns", advertiserId)
+
+	if size != nil {
+		req = req.WithQuery("size", *size)
+	}
+	if page != nil {
+		req = req.WithQuery("page", *page)
+	}
+
+	return req
+}
+
+func listCampaignsSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, size *int, page *int) *httpexpect.Response {
+	return listCampaigns(e, advertiserId, size, page).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func updateCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, updated helpers.JSON) *httpexpect.Request {
+	return e.PUT("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId).
+		WithJSON(updated)
+}
+
+func updateCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, updated helpers.JSON) *httpexpect.Response {
+	return updateCampaign(e, advertiserId, campaignId, updated).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func patchCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, patch helpers.JSON) *httpexpect.Request {
+	body, _ := json.Marshal(patch)
+	return e.PATCH("/advertisers/{advertiser_id}/campaigns/{campaign_id}", advertiserId, campaignId).
+		WithHeader("Content-Type", "application/merge-patch+json").
+		WithBytes(body)
+}
+
+func patchCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, patch helpers.JSON) *httpexpect.Response {
+	return patchCampaign(e, advertiserId, campaignId, patch).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func searchAdvertiserCampaigns(e *httpexpect.Expect, advertiserId uuid.UUID, query string) *httpexpect.Request {
+	return e.GET("/advertisers/{advertiser_id}/campaigns/search", advertiserId).
+		WithQuery("q", query)
+}
+
+func searchCampaigns(e *httpexpect.Expect, query string) *httpexpect.Request {
+	return e.GET("/admin/campaigns/search").
+		WithQuery("q", query)
+}
+
+func importCampaigns(e *httpexpect.Expect, advertiserId uuid.UUID, contentType string, body string, atomic bool) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns/import", advertiserId).
+		WithQuery("atomic", atomic).
+		WithHeader("Content-Type", contentType).
+		WithBytes([]byte(body))
+}
+
+func importCampaignsSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, contentType string, body string, atomic bool) *httpexpect.Response {
+	return importCampaigns(e, advertiserId, contentType, body, atomic).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func exportCampaigns(e *httpexpect.Expect, advertiserId uuid.UUID, format string) *httpexpect.Request {
+	return e.GET("/advertisers/{advertiser_id}/campaigns/export", advertiserId).
+		WithQuery("format", format)
+}
+
+func exportCampaignsSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, format string) *httpexpect.Response {
+	return exportCampaigns(e, advertiserId, format).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func overrideCampaignModeration(e *httpexpect.Expect, campaignId uuid.UUID, status string) *httpexpect.Request {
+	return e.POST("/admin/campaigns/{campaign_id}/moderation", campaignId).
+		WithJSON(helpers.JSON{
+			"moderation_status": status,
+		})
+}
+
+func overrideCampaignModerationSuccess(e *httpexpect.Expect, campaignId uuid.UUID, status string) *httpexpect.Response {
+	return overrideCampaignModeration(e, campaignId, status).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func generateCampaign(advertiserId uuid.UUID, targeting helpers.JSON) helpers.JSON {
+	impressionsLimit := gofakeit.IntRange(100, 2000)
+	clicksLimit := gofakeit.IntRange(20, impressionsLimit)
+	startDate := gofakeit.IntRange(0, 300)
+	endDate := gofakeit.IntRange(startDate, 999)
+	return helpers.JSON{
+		"advertiser_id":       advertiserId,
+		"impressions_limit":   impressionsLimit,
+		"clicks_limit":        clicksLimit,
+		"cost_per_impression": gofakeit.Float32Range(0, 10000),
+		"cost_per_click":      gofakeit.Float32Range(0, 100000),
+		"ad_title":            gofakeit.Phrase(),
+		"ad_text":             gofakeit.Sentence(gofakeit.IntRange(8, 20)),
+		"start_date":          startDate,
+		"end_date":            endDate,
+		"targeting":           targeting,
+		"pacing":              "EVEN",
+		"status":              "ACTIVE",
+		"moderation_status":   "APPROVED",
+		"flagged_phrases":     []string{},
+	}
+}
+
+func generateGender() string {
+	return gofakeit.RandomString([]string{"MALE", "FEMALE", "ALL"})
+}
+
+func generateFullTargeting() helpers.JSON {
+	ageFrom := gofakeit.IntRange(0, 30)
+	ageTo := gofakeit.IntRange(ageFrom, 70)
+	return helpers.JSON{
+		"gender":   generateGender(),
+		"age_from": ageFrom,
+		"age_to":   ageTo,
+		"location": gofakeit.City(),
+	}
+}
+
+func generatePartialTargeting() helpers.JSON {
+	fullTargeting := generateFullTargeting()
+	fields := []string{"gender", "age_from", "age_to", "location"}
+	for _, field := range fields {
+		if gofakeit.Float32Range(0, 1) >= 0.5 {
+			fullTargeting[field] = nil
+		}
+	}
+	return fullTargeting
+}
+
+func setupCampaignHelper(t *testing.T, e *httpexpect.Expect) (uuid.UUID, uuid.UUID, helpers.JSON) {
+	advertiser := generateAdvertiser()
+	upsertAdvertisersSuccess(e, advertiser)
+	advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+	campaign := generateCampaign(advertiserId, generatePartialTargeting())
+	campaign["start_date"] = 5
+	campaign["end_date"] = 10
+	campaignIdStr := createCampaignSuccess(e, campaign).
+		JSON().
+		Object().
+		Value("campaign_id").
+		String().Raw()
+	campaignId := uuid.MustParse(campaignIdStr)
+	t.Cleanup(func() {
+		deleteCapaignSuccess(e, advertiserId, campaignId)
+	})
+	campaign["campaign_id"] = campaignId
+	return advertiserId, campaignId, campaign
+}
+
+func pauseCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns/{campaign_id}/pause", advertiserId, campaignId)
+}
+
+func pauseCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
+	return pauseCampaign(e, advertiserId, campaignId).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func resumeCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns/{campaign_id}/resume", advertiserId, campaignId)
+}
+
+func resumeCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
+	return resumeCampaign(e, advertiserId, campaignId).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func archiveCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns/{campaign_id}/archive", advertiserId, campaignId)
+}
+
+func archiveCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
+	return archiveCampaign(e, advertiserId, campaignId).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func purgeCampaign(e *httpexpect.Expect, campaignId uuid.UUID) *httpexpect.Request {
+	return e.DELETE("/admin/campaigns/{campaign_id}", campaignId)
+}
+
+func purgeCampaignSuccess(e *httpexpect.Expect, campaignId uuid.UUID) *httpexpect.Response {
+	return purgeCampaign(e, campaignId).
+		Expect().
+		Status(http.StatusNoContent)
+}
+
+func listCampaignHistory(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Request {
+	return e.GET("/advertisers/{advertiser_id}/campaigns/{campaign_id}/history", advertiserId, campaignId)
+}
+
+func listCampaignHistorySuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID) *httpexpect.Response {
+	return listCampaignHistory(e, advertiserId, campaignId).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func restoreCampaignVersion(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, version int) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns/{campaign_id}/history/{version}/restore", advertiserId, campaignId, version)
+}
+
+func restoreCampaignVersionSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, version int) *httpexpect.Response {
+	return restoreCampaignVersion(e, advertiserId, campaignId, version).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func cloneCampaign(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, overrides helpers.JSON) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaigns/{campaign_id}/clone", advertiserId, campaignId).
+		WithJSON(overrides)
+}
+
+func cloneCampaignSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, campaignId uuid.UUID, overrides helpers.JSON) *httpexpect.Response {
+	return cloneCampaign(e, advertiserId, campaignId, overrides).
+		Expect().
+		Status(http.StatusCreated)
+}
+
+func createCampaignTemplate(e *httpexpect.Expect, advertiserId uuid.UUID, template helpers.JSON) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaign-templates", advertiserId).
+		WithJSON(template)
+}
+
+func createCampaignTemplateSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, template helpers.JSON) *httpexpect.Response {
+	return createCampaignTemplate(e, advertiserId, template).
+		Expect().
+		Status(http.StatusCreated)
+}
+
+func listCampaignTemplatesSuccess(e *httpexpect.Expect, advertiserId uuid.UUID) *httpexpect.Response {
+	return e.GET("/advertisers/{advertiser_id}/campaign-templates", advertiserId).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func getCampaignTemplate(e *httpexpect.Expect, advertiserId uuid.UUID, templateId uuid.UUID) *httpexpect.Request {
+	return e.GET("/advertisers/{advertiser_id}/campaign-templates/{template_id}", advertiserId, templateId)
+}
+
+func deleteCampaignTemplate(e *httpexpect.Expect, advertiserId uuid.UUID, templateId uuid.UUID) *httpexpect.Request {
+	return e.DELETE("/advertisers/{advertiser_id}/campaign-templates/{template_id}", advertiserId, templateId)
+}
+
+func instantiateCampaignTemplate(e *httpexpect.Expect, advertiserId uuid.UUID, templateId uuid.UUID, overrides helpers.JSON) *httpexpect.Request {
+	return e.POST("/advertisers/{advertiser_id}/campaign-templates/{template_id}/campaigns", advertiserId, templateId).
+		WithJSON(overrides)
+}
+
+func instantiateCampaignTemplateSuccess(e *httpexpect.Expect, advertiserId uuid.UUID, templateId uuid.UUID, overrides helpers.JSON) *httpexpect.Response {
+	return instantiateCampaignTemplate(e, advertiserId, templateId, overrides).
+		Expect().
+		Status(http.StatusCreated)
 }
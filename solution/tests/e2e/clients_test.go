@@ -112,6 +112,37 @@ func TestClients(t *testing.T) {
 			Status(http.StatusBadRequest)
 	})
 
+	t.Run("insert client with attributes", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		client := generateClient()
+		client["attributes"] = helpers.JSON{
+			"device":    "ios",
+			"tier":      3,
+			"beta":      true,
+			"interests": []string{"sport", "music"},
+		}
+
+		upsertClientsSuccess(e, client).
+			JSON().
+			Array().
+			ContainsOnly(client)
+
+		getClientSuccess(e, client["client_id"].(uuid.UUID)).
+			JSON().
+			IsEqual(client)
+
+		// insert client with nested object attribute
+		client = generateClient()
+		client["attributes"] = helpers.JSON{
+			"device": helpers.JSON{"os": "ios"},
+		}
+
+		upsertClients(e, client).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
 	t.Run("get non-existent client", func(t *testing.T) {
 		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
 
@@ -122,6 +153,111 @@ func TestClients(t *testing.T) {
 
 }
 
+func TestClientDataExportAndErasure(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+	// set day
+	advanceDaySuccess(e, pointer(0))
+
+	// create advertiser and campaign
+	advertiser := generateAdvertiser()
+	advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+	upsertAdvertisersSuccess(e, advertiser)
+
+	campaign := generateCampaign(advertiserId, helpers.JSON{})
+	campaign["start_date"] = 0
+	campaign["end_date"] = 10
+	campaign["impressions_limit"] = 1000
+	campaign["clicks_limit"] = 900
+	campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+	campaignId := uuid.MustParse(campaignIdStr)
+	t.Cleanup(func() {
+		deleteCapaignSuccess(e, advertiserId, campaignId)
+	})
+
+	// create client with ml score, impression and click
+	client := generateClient()
+	clientId := client["client_id"].(uuid.UUID)
+	upsertClientsSuccess(e, client)
+
+	mlScore := helpers.JSON{
+		"client_id":     clientId,
+		"advertiser_id": advertiserId,
+		"score":         10,
+	}
+	upsertMLScoreSuccess(e, mlScore)
+
+	getAdForClientSuccess(e, clientId).
+		JSON().
+		Object().
+		HasValue("ad_id", campaignId)
+
+	recordClickSuccess(e, campaignId, clientId)
+
+	t.Run("export client data", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		data := exportClientDataSuccess(e, clientId).JSON().Object()
+		data.Value("client").IsEqual(client)
+		data.Value("ml_scores").Array().IsEqual([]helpers.JSON{mlScore})
+
+		impressions := data.Value("impressions").Array()
+		impressions.Length().IsEqual(1)
+		impression := impressions.Value(0).Object()
+		impression.HasValue("campaign_id", campaignId)
+		impression.HasValue("date", 0)
+		impressionId := impression.Value("impression_id").String().Raw()
+
+		clicks := data.Value("clicks").Array()
+		clicks.Length().IsEqual(1)
+		clicks.Value(0).Object().
+			HasValue("impression_id", impressionId).
+			HasValue("campaign_id", campaignId).
+			HasValue("date", 0)
+	})
+
+	t.Run("erase client", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		statsWas := getCampaignStatsSuccess(e, campaignId).JSON().Object().Raw()
+
+		eraseClient(e, clientId).
+			Expect().
+			Status(http.StatusNoContent)
+
+		// stats are kept
+		getCampaignStatsSuccess(e, campaignId).JSON().Object().IsEqual(statsWas)
+
+		getClient(e, clientId).
+			Expect().
+			Status(http.StatusNotFound)
+
+		exportClientData(e, clientId).
+			Expect().
+			Status(http.StatusNotFound)
+
+		upsertMLScore(e, mlScore).
+			Expect().
+			Status(http.StatusNotFound)
+
+		eraseClient(e, clientId).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+
+	t.Run("erase non-existent client", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		eraseClient(e, uuid.New()).
+			Expect().
+			Status(http.StatusNotFound)
+	})
+}
+
 func generateClient() helpers.JSON {
 	return helpers.JSON{
 		"client_id": uuid.New(),
@@ -153,3 +289,17 @@ func getClientSuccess(e *httpexpect.Expect, id uuid.UUID) *httpexpect.Response {
 		Expect().
 		Status(http.StatusOK)
 }
+
+func exportClientData(e *httpexpect.Expect, id uuid.UUID) *httpexpect.Request {
+	return e.GET("/clients/{client_id}/export", id)
+}
+
+func exportClientDataSuccess(e *httpexpect.Expect, id uuid.UUID) *httpexpect.Response {
+	return exportClientData(e, id).
+		Expect().
+		Status(http.StatusOK)
+}
+
+func eraseClient(e *httpexpect.Expect, id uuid.UUID) *httpexpect.Request {
+	return e.DELETE("/clients/{client_id}", id)
+}
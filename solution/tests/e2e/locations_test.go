@@ -0,0 +1,143 @@
+package e2e
+
+import (
+	"advertising/tests/helpers"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/gavv/httpexpect/v2"
+	"github.com/google/uuid"
+)
+
+func TestLocations(t *testing.T) {
+	ctx := context.Background()
+	// advertisingServerUrl := helpers.SetUpInfrastructure(ctx, t, "../../advertising-service/migrations")
+	advertisingServerUrl := "http://localhost:8080"
+
+	t.Run("upsert locations hierarchy", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		country, region, city := generateLocationsHierarchy()
+
+		upsertLocationsSuccess(e, city, region, country).
+			JSON().
+			Array().
+			IsEqualUnordered([]helpers.JSON{country, region, city})
+
+		listLocations := e.GET("/locations").
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Array()
+		listLocations.ContainsAll(country, region, city)
+
+		// move city to country
+		city["parent"] = country["name"]
+		upsertLocationsSuccess(e, city).
+			JSON().
+			Array().
+			ContainsOnly(city)
+	})
+
+	t.Run("upsert location with unknown parent", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		upsertLocations(e, helpers.JSON{
+			"name":   gofakeit.UUID(),
+			"type":   "CITY",
+			"parent": gofakeit.UUID(),
+		}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("upsert country with parent", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		country, _, _ := generateLocationsHierarchy()
+		upsertLocationsSuccess(e, country)
+
+		upsertLocations(e, helpers.JSON{
+			"name":   gofakeit.UUID(),
+			"type":   "COUNTRY",
+			"parent": country["name"],
+		}).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
+
+	t.Run("get ad by hierarchical location targeting", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// set day
+		advanceDaySuccess(e, pointer(0))
+
+		country, region, city := generateLocationsHierarchy()
+		upsertLocationsSuccess(e, country, region, city)
+
+		// client from the city
+		client := generateClient()
+		client["location"] = city["name"]
+		upsertClientsSuccess(e, client)
+		clientId := client["client_id"].(uuid.UUID)
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+
+		// campaign targeted to the country among another location
+		campaign := generateCampaign(advertiserId, helpers.JSON{
+			"locations": []string{gofakeit.UUID(), country["name"].(string)},
+		})
+		campaign["start_date"] = 0
+		campaign["cost_per_impression"] = 100000
+		created := createCampaignSuccess(e, campaign).
+			JSON().
+			Object()
+		created.Value("targeting").
+			Object().
+			HasValue("locations", campaign["targeting"].(helpers.JSON)["locations"])
+		campaignId := uuid.MustParse(created.Value("campaign_id").String().Raw())
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+
+		getAdForClientSuccess(e, clientId).
+			JSON().
+			Object().
+			HasValue("ad_id", campaignId)
+	})
+}
+
+// generateLocationsHierarchy returns country, region of the country and city of the region
+func generateLocationsHierarchy() (helpers.JSON, helpers.JSON, helpers.JSON) {
+	country := helpers.JSON{
+		"name":   gofakeit.UUID(),
+		"type":   "COUNTRY",
+		"parent": nil,
+	}
+	region := helpers.JSON{
+		"name":   gofakeit.UUID(),
+		"type":   "REGION",
+		"parent": country["name"],
+	}
+	city := helpers.JSON{
+		"name":   gofakeit.UUID(),
+		"type":   "CITY",
+		"parent": region["name"],
+	}
+	return country, region, city
+}
+
+func upsertLocations(e *httpexpect.Expect, locations ...helpers.JSON) *httpexpect.Request {
+	return e.POST("/locations/bulk").
+		WithJSON(locations)
+}
+
+func upsertLocationsSuccess(e *httpexpect.Expect, locations ...helpers.JSON) *httpexpect.Response {
+	return upsertLocations(e, locations...).
+		Expect().
+		Status(http.StatusCreated)
+}
@@ -2,7 +2,10 @@ package e2e
 
 import (
 	"advertising/tests/helpers"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"fmt"
 	"math"
 	"net/http"
 	"testing"
@@ -94,6 +97,74 @@ func TestMlScores(t *testing.T) {
 			Expect().
 			Status(http.StatusNotFound)
 	})
+
+	t.Run("load ml_scores from gzip csv", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		client := generateClient()
+		upsertClientsSuccess(e, client)
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+
+		body := fmt.Sprintf(
+			"client_id,advertiser_id,score\n%s,%s,10\n%s,%s,20\n%s,%s,abc\n%s,%s,30\n",
+			client["client_id"], advertiser["advertiser_id"],
+			uuid.New(), advertiser["advertiser_id"],
+			client["client_id"], advertiser["advertiser_id"],
+			client["client_id"], advertiser["advertiser_id"],
+		)
+
+		var compressed bytes.Buffer
+		gw := gzip.NewWriter(&compressed)
+		gw.Write([]byte(body))
+		gw.Close()
+
+		report := loadMLScoresSuccess(e, "text/csv", compressed.Bytes()).
+			JSON().Object()
+		report.Value("loaded").IsEqual(2)
+		report.Value("failed").IsEqual(2)
+		report.Value("errors").Array().IsEqual([]helpers.JSON{
+			{"row": 2, "error": "client not found"},
+			{"row": 3, "error": "score must be integer"},
+		})
+	})
+
+	t.Run("load ml_scores from ndjson", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		client := generateClient()
+		upsertClientsSuccess(e, client)
+
+		advertiser := generateAdvertiser()
+		upsertAdvertisersSuccess(e, advertiser)
+
+		body := fmt.Sprintf(
+			"{\"client_id\":%q,\"advertiser_id\":%q,\"score\":10}\n"+
+				"{\"client_id\":%q,\"score\":20}\n"+
+				"{\"client_id\":%q,\"advertiser_id\":%q,\"score\":-1}\n",
+			client["client_id"], advertiser["advertiser_id"],
+			client["client_id"],
+			client["client_id"], advertiser["advertiser_id"],
+		)
+
+		report := loadMLScoresSuccess(e, "application/x-ndjson", []byte(body)).
+			JSON().Object()
+		report.Value("loaded").IsEqual(1)
+		report.Value("failed").IsEqual(2)
+		report.Value("errors").Array().IsEqual([]helpers.JSON{
+			{"row": 2, "error": "advertiser_id is required"},
+			{"row": 3, "error": "score must be not negative"},
+		})
+	})
+
+	t.Run("load ml_scores with invalid csv header", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		loadMLScores(e, "text/csv", []byte("client_id,score\n")).
+			Expect().
+			Status(http.StatusBadRequest)
+	})
 }
 
 func upsertMLScore(e *httpexpect.Expect, mlScore helpers.JSON) *httpexpect.Request {
@@ -105,3 +176,15 @@ func upsertMLScoreSuccess(e *httpexpect.Expect, mlScore helpers.JSON) *httpexpec
 		Expect().
 		Status(http.StatusOK)
 }
+
+func loadMLScores(e *httpexpect.Expect, contentType string, body []byte) *httpexpect.Request {
+	return e.POST("/ml-scores/bulk").
+		WithHeader("Content-Type", contentType).
+		WithBytes(body)
+}
+
+func loadMLScoresSuccess(e *httpexpect.Expect, contentType string, body []byte) *httpexpect.Response {
+	return loadMLScores(e, contentType, body).
+		Expect().
+		Status(http.StatusOK)
+}
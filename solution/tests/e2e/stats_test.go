@@ -86,12 +86,14 @@ func TestGetCampaignStats(t *testing.T) {
 
 		// check result
 		expected := helpers.JSON{
-			"impressions_count": 5,
-			"clicks_count":      4,
-			"conversion":        float64(4) / float64(5) * 100,
-			"spent_impressions": 5 * costPerImpression,
-			"spent_clicks":      4 * costPerClick,
-			"spent_total":       5*costPerImpression + 4*costPerClick,
+			"impressions_count":  5,
+			"clicks_count":       4,
+			"conversion":         float64(4) / float64(5) * 100,
+			"spent_impressions":  5 * costPerImpression,
+			"spent_clicks":       4 * costPerClick,
+			"spent_total":        5*costPerImpression + 4*costPerClick,
+			"spent_first_price":  5*costPerImpression + 4*costPerClick,
+			"spent_second_price": float64(0),
 		}
 
 		var actual helpers.JSON
@@ -134,7 +136,70 @@ func TestGetCampaignStats(t *testing.T) {
 			HasValue("conversion", 0).
 			HasValue("spent_impressions", 0).
 			HasValue("spent_clicks", 0).
-			HasValue("spent_total", 0)
+			HasValue("spent_total", 0).
+			HasValue("spent_first_price", 0).
+			HasValue("spent_second_price", 0).
+			HasValue("clicks_limit_reached", false)
+	})
+
+	t.Run("get campaign stats clicks limit reached", func(t *testing.T) {
+		e := helpers.ConfigureExpect(t, ctx, advertisingServerUrl)
+
+		// set day
+		advanceDaySuccess(e, pointer(0))
+
+		// create advertiser
+		advertiser := generateAdvertiser()
+		advertiserId := advertiser["advertiser_id"].(uuid.UUID)
+		upsertAdvertisersSuccess(e, advertiser)
+
+		// create campaign
+		campaign := generateCampaign(advertiserId, helpers.JSON{})
+		campaign["start_date"] = 0
+		campaign["end_date"] = 10
+		campaign["impressions_limit"] = 1000
+		campaign["clicks_limit"] = 1
+		campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
+		campaignId := uuid.MustParse(campaignIdStr)
+		t.Cleanup(func() {
+			deleteCapaignSuccess(e, advertiserId, campaignId)
+		})
+
+		costPerImpression := float64(campaign["cost_per_impression"].(float32))
+		costPerClick := float64(campaign["cost_per_click"].(float32))
+
+		// create clients
+		firstClient := generateClient()
+		firstClientId := firstClient["client_id"].(uuid.UUID)
+		secondClient := generateClient()
+		secondClientId := secondClient["client_id"].(uuid.UUID)
+		upsertClientsSuccess(e, firstClient, secondClient)
+
+		// both clients saw the ad, but only the first click is billed
+		getAdForClientSuccess(e, firstClientId)
+		getAdForClientSuccess(e, secondClientId)
+		recordClickSuccess(e, campaignId, firstClientId)
+		recordClickSuccess(e, campaignId, secondClientId)
+
+		// check result
+		expected := helpers.JSON{
+			"impressions_count":  2,
+			"clicks_count":       2,
+			"conversion":         float64(100),
+			"spent_impressions":  2 * costPerImpression,
+			"spent_clicks":       costPerClick,
+			"spent_total":        2*costPerImpression + costPerClick,
+			"spent_first_price":  2*costPerImpression + costPerClick,
+			"spent_second_price": float64(0),
+		}
+
+		var actual helpers.JSON
+		getCampaignStatsSuccess(e, campaignId).JSON().
+			IsObject().
+			Object().Decode(&actual)
+
+		checkStats(t, expected, actual)
+		assert.Equal(t, true, actual["clicks_limit_reached"])
 	})
 
 	t.Run("get stats for non-existent campaign", func(t *testing.T) {
@@ -164,8 +229,8 @@ func TestGetCampaignStatsDaily(t *testing.T) {
 
 		// create campaign
 		campaign := generateCampaign(advertiserId, helpers.JSON{})
-		campaign["start_date"] = 0
-		campaign["end_date"] = 10
+		campaign["start_date"] = 1
+		campaign["end_date"] = 3
 		campaign["impressions_limit"] = 1000
 		campaign["clicks_limit"] = 1000
 		campaignIdStr := createCampaignSuccess(e, campaign).JSON().Object().Value("campaign_id").String().Raw()
@@ -219,31 +284,37 @@ func TestGetCampaignStatsDaily(t *testing.T) {
 
 		expected := []helpers.JSON{
 			{
-				"date":              1,
-				"impressions_count": 2,
-				"clicks_count":      1,
-				"conversion":        float64(50),
-				"spent_impressions": float64(2) * costPerImpression,
-				"spent_clicks":      float64(1) * costPerClick,
-				"spent_total":       float64(2)*costPerImpression + float64(1)*costPerClick,
+				"date":               1,
+				"impressions_count":  2,
+				"clicks_count":       1,
+				"conversion":         float64(50),
+				"spent_impressions":  float64(2) * costPerImpression,
+				"spent_clicks":       float64(1) * costPerClick,
+				"spent_total":        float64(2)*costPerImpression + float64(1)*costPerClick,
+				"spent_first_price":  float64(2)*costPerImpression + float64(1)*costPerClick,
+				"spent_second_price": float64(0),
 			},
 			{
-				"date":              2,
-				"impressions_count": 1,
-				"clicks_count":      1,
-				"conversion":        float64(100),
-				"spent_impressions": costPerImpression,
-				"spent_clicks":      costPerClick,
-				"spent_total":       costPerImpression + costPerClick,
+				"date":               2,
+				"impressions_count":  1,
+				"clicks_count":       1,
+				"conversion":         float64(100),
+				"spent_impressions":  costPerImpression,
+				"spent_clicks":       costPerClick,
+				"spent_total":        costPerImpression + costPerClick,
+				"spent_first_price":  costPerImpression + costPerClick,
+				"spent_second_price": float64(0),
 			},
 			{
-				"date":              3,
-				"impressions_count": 2,
-				"clicks_count":      2,
-				"conversion":        float64(100),
-				"spent_impressions": float64(2) * costPerImpression,
-				"spent_clicks":      float64(2) * costPerClick,
-				"spent_total":       float64(2)*costPerImpression + float64(2)*costPerClick,
+				"date":               3,
+				"impressions_count":  2,
+				"clicks_count":       2,
+				"conversion":         float64(100),
+				"spent_impressions":  float64(2) * costPerImpression,
+				"spent_clicks":       float64(2) * costPerClick,
+				"spent_total":        float64(2)*costPerImpression + float64(2)*costPerClick,
+				"spent_first_price":  float64(2)*costPerImpression + float64(2)*costPerClick,
+				"spent_second_price": float64(0),
 			},
 		}
 
@@ -254,6 +325,14 @@ func TestGetCampaignStatsDaily(t *testing.T) {
 			IsArray().
 			Array().Decode(&actual)
 		checkStatsDaily(t, expected, actual)
+
+		// even pacing targets:
+		// day 1: 1000 impressions for 3 days
+		// day 2: 998 impressions for 2 days
+		// day 3: 997 impressions for 1 day
+		for i, target := range []int{334, 499, 997} {
+			require.EqualValues(t, target, actual[i]["impressions_target"])
+		}
 	})
 
 	t.Run("get campaign stats daily for campaign with no impressions", func(t *testing.T) {
@@ -279,12 +358,21 @@ func TestGetCampaignStatsDaily(t *testing.T) {
 			deleteCapaignSuccess(e, advertiserId, campaignId)
 		})
 
+		// every day of the campaign is returned with zero stats
+		var actual []helpers.JSON
 		getCampaignStatsDailySuccess(e, campaignId).
 			JSON().
 			IsArray().
-			Array().
-			Length().
-			IsEqual(0)
+			Array().Decode(&actual)
+		require.Len(t, actual, 11)
+		for i, dayStats := range actual {
+			require.EqualValues(t, i, dayStats["date"])
+			require.EqualValues(t, 0, dayStats["impressions_count"])
+			require.EqualValues(t, 0, dayStats["clicks_count"])
+		}
+
+		// even pacing target of the first day: 1000 impressions for 11 days
+		require.EqualValues(t, 91, actual[0]["impressions_target"])
 	})
 
 	t.Run("get daily stats for non-existent campaign", func(t *testing.T) {
@@ -439,12 +527,14 @@ func TestGetAdvertiserStats(t *testing.T) {
 		// check
 
 		expected := helpers.JSON{
-			"impressions_count": impressionsCount,
-			"clicks_count":      clicksCount,
-			"conversion":        conversion,
-			"spent_impressions": spentImpressions,
-			"spent_clicks":      spentClicks,
-			"spent_total":       spentTotal,
+			"impressions_count":  impressionsCount,
+			"clicks_count":       clicksCount,
+			"conversion":         conversion,
+			"spent_impressions":  spentImpressions,
+			"spent_clicks":       spentClicks,
+			"spent_total":        spentTotal,
+			"spent_first_price":  spentTotal,
+			"spent_second_price": float64(0),
 		}
 
 		var actual helpers.JSON
@@ -477,7 +567,9 @@ func TestGetAdvertiserStats(t *testing.T) {
 			HasValue("conversion", 0).
 			HasValue("spent_impressions", 0).
 			HasValue("spent_clicks", 0).
-			HasValue("spent_total", 0)
+			HasValue("spent_total", 0).
+			HasValue("spent_first_price", 0).
+			HasValue("spent_second_price", 0)
 	})
 
 	t.Run("get stats for non-existent advertiser", func(t *testing.T) {
@@ -548,31 +640,37 @@ func TestGetAdvertiserStatsDaily(t *testing.T) {
 
 		expected := []helpers.JSON{
 			{
-				"date":              1,
-				"impressions_count": 3,
-				"clicks_count":      1,
-				"conversion":        float64(1) / float64(3) * 100,
-				"spent_impressions": float64(0),
-				"spent_clicks":      float64(0),
-				"spent_total":       float64(0),
+				"date":               1,
+				"impressions_count":  3,
+				"clicks_count":       1,
+				"conversion":         float64(1) / float64(3) * 100,
+				"spent_impressions":  float64(0),
+				"spent_clicks":       float64(0),
+				"spent_total":        float64(0),
+				"spent_first_price":  float64(0),
+				"spent_second_price": float64(0),
 			},
 			{
-				"date":              2,
-				"impressions_count": 2,
-				"clicks_count":      2,
-				"conversion":        float64(100),
-				"spent_impressions": float64(0),
-				"spent_clicks":      float64(0),
-				"spent_total":       float64(0),
+				"date":               2,
+				"impressions_count":  2,
+				"clicks_count":       2,
+				"conversion":         float64(100),
+				"spent_impressions":  float64(0),
+				"spent_clicks":       float64(0),
+				"spent_total":        float64(0),
+				"spent_first_price":  float64(0),
+				"spent_second_price": float64(0),
 			},
 			{
-				"date":              3,
-				"impressions_count": 4,
-				"clicks_count":      3,
-				"conversion":        float64(3) / float64(4) * 100,
-				"spent_impressions": float64(0),
-				"spent_clicks":      float64(0),
-				"spent_total":       float64(0),
+				"date":               3,
+				"impressions_count":  4,
+				"clicks_count":       3,
+				"conversion":         float64(3) / float64(4) * 100,
+				"spent_impressions":  float64(0),
+				"spent_clicks":       float64(0),
+				"spent_total":        float64(0),
+				"spent_first_price":  float64(0),
+				"spent_second_price": float64(0),
 			},
 		}
 
@@ -756,6 +854,8 @@ func checkStats(t *testing.T, expected, actual helpers.JSON) {
 	assert.InDelta(t, expected["spent_impressions"], actual["spent_impressions"], accurancy)
 	assert.InDelta(t, expected["spent_clicks"], actual["spent_clicks"], accurancy)
 	assert.InDelta(t, expected["spent_total"], actual["spent_total"], accurancy)
+	assert.InDelta(t, expected["spent_first_price"], actual["spent_first_price"], accurancy)
+	assert.InDelta(t, expected["spent_second_price"], actual["spent_second_price"], accurancy)
 }
 
 func checkStatsDaily(t *testing.T, expected, actual []helpers.JSON) {